@@ -0,0 +1,163 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/rancher/go-rancher/api"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func (s *Server) FileRestoreSessionCreate(w http.ResponseWriter, req *http.Request) error {
+	var input FileRestoreSessionInput
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return err
+	}
+
+	obj := &longhorn.FileRestoreSession{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: input.Name,
+		},
+		Spec: longhorn.FileRestoreSessionSpec{
+			BackupName:   input.BackupName,
+			SourceVolume: input.SourceVolume,
+			SnapshotName: input.SnapshotName,
+			NodeID:       input.NodeID,
+			TTLMinutes:   input.TTLMinutes,
+		},
+	}
+	session, err := s.m.CreateFileRestoreSession(obj)
+	if err != nil {
+		return errors.Wrap(err, "failed to create FileRestoreSession")
+	}
+
+	apiContext.Write(toFileRestoreSessionResource(session))
+	return nil
+}
+
+func (s *Server) FileRestoreSessionDelete(w http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	if err := s.m.DeleteFileRestoreSession(name); err != nil {
+		return errors.Wrap(err, "failed to delete FileRestoreSession")
+	}
+	return nil
+}
+
+func (s *Server) FileRestoreSessionGet(w http.ResponseWriter, req *http.Request) error {
+	apiContext := api.GetApiContext(req)
+
+	name := mux.Vars(req)["name"]
+
+	session, err := s.m.GetFileRestoreSession(name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get FileRestoreSession '%s'", name)
+	}
+	apiContext.Write(toFileRestoreSessionResource(session))
+	return nil
+}
+
+func (s *Server) FileRestoreSessionList(w http.ResponseWriter, req *http.Request) error {
+	sessions, err := s.m.ListFileRestoreSessionsSorted()
+	if err != nil {
+		return errors.Wrap(err, "failed to list FileRestoreSessions")
+	}
+
+	apiContext := api.GetApiContext(req)
+	apiContext.Write(toFileRestoreSessionCollection(sessions))
+	return nil
+}
+
+func (s *Server) FileRestoreSessionListFiles(w http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	resp, err := s.requestFileRestoreServer(req, name, types.FileRestoreServerListPath)
+	if err != nil {
+		return err
+	}
+	defer closeFileRestoreServerResponse(resp)
+
+	var entries []types.FileRestoreEntry
+	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+		return errors.Wrapf(err, "failed to decode the file list of FileRestoreSession %v", name)
+	}
+
+	apiContext := api.GetApiContext(req)
+	apiContext.Write(toFileRestoreEntryCollection(entries))
+	return nil
+}
+
+func (s *Server) FileRestoreSessionDownload(w http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	resp, err := s.requestFileRestoreServer(req, name, types.FileRestoreServerDownloadPath)
+	if err != nil {
+		return err
+	}
+	defer closeFileRestoreServerResponse(resp)
+
+	for _, header := range []string{"Content-Disposition", "Content-Type", "Content-Length"} {
+		w.Header().Set(header, resp.Header.Get(header))
+	}
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return errors.Wrapf(err, "failed to download from FileRestoreSession %v", name)
+	}
+	return nil
+}
+
+// requestFileRestoreServer forwards the request for the path in the query to the file server of the ready session.
+func (s *Server) requestFileRestoreServer(req *http.Request, name, apiPath string) (*http.Response, error) {
+	session, err := s.m.GetFileRestoreSession(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get FileRestoreSession '%s'", name)
+	}
+	if session.Status.State != longhorn.FileRestoreSessionStateReady || session.Status.Endpoint == "" {
+		return nil, fmt.Errorf("FileRestoreSession %v is not ready", name)
+	}
+	token, err := s.m.GetFileRestoreSessionToken(name)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceURL := types.GetFileRestoreServerURL(session.Status.Endpoint, apiPath, req.URL.Query().Get(types.FileRestoreServerQueryPath))
+	newReq, err := http.NewRequestWithContext(req.Context(), http.MethodGet, sourceURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	newReq.Header.Set("Authorization", "Bearer "+token)
+
+	httpClient := http.Client{
+		Timeout: types.FileRestoreDownloadTimeout,
+	}
+
+	resp, err := httpClient.Do(newReq)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer closeFileRestoreServerResponse(resp)
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, fmt.Errorf("file server of FileRestoreSession %v responded %v: %v", name, resp.Status, strings.TrimSpace(string(message)))
+	}
+	return resp, nil
+}
+
+func closeFileRestoreServerResponse(resp *http.Response) {
+	if err := resp.Body.Close(); err != nil {
+		logrus.WithError(err).Warn("Failed to close file restore server response body")
+	}
+}
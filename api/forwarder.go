@@ -58,6 +58,18 @@ func OwnerIDFromBackupTarget(m *manager.VolumeManager) func(req *http.Request) (
 	}
 }
 
+// OwnerIDFromSupportBundle picks the owner node of the support bundle, which keeps the processed support bundle.
+func OwnerIDFromSupportBundle(m *manager.VolumeManager) func(req *http.Request) (string, error) {
+	return func(req *http.Request) (string, error) {
+		bundleName := mux.Vars(req)["bundleName"]
+		supportBundle, err := m.GetSupportBundleRO(bundleName)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get support bundle '%s'", bundleName)
+		}
+		return supportBundle.Status.OwnerID, nil
+	}
+}
+
 // NodeHasDefaultEngineImage picks a node that is ready and has default engine image deployed.
 // To prevent the repeatedly forwarding the request around, prioritize the current node if it meets the requirement.
 func NodeHasDefaultEngineImage(m *manager.VolumeManager) func(req *http.Request) (string, error) {
@@ -81,6 +93,17 @@ func NodeHasDefaultEngineImage(m *manager.VolumeManager) func(req *http.Request)
 	}
 }
 
+func OwnerIDFromVolumeTransfer(m *manager.VolumeManager) func(req *http.Request) (string, error) {
+	return func(req *http.Request) (string, error) {
+		name := mux.Vars(req)["name"]
+		transfer, err := m.GetVolumeTransfer(name)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get volume transfer '%s'", name)
+		}
+		return transfer.Status.OwnerID, nil
+	}
+}
+
 func OwnerIDFromNode(m *manager.VolumeManager) func(req *http.Request) (string, error) {
 	return func(req *http.Request) (string, error) {
 		id := mux.Vars(req)["name"]
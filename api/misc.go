@@ -53,6 +53,18 @@ func (s *Server) NodeTagList(rw http.ResponseWriter, req *http.Request) error {
 	return nil
 }
 
+func (s *Server) ClusterCapacityForecastGet(rw http.ResponseWriter, req *http.Request) error {
+	apiContext := api.GetApiContext(req)
+
+	forecast, err := s.m.GetClusterCapacityForecast()
+	if err != nil {
+		return errors.Wrap(err, "failed to get cluster capacity forecast")
+	}
+
+	apiContext.Write(toClusterCapacityForecastResource(forecast))
+	return nil
+}
+
 func (s *Server) InstanceManagerGet(rw http.ResponseWriter, req *http.Request) error {
 	id := mux.Vars(req)["name"]
 	apiContext := api.GetApiContext(req)
@@ -18,6 +18,7 @@ import (
 	"github.com/longhorn/longhorn-manager/manager"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/util/capacityforecast"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -64,18 +65,25 @@ type Volume struct {
 	ReplicaRebuildingBandwidthLimit int64                                  `json:"replicaRebuildingBandwidthLimit"`
 	FreezeFilesystemForSnapshot     longhorn.FreezeFilesystemForSnapshot   `json:"freezeFilesystemForSnapshot"`
 	BackupTargetName                string                                 `json:"backupTargetName"`
+	RecoveryPointObjective          int                                    `json:"recoveryPointObjective"`
 
-	DiskSelector         []string                      `json:"diskSelector"`
-	NodeSelector         []string                      `json:"nodeSelector"`
-	RecurringJobSelector []longhorn.VolumeRecurringJob `json:"recurringJobSelector"`
+	DiskSelector         []string                            `json:"diskSelector"`
+	NodeSelector         []string                            `json:"nodeSelector"`
+	RecurringJobSelector []longhorn.VolumeRecurringJob       `json:"recurringJobSelector"`
+	TieringPolicy        *longhorn.VolumeTieringPolicy       `json:"tieringPolicy"`
+	AutoExpansionPolicy  *longhorn.VolumeAutoExpansionPolicy `json:"autoExpansionPolicy"`
 
 	NumberOfReplicas   int                         `json:"numberOfReplicas"`
 	ReplicaAutoBalance longhorn.ReplicaAutoBalance `json:"replicaAutoBalance"`
 
-	Conditions       map[string]longhorn.Condition `json:"conditions"`
-	KubernetesStatus longhorn.KubernetesStatus     `json:"kubernetesStatus"`
-	CloneStatus      longhorn.VolumeCloneStatus    `json:"cloneStatus"`
-	Ready            bool                          `json:"ready"`
+	Conditions       map[string]longhorn.Condition       `json:"conditions"`
+	KubernetesStatus longhorn.KubernetesStatus           `json:"kubernetesStatus"`
+	CloneStatus      longhorn.VolumeCloneStatus          `json:"cloneStatus"`
+	Tiering          *longhorn.VolumeTieringStatus       `json:"tiering"`
+	RecoveryPoint    *longhorn.VolumeRecoveryPointStatus `json:"recoveryPoint"`
+	FilesystemUsage  *longhorn.VolumeFilesystemUsage     `json:"filesystemUsage"`
+	Activity         *longhorn.VolumeActivityStatus      `json:"activity"`
+	Ready            bool                                `json:"ready"`
 
 	AccessMode        longhorn.AccessMode              `json:"accessMode"`
 	ShareEndpoint     string                           `json:"shareEndpoint"`
@@ -246,6 +254,10 @@ type Attachment struct {
 	AttachmentType string            `json:"attachmentType"`
 	NodeID         string            `json:"nodeID"`
 	Parameters     map[string]string `json:"parameters"`
+	Priority       int               `json:"priority"`
+	Reservation    bool              `json:"reservation"`
+	ExpiresAt      string            `json:"expiresAt"`
+	Reason         string            `json:"reason"`
 	// Indicate whether this attachment ticket has been satisfied
 	Satisfied  bool                 `json:"satisfied"`
 	Conditions []longhorn.Condition `json:"conditions"`
@@ -312,6 +324,20 @@ type AttachInput struct {
 	AttachmentID    string `json:"attachmentID"`
 }
 
+type AttachmentTicketInput struct {
+	AttachmentID    string `json:"attachmentID"`
+	NodeID          string `json:"nodeID"`
+	Priority        int    `json:"priority"`
+	TTLSeconds      int64  `json:"ttlSeconds"`
+	Reason          string `json:"reason"`
+	Reservation     bool   `json:"reservation"`
+	DisableFrontend bool   `json:"disableFrontend"`
+}
+
+type AttachmentTicketDeleteInput struct {
+	AttachmentID string `json:"attachmentID"`
+}
+
 type DetachInput struct {
 	AttachmentID string `json:"attachmentID"`
 	HostID       string `json:"hostId"`
@@ -377,6 +403,47 @@ type UpdateReplicaRebuildingBandwidthLimitInput struct {
 	ReplicaRebuildingBandwidthLimit string `json:"replicaRebuildingBandwidthLimit"`
 }
 
+type UpdateTieringPolicyInput struct {
+	DiskSelector   []string `json:"diskSelector"`
+	Trigger        string   `json:"trigger"`
+	Schedule       string   `json:"schedule"`
+	ThresholdHours int      `json:"thresholdHours"`
+	BandwidthLimit string   `json:"bandwidthLimit"`
+}
+
+type UpdateRecoveryPointObjectiveInput struct {
+	RecoveryPointObjective int `json:"recoveryPointObjective"`
+}
+
+type UpdateAutoExpansionPolicyInput struct {
+	UsageThreshold int    `json:"usageThreshold"`
+	StepSize       string `json:"stepSize"`
+	MaxSize        string `json:"maxSize"`
+}
+
+type IdleVolume struct {
+	client.Resource
+
+	Name             string               `json:"name"`
+	State            longhorn.VolumeState `json:"state"`
+	Size             string               `json:"size"`
+	ActualSize       int64                `json:"actualSize"`
+	NumberOfReplicas int                  `json:"numberOfReplicas"`
+	PVName           string               `json:"pvName"`
+	PVCName          string               `json:"pvcName"`
+	Namespace        string               `json:"namespace"`
+	LastActiveAt     string               `json:"lastActiveAt"`
+	LastIOAt         string               `json:"lastIOAt"`
+	LastAttachedAt   string               `json:"lastAttachedAt"`
+	LastAttachedBy   string               `json:"lastAttachedBy"`
+	IdleSeconds      int64                `json:"idleSeconds"`
+}
+
+type UpdateFilesystemUsageInput struct {
+	UsedBytes  int64 `json:"usedBytes"`
+	TotalBytes int64 `json:"totalBytes"`
+}
+
 type UpdateBackupCompressionMethodInput struct {
 	BackupCompressionMethod string `json:"backupCompressionMethod"`
 }
@@ -457,6 +524,8 @@ type Node struct {
 	Zone                      string                        `json:"zone"`
 	InstanceManagerCPURequest int                           `json:"instanceManagerCPURequest"`
 	AutoEvicting              bool                          `json:"autoEvicting"`
+	SettingOverrides          map[string]string             `json:"settingOverrides"`
+	CapacityForecast          *longhorn.CapacityForecast    `json:"capacityForecast"`
 }
 
 type DiskStatus struct {
@@ -466,7 +535,10 @@ type DiskStatus struct {
 	StorageMaximum        int64                         `json:"storageMaximum"`
 	ScheduledReplica      map[string]int64              `json:"scheduledReplica"`
 	ScheduledBackingImage map[string]int64              `json:"scheduledBackingImage"`
+	ReplicaCount          int                           `json:"replicaCount"`
+	ReplicaCountLimit     int                           `json:"replicaCountLimit"`
 	DiskUUID              string                        `json:"diskUUID"`
+	CapacityForecast      *longhorn.CapacityForecast    `json:"capacityForecast"`
 }
 
 type DiskInfo struct {
@@ -476,6 +548,11 @@ type DiskInfo struct {
 	DiskStatus
 }
 
+type ClusterCapacityForecast struct {
+	client.Resource
+	longhorn.CapacityForecast
+}
+
 type DiskUpdateInput struct {
 	Disks map[string]longhorn.DiskSpec `json:"disks"`
 }
@@ -498,6 +575,16 @@ type SupportBundle struct {
 type SupportBundleInitateInput struct {
 	IssueURL    string `json:"issueURL"`
 	Description string `json:"description"`
+
+	VolumeNames []string `json:"volumeNames"`
+	NodeNames   []string `json:"nodeNames"`
+	Since       string   `json:"since"`
+	Until       string   `json:"until"`
+
+	RedactSecrets                 bool `json:"redactSecrets"`
+	RedactBackupTargetCredentials bool `json:"redactBackupTargetCredentials"`
+	RedactIPAddresses             bool `json:"redactIPAddresses"`
+	RedactHostnames               bool `json:"redactHostnames"`
 }
 
 type SystemBackup struct {
@@ -518,6 +605,61 @@ type SystemBackupInput struct {
 	VolumeBackupPolicy longhorn.SystemBackupCreateVolumeBackupPolicy `json:"volumeBackupPolicy"`
 }
 
+type FileRestoreSession struct {
+	client.Resource
+
+	Name         string `json:"name"`
+	BackupName   string `json:"backupName"`
+	SourceVolume string `json:"sourceVolume"`
+	SnapshotName string `json:"snapshotName"`
+	NodeID       string `json:"nodeID"`
+	TTLMinutes   int    `json:"ttlMinutes"`
+
+	State      longhorn.FileRestoreSessionState `json:"state,omitempty"`
+	VolumeName string                           `json:"volumeName,omitempty"`
+	Endpoint   string                           `json:"endpoint,omitempty"`
+	ExpiresAt  string                           `json:"expiresAt,omitempty"`
+	CreatedAt  string                           `json:"createdAt,omitempty"`
+	Message    string                           `json:"message,omitempty"`
+}
+
+type FileRestoreSessionInput struct {
+	Name         string `json:"name"`
+	BackupName   string `json:"backupName"`
+	SourceVolume string `json:"sourceVolume"`
+	SnapshotName string `json:"snapshotName"`
+	NodeID       string `json:"nodeID"`
+	TTLMinutes   int    `json:"ttlMinutes"`
+}
+
+type FileRestoreEntry struct {
+	client.Resource
+
+	Name        string `json:"name"`
+	Path        string `json:"path"`
+	Size        int64  `json:"size"`
+	Mode        string `json:"mode"`
+	IsDirectory bool   `json:"isDirectory"`
+	ModifiedAt  string `json:"modifiedAt"`
+}
+
+type SettingHistory struct {
+	client.Resource
+	Name            string                     `json:"name"`
+	SettingName     string                     `json:"settingName"`
+	CurrentRevision int64                      `json:"currentRevision"`
+	Revisions       []longhorn.SettingRevision `json:"revisions"`
+}
+
+type SettingRollbackInput struct {
+	Name     string `json:"name"`
+	Revision int64  `json:"revision"`
+}
+
+type SettingsRollbackInput struct {
+	Settings []SettingRollbackInput `json:"settings"`
+}
+
 type SystemRestore struct {
 	client.Resource
 	Name         string                      `json:"name"`
@@ -594,6 +736,8 @@ type InstanceManager struct {
 	InstanceReplicas map[string]longhorn.InstanceProcess `json:"instanceReplicas"`
 
 	Instances map[string]longhorn.InstanceProcess `json:"instances"`
+
+	ResourceRecommendation *longhorn.InstanceManagerResourceRecommendation `json:"resourceRecommendation"`
 }
 
 type RecurringJob struct {
@@ -650,6 +794,8 @@ func NewSchema() *client.Schemas {
 	schemas.AddType("error", client.ServerApiError{})
 	schemas.AddType("attachInput", AttachInput{})
 	schemas.AddType("detachInput", DetachInput{})
+	schemas.AddType("attachmentTicketInput", AttachmentTicketInput{})
+	schemas.AddType("attachmentTicketDeleteInput", AttachmentTicketDeleteInput{})
 	schemas.AddType("snapshotInput", SnapshotInput{})
 	schemas.AddType("snapshotCRInput", SnapshotCRInput{})
 	schemas.AddType("backup", Backup{})
@@ -676,6 +822,18 @@ func NewSchema() *client.Schemas {
 	schemas.AddType("UpdateSnapshotMaxCountInput", UpdateSnapshotMaxCountInput{})
 	schemas.AddType("UpdateSnapshotMaxSizeInput", UpdateSnapshotMaxSizeInput{})
 	schemas.AddType("UpdateReplicaRebuildingBandwidthLimitInput", UpdateReplicaRebuildingBandwidthLimitInput{})
+	schemas.AddType("UpdateTieringPolicyInput", UpdateTieringPolicyInput{})
+	schemas.AddType("volumeTieringPolicy", longhorn.VolumeTieringPolicy{})
+	schemas.AddType("volumeTieringStatus", longhorn.VolumeTieringStatus{})
+	schemas.AddType("UpdateRecoveryPointObjectiveInput", UpdateRecoveryPointObjectiveInput{})
+	schemas.AddType("volumeRecoveryPointStatus", longhorn.VolumeRecoveryPointStatus{})
+	schemas.AddType("UpdateAutoExpansionPolicyInput", UpdateAutoExpansionPolicyInput{})
+	schemas.AddType("UpdateFilesystemUsageInput", UpdateFilesystemUsageInput{})
+	schemas.AddType("volumeAutoExpansionPolicy", longhorn.VolumeAutoExpansionPolicy{})
+	schemas.AddType("volumeFilesystemUsage", longhorn.VolumeFilesystemUsage{})
+	schemas.AddType("volumeIOStats", longhorn.VolumeIOStats{})
+	volumeActivityStatusSchema(schemas.AddType("volumeActivityStatus", longhorn.VolumeActivityStatus{}))
+	schemas.AddType("idleVolume", IdleVolume{})
 	schemas.AddType("UpdateBackupCompressionInput", UpdateBackupCompressionMethodInput{})
 	schemas.AddType("UpdateUnmapMarkSnapChainRemovedInput", UpdateUnmapMarkSnapChainRemovedInput{})
 	schemas.AddType("UpdateReplicaSoftAntiAffinityInput", UpdateReplicaSoftAntiAffinityInput{})
@@ -695,6 +853,9 @@ func NewSchema() *client.Schemas {
 	schemas.AddType("PVCCreateInput", PVCCreateInput{})
 
 	schemas.AddType("settingDefinition", types.SettingDefinition{})
+	schemas.AddType("settingRevision", longhorn.SettingRevision{})
+	schemas.AddType("settingRollbackInput", SettingRollbackInput{})
+	settingsRollbackInputSchema(schemas.AddType("settingsRollbackInput", SettingsRollbackInput{}))
 	// to avoid duplicate name with built-in type condition
 	schemas.AddType("volumeCondition", longhorn.Condition{})
 	schemas.AddType("nodeCondition", longhorn.Condition{})
@@ -707,8 +868,12 @@ func NewSchema() *client.Schemas {
 
 	schemas.AddType("tag", Tag{})
 
+	schemas.AddType("capacityForecast", longhorn.CapacityForecast{})
+	schemas.AddType("clusterCapacityForecast", ClusterCapacityForecast{})
+
 	schemas.AddType("instanceManager", InstanceManager{})
 	schemas.AddType("instanceProcess", longhorn.InstanceProcess{})
+	schemas.AddType("instanceManagerResourceRecommendation", longhorn.InstanceManagerResourceRecommendation{})
 
 	schemas.AddType("backingImageDiskFileStatus", longhorn.BackingImageDiskFileStatus{})
 	schemas.AddType("backingImageCleanupInput", BackingImageCleanupInput{})
@@ -724,6 +889,7 @@ func NewSchema() *client.Schemas {
 	backupVolumeSchema(schemas.AddType("backupVolume", BackupVolume{}))
 	backupBackingImageSchema(schemas.AddType("backupBackingImage", BackupBackingImage{}))
 	settingSchema(schemas.AddType("setting", Setting{}))
+	settingHistorySchema(schemas.AddType("settingHistory", SettingHistory{}))
 	recurringJobSchema(schemas.AddType("recurringJob", RecurringJob{}))
 	engineImageSchema(schemas.AddType("engineImage", EngineImage{}))
 	backingImageSchema(schemas.AddType("backingImage", BackingImage{}))
@@ -737,6 +903,8 @@ func NewSchema() *client.Schemas {
 	snapshotListOutputSchema(schemas.AddType("snapshotListOutput", SnapshotListOutput{}))
 	systemBackupSchema(schemas.AddType("systemBackup", SystemBackup{}))
 	systemRestoreSchema(schemas.AddType("systemRestore", SystemRestore{}))
+	fileRestoreSessionSchema(schemas.AddType("fileRestoreSession", FileRestoreSession{}))
+	schemas.AddType("fileRestoreEntry", FileRestoreEntry{})
 	snapshotCRListOutputSchema(schemas.AddType("snapshotCRListOutput", SnapshotCRListOutput{}))
 
 	return schemas
@@ -775,6 +943,11 @@ func nodeSchema(node *client.Schema) {
 	tags := node.ResourceFields["tags"]
 	tags.Create = true
 	node.ResourceFields["tags"] = tags
+
+	settingOverrides := node.ResourceFields["settingOverrides"]
+	settingOverrides.Type = "map[string]"
+	settingOverrides.Nullable = true
+	node.ResourceFields["settingOverrides"] = settingOverrides
 }
 
 func diskSchema(diskUpdateInput *client.Schema) {
@@ -898,6 +1071,16 @@ func recurringJobSchema(job *client.Schema) {
 	job.ResourceFields["parameters"] = parameters
 }
 
+func volumeActivityStatusSchema(activity *client.Schema) {
+	io := activity.ResourceFields["io"]
+	io.Type = "volumeIOStats"
+	activity.ResourceFields["io"] = io
+
+	observedEngineIO := activity.ResourceFields["observedEngineIO"]
+	observedEngineIO.Type = "volumeIOStats"
+	activity.ResourceFields["observedEngineIO"] = observedEngineIO
+}
+
 func kubernetesStatusSchema(status *client.Schema) {
 	workloadsStatus := status.ResourceFields["workloadsStatus"]
 	workloadsStatus.Type = "array[workloadStatus]"
@@ -985,6 +1168,33 @@ func settingSchema(setting *client.Schema) {
 		Type:     "settingDefinition",
 		Nullable: false,
 	}
+
+	setting.ResourceActions = map[string]client.Action{
+		"rollback": {
+			Input:  "settingRollbackInput",
+			Output: "setting",
+		},
+	}
+	setting.CollectionActions = map[string]client.Action{
+		"rollback": {
+			Input: "settingsRollbackInput",
+		},
+	}
+}
+
+func settingsRollbackInputSchema(input *client.Schema) {
+	settings := input.ResourceFields["settings"]
+	settings.Type = "array[settingRollbackInput]"
+	input.ResourceFields["settings"] = settings
+}
+
+func settingHistorySchema(settingHistory *client.Schema) {
+	settingHistory.CollectionMethods = []string{"GET"}
+	settingHistory.ResourceMethods = []string{"GET"}
+
+	revisions := settingHistory.ResourceFields["revisions"]
+	revisions.Type = "array[settingRevision]"
+	settingHistory.ResourceFields["revisions"] = revisions
 }
 
 func volumeSchema(volume *client.Schema) {
@@ -1111,6 +1321,26 @@ func volumeSchema(volume *client.Schema) {
 			Input: "UpdateReplicaRebuildingBandwidthLimitInput",
 		},
 
+		"updateTieringPolicy": {
+			Input: "UpdateTieringPolicyInput",
+		},
+
+		"removeTieringPolicy": {},
+
+		"updateRecoveryPointObjective": {
+			Input: "UpdateRecoveryPointObjectiveInput",
+		},
+
+		"updateAutoExpansionPolicy": {
+			Input: "UpdateAutoExpansionPolicyInput",
+		},
+
+		"removeAutoExpansionPolicy": {},
+
+		"updateFilesystemUsage": {
+			Input: "UpdateFilesystemUsageInput",
+		},
+
 		"updateBackupCompressionMethod": {
 			Input: "UpdateBackupCompressionMethodInput",
 		},
@@ -1294,6 +1524,30 @@ func volumeSchema(volume *client.Schema) {
 	cloneStatus.Type = "cloneStatus"
 	volume.ResourceFields["cloneStatus"] = cloneStatus
 
+	tieringPolicy := volume.ResourceFields["tieringPolicy"]
+	tieringPolicy.Type = "volumeTieringPolicy"
+	volume.ResourceFields["tieringPolicy"] = tieringPolicy
+
+	tiering := volume.ResourceFields["tiering"]
+	tiering.Type = "volumeTieringStatus"
+	volume.ResourceFields["tiering"] = tiering
+
+	recoveryPoint := volume.ResourceFields["recoveryPoint"]
+	recoveryPoint.Type = "volumeRecoveryPointStatus"
+	volume.ResourceFields["recoveryPoint"] = recoveryPoint
+
+	autoExpansionPolicy := volume.ResourceFields["autoExpansionPolicy"]
+	autoExpansionPolicy.Type = "volumeAutoExpansionPolicy"
+	volume.ResourceFields["autoExpansionPolicy"] = autoExpansionPolicy
+
+	filesystemUsage := volume.ResourceFields["filesystemUsage"]
+	filesystemUsage.Type = "volumeFilesystemUsage"
+	volume.ResourceFields["filesystemUsage"] = filesystemUsage
+
+	activity := volume.ResourceFields["activity"]
+	activity.Type = "volumeActivityStatus"
+	volume.ResourceFields["activity"] = activity
+
 	backupStatus := volume.ResourceFields["backupStatus"]
 	backupStatus.Type = "array[backupStatus]"
 	volume.ResourceFields["backupStatus"] = backupStatus
@@ -1378,6 +1632,17 @@ func systemRestoreSchema(systemRestore *client.Schema) {
 	systemRestore.ResourceFields["systemBackup"] = systemBackup
 }
 
+func fileRestoreSessionSchema(fileRestoreSession *client.Schema) {
+	fileRestoreSession.CollectionMethods = []string{"GET", "POST"}
+	fileRestoreSession.ResourceMethods = []string{"GET", "DELETE"}
+
+	name := fileRestoreSession.ResourceFields["name"]
+	name.Required = true
+	name.Unique = true
+	name.Create = true
+	fileRestoreSession.ResourceFields["name"] = name
+}
+
 func snapshotCRListOutputSchema(snapshotList *client.Schema) {
 	data := snapshotList.ResourceFields["data"]
 	data.Type = "array[snapshotCR]"
@@ -1394,6 +1659,17 @@ func volumeAttachmentSchema(volumeAttachment *client.Schema) {
 	attachments := volumeAttachment.ResourceFields["attachments"]
 	attachments.Type = "map[attachment]"
 	volumeAttachment.ResourceFields["attachments"] = attachments
+
+	volumeAttachment.ResourceActions = map[string]client.Action{
+		"attachmentTicketCreate": {
+			Input:  "attachmentTicketInput",
+			Output: "volumeAttachment",
+		},
+		"attachmentTicketDelete": {
+			Input:  "attachmentTicketDeleteInput",
+			Output: "volumeAttachment",
+		},
+	}
 }
 
 func toEmptyResource() *Empty {
@@ -1421,6 +1697,27 @@ func toSettingResource(setting *longhorn.Setting) *Setting {
 	}
 }
 
+func toSettingHistoryResource(history *longhorn.SettingHistory) *SettingHistory {
+	return &SettingHistory{
+		Resource: client.Resource{
+			Id:   history.Name,
+			Type: "settingHistory",
+		},
+		Name:            history.Name,
+		SettingName:     history.Spec.SettingName,
+		CurrentRevision: history.Status.CurrentRevision,
+		Revisions:       history.Status.Revisions,
+	}
+}
+
+func toSettingHistoryCollection(histories []*longhorn.SettingHistory) *client.GenericCollection {
+	data := []interface{}{}
+	for _, history := range histories {
+		data = append(data, toSettingHistoryResource(history))
+	}
+	return &client.GenericCollection{Data: data, Collection: client.Collection{ResourceType: "settingHistory"}}
+}
+
 func toSettingCollection(settings []*longhorn.Setting) *client.GenericCollection {
 	data := []interface{}{}
 	for _, setting := range settings {
@@ -1572,12 +1869,7 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 	if lhVolumeAttachment != nil {
 		for k, v := range lhVolumeAttachment.Spec.AttachmentTickets {
 			if v != nil {
-				volumeAttachment.Attachments[k] = Attachment{
-					AttachmentID:   v.ID,
-					AttachmentType: string(v.Type),
-					NodeID:         v.NodeID,
-					Parameters:     v.Parameters,
-				}
+				volumeAttachment.Attachments[k] = toAttachmentResource(v)
 			}
 		}
 		for k, v := range lhVolumeAttachment.Status.AttachmentTicketStatuses {
@@ -1644,9 +1936,12 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 		Standby:                         v.Spec.Standby,
 		DiskSelector:                    v.Spec.DiskSelector,
 		NodeSelector:                    v.Spec.NodeSelector,
+		TieringPolicy:                   v.Spec.TieringPolicy,
+		AutoExpansionPolicy:             v.Spec.AutoExpansionPolicy,
 		RestoreVolumeRecurringJob:       v.Spec.RestoreVolumeRecurringJob,
 		FreezeFilesystemForSnapshot:     v.Spec.FreezeFilesystemForSnapshot,
 		BackupTargetName:                v.Spec.BackupTargetName,
+		RecoveryPointObjective:          v.Spec.RecoveryPointObjective,
 
 		State:                       v.Status.State,
 		Robustness:                  v.Status.Robustness,
@@ -1675,6 +1970,10 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 		Conditions:       sliceToMap(v.Status.Conditions),
 		KubernetesStatus: v.Status.KubernetesStatus,
 		CloneStatus:      v.Status.CloneStatus,
+		Tiering:          v.Status.Tiering,
+		RecoveryPoint:    v.Status.RecoveryPoint,
+		FilesystemUsage:  v.Status.FilesystemUsage,
+		Activity:         v.Status.Activity,
 
 		Controllers:      controllers,
 		Replicas:         replicas,
@@ -1720,6 +2019,11 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 			actions["updateSnapshotMaxCount"] = struct{}{}
 			actions["updateSnapshotMaxSize"] = struct{}{}
 			actions["updateReplicaRebuildingBandwidthLimit"] = struct{}{}
+			actions["updateTieringPolicy"] = struct{}{}
+			actions["removeTieringPolicy"] = struct{}{}
+			actions["updateRecoveryPointObjective"] = struct{}{}
+			actions["updateAutoExpansionPolicy"] = struct{}{}
+			actions["removeAutoExpansionPolicy"] = struct{}{}
 			actions["updateBackupCompressionMethod"] = struct{}{}
 			actions["updateReplicaSoftAntiAffinity"] = struct{}{}
 			actions["updateReplicaZoneSoftAntiAffinity"] = struct{}{}
@@ -1755,6 +2059,12 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 			actions["updateSnapshotMaxCount"] = struct{}{}
 			actions["updateSnapshotMaxSize"] = struct{}{}
 			actions["updateReplicaRebuildingBandwidthLimit"] = struct{}{}
+			actions["updateTieringPolicy"] = struct{}{}
+			actions["removeTieringPolicy"] = struct{}{}
+			actions["updateRecoveryPointObjective"] = struct{}{}
+			actions["updateAutoExpansionPolicy"] = struct{}{}
+			actions["removeAutoExpansionPolicy"] = struct{}{}
+			actions["updateFilesystemUsage"] = struct{}{}
 			actions["updateBackupCompressionMethod"] = struct{}{}
 			actions["updateReplicaSoftAntiAffinity"] = struct{}{}
 			actions["updateReplicaZoneSoftAntiAffinity"] = struct{}{}
@@ -2187,6 +2497,8 @@ func toNodeResource(node *longhorn.Node, address string, apiContext *api.ApiCont
 		Zone:                      node.Status.Zone,
 		InstanceManagerCPURequest: node.Spec.InstanceManagerCPURequest,
 		AutoEvicting:              node.Status.AutoEvicting,
+		SettingOverrides:          node.Spec.SettingOverrides,
+		CapacityForecast:          node.Status.CapacityForecast,
 	}
 
 	disks := map[string]DiskInfo{}
@@ -2202,7 +2514,10 @@ func toNodeResource(node *longhorn.Node, address string, apiContext *api.ApiCont
 				StorageMaximum:        node.Status.DiskStatus[name].StorageMaximum,
 				ScheduledReplica:      node.Status.DiskStatus[name].ScheduledReplica,
 				ScheduledBackingImage: node.Status.DiskStatus[name].ScheduledBackingImage,
+				ReplicaCount:          node.Status.DiskStatus[name].ReplicaCount,
+				ReplicaCountLimit:     node.Status.DiskStatus[name].ReplicaCountLimit,
 				DiskUUID:              node.Status.DiskStatus[name].DiskUUID,
+				CapacityForecast:      node.Status.DiskStatus[name].CapacityForecast,
 			}
 		}
 		disks[name] = di
@@ -2224,6 +2539,59 @@ func toNodeCollection(nodeList []*longhorn.Node, nodeIPMap map[string]string, ap
 	return &client.GenericCollection{Data: data, Collection: client.Collection{ResourceType: "node"}}
 }
 
+func toIdleVolumeResource(v *longhorn.Volume, now time.Time) *IdleVolume {
+	idleVolume := &IdleVolume{
+		Resource: client.Resource{
+			Id:    v.Name,
+			Type:  "idleVolume",
+			Links: map[string]string{},
+		},
+		Name:             v.Name,
+		State:            v.Status.State,
+		Size:             strconv.FormatInt(v.Spec.Size, 10),
+		ActualSize:       v.Status.ActualSize,
+		NumberOfReplicas: v.Spec.NumberOfReplicas,
+		PVName:           v.Status.KubernetesStatus.PVName,
+		PVCName:          v.Status.KubernetesStatus.PVCName,
+		Namespace:        v.Status.KubernetesStatus.Namespace,
+		LastActiveAt:     types.GetVolumeLastActiveAt(v).UTC().Format(time.RFC3339),
+		IdleSeconds:      int64(types.GetVolumeIdleDuration(v, now).Seconds()),
+	}
+	if v.Status.Activity != nil {
+		idleVolume.LastIOAt = v.Status.Activity.IO.LastIOAt
+		idleVolume.LastAttachedAt = v.Status.Activity.LastAttachedAt
+		idleVolume.LastAttachedBy = v.Status.Activity.LastAttachedBy
+	}
+	return idleVolume
+}
+
+func toIdleVolumeCollection(volumes []*longhorn.Volume) *client.GenericCollection {
+	now := time.Now()
+	data := []interface{}{}
+	for _, v := range volumes {
+		data = append(data, toIdleVolumeResource(v, now))
+	}
+	return &client.GenericCollection{Data: data, Collection: client.Collection{ResourceType: "idleVolume"}}
+}
+
+func toClusterCapacityForecastResource(forecast *longhorn.CapacityForecast) *ClusterCapacityForecast {
+	f := &ClusterCapacityForecast{
+		Resource: client.Resource{
+			Id:    "cluster",
+			Type:  "clusterCapacityForecast",
+			Links: map[string]string{},
+		},
+		CapacityForecast: longhorn.CapacityForecast{
+			DaysUntilFull:                  capacityforecast.DaysNotGrowing,
+			DaysUntilOverProvisioningLimit: capacityforecast.DaysNotGrowing,
+		},
+	}
+	if forecast != nil {
+		f.CapacityForecast = *forecast
+	}
+	return f
+}
+
 func toEventResource(event corev1.Event) *Event {
 	e := &Event{
 		Resource: client.Resource{
@@ -2336,6 +2704,55 @@ func toSystemRestoreResource(systemRestore *longhorn.SystemRestore) *SystemResto
 	}
 }
 
+func toFileRestoreSessionCollection(sessions []*longhorn.FileRestoreSession) *client.GenericCollection {
+	data := []interface{}{}
+	for _, session := range sessions {
+		data = append(data, toFileRestoreSessionResource(session))
+	}
+	return &client.GenericCollection{Data: data, Collection: client.Collection{ResourceType: "fileRestoreSession"}}
+}
+
+func toFileRestoreSessionResource(session *longhorn.FileRestoreSession) *FileRestoreSession {
+	return &FileRestoreSession{
+		Resource: client.Resource{
+			Id:   session.Name,
+			Type: "fileRestoreSession",
+		},
+		Name:         session.Name,
+		BackupName:   session.Spec.BackupName,
+		SourceVolume: session.Spec.SourceVolume,
+		SnapshotName: session.Spec.SnapshotName,
+		NodeID:       session.Spec.NodeID,
+		TTLMinutes:   session.Spec.TTLMinutes,
+
+		State:      session.Status.State,
+		VolumeName: session.Status.VolumeName,
+		Endpoint:   session.Status.Endpoint,
+		ExpiresAt:  session.Status.ExpiresAt,
+		CreatedAt:  session.CreationTimestamp.String(),
+		Message:    session.Status.Message,
+	}
+}
+
+func toFileRestoreEntryCollection(entries []types.FileRestoreEntry) *client.GenericCollection {
+	data := []interface{}{}
+	for _, entry := range entries {
+		data = append(data, &FileRestoreEntry{
+			Resource: client.Resource{
+				Id:   entry.Path,
+				Type: "fileRestoreEntry",
+			},
+			Name:        entry.Name,
+			Path:        entry.Path,
+			Size:        entry.Size,
+			Mode:        entry.Mode,
+			IsDirectory: entry.IsDirectory,
+			ModifiedAt:  entry.ModifiedAt,
+		})
+	}
+	return &client.GenericCollection{Data: data, Collection: client.Collection{ResourceType: "fileRestoreEntry"}}
+}
+
 func toTagResource(tag string, tagType string, apiContext *api.ApiContext) *Tag {
 	t := &Tag{
 		Resource: client.Resource{
@@ -2373,6 +2790,8 @@ func toInstanceManagerResource(im *longhorn.InstanceManager) *InstanceManager {
 		InstanceEngines:  im.Status.InstanceEngines,
 		InstanceReplicas: im.Status.InstanceReplicas,
 		Instances:        im.Status.Instances, // nolint: staticcheck
+
+		ResourceRecommendation: im.Status.ResourceRecommendation,
 	}
 }
 
@@ -2438,20 +2857,28 @@ func toOrphanCollection(orphans map[string]*longhorn.Orphan) *client.GenericColl
 	return &client.GenericCollection{Data: data, Collection: client.Collection{ResourceType: "orphan"}}
 }
 
-func toVolumeAttachmentResource(volumeAttachment *longhorn.VolumeAttachment) *VolumeAttachment {
+func toAttachmentResource(ticket *longhorn.AttachmentTicket) Attachment {
+	return Attachment{
+		AttachmentID:   ticket.ID,
+		AttachmentType: string(ticket.Type),
+		NodeID:         ticket.NodeID,
+		Parameters:     ticket.Parameters,
+		Priority:       longhorn.GetAttachmentTicketPriorityLevel(ticket),
+		Reservation:    longhorn.IsReservationAttachmentTicket(ticket),
+		ExpiresAt:      ticket.Parameters[longhorn.AttachmentParameterExpiresAt],
+		Reason:         ticket.Parameters[longhorn.AttachmentParameterReason],
+		Satisfied:      false,
+		Conditions:     nil,
+	}
+}
+
+func toVolumeAttachmentResource(volumeAttachment *longhorn.VolumeAttachment, apiContext *api.ApiContext) *VolumeAttachment {
 	attachments := make(map[string]Attachment)
 
 	for ticketName, ticket := range volumeAttachment.Spec.AttachmentTickets {
 		status := volumeAttachment.Status.AttachmentTicketStatuses[ticketName]
 
-		attachment := Attachment{
-			AttachmentID:   ticket.ID,
-			AttachmentType: string(ticket.Type),
-			NodeID:         ticket.NodeID,
-			Parameters:     ticket.Parameters,
-			Satisfied:      false,
-			Conditions:     nil,
-		}
+		attachment := toAttachmentResource(ticket)
 
 		if status != nil {
 			attachment.Satisfied = status.Satisfied
@@ -2461,7 +2888,7 @@ func toVolumeAttachmentResource(volumeAttachment *longhorn.VolumeAttachment) *Vo
 		attachments[ticketName] = attachment
 	}
 
-	return &VolumeAttachment{
+	res := &VolumeAttachment{
 		Resource: client.Resource{
 			Id:   volumeAttachment.Name,
 			Type: "volumeAttachment",
@@ -2469,12 +2896,17 @@ func toVolumeAttachmentResource(volumeAttachment *longhorn.VolumeAttachment) *Vo
 		Volume:      volumeAttachment.Spec.Volume,
 		Attachments: attachments,
 	}
+	res.Actions = map[string]string{
+		"attachmentTicketCreate": apiContext.UrlBuilder.ActionLink(res.Resource, "attachmentTicketCreate"),
+		"attachmentTicketDelete": apiContext.UrlBuilder.ActionLink(res.Resource, "attachmentTicketDelete"),
+	}
+	return res
 }
 
 func toVolumeAttachmentCollection(attachments []*longhorn.VolumeAttachment, apiContext *api.ApiContext) *client.GenericCollection {
 	data := []interface{}{}
 	for _, attachment := range attachments {
-		data = append(data, toVolumeAttachmentResource(attachment))
+		data = append(data, toVolumeAttachmentResource(attachment, apiContext))
 	}
 	return &client.GenericCollection{Data: data, Collection: client.Collection{ResourceType: "volumeAttachment"}}
 }
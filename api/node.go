@@ -77,6 +77,10 @@ func (s *Server) NodeUpdate(rw http.ResponseWriter, req *http.Request) error {
 		node.Spec.EvictionRequested = n.EvictionRequested
 		node.Spec.Tags = n.Tags
 		node.Spec.InstanceManagerCPURequest = n.InstanceManagerCPURequest
+		// Keep the existing overrides for the clients not aware of the field
+		if n.SettingOverrides != nil {
+			node.Spec.SettingOverrides = n.SettingOverrides
+		}
 
 		return s.m.UpdateNode(node)
 	})
@@ -63,6 +63,11 @@ func NewRouter(s *Server) *mux.Router {
 	r.Methods("GET").Path("/v1/settings").Handler(f(schemas, s.SettingList))
 	r.Methods("GET").Path("/v1/settings/{name}").Handler(f(schemas, s.SettingGet))
 	r.Methods("PUT").Path("/v1/settings/{name}").Handler(f(schemas, s.SettingSet))
+	r.Methods("POST").Path("/v1/settings").Queries("action", "rollback").Handler(f(schemas, s.SettingsRollback))
+	r.Methods("POST").Path("/v1/settings/{name}").Queries("action", "rollback").Handler(f(schemas, s.SettingRollback))
+
+	r.Methods("GET").Path("/v1/settinghistories").Handler(f(schemas, s.SettingHistoryList))
+	r.Methods("GET").Path("/v1/settinghistories/{name}").Handler(f(schemas, s.SettingHistoryGet))
 
 	r.Methods("GET").Path("/v1/volumes").Handler(f(schemas, s.VolumeList))
 	r.Methods("GET").Path("/v1/volumes/{name}").Handler(f(schemas, s.VolumeGet))
@@ -78,6 +83,12 @@ func NewRouter(s *Server) *mux.Router {
 		"updateSnapshotMaxCount":                s.VolumeUpdateSnapshotMaxCount,
 		"updateSnapshotMaxSize":                 s.VolumeUpdateSnapshotMaxSize,
 		"updateReplicaRebuildingBandwidthLimit": s.VolumeUpdateReplicaRebuildingBandwidthLimit,
+		"updateTieringPolicy":                   s.VolumeUpdateTieringPolicy,
+		"removeTieringPolicy":                   s.VolumeRemoveTieringPolicy,
+		"updateRecoveryPointObjective":          s.VolumeUpdateRecoveryPointObjective,
+		"updateAutoExpansionPolicy":             s.VolumeUpdateAutoExpansionPolicy,
+		"removeAutoExpansionPolicy":             s.VolumeRemoveAutoExpansionPolicy,
+		"updateFilesystemUsage":                 s.VolumeUpdateFilesystemUsage,
 		"updateReplicaSoftAntiAffinity":         s.VolumeUpdateReplicaSoftAntiAffinity,
 		"updateReplicaZoneSoftAntiAffinity":     s.VolumeUpdateReplicaZoneSoftAntiAffinity,
 		"updateReplicaDiskSoftAntiAffinity":     s.VolumeUpdateReplicaDiskSoftAntiAffinity,
@@ -170,6 +181,8 @@ func NewRouter(s *Server) *mux.Router {
 
 	r.Methods("GET").Path("/v1/disktags").Handler(f(schemas, s.DiskTagList))
 	r.Methods("GET").Path("/v1/nodetags").Handler(f(schemas, s.NodeTagList))
+	r.Methods("GET").Path("/v1/capacityforecast").Handler(f(schemas, s.ClusterCapacityForecastGet))
+	r.Methods("GET").Path("/v1/idlevolumes").Handler(f(schemas, s.IdleVolumeList))
 
 	r.Methods("GET").Path("/v1/instancemanagers").Handler(f(schemas, s.InstanceManagerList))
 	r.Methods("GET").Path("/v1/instancemanagers/{name}").Handler(f(schemas, s.InstanceManagerGet))
@@ -214,7 +227,7 @@ func NewRouter(s *Server) *mux.Router {
 	r.Methods("GET").Path("/v1/supportbundles/{name}/{bundleName}").Handler(f(schemas,
 		s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromNode(s.m)), s.SupportBundleGet)))
 	r.Methods("GET").Path("/v1/supportbundles/{name}/{bundleName}/download").Handler(f(schemas,
-		s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromNode(s.m)), s.SupportBundleDownload)))
+		s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromSupportBundle(s.m)), s.SupportBundleDownload)))
 	r.Methods("DELETE").Path("/v1/supportbundles/{name}/{bundleName}").Handler(f(schemas,
 		s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromNode(s.m)), s.SupportBundleDelete)))
 
@@ -223,6 +236,20 @@ func NewRouter(s *Server) *mux.Router {
 	r.Methods("GET").Path("/v1/systembackups/{name}").Handler(f(schemas, s.SystemBackupGet))
 	r.Methods("DELETE").Path("/v1/systembackups/{name}").Handler(f(schemas, s.SystemBackupDelete))
 
+	r.Methods("POST").Path("/v1/filerestoresessions").Handler(f(schemas, s.FileRestoreSessionCreate))
+	r.Methods("GET").Path("/v1/filerestoresessions").Handler(f(schemas, s.FileRestoreSessionList))
+	r.Methods("GET").Path("/v1/filerestoresessions/{name}").Handler(f(schemas, s.FileRestoreSessionGet))
+	r.Methods("DELETE").Path("/v1/filerestoresessions/{name}").Handler(f(schemas, s.FileRestoreSessionDelete))
+	r.Methods("GET").Path("/v1/filerestoresessions/{name}/files").Handler(f(schemas, s.FileRestoreSessionListFiles))
+	r.Methods("GET").Path("/v1/filerestoresessions/{name}/download").Handler(f(schemas, s.FileRestoreSessionDownload))
+
+	r.Methods("PUT").Path("/v1/volumetransfers/{name}/chunks/{index}").Handler(f(schemas,
+		s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolumeTransfer(s.m)), s.VolumeTransferWriteChunk)))
+	r.Methods("POST").Path("/v1/volumetransfers/{name}/progress").Handler(f(schemas,
+		s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolumeTransfer(s.m)), s.VolumeTransferReportProgress)))
+	r.Methods("POST").Path("/v1/volumetransfers/{name}/finalize").Handler(f(schemas,
+		s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolumeTransfer(s.m)), s.VolumeTransferFinalize)))
+
 	r.Methods("POST").Path("/v1/systemrestores").Handler(f(schemas, s.SystemRestoreCreate))
 	r.Methods("GET").Path("/v1/systemrestores").Handler(f(schemas, s.SystemRestoreList))
 	r.Methods("GET").Path("/v1/systemrestores/{name}").Handler(f(schemas, s.SystemRestoreGet))
@@ -295,6 +322,13 @@ func NewRouter(s *Server) *mux.Router {
 	// VolumeAttachment routes
 	r.Methods("GET").Path("/v1/volumeattachments").Handler(f(schemas, s.VolumeAttachmentList))
 	r.Methods("GET").Path("/v1/volumeattachments/{name}").Handler(f(schemas, s.VolumeAttachmentGet))
+	volumeAttachmentActions := map[string]func(http.ResponseWriter, *http.Request) error{
+		"attachmentTicketCreate": s.AttachmentTicketCreate,
+		"attachmentTicketDelete": s.AttachmentTicketDelete,
+	}
+	for name, action := range volumeAttachmentActions {
+		r.Methods("POST").Path("/v1/volumeattachments/{name}").Queries("action", name).Handler(f(schemas, action))
+	}
 
 	volumeAttachmentListStream := NewStreamHandlerFunc("volumeattachments", s.wsc.NewWatcher("volumeAttachment"), s.volumeAttachmentList)
 	r.Path("/v1/ws/volumeattachments").Handler(f(schemas, volumeAttachmentListStream))
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -60,6 +61,10 @@ func (s *Server) SettingSet(w http.ResponseWriter, req *http.Request) error {
 	}
 
 	si.Value = strings.TrimSpace(setting.Value)
+	if si.Annotations == nil {
+		si.Annotations = map[string]string{}
+	}
+	si.Annotations[types.GetLonghornLabelKey(types.UpdateSettingRequester)] = getSettingRequester(req)
 	si, err = s.m.CreateOrUpdateSetting(si)
 	if err != nil {
 		return err
@@ -68,3 +73,85 @@ func (s *Server) SettingSet(w http.ResponseWriter, req *http.Request) error {
 	apiContext.Write(toSettingResource(si))
 	return nil
 }
+
+func (s *Server) SettingRollback(w http.ResponseWriter, req *http.Request) error {
+	var input SettingRollbackInput
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return err
+	}
+
+	name := mux.Vars(req)["name"]
+	settings, err := s.m.RollbackSettings(map[types.SettingName]int64{types.SettingName(name): input.Revision}, getSettingRequester(req))
+	if err != nil {
+		return err
+	}
+
+	apiContext.Write(toSettingResource(settings[0]))
+	return nil
+}
+
+func (s *Server) SettingsRollback(w http.ResponseWriter, req *http.Request) error {
+	var input SettingsRollbackInput
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return err
+	}
+	if len(input.Settings) == 0 {
+		return fmt.Errorf("no setting is specified for rollback")
+	}
+
+	revisions := map[types.SettingName]int64{}
+	for _, setting := range input.Settings {
+		if _, exists := revisions[types.SettingName(setting.Name)]; exists {
+			return fmt.Errorf("setting %v is specified more than once for rollback", setting.Name)
+		}
+		revisions[types.SettingName(setting.Name)] = setting.Revision
+	}
+
+	settings, err := s.m.RollbackSettings(revisions, getSettingRequester(req))
+	if err != nil {
+		return err
+	}
+
+	apiContext.Write(toSettingCollection(settings))
+	return nil
+}
+
+func (s *Server) SettingHistoryList(w http.ResponseWriter, req *http.Request) error {
+	histories, err := s.m.ListSettingHistoriesSorted()
+	if err != nil {
+		return errors.Wrap(err, "failed to list setting histories")
+	}
+
+	apiContext := api.GetApiContext(req)
+	apiContext.Write(toSettingHistoryCollection(histories))
+	return nil
+}
+
+func (s *Server) SettingHistoryGet(w http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	history, err := s.m.GetSettingHistory(name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get history of setting %v", name)
+	}
+
+	apiContext := api.GetApiContext(req)
+	apiContext.Write(toSettingHistoryResource(history))
+	return nil
+}
+
+// getSettingRequester returns who requests the setting change through the Longhorn API. The Longhorn API does not
+// authenticate the clients, so the requester is the client address. The user set by a proxy in front of the API is
+// only noted as unverified, since any client can set the header.
+func getSettingRequester(req *http.Request) string {
+	for _, header := range []string{"X-Forwarded-User", "X-Remote-User"} {
+		if user := req.Header.Get(header); user != "" {
+			return fmt.Sprintf("%v (unverified %v: %v)", req.RemoteAddr, header, user)
+		}
+	}
+	return req.RemoteAddr
+}
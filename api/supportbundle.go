@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"strconv"
 
 	"github.com/gorilla/mux"
@@ -13,7 +14,9 @@ import (
 	"github.com/rancher/go-rancher/api"
 	"github.com/rancher/go-rancher/client"
 
+	"github.com/longhorn/longhorn-manager/manager"
 	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util/supportbundle"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -25,7 +28,19 @@ func (s *Server) SupportBundleCreate(w http.ResponseWriter, req *http.Request) e
 	if err := apiContext.Read(&supportBundleInput); err != nil {
 		return err
 	}
-	supportBundle, err := s.m.CreateSupportBundle(supportBundleInput.IssueURL, supportBundleInput.Description)
+	scope := longhorn.SupportBundleScope{
+		VolumeNames: supportBundleInput.VolumeNames,
+		NodeNames:   supportBundleInput.NodeNames,
+		Since:       supportBundleInput.Since,
+		Until:       supportBundleInput.Until,
+	}
+	redactionPolicy := longhorn.SupportBundleRedactionPolicy{
+		Secrets:                 supportBundleInput.RedactSecrets,
+		BackupTargetCredentials: supportBundleInput.RedactBackupTargetCredentials,
+		IPAddresses:             supportBundleInput.RedactIPAddresses,
+		Hostnames:               supportBundleInput.RedactHostnames,
+	}
+	supportBundle, err := s.m.CreateSupportBundle(supportBundleInput.IssueURL, supportBundleInput.Description, scope, redactionPolicy)
 	if err != nil {
 		return errors.Wrap(err, "failed to create SupportBundle")
 	}
@@ -49,10 +64,6 @@ func (s *Server) SupportBundleDownload(w http.ResponseWriter, req *http.Request)
 		return err
 	}
 
-	if supportBundleIP == "" {
-		return errors.New("failed to get SupportBundle IP")
-	}
-
 	if supportBundle.State == longhorn.SupportBundleStateError {
 		return errors.Wrap(err, "failed to create support bundle")
 	}
@@ -60,6 +71,19 @@ func (s *Server) SupportBundleDownload(w http.ResponseWriter, req *http.Request)
 		return fmt.Errorf("support bundle not ready")
 	}
 
+	// The owner longhorn-manager keeps the support bundle limited to its scope and redacted, and the original one is
+	// deleted with the support bundle manager
+	if supportbundle.IsRequired(supportBundle.Scope, supportBundle.RedactionPolicy) {
+		if err := s.downloadProcessedSupportBundle(w, supportBundle); err != nil {
+			return errors.Wrapf(err, "failed to download processed support bundle %v", bundleName)
+		}
+		return s.m.DeleteSupportBundle(bundleName)
+	}
+
+	if supportBundleIP == "" {
+		return errors.New("failed to get SupportBundle IP")
+	}
+
 	sourceURL := fmt.Sprintf(types.SupportBundleURLDownloadFmt, supportBundleIP, types.SupportBundleURLPort)
 	newReq, err := http.NewRequestWithContext(req.Context(), http.MethodGet, sourceURL, nil)
 	if err != nil {
@@ -94,6 +118,29 @@ func (s *Server) SupportBundleDownload(w http.ResponseWriter, req *http.Request)
 	return s.m.DeleteSupportBundle(bundleName)
 }
 
+func (s *Server) downloadProcessedSupportBundle(w http.ResponseWriter, supportBundle *manager.SupportBundle) error {
+	f, err := os.Open(types.GetProcessedSupportBundlePath(supportBundle.Name))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			logrus.WithError(closeErr).Warnf("Failed to close processed support bundle %v", f.Name())
+		}
+	}()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Disposition", "attachment; filename="+supportBundle.Filename)
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Length", strconv.FormatInt(info.Size(), 10))
+	_, err = io.Copy(w, f)
+	return err
+}
+
 func (s *Server) SupportBundleGet(w http.ResponseWriter, req *http.Request) error {
 	bundleName := mux.Vars(req)["bundleName"]
 	apiContext := api.GetApiContext(req)
@@ -36,6 +36,18 @@ func (s *Server) VolumeList(rw http.ResponseWriter, req *http.Request) (err erro
 	return nil
 }
 
+func (s *Server) IdleVolumeList(rw http.ResponseWriter, req *http.Request) error {
+	apiContext := api.GetApiContext(req)
+
+	volumes, err := s.m.ListIdleVolumesSorted()
+	if err != nil {
+		return errors.Wrap(err, "failed to list idle volumes")
+	}
+
+	apiContext.Write(toIdleVolumeCollection(volumes))
+	return nil
+}
+
 func (s *Server) volumeList(apiContext *api.ApiContext) (*client.GenericCollection, error) {
 	resp := &client.GenericCollection{}
 
@@ -209,6 +221,7 @@ func (s *Server) VolumeCreate(rw http.ResponseWriter, req *http.Request) error {
 		FreezeFilesystemForSnapshot:     volume.FreezeFilesystemForSnapshot,
 		BackupTargetName:                volume.BackupTargetName,
 		OfflineRebuilding:               volume.OfflineRebuilding,
+		RecoveryPointObjective:          volume.RecoveryPointObjective,
 	}, volume.RecurringJobSelector)
 	if err != nil {
 		return errors.Wrap(err, "failed to create volume")
@@ -819,6 +832,102 @@ func (s *Server) VolumeUpdateSnapshotMaxCount(rw http.ResponseWriter, req *http.
 	return s.responseWithVolume(rw, req, "", v)
 }
 
+func (s *Server) VolumeUpdateRecoveryPointObjective(rw http.ResponseWriter, req *http.Request) error {
+	var input UpdateRecoveryPointObjectiveInput
+	id := mux.Vars(req)["name"]
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return errors.Wrap(err, "failed to read RecoveryPointObjective input")
+	}
+
+	obj, err := util.RetryOnConflictCause(func() (interface{}, error) {
+		return s.m.UpdateRecoveryPointObjective(id, input.RecoveryPointObjective)
+	})
+	if err != nil {
+		return err
+	}
+	v, ok := obj.(*longhorn.Volume)
+	if !ok {
+		return fmt.Errorf("failed to convert to volume %v object", id)
+	}
+	return s.responseWithVolume(rw, req, "", v)
+}
+
+func (s *Server) VolumeUpdateAutoExpansionPolicy(rw http.ResponseWriter, req *http.Request) error {
+	var input UpdateAutoExpansionPolicyInput
+	id := mux.Vars(req)["name"]
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return errors.Wrap(err, "failed to read AutoExpansionPolicy input")
+	}
+
+	stepSize, err := util.ConvertSize(input.StepSize)
+	if err != nil {
+		return fmt.Errorf("failed to parse auto expansion step size %v", err)
+	}
+	maxSize, err := util.ConvertSize(input.MaxSize)
+	if err != nil {
+		return fmt.Errorf("failed to parse auto expansion max size %v", err)
+	}
+	policy := &longhorn.VolumeAutoExpansionPolicy{
+		UsageThreshold: input.UsageThreshold,
+		StepSize:       stepSize,
+		MaxSize:        maxSize,
+	}
+
+	obj, err := util.RetryOnConflictCause(func() (interface{}, error) {
+		return s.m.UpdateAutoExpansionPolicy(id, policy)
+	})
+	if err != nil {
+		return err
+	}
+	v, ok := obj.(*longhorn.Volume)
+	if !ok {
+		return fmt.Errorf("failed to convert to volume %v object", id)
+	}
+	return s.responseWithVolume(rw, req, "", v)
+}
+
+func (s *Server) VolumeRemoveAutoExpansionPolicy(rw http.ResponseWriter, req *http.Request) error {
+	id := mux.Vars(req)["name"]
+
+	obj, err := util.RetryOnConflictCause(func() (interface{}, error) {
+		return s.m.UpdateAutoExpansionPolicy(id, nil)
+	})
+	if err != nil {
+		return err
+	}
+	v, ok := obj.(*longhorn.Volume)
+	if !ok {
+		return fmt.Errorf("failed to convert to volume %v object", id)
+	}
+	return s.responseWithVolume(rw, req, "", v)
+}
+
+func (s *Server) VolumeUpdateFilesystemUsage(rw http.ResponseWriter, req *http.Request) error {
+	var input UpdateFilesystemUsageInput
+	id := mux.Vars(req)["name"]
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return errors.Wrap(err, "failed to read FilesystemUsage input")
+	}
+
+	obj, err := util.RetryOnConflictCause(func() (interface{}, error) {
+		return s.m.UpdateFilesystemUsage(id, input.UsedBytes, input.TotalBytes)
+	})
+	if err != nil {
+		return err
+	}
+	v, ok := obj.(*longhorn.Volume)
+	if !ok {
+		return fmt.Errorf("failed to convert to volume %v object", id)
+	}
+	return s.responseWithVolume(rw, req, "", v)
+}
+
 func (s *Server) VolumeUpdateSnapshotMaxSize(rw http.ResponseWriter, req *http.Request) error {
 	var input UpdateSnapshotMaxSize
 	id := mux.Vars(req)["name"]
@@ -873,6 +982,61 @@ func (s *Server) VolumeUpdateReplicaRebuildingBandwidthLimit(rw http.ResponseWri
 	return s.responseWithVolume(rw, req, "", v)
 }
 
+func (s *Server) VolumeUpdateTieringPolicy(rw http.ResponseWriter, req *http.Request) error {
+	var input UpdateTieringPolicyInput
+	id := mux.Vars(req)["name"]
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return errors.Wrap(err, "failed to read TieringPolicy input")
+	}
+
+	policy := &longhorn.VolumeTieringPolicy{
+		DiskSelector:   input.DiskSelector,
+		Trigger:        longhorn.VolumeTieringTrigger(input.Trigger),
+		Schedule:       input.Schedule,
+		ThresholdHours: input.ThresholdHours,
+	}
+	if policy.Trigger == "" {
+		policy.Trigger = longhorn.VolumeTieringTriggerOnDemand
+	}
+	if input.BandwidthLimit != "" {
+		bandwidthLimit, err := util.ConvertSize(input.BandwidthLimit)
+		if err != nil {
+			return fmt.Errorf("failed to parse tiering bandwidth limit %v", err)
+		}
+		policy.BandwidthLimit = bandwidthLimit
+	}
+
+	obj, err := util.RetryOnConflictCause(func() (interface{}, error) {
+		return s.m.UpdateTieringPolicy(id, policy)
+	})
+	if err != nil {
+		return err
+	}
+	v, ok := obj.(*longhorn.Volume)
+	if !ok {
+		return fmt.Errorf("failed to convert to volume %v object", id)
+	}
+	return s.responseWithVolume(rw, req, "", v)
+}
+
+func (s *Server) VolumeRemoveTieringPolicy(rw http.ResponseWriter, req *http.Request) error {
+	id := mux.Vars(req)["name"]
+
+	obj, err := util.RetryOnConflictCause(func() (interface{}, error) {
+		return s.m.UpdateTieringPolicy(id, nil)
+	})
+	if err != nil {
+		return err
+	}
+	v, ok := obj.(*longhorn.Volume)
+	if !ok {
+		return fmt.Errorf("failed to convert to volume %v object", id)
+	}
+	return s.responseWithVolume(rw, req, "", v)
+}
+
 func (s *Server) VolumeUpdateFreezeFilesystemForSnapshot(rw http.ResponseWriter, req *http.Request) error {
 	var input UpdateFreezeFilesystemForSnapshotInput
 	id := mux.Vars(req)["name"]
@@ -1,13 +1,20 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 
 	"github.com/rancher/go-rancher/api"
 	"github.com/rancher/go-rancher/client"
+
+	"github.com/longhorn/longhorn-manager/manager"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
 func (s *Server) VolumeAttachmentGet(rw http.ResponseWriter, req *http.Request) error {
@@ -19,7 +26,7 @@ func (s *Server) VolumeAttachmentGet(rw http.ResponseWriter, req *http.Request)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get volume attachment  '%s'", id)
 	}
-	apiContext.Write(toVolumeAttachmentResource(volumeAttachment))
+	apiContext.Write(toVolumeAttachmentResource(volumeAttachment, apiContext))
 	return nil
 }
 
@@ -41,3 +48,63 @@ func (s *Server) volumeAttachmentList(apiContext *api.ApiContext) (*client.Gener
 	}
 	return toVolumeAttachmentCollection(volumeAttachmentList, apiContext), nil
 }
+
+func (s *Server) AttachmentTicketCreate(rw http.ResponseWriter, req *http.Request) error {
+	var input AttachmentTicketInput
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return err
+	}
+	id := mux.Vars(req)["name"]
+
+	request := &manager.AttachmentTicketRequest{
+		AttachmentID:    input.AttachmentID,
+		NodeID:          input.NodeID,
+		Priority:        input.Priority,
+		TTL:             time.Duration(input.TTLSeconds) * time.Second,
+		Reason:          input.Reason,
+		Reservation:     input.Reservation,
+		DisableFrontend: input.DisableFrontend,
+	}
+	obj, err := util.RetryOnConflictCause(func() (interface{}, error) {
+		return s.m.CreateAttachmentTicket(id, request)
+	})
+	if err != nil {
+		return err
+	}
+	va, ok := obj.(*longhorn.VolumeAttachment)
+	if !ok {
+		return fmt.Errorf("failed to convert to volume attachment %v object", id)
+	}
+
+	apiContext.Write(toVolumeAttachmentResource(va, apiContext))
+	return nil
+}
+
+func (s *Server) AttachmentTicketDelete(rw http.ResponseWriter, req *http.Request) error {
+	var input AttachmentTicketDeleteInput
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return err
+	}
+	if input.AttachmentID == "" {
+		return fmt.Errorf("attachment ID is required")
+	}
+	id := mux.Vars(req)["name"]
+
+	obj, err := util.RetryOnConflictCause(func() (interface{}, error) {
+		return s.m.DeleteAttachmentTicket(id, input.AttachmentID)
+	})
+	if err != nil {
+		return err
+	}
+	va, ok := obj.(*longhorn.VolumeAttachment)
+	if !ok {
+		return fmt.Errorf("failed to convert to volume attachment %v object", id)
+	}
+
+	apiContext.Write(toVolumeAttachmentResource(va, apiContext))
+	return nil
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-manager/util/volumetransfer"
+)
+
+// The endpoints receive the data of a volume transfer from the source cluster. They are forwarded to the owner node of
+// the destination transfer, where the volume is attached, and authenticated by the token of the transfer.
+
+func (s *Server) VolumeTransferWriteChunk(w http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+	if !s.authenticateVolumeTransfer(w, req, name) {
+		return nil
+	}
+
+	index, err := strconv.Atoi(mux.Vars(req)["index"])
+	if err != nil {
+		return errors.Wrapf(err, "invalid chunk index %v", mux.Vars(req)["index"])
+	}
+	if req.Header.Get(volumetransfer.HeaderChunkZero) == "true" {
+		if err := s.m.WriteVolumeTransferZeroChunk(name, index); err != nil {
+			return errors.Wrapf(err, "failed to zero chunk %v of VolumeTransfer %v", index, name)
+		}
+		return nil
+	}
+	data, err := io.ReadAll(io.LimitReader(req.Body, volumetransfer.ChunkSize+1))
+	if err != nil {
+		return errors.Wrapf(err, "failed to read chunk %v of VolumeTransfer %v", index, name)
+	}
+
+	if err := s.m.WriteVolumeTransferChunk(name, index, data, req.Header.Get(volumetransfer.HeaderChunkChecksum)); err != nil {
+		return errors.Wrapf(err, "failed to write chunk %v of VolumeTransfer %v", index, name)
+	}
+	return nil
+}
+
+func (s *Server) VolumeTransferReportProgress(w http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+	if !s.authenticateVolumeTransfer(w, req, name) {
+		return nil
+	}
+
+	progress := &volumetransfer.Progress{}
+	if err := json.NewDecoder(req.Body).Decode(progress); err != nil {
+		return errors.Wrapf(err, "failed to decode the progress of VolumeTransfer %v", name)
+	}
+	if err := s.m.UpdateVolumeTransferProgress(name, progress); err != nil {
+		return errors.Wrapf(err, "failed to update the progress of VolumeTransfer %v", name)
+	}
+	return nil
+}
+
+func (s *Server) VolumeTransferFinalize(w http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+	if !s.authenticateVolumeTransfer(w, req, name) {
+		return nil
+	}
+
+	if err := s.m.FinalizeVolumeTransfer(name); err != nil {
+		return errors.Wrapf(err, "failed to finalize VolumeTransfer %v", name)
+	}
+	return nil
+}
+
+// authenticateVolumeTransfer responds with unauthorized and returns false if the request does not carry the token of
+// the transfer.
+func (s *Server) authenticateVolumeTransfer(w http.ResponseWriter, req *http.Request, name string) bool {
+	token, err := s.m.GetVolumeTransferToken(name)
+	if err == nil {
+		err = volumetransfer.Authenticate(req, token)
+	}
+	if err != nil {
+		logrus.WithError(err).Warnf("Failed to authenticate VolumeTransfer %v", name)
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return false
+	}
+	return true
+}
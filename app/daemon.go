@@ -122,7 +122,7 @@ func DaemonCmd() cli.Command {
 // - ctx: The context used to manage the webhook servers lifecycle.
 // - kubeconfigPath: The path to the kubeconfig file.
 // - currentNodeID: The ID of the current node attempting to acquire the leadership.
-func startWebhooksByLeaderElection(ctx context.Context, kubeconfigPath, currentNodeID string) error {
+func startWebhooksByLeaderElection(ctx context.Context, kubeconfigPath, currentNodeID, serviceAccount string) error {
 	config, err := rest.InClusterConfig()
 	if err != nil {
 		return errors.Wrap(err, "failed to get client config")
@@ -165,7 +165,7 @@ func startWebhooksByLeaderElection(ctx context.Context, kubeconfigPath, currentN
 			if err != nil {
 				return err
 			}
-			if err := webhook.StartWebhook(ctx, types.WebhookTypeConversion, clientsWithoutDatastore); err != nil {
+			if err := webhook.StartWebhook(ctx, types.WebhookTypeConversion, clientsWithoutDatastore, serviceAccount); err != nil {
 				return err
 			}
 
@@ -192,7 +192,7 @@ func startWebhooksByLeaderElection(ctx context.Context, kubeconfigPath, currentN
 			return err
 		}
 
-		if err := webhook.StartWebhook(ctx, types.WebhookTypeAdmission, clients); err != nil {
+		if err := webhook.StartWebhook(ctx, types.WebhookTypeAdmission, clients, serviceAccount); err != nil {
 			return err
 		}
 
@@ -292,7 +292,7 @@ func startManager(c *cli.Context) error {
 
 	logger := logrus.StandardLogger().WithField("node", currentNodeID)
 
-	err = startWebhooksByLeaderElection(ctx, kubeconfigPath, currentNodeID)
+	err = startWebhooksByLeaderElection(ctx, kubeconfigPath, currentNodeID, serviceAccount)
 	if err != nil {
 		return err
 	}
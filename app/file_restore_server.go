@@ -0,0 +1,226 @@
+package app
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"path"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+
+	"k8s.io/mount-utils"
+
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+)
+
+const (
+	FlagFileRestoreDevice    = "device"
+	FlagFileRestoreMountPath = "mount-path"
+	FlagFileRestorePort      = "port"
+	FlagFileRestoreToken     = "token"
+
+	defaultFileRestoreMountPath = "/mnt/file-restore"
+)
+
+func FileRestoreServerCmd() cli.Command {
+	return cli.Command{
+		Name:  "file-restore-server",
+		Usage: "Mount a restored volume read-only and serve its files for a file restore session",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:     FlagFileRestoreDevice,
+				Required: true,
+				Usage:    "Specify the block device of the restored volume",
+			},
+			cli.StringFlag{
+				Name:  FlagFileRestoreMountPath,
+				Value: defaultFileRestoreMountPath,
+				Usage: "Specify the path the block device is mounted to",
+			},
+			cli.IntFlag{
+				Name:  FlagFileRestorePort,
+				Value: types.FileRestoreServerPort,
+				Usage: "Specify the port the files are served on",
+			},
+			cli.StringFlag{
+				Name:   FlagFileRestoreToken,
+				EnvVar: types.FileRestoreServerTokenEnv,
+				Usage:  "Specify the bearer token requests must carry to be served",
+			},
+		},
+		Action: func(c *cli.Context) {
+			if err := fileRestoreServer(c); err != nil {
+				logrus.WithError(err).Fatal("Failed to run file restore server")
+			}
+		},
+	}
+}
+
+func fileRestoreServer(c *cli.Context) error {
+	device := c.String(FlagFileRestoreDevice)
+	mountPath := c.String(FlagFileRestoreMountPath)
+	token := c.String(FlagFileRestoreToken)
+	if token == "" {
+		return fmt.Errorf("%v is required", FlagFileRestoreToken)
+	}
+
+	if err := os.MkdirAll(mountPath, 0755); err != nil {
+		return errors.Wrapf(err, "failed to create mount path %v", mountPath)
+	}
+	mounter := mount.New("")
+	if err := mounter.Mount(device, mountPath, "", []string{"ro"}); err != nil {
+		return errors.Wrapf(err, "failed to mount device %v to %v read-only", device, mountPath)
+	}
+	defer func() {
+		if err := mounter.Unmount(mountPath); err != nil {
+			logrus.WithError(err).Warnf("Failed to unmount %v", mountPath)
+		}
+	}()
+
+	root, err := os.OpenRoot(mountPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open mount path %v", mountPath)
+	}
+	defer func() {
+		if err := root.Close(); err != nil {
+			logrus.WithError(err).Warnf("Failed to close mount path %v", mountPath)
+		}
+	}()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(types.FileRestoreServerListPath, func(w http.ResponseWriter, req *http.Request) {
+		listFiles(w, req, root)
+	})
+	mux.HandleFunc(types.FileRestoreServerDownloadPath, func(w http.ResponseWriter, req *http.Request) {
+		downloadFile(w, req, root)
+	})
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%v", c.Int(FlagFileRestorePort)),
+		Handler: authenticateFileRestoreRequest(mux, token),
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigs
+		if err := server.Close(); err != nil {
+			logrus.WithError(err).Warn("Failed to close file restore server")
+		}
+	}()
+
+	logrus.Infof("Serving files of device %v mounted at %v on %v", device, mountPath, server.Addr)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// authenticateFileRestoreRequest only passes the requests carrying the token of the session to the handler. The pod
+// network is reachable from any pod of the cluster, so the manager proxying the requests is the only trusted client.
+func authenticateFileRestoreRequest(handler http.Handler, token string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requestToken, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(requestToken), []byte(token)) != 1 {
+			http.Error(w, "invalid or missing token", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// getFileRestoreRelativePath converts the requested path to a path relative to the mount path. The root prevents the
+// path from escaping the mount path through symlinks or "..".
+func getFileRestoreRelativePath(requestedPath string) string {
+	relativePath := strings.TrimPrefix(path.Clean("/"+requestedPath), "/")
+	if relativePath == "" {
+		return "."
+	}
+	return relativePath
+}
+
+func listFiles(w http.ResponseWriter, req *http.Request, root *os.Root) {
+	relativePath := getFileRestoreRelativePath(req.URL.Query().Get(types.FileRestoreServerQueryPath))
+
+	dir, err := root.Open(relativePath)
+	if err != nil {
+		writeFileRestoreError(w, err)
+		return
+	}
+	defer dir.Close()
+
+	dirEntries, err := dir.ReadDir(-1)
+	if err != nil {
+		writeFileRestoreError(w, err)
+		return
+	}
+
+	entries := []types.FileRestoreEntry{}
+	for _, dirEntry := range dirEntries {
+		info, err := dirEntry.Info()
+		if err != nil {
+			continue
+		}
+		entries = append(entries, types.FileRestoreEntry{
+			Name:        dirEntry.Name(),
+			Path:        path.Join("/", relativePath, dirEntry.Name()),
+			Size:        info.Size(),
+			Mode:        info.Mode().String(),
+			IsDirectory: info.IsDir(),
+			ModifiedAt:  util.FormatTimeZ(info.ModTime()),
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(entries); err != nil {
+		logrus.WithError(err).Warnf("Failed to write the file list of %v", relativePath)
+	}
+}
+
+func downloadFile(w http.ResponseWriter, req *http.Request, root *os.Root) {
+	relativePath := getFileRestoreRelativePath(req.URL.Query().Get(types.FileRestoreServerQueryPath))
+
+	file, err := root.Open(relativePath)
+	if err != nil {
+		writeFileRestoreError(w, err)
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		writeFileRestoreError(w, err)
+		return
+	}
+	if !info.Mode().IsRegular() {
+		http.Error(w, fmt.Sprintf("%v is not a regular file", relativePath), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", info.Name()))
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.FormatInt(info.Size(), 10))
+	if _, err := io.Copy(w, file); err != nil {
+		logrus.WithError(err).Warnf("Failed to download %v", relativePath)
+	}
+}
+
+func writeFileRestoreError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, os.ErrPermission):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	default:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
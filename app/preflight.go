@@ -0,0 +1,140 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+
+	"k8s.io/client-go/tools/clientcmd"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientset "k8s.io/client-go/kubernetes"
+
+	"github.com/longhorn/longhorn-manager/controller/monitor"
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	FlagPreflightNodeName       = "node-name"
+	FlagPreflightV2DataEngine   = "v2-data-engine"
+	FlagPreflightHugepageLimit  = "hugepage-limit"
+	FlagPreflightDiskPath       = "disk-path"
+	FlagPreflightKubeletRootDir = "kubelet-root-dir"
+	FlagPreflightOutput         = "output"
+
+	preflightOutputText = "text"
+	preflightOutputJSON = "json"
+)
+
+func PreflightCmd() cli.Command {
+	return cli.Command{
+		Name:  "preflight",
+		Usage: "Check if the node meets the requirements of Longhorn before installing or upgrading",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  FlagKubeConfig,
+				Usage: "Specify path to kube config (optional)",
+			},
+			cli.StringFlag{
+				Name:     FlagPreflightNodeName,
+				EnvVar:   types.EnvNodeName,
+				Required: true,
+				Usage:    "Specify the name of the node to be checked",
+			},
+			cli.BoolFlag{
+				Name:  FlagPreflightV2DataEngine,
+				Usage: "Check the requirements of the v2 data engine",
+			},
+			cli.Int64Flag{
+				Name:  FlagPreflightHugepageLimit,
+				Value: 2048,
+				Usage: "Specify the hugepage size in MiB required by the v2 data engine",
+			},
+			cli.StringSliceFlag{
+				Name:  FlagPreflightDiskPath,
+				Usage: "Specify the host path of a disk to be checked for writability, can be specified multiple times",
+			},
+			cli.StringFlag{
+				Name:  FlagPreflightKubeletRootDir,
+				Value: types.DefaultKubeletRootDir,
+				Usage: "Specify the root directory of kubelet on the host",
+			},
+			cli.StringFlag{
+				Name:  FlagPreflightOutput,
+				Value: preflightOutputText,
+				Usage: "Specify the output format of the report, text or json",
+			},
+		},
+		Action: func(c *cli.Context) {
+			if err := preflight(c); err != nil {
+				logrus.WithError(err).Fatal("Failed to run preflight checks")
+			}
+		},
+	}
+}
+
+func preflight(c *cli.Context) error {
+	output := c.String(FlagPreflightOutput)
+	if output != preflightOutputText && output != preflightOutputJSON {
+		return fmt.Errorf("invalid output format %v", output)
+	}
+
+	config, err := clientcmd.BuildConfigFromFlags("", c.String(FlagKubeConfig))
+	if err != nil {
+		return errors.Wrap(err, "failed to get client config")
+	}
+
+	kubeClient, err := clientset.NewForConfig(config)
+	if err != nil {
+		return errors.Wrap(err, "failed to get k8s client")
+	}
+
+	nodeName := c.String(FlagPreflightNodeName)
+	kubeNode, err := kubeClient.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get Kubernetes node %v", nodeName)
+	}
+
+	report := monitor.RunPreflightChecks(logrus.StandardLogger(), &monitor.PreflightOptions{
+		KubeNode:             kubeNode,
+		V2DataEngine:         c.Bool(FlagPreflightV2DataEngine),
+		HugePageLimitInMiB:   c.Int64(FlagPreflightHugepageLimit),
+		DiskPaths:            c.StringSlice(FlagPreflightDiskPath),
+		KubeletRootDir:       c.String(FlagPreflightKubeletRootDir),
+		MountPropagationPath: monitor.DefaultPreflightMountPropagationPath,
+		ReferenceTime: func() (time.Time, error) {
+			return monitor.GetKubernetesAPIServerTime(kubeClient)
+		},
+	})
+
+	if err := printPreflightReport(nodeName, report, output); err != nil {
+		return err
+	}
+
+	if report.Result == longhorn.PreflightCheckResultFail {
+		return fmt.Errorf("node %v failed the preflight checks", nodeName)
+	}
+	return nil
+}
+
+func printPreflightReport(nodeName string, report *longhorn.PreflightNodeReport, output string) error {
+	if output == preflightOutputJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(map[string]*longhorn.PreflightNodeReport{nodeName: report})
+	}
+
+	fmt.Printf("Node %v: %v\n", nodeName, report.Result)
+	for _, check := range report.Checks {
+		fmt.Printf("  [%v] %v: %v\n", check.Result, check.Name, check.Message)
+	}
+	return nil
+}
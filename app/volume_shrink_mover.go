@@ -0,0 +1,265 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+
+	"k8s.io/mount-utils"
+
+	utilexec "k8s.io/utils/exec"
+
+	"github.com/longhorn/longhorn-manager/types"
+)
+
+const (
+	FlagVolumeShrinkSourceDevice = "source-device"
+	FlagVolumeShrinkTargetDevice = "target-device"
+	FlagVolumeShrinkFSType       = "fs-type"
+	FlagVolumeShrinkMode         = "mode"
+	FlagVolumeShrinkPort         = "port"
+
+	volumeShrinkSourceMountPath = "/mnt/source"
+	volumeShrinkTargetMountPath = "/mnt/target"
+
+	volumeShrinkTerminationMessagePath = "/dev/termination-log"
+)
+
+var rsyncProgressRegex = regexp.MustCompile(`\s(\d{1,3})%\s`)
+
+func VolumeShrinkMoverCmd() cli.Command {
+	return cli.Command{
+		Name:  "volume-shrink-mover",
+		Usage: "Copy the files of a volume into a smaller volume for a volume shrink",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:     FlagVolumeShrinkSourceDevice,
+				Required: true,
+				Usage:    "Specify the block device of the volume to shrink",
+			},
+			cli.StringFlag{
+				Name:     FlagVolumeShrinkTargetDevice,
+				Required: true,
+				Usage:    "Specify the block device of the smaller volume",
+			},
+			cli.StringFlag{
+				Name:     FlagVolumeShrinkFSType,
+				Required: true,
+				Usage:    "Specify the filesystem of the volume to shrink, ext4 or xfs",
+			},
+			cli.StringFlag{
+				Name:  FlagVolumeShrinkMode,
+				Value: types.VolumeShrinkMoverModeCopy,
+				Usage: fmt.Sprintf("Specify %v to format the smaller volume and copy the files, or %v to copy the remaining changes and verify the files",
+					types.VolumeShrinkMoverModeCopy, types.VolumeShrinkMoverModeFinal),
+			},
+			cli.IntFlag{
+				Name:  FlagVolumeShrinkPort,
+				Value: types.VolumeShrinkMoverPort,
+				Usage: "Specify the port the progress is served on",
+			},
+		},
+		Action: func(c *cli.Context) {
+			if err := volumeShrinkMover(c); err != nil {
+				// The message is shown in the volume shrink by the controller
+				if errWrite := os.WriteFile(volumeShrinkTerminationMessagePath, []byte(err.Error()), 0644); errWrite != nil {
+					logrus.WithError(errWrite).Warn("Failed to write termination message")
+				}
+				logrus.WithError(err).Fatal("Failed to run volume shrink mover")
+			}
+		},
+	}
+}
+
+func volumeShrinkMover(c *cli.Context) error {
+	sourceDevice := c.String(FlagVolumeShrinkSourceDevice)
+	targetDevice := c.String(FlagVolumeShrinkTargetDevice)
+	fsType := c.String(FlagVolumeShrinkFSType)
+	mode := c.String(FlagVolumeShrinkMode)
+	if fsType != "ext4" && fsType != "xfs" {
+		return fmt.Errorf("unsupported filesystem %v", fsType)
+	}
+	if mode != types.VolumeShrinkMoverModeCopy && mode != types.VolumeShrinkMoverModeFinal {
+		return fmt.Errorf("unknown mode %v", mode)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigs
+		cancel()
+	}()
+
+	progress := &atomic.Int64{}
+	server := &http.Server{
+		Addr: fmt.Sprintf(":%v", c.Int(FlagVolumeShrinkPort)),
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != types.VolumeShrinkMoverProgressPath {
+				http.NotFound(w, req)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(&types.VolumeShrinkMoverProgress{Progress: int(progress.Load())}); err != nil {
+				logrus.WithError(err).Warn("Failed to encode progress")
+			}
+		}),
+	}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logrus.WithError(err).Warn("Failed to serve progress")
+		}
+	}()
+	defer func() {
+		if err := server.Close(); err != nil {
+			logrus.WithError(err).Warn("Failed to close progress server")
+		}
+	}()
+
+	// In the copy mode the workload may have the source filesystem mounted read-write on this node. Mounting the
+	// device with the same flags shares the superblock of the workload mount, and the mover only reads from it.
+	sourceOptions := []string{}
+	if mode == types.VolumeShrinkMoverModeFinal {
+		sourceOptions = []string{"ro"}
+	}
+	mounter := &mount.SafeFormatAndMount{Interface: mount.New(""), Exec: utilexec.New()}
+	if err := mountVolumeShrinkDevice(sourceDevice, volumeShrinkSourceMountPath, func() error {
+		return mounter.Mount(sourceDevice, volumeShrinkSourceMountPath, fsType, sourceOptions)
+	}); err != nil {
+		return err
+	}
+	defer unmountVolumeShrinkDevice(mounter, volumeShrinkSourceMountPath)
+
+	// The target volume is blank before the first copy, so it is formatted on the first mount
+	if err := mountVolumeShrinkDevice(targetDevice, volumeShrinkTargetMountPath, func() error {
+		return mounter.FormatAndMount(targetDevice, volumeShrinkTargetMountPath, fsType, nil)
+	}); err != nil {
+		return err
+	}
+	defer unmountVolumeShrinkDevice(mounter, volumeShrinkTargetMountPath)
+
+	logrus.Infof("Copying files from device %v to device %v", sourceDevice, targetDevice)
+	if err := copyVolumeShrinkFiles(ctx, volumeShrinkSourceMountPath, volumeShrinkTargetMountPath, progress); err != nil {
+		return err
+	}
+	if mode == types.VolumeShrinkMoverModeCopy {
+		logrus.Info("Copied files")
+		return nil
+	}
+
+	logrus.Info("Verifying files")
+	if err := verifyVolumeShrinkFiles(ctx, volumeShrinkSourceMountPath, volumeShrinkTargetMountPath); err != nil {
+		return err
+	}
+	logrus.Info("Verified files")
+	return nil
+}
+
+func mountVolumeShrinkDevice(device, mountPath string, mountFunc func() error) error {
+	if err := os.MkdirAll(mountPath, 0755); err != nil {
+		return errors.Wrapf(err, "failed to create mount path %v", mountPath)
+	}
+	if err := mountFunc(); err != nil {
+		return errors.Wrapf(err, "failed to mount device %v to %v", device, mountPath)
+	}
+	return nil
+}
+
+func unmountVolumeShrinkDevice(mounter mount.Interface, mountPath string) {
+	if err := mounter.Unmount(mountPath); err != nil {
+		logrus.WithError(err).Warnf("Failed to unmount %v", mountPath)
+	}
+}
+
+// copyVolumeShrinkFiles copies the files with the hard links, the ACLs and the extended attributes, and removes the
+// files deleted from the source since the last copy.
+func copyVolumeShrinkFiles(ctx context.Context, sourcePath, targetPath string, progress *atomic.Int64) error {
+	cmd := exec.CommandContext(ctx, "rsync", "-aHAX", "--numeric-ids", "--delete", "--info=progress2", "--no-inc-recursive",
+		sourcePath+"/", targetPath+"/")
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	if err := cmd.Start(); err != nil {
+		return errors.Wrap(err, "failed to start rsync")
+	}
+
+	scanner := bufio.NewScanner(stdout)
+	scanner.Split(scanRsyncProgressLines)
+	for scanner.Scan() {
+		if percentage, ok := parseRsyncProgress(scanner.Text()); ok {
+			progress.Store(int64(percentage))
+		}
+	}
+	if _, err := io.Copy(io.Discard, stdout); err != nil {
+		logrus.WithError(err).Warn("Failed to drain rsync output")
+	}
+
+	if err := cmd.Wait(); err != nil {
+		return errors.Wrapf(err, "failed to copy files: %v", strings.TrimSpace(stderr.String()))
+	}
+	progress.Store(100)
+	return nil
+}
+
+// verifyVolumeShrinkFiles compares the content and the attributes of the files, and fails if any file differs.
+func verifyVolumeShrinkFiles(ctx context.Context, sourcePath, targetPath string) error {
+	output, err := exec.CommandContext(ctx, "rsync", "-aHAX", "--numeric-ids", "--delete", "--dry-run", "--checksum",
+		"--out-format=%i %n", sourcePath+"/", targetPath+"/").CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "failed to verify files: %v", strings.TrimSpace(string(output)))
+	}
+	if differences := strings.TrimSpace(string(output)); differences != "" {
+		lines := strings.Split(differences, "\n")
+		return fmt.Errorf("%v files differ after the copy, first difference: %v", len(lines), lines[0])
+	}
+	return nil
+}
+
+// scanRsyncProgressLines splits the output of rsync by the carriage returns of the progress updates as well as by
+// the line feeds.
+func scanRsyncProgressLines(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if i := bytes.IndexAny(data, "\r\n"); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+	if atEOF {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// parseRsyncProgress returns the overall percentage of a progress line of rsync, e.g.
+// "    1,234,567  45%   10.00MB/s    0:00:10 (xfr#3, to-chk=0/5)".
+func parseRsyncProgress(line string) (int, bool) {
+	matches := rsyncProgressRegex.FindStringSubmatch(line + " ")
+	if len(matches) != 2 {
+		return 0, false
+	}
+	percentage, err := strconv.Atoi(matches[1])
+	if err != nil || percentage > 100 {
+		return 0, false
+	}
+	return percentage, true
+}
@@ -13,10 +13,18 @@ type Attachment struct {
 
 	Conditions []LonghornCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
 
+	ExpiresAt string `json:"expiresAt,omitempty" yaml:"expires_at,omitempty"`
+
 	NodeID string `json:"nodeID,omitempty" yaml:"node_id,omitempty"`
 
 	Parameters map[string]string `json:"parameters,omitempty" yaml:"parameters,omitempty"`
 
+	Priority int64 `json:"priority,omitempty" yaml:"priority,omitempty"`
+
+	Reason string `json:"reason,omitempty" yaml:"reason,omitempty"`
+
+	Reservation bool `json:"reservation,omitempty" yaml:"reservation,omitempty"`
+
 	Satisfied bool `json:"satisfied,omitempty" yaml:"satisfied,omitempty"`
 }
 
@@ -0,0 +1,79 @@
+package client
+
+const (
+	ATTACHMENT_TICKET_DELETE_INPUT_TYPE = "attachmentTicketDeleteInput"
+)
+
+type AttachmentTicketDeleteInput struct {
+	Resource `yaml:"-"`
+
+	AttachmentID string `json:"attachmentID,omitempty" yaml:"attachment_id,omitempty"`
+}
+
+type AttachmentTicketDeleteInputCollection struct {
+	Collection
+	Data   []AttachmentTicketDeleteInput `json:"data,omitempty"`
+	client *AttachmentTicketDeleteInputClient
+}
+
+type AttachmentTicketDeleteInputClient struct {
+	rancherClient *RancherClient
+}
+
+type AttachmentTicketDeleteInputOperations interface {
+	List(opts *ListOpts) (*AttachmentTicketDeleteInputCollection, error)
+	Create(opts *AttachmentTicketDeleteInput) (*AttachmentTicketDeleteInput, error)
+	Update(existing *AttachmentTicketDeleteInput, updates interface{}) (*AttachmentTicketDeleteInput, error)
+	ById(id string) (*AttachmentTicketDeleteInput, error)
+	Delete(container *AttachmentTicketDeleteInput) error
+}
+
+func newAttachmentTicketDeleteInputClient(rancherClient *RancherClient) *AttachmentTicketDeleteInputClient {
+	return &AttachmentTicketDeleteInputClient{
+		rancherClient: rancherClient,
+	}
+}
+
+func (c *AttachmentTicketDeleteInputClient) Create(container *AttachmentTicketDeleteInput) (*AttachmentTicketDeleteInput, error) {
+	resp := &AttachmentTicketDeleteInput{}
+	err := c.rancherClient.doCreate(ATTACHMENT_TICKET_DELETE_INPUT_TYPE, container, resp)
+	return resp, err
+}
+
+func (c *AttachmentTicketDeleteInputClient) Update(existing *AttachmentTicketDeleteInput, updates interface{}) (*AttachmentTicketDeleteInput, error) {
+	resp := &AttachmentTicketDeleteInput{}
+	err := c.rancherClient.doUpdate(ATTACHMENT_TICKET_DELETE_INPUT_TYPE, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *AttachmentTicketDeleteInputClient) List(opts *ListOpts) (*AttachmentTicketDeleteInputCollection, error) {
+	resp := &AttachmentTicketDeleteInputCollection{}
+	err := c.rancherClient.doList(ATTACHMENT_TICKET_DELETE_INPUT_TYPE, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (cc *AttachmentTicketDeleteInputCollection) Next() (*AttachmentTicketDeleteInputCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &AttachmentTicketDeleteInputCollection{}
+		err := cc.client.rancherClient.doNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *AttachmentTicketDeleteInputClient) ById(id string) (*AttachmentTicketDeleteInput, error) {
+	resp := &AttachmentTicketDeleteInput{}
+	err := c.rancherClient.doById(ATTACHMENT_TICKET_DELETE_INPUT_TYPE, id, resp)
+	if apiError, ok := err.(*ApiError); ok {
+		if apiError.StatusCode == 404 {
+			return nil, nil
+		}
+	}
+	return resp, err
+}
+
+func (c *AttachmentTicketDeleteInputClient) Delete(container *AttachmentTicketDeleteInput) error {
+	return c.rancherClient.doResourceDelete(ATTACHMENT_TICKET_DELETE_INPUT_TYPE, &container.Resource)
+}
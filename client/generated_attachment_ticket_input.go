@@ -0,0 +1,91 @@
+package client
+
+const (
+	ATTACHMENT_TICKET_INPUT_TYPE = "attachmentTicketInput"
+)
+
+type AttachmentTicketInput struct {
+	Resource `yaml:"-"`
+
+	AttachmentID string `json:"attachmentID,omitempty" yaml:"attachment_id,omitempty"`
+
+	DisableFrontend bool `json:"disableFrontend,omitempty" yaml:"disable_frontend,omitempty"`
+
+	NodeID string `json:"nodeID,omitempty" yaml:"node_id,omitempty"`
+
+	Priority int64 `json:"priority,omitempty" yaml:"priority,omitempty"`
+
+	Reason string `json:"reason,omitempty" yaml:"reason,omitempty"`
+
+	Reservation bool `json:"reservation,omitempty" yaml:"reservation,omitempty"`
+
+	TtlSeconds int64 `json:"ttlSeconds,omitempty" yaml:"ttl_seconds,omitempty"`
+}
+
+type AttachmentTicketInputCollection struct {
+	Collection
+	Data   []AttachmentTicketInput `json:"data,omitempty"`
+	client *AttachmentTicketInputClient
+}
+
+type AttachmentTicketInputClient struct {
+	rancherClient *RancherClient
+}
+
+type AttachmentTicketInputOperations interface {
+	List(opts *ListOpts) (*AttachmentTicketInputCollection, error)
+	Create(opts *AttachmentTicketInput) (*AttachmentTicketInput, error)
+	Update(existing *AttachmentTicketInput, updates interface{}) (*AttachmentTicketInput, error)
+	ById(id string) (*AttachmentTicketInput, error)
+	Delete(container *AttachmentTicketInput) error
+}
+
+func newAttachmentTicketInputClient(rancherClient *RancherClient) *AttachmentTicketInputClient {
+	return &AttachmentTicketInputClient{
+		rancherClient: rancherClient,
+	}
+}
+
+func (c *AttachmentTicketInputClient) Create(container *AttachmentTicketInput) (*AttachmentTicketInput, error) {
+	resp := &AttachmentTicketInput{}
+	err := c.rancherClient.doCreate(ATTACHMENT_TICKET_INPUT_TYPE, container, resp)
+	return resp, err
+}
+
+func (c *AttachmentTicketInputClient) Update(existing *AttachmentTicketInput, updates interface{}) (*AttachmentTicketInput, error) {
+	resp := &AttachmentTicketInput{}
+	err := c.rancherClient.doUpdate(ATTACHMENT_TICKET_INPUT_TYPE, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *AttachmentTicketInputClient) List(opts *ListOpts) (*AttachmentTicketInputCollection, error) {
+	resp := &AttachmentTicketInputCollection{}
+	err := c.rancherClient.doList(ATTACHMENT_TICKET_INPUT_TYPE, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (cc *AttachmentTicketInputCollection) Next() (*AttachmentTicketInputCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &AttachmentTicketInputCollection{}
+		err := cc.client.rancherClient.doNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *AttachmentTicketInputClient) ById(id string) (*AttachmentTicketInput, error) {
+	resp := &AttachmentTicketInput{}
+	err := c.rancherClient.doById(ATTACHMENT_TICKET_INPUT_TYPE, id, resp)
+	if apiError, ok := err.(*ApiError); ok {
+		if apiError.StatusCode == 404 {
+			return nil, nil
+		}
+	}
+	return resp, err
+}
+
+func (c *AttachmentTicketInputClient) Delete(container *AttachmentTicketInput) error {
+	return c.rancherClient.doResourceDelete(ATTACHMENT_TICKET_INPUT_TYPE, &container.Resource)
+}
@@ -7,6 +7,8 @@ type RancherClient struct {
 	Error                                  ErrorOperations
 	AttachInput                            AttachInputOperations
 	DetachInput                            DetachInputOperations
+	AttachmentTicketInput                  AttachmentTicketInputOperations
+	AttachmentTicketDeleteInput            AttachmentTicketDeleteInputOperations
 	SnapshotInput                          SnapshotInputOperations
 	SnapshotCRInput                        SnapshotCRInputOperations
 	Backup                                 BackupOperations
@@ -26,6 +28,7 @@ type RancherClient struct {
 	Controller                             ControllerOperations
 	DiskUpdate                             DiskUpdateOperations
 	UpdateReplicaCountInput                UpdateReplicaCountInputOperations
+	UpdateFilesystemUsageInput             UpdateFilesystemUsageInputOperations
 	UpdateReplicaAutoBalanceInput          UpdateReplicaAutoBalanceInputOperations
 	UpdateDataLocalityInput                UpdateDataLocalityInputOperations
 	UpdateAccessModeInput                  UpdateAccessModeInputOperations
@@ -94,6 +97,8 @@ func constructClient(rancherBaseClient *RancherBaseClientImpl) *RancherClient {
 	client.Error = newErrorClient(client)
 	client.AttachInput = newAttachInputClient(client)
 	client.DetachInput = newDetachInputClient(client)
+	client.AttachmentTicketInput = newAttachmentTicketInputClient(client)
+	client.AttachmentTicketDeleteInput = newAttachmentTicketDeleteInputClient(client)
 	client.SnapshotInput = newSnapshotInputClient(client)
 	client.SnapshotCRInput = newSnapshotCRInputClient(client)
 	client.Backup = newBackupClient(client)
@@ -113,6 +118,7 @@ func constructClient(rancherBaseClient *RancherBaseClientImpl) *RancherClient {
 	client.Controller = newControllerClient(client)
 	client.DiskUpdate = newDiskUpdateClient(client)
 	client.UpdateReplicaCountInput = newUpdateReplicaCountInputClient(client)
+	client.UpdateFilesystemUsageInput = newUpdateFilesystemUsageInputClient(client)
 	client.UpdateReplicaAutoBalanceInput = newUpdateReplicaAutoBalanceInputClient(client)
 	client.UpdateDataLocalityInput = newUpdateDataLocalityInputClient(client)
 	client.UpdateAccessModeInput = newUpdateAccessModeInputClient(client)
@@ -9,12 +9,20 @@ type CloneStatus struct {
 
 	AttemptCount int64 `json:"attemptCount,omitempty" yaml:"attempt_count,omitempty"`
 
+	CompletedAt string `json:"completedAt,omitempty" yaml:"completed_at,omitempty"`
+
+	EstimatedStartAt string `json:"estimatedStartAt,omitempty" yaml:"estimated_start_at,omitempty"`
+
 	NextAllowedAttemptAt string `json:"nextAllowedAttemptAt,omitempty" yaml:"next_allowed_attempt_at,omitempty"`
 
+	QueuePosition int64 `json:"queuePosition,omitempty" yaml:"queue_position,omitempty"`
+
 	Snapshot string `json:"snapshot,omitempty" yaml:"snapshot,omitempty"`
 
 	SourceVolume string `json:"sourceVolume,omitempty" yaml:"source_volume,omitempty"`
 
+	StartedAt string `json:"startedAt,omitempty" yaml:"started_at,omitempty"`
+
 	State string `json:"state,omitempty" yaml:"state,omitempty"`
 }
 
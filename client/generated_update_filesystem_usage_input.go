@@ -0,0 +1,81 @@
+package client
+
+const (
+	UPDATE_FILESYSTEM_USAGE_INPUT_TYPE = "UpdateFilesystemUsageInput"
+)
+
+type UpdateFilesystemUsageInput struct {
+	Resource `yaml:"-"`
+
+	TotalBytes int64 `json:"totalBytes,omitempty" yaml:"total_bytes,omitempty"`
+
+	UsedBytes int64 `json:"usedBytes,omitempty" yaml:"used_bytes,omitempty"`
+}
+
+type UpdateFilesystemUsageInputCollection struct {
+	Collection
+	Data   []UpdateFilesystemUsageInput `json:"data,omitempty"`
+	client *UpdateFilesystemUsageInputClient
+}
+
+type UpdateFilesystemUsageInputClient struct {
+	rancherClient *RancherClient
+}
+
+type UpdateFilesystemUsageInputOperations interface {
+	List(opts *ListOpts) (*UpdateFilesystemUsageInputCollection, error)
+	Create(opts *UpdateFilesystemUsageInput) (*UpdateFilesystemUsageInput, error)
+	Update(existing *UpdateFilesystemUsageInput, updates interface{}) (*UpdateFilesystemUsageInput, error)
+	ById(id string) (*UpdateFilesystemUsageInput, error)
+	Delete(container *UpdateFilesystemUsageInput) error
+}
+
+func newUpdateFilesystemUsageInputClient(rancherClient *RancherClient) *UpdateFilesystemUsageInputClient {
+	return &UpdateFilesystemUsageInputClient{
+		rancherClient: rancherClient,
+	}
+}
+
+func (c *UpdateFilesystemUsageInputClient) Create(container *UpdateFilesystemUsageInput) (*UpdateFilesystemUsageInput, error) {
+	resp := &UpdateFilesystemUsageInput{}
+	err := c.rancherClient.doCreate(UPDATE_FILESYSTEM_USAGE_INPUT_TYPE, container, resp)
+	return resp, err
+}
+
+func (c *UpdateFilesystemUsageInputClient) Update(existing *UpdateFilesystemUsageInput, updates interface{}) (*UpdateFilesystemUsageInput, error) {
+	resp := &UpdateFilesystemUsageInput{}
+	err := c.rancherClient.doUpdate(UPDATE_FILESYSTEM_USAGE_INPUT_TYPE, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *UpdateFilesystemUsageInputClient) List(opts *ListOpts) (*UpdateFilesystemUsageInputCollection, error) {
+	resp := &UpdateFilesystemUsageInputCollection{}
+	err := c.rancherClient.doList(UPDATE_FILESYSTEM_USAGE_INPUT_TYPE, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (cc *UpdateFilesystemUsageInputCollection) Next() (*UpdateFilesystemUsageInputCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &UpdateFilesystemUsageInputCollection{}
+		err := cc.client.rancherClient.doNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *UpdateFilesystemUsageInputClient) ById(id string) (*UpdateFilesystemUsageInput, error) {
+	resp := &UpdateFilesystemUsageInput{}
+	err := c.rancherClient.doById(UPDATE_FILESYSTEM_USAGE_INPUT_TYPE, id, resp)
+	if apiError, ok := err.(*ApiError); ok {
+		if apiError.StatusCode == 404 {
+			return nil, nil
+		}
+	}
+	return resp, err
+}
+
+func (c *UpdateFilesystemUsageInputClient) Delete(container *UpdateFilesystemUsageInput) error {
+	return c.rancherClient.doResourceDelete(UPDATE_FILESYSTEM_USAGE_INPUT_TYPE, &container.Resource)
+}
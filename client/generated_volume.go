@@ -188,6 +188,8 @@ type VolumeOperations interface {
 	ActionTrimFilesystem(*Volume) (*Volume, error)
 
 	ActionUpdateAccessMode(*Volume, *UpdateAccessModeInput) (*Volume, error)
+
+	ActionUpdateFilesystemUsage(*Volume, *UpdateFilesystemUsageInput) (*Volume, error)
 }
 
 func newVolumeClient(rancherClient *RancherClient) *VolumeClient {
@@ -473,3 +475,12 @@ func (c *VolumeClient) ActionUpdateAccessMode(resource *Volume, input *UpdateAcc
 
 	return resp, err
 }
+
+func (c *VolumeClient) ActionUpdateFilesystemUsage(resource *Volume, input *UpdateFilesystemUsageInput) (*Volume, error) {
+
+	resp := &Volume{}
+
+	err := c.rancherClient.doAction(VOLUME_TYPE, "updateFilesystemUsage", &resource.Resource, input, resp)
+
+	return resp, err
+}
@@ -28,6 +28,10 @@ type VolumeAttachmentOperations interface {
 	Update(existing *VolumeAttachment, updates interface{}) (*VolumeAttachment, error)
 	ById(id string) (*VolumeAttachment, error)
 	Delete(container *VolumeAttachment) error
+
+	ActionAttachmentTicketCreate(*VolumeAttachment, *AttachmentTicketInput) (*VolumeAttachment, error)
+
+	ActionAttachmentTicketDelete(*VolumeAttachment, *AttachmentTicketDeleteInput) (*VolumeAttachment, error)
 }
 
 func newVolumeAttachmentClient(rancherClient *RancherClient) *VolumeAttachmentClient {
@@ -79,3 +83,21 @@ func (c *VolumeAttachmentClient) ById(id string) (*VolumeAttachment, error) {
 func (c *VolumeAttachmentClient) Delete(container *VolumeAttachment) error {
 	return c.rancherClient.doResourceDelete(VOLUME_ATTACHMENT_TYPE, &container.Resource)
 }
+
+func (c *VolumeAttachmentClient) ActionAttachmentTicketCreate(resource *VolumeAttachment, input *AttachmentTicketInput) (*VolumeAttachment, error) {
+
+	resp := &VolumeAttachment{}
+
+	err := c.rancherClient.doAction(VOLUME_ATTACHMENT_TYPE, "attachmentTicketCreate", &resource.Resource, input, resp)
+
+	return resp, err
+}
+
+func (c *VolumeAttachmentClient) ActionAttachmentTicketDelete(resource *VolumeAttachment, input *AttachmentTicketDeleteInput) (*VolumeAttachment, error) {
+
+	resp := &VolumeAttachment{}
+
+	err := c.rancherClient.doAction(VOLUME_ATTACHMENT_TYPE, "attachmentTicketDelete", &resource.Resource, input, resp)
+
+	return resp, err
+}
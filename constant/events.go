@@ -29,9 +29,11 @@ const (
 	EventReasonRestoredFmt   = "Restored %v"
 	EventReasonFailedRestore = "FailedRestore"
 
-	EventReasonFailedExpansion    = "FailedExpansion"
-	EventReasonSucceededExpansion = "SucceededExpansion"
-	EventReasonCanceledExpansion  = "CanceledExpansion"
+	EventReasonFailedExpansion     = "FailedExpansion"
+	EventReasonSucceededExpansion  = "SucceededExpansion"
+	EventReasonCanceledExpansion   = "CanceledExpansion"
+	EventReasonAutoExpansion       = "AutoExpansion"
+	EventReasonFailedAutoExpansion = "FailedAutoExpansion"
 
 	EventReasonAttached = "Attached"
 	EventReasonDetached = "Detached"
@@ -45,6 +47,7 @@ const (
 	EventReasonEvictionUserRequested = "EvictionUserRequested"
 	EventReasonEvictionCanceled      = "EvictionCanceled"
 	EventReasonEvictionFailed        = "EvictionFailed"
+	EventReasonEvictionTiering       = "EvictionTiering"
 
 	EventReasonDetachedUnexpectedly = "DetachedUnexpectedly"
 	EventReasonRemount              = "Remount"
@@ -68,8 +71,55 @@ const (
 	EventReasonPassedUpgradeCheck     = "PassedUpgradeCheck"
 
 	EventReasonRolloutSkippedFmt = "RolloutSkipped: %v %v"
+	EventReasonRolloutPaused     = "RolloutPaused"
+	EventReasonRolloutResumed    = "RolloutResumed"
+	EventReasonRolloutRolledBack = "RolloutRolledBack"
+	EventReasonRolloutCompleted  = "RolloutCompleted"
 
 	EventReasonMigrationFailed = "MigrationFailed"
 
 	EventReasonOrphanCleanupCompleted = "OrphanCleanupCompleted"
+
+	EventReasonForceDeleted            = "ForceDeleted"
+	EventReasonFailedForceDeleting     = "FailedForceDeleting"
+	EventReasonWaitingForNodeFencing   = "WaitingForNodeFencing"
+	EventReasonDeletedVolumeAttachment = "DeletedVolumeAttachment"
+
+	EventReasonDiskProvisioned = "DiskProvisioned"
+
+	EventReasonNodeMaintenanceDrained  = "NodeMaintenanceDrained"
+	EventReasonNodeMaintenanceAborted  = "NodeMaintenanceAborted"
+	EventReasonNodeMaintenanceTimedOut = "NodeMaintenanceTimedOut"
+
+	EventReasonTieringStarted   = "TieringStarted"
+	EventReasonTieringCompleted = "TieringCompleted"
+	EventReasonTieringCanceled  = "TieringCanceled"
+
+	EventReasonNotificationFailed = "NotificationFailed"
+
+	EventReasonPreflightCheckCompleted = "PreflightCheckCompleted"
+
+	EventReasonDisasterRecoveryPlanFailoverStarted = "DisasterRecoveryPlanFailoverStarted"
+	EventReasonDisasterRecoveryPlanFailedOver      = "DisasterRecoveryPlanFailedOver"
+	EventReasonDisasterRecoveryPlanFailoverFailed  = "DisasterRecoveryPlanFailoverFailed"
+
+	EventReasonFileRestoreSessionCreatedVolume = "FileRestoreSessionCreatedVolume"
+	EventReasonFileRestoreSessionReady         = "FileRestoreSessionReady"
+	EventReasonFileRestoreSessionExpired       = "FileRestoreSessionExpired"
+	EventReasonFailedFileRestoreSession        = "FailedFileRestoreSession"
+
+	EventReasonDataEngineConversionCloned     = "DataEngineConversionCloned"
+	EventReasonDataEngineConversionFinalized  = "DataEngineConversionFinalized"
+	EventReasonDataEngineConversionRolledBack = "DataEngineConversionRolledBack"
+	EventReasonDataEngineConversionSwapped    = "DataEngineConversionSwapped"
+	EventReasonDataEngineConversionFailed     = "DataEngineConversionFailed"
+
+	EventReasonVolumeTransferCreatedVolume = "VolumeTransferCreatedVolume"
+	EventReasonVolumeTransferSyncFailed    = "VolumeTransferSyncFailed"
+	EventReasonVolumeTransferCompleted     = "VolumeTransferCompleted"
+	EventReasonVolumeTransferFailed        = "VolumeTransferFailed"
+
+	EventReasonVolumeShrinkCopied  = "VolumeShrinkCopied"
+	EventReasonVolumeShrinkSwapped = "VolumeShrinkSwapped"
+	EventReasonVolumeShrinkFailed  = "VolumeShrinkFailed"
 )
@@ -84,7 +84,7 @@ func StartControllers(logger logrus.FieldLogger, clients *client.Clients,
 	if err != nil {
 		return nil, err
 	}
-	instanceManagerController, err := NewInstanceManagerController(logger, ds, scheme, kubeClient, namespace, controllerID, serviceAccount, proxyConnCounter)
+	instanceManagerController, err := NewInstanceManagerController(logger, ds, scheme, kubeClient, metricsClient, namespace, controllerID, serviceAccount, proxyConnCounter)
 	if err != nil {
 		return nil, err
 	}
@@ -112,6 +112,38 @@ func StartControllers(logger logrus.FieldLogger, clients *client.Clients,
 	if err != nil {
 		return nil, err
 	}
+	nodeMaintenanceController, err := NewNodeMaintenanceController(logger, ds, scheme, kubeClient, controllerID, namespace)
+	if err != nil {
+		return nil, err
+	}
+	notificationSinkController, err := NewNotificationSinkController(logger, ds, scheme, kubeClient, controllerID, namespace)
+	if err != nil {
+		return nil, err
+	}
+	preflightCheckController, err := NewPreflightCheckController(logger, ds, scheme, kubeClient, controllerID, namespace)
+	if err != nil {
+		return nil, err
+	}
+	disasterRecoveryPlanController, err := NewDisasterRecoveryPlanController(logger, ds, scheme, kubeClient, controllerID, namespace)
+	if err != nil {
+		return nil, err
+	}
+	fileRestoreSessionController, err := NewFileRestoreSessionController(logger, ds, scheme, kubeClient, controllerID, namespace, managerImage)
+	if err != nil {
+		return nil, err
+	}
+	dataEngineConversionController, err := NewDataEngineConversionController(logger, ds, scheme, kubeClient, controllerID, namespace)
+	if err != nil {
+		return nil, err
+	}
+	volumeTransferController, err := NewVolumeTransferController(logger, ds, scheme, kubeClient, controllerID, namespace)
+	if err != nil {
+		return nil, err
+	}
+	volumeShrinkController, err := NewVolumeShrinkController(logger, ds, scheme, kubeClient, controllerID, namespace, managerImage)
+	if err != nil {
+		return nil, err
+	}
 	snapshotController, err := NewSnapshotController(logger, ds, scheme, kubeClient, namespace, controllerID, &engineapi.EngineCollection{}, proxyConnCounter)
 	if err != nil {
 		return nil, err
@@ -144,6 +176,10 @@ func StartControllers(logger logrus.FieldLogger, clients *client.Clients,
 	if err != nil {
 		return nil, err
 	}
+	volumeTieringController, err := NewVolumeTieringController(logger, ds, scheme, kubeClient, controllerID, namespace)
+	if err != nil {
+		return nil, err
+	}
 	volumeCloneController, err := NewVolumeCloneController(logger, ds, scheme, kubeClient, controllerID, namespace)
 	if err != nil {
 		return nil, err
@@ -202,6 +238,14 @@ func StartControllers(logger logrus.FieldLogger, clients *client.Clients,
 	go backupBackingImageController.Run(Workers, stopCh)
 	go recurringJobController.Run(Workers, stopCh)
 	go orphanController.Run(Workers, stopCh)
+	go nodeMaintenanceController.Run(Workers, stopCh)
+	go notificationSinkController.Run(Workers, stopCh)
+	go preflightCheckController.Run(Workers, stopCh)
+	go disasterRecoveryPlanController.Run(Workers, stopCh)
+	go fileRestoreSessionController.Run(Workers, stopCh)
+	go dataEngineConversionController.Run(Workers, stopCh)
+	go volumeTransferController.Run(Workers, stopCh)
+	go volumeShrinkController.Run(Workers, stopCh)
 	go snapshotController.Run(Workers, stopCh)
 	go supportBundleController.Run(Workers, stopCh)
 	go systemBackupController.Run(Workers, stopCh)
@@ -210,6 +254,7 @@ func StartControllers(logger logrus.FieldLogger, clients *client.Clients,
 	go volumeRestoreController.Run(Workers, stopCh)
 	go volumeRebuildingController.Run(Workers, stopCh)
 	go volumeEvictionController.Run(Workers, stopCh)
+	go volumeTieringController.Run(Workers, stopCh)
 	go volumeCloneController.Run(Workers, stopCh)
 	go volumeExpansionController.Run(Workers, stopCh)
 
@@ -269,6 +314,15 @@ func GetInstanceManagerCPURequirement(ds *datastore.DataStore, imName string) (*
 			}
 			allocatableMilliCPU := float64(kubeNode.Status.Allocatable.Cpu().MilliValue())
 			cpuRequest = int(math.Round(allocatableMilliCPU * guaranteedCPUPercentage / 100.0))
+
+			// The recommendation replaces the guaranteed CPU but not the CPU request of the node set by users.
+			recommendation, err := GetAppliedInstanceManagerResourceRecommendation(ds, im)
+			if err != nil {
+				return nil, err
+			}
+			if recommendation != nil {
+				cpuRequest = int(recommendation.CPURequest)
+			}
 		}
 	default:
 		return nil, fmt.Errorf("unknown data engine %v", im.Spec.DataEngine)
@@ -277,6 +331,19 @@ func GetInstanceManagerCPURequirement(ds *datastore.DataStore, imName string) (*
 	return ParseResourceRequirement(fmt.Sprintf("%dm", cpuRequest))
 }
 
+// GetAppliedInstanceManagerResourceRecommendation returns the resource recommendation of the instance manager if the
+// recommendation is applied to the instance manager pod.
+func GetAppliedInstanceManagerResourceRecommendation(ds *datastore.DataStore, im *longhorn.InstanceManager) (*longhorn.InstanceManagerResourceRecommendation, error) {
+	mode, err := ds.GetSettingValueExisted(types.SettingNameInstanceManagerResourceRecommendationMode)
+	if err != nil {
+		return nil, err
+	}
+	if types.InstanceManagerResourceRecommendationMode(mode) != types.InstanceManagerResourceRecommendationModeApply {
+		return nil, nil
+	}
+	return im.Status.ResourceRecommendation, nil
+}
+
 func isControllerResponsibleFor(controllerID string, ds *datastore.DataStore, name, preferredOwnerID, currentOwnerID string) bool {
 	// we use this approach so that if there is an issue with the data store
 	// we don't accidentally transfer ownership
@@ -0,0 +1,924 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientset "k8s.io/client-go/kubernetes"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/longhorn/longhorn-manager/constant"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/util/dataengineconversion"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	dataEngineConversionSwapCheckInterval = 5 * time.Second
+	dataEngineConversionSyncRetryInterval = 30 * time.Second
+)
+
+// DataEngineConversionController converts a volume to the other data engine. It copies the block device of the
+// volume into a new volume with the target data engine while the workload keeps running, since the snapshot clone of
+// the engines cannot cross data engines. Once the workload detaches the volume, it copies the changes written during
+// the copy into the new volume, compares the content of both volumes, and then rebinds the PVC of the volume to the
+// new volume. The new volume is deleted if the verification fails, and the source volume is retained after the swap.
+type DataEngineConversionController struct {
+	*baseController
+
+	// which namespace controller is running with
+	namespace string
+	// use as the OwnerID of the controller
+	controllerID string
+
+	kubeClient    clientset.Interface
+	eventRecorder record.EventRecorder
+
+	ds *datastore.DataStore
+
+	cacheSyncs []cache.InformerSynced
+
+	syncersLock sync.Mutex
+	syncers     map[string]*dataEngineConversionSyncer
+}
+
+// dataEngineConversionSyncer runs the copy or the final sync of a conversion in the background, since it reads both
+// volumes entirely.
+type dataEngineConversionSyncer struct {
+	lock sync.RWMutex
+
+	cancel   context.CancelFunc
+	progress int
+
+	done       bool
+	finishedAt time.Time
+	result     *dataengineconversion.SyncResult
+	err        error
+}
+
+func (s *dataEngineConversionSyncer) update(progress int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.progress = progress
+}
+
+func (s *dataEngineConversionSyncer) finish(result *dataengineconversion.SyncResult, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.done = true
+	s.finishedAt = time.Now()
+	s.result = result
+	s.err = err
+}
+
+func NewDataEngineConversionController(
+	logger logrus.FieldLogger,
+	ds *datastore.DataStore,
+	scheme *runtime.Scheme,
+	kubeClient clientset.Interface,
+	controllerID string,
+	namespace string) (*DataEngineConversionController, error) {
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(logrus.Infof)
+	// TODO: remove the wrapper when every clients have moved to use the clientset.
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{
+		Interface: v1core.New(kubeClient.CoreV1().RESTClient()).Events(""),
+	})
+
+	decc := &DataEngineConversionController{
+		baseController: newBaseController("longhorn-data-engine-conversion", logger),
+
+		namespace:    namespace,
+		controllerID: controllerID,
+
+		ds: ds,
+
+		kubeClient:    kubeClient,
+		eventRecorder: eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "longhorn-data-engine-conversion-controller"}),
+
+		syncers: map[string]*dataEngineConversionSyncer{},
+	}
+
+	var err error
+	if _, err = ds.DataEngineConversionInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    decc.enqueueDataEngineConversion,
+		UpdateFunc: func(old, cur interface{}) { decc.enqueueDataEngineConversion(cur) },
+		DeleteFunc: decc.enqueueDataEngineConversion,
+	}); err != nil {
+		return nil, err
+	}
+	decc.cacheSyncs = append(decc.cacheSyncs, ds.DataEngineConversionInformer.HasSynced)
+
+	if _, err = ds.VolumeInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		AddFunc:    decc.enqueueDataEngineConversionForVolume,
+		UpdateFunc: func(old, cur interface{}) { decc.enqueueDataEngineConversionForVolume(cur) },
+		DeleteFunc: decc.enqueueDataEngineConversionForVolume,
+	}, 0); err != nil {
+		return nil, err
+	}
+	decc.cacheSyncs = append(decc.cacheSyncs, ds.VolumeInformer.HasSynced)
+
+	return decc, nil
+}
+
+func (decc *DataEngineConversionController) enqueueDataEngineConversion(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to get key for object %#v: %v", obj, err))
+		return
+	}
+
+	decc.queue.Add(key)
+}
+
+// enqueueDataEngineConversionForVolume enqueues the active conversions of which the volume is the source or the
+// target.
+func (decc *DataEngineConversionController) enqueueDataEngineConversionForVolume(obj interface{}) {
+	v, isVolume := obj.(*longhorn.Volume)
+	if !isVolume {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("received unexpected obj: %#v", obj))
+			return
+		}
+
+		// use the last known state, to enqueue, dependent objects
+		v, ok = deletedState.Obj.(*longhorn.Volume)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("DeletedFinalStateUnknown contained invalid object: %#v", deletedState.Obj))
+			return
+		}
+	}
+
+	conversions, err := decc.ds.ListDataEngineConversionsRO()
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to list data engine conversions since %v", err))
+		return
+	}
+	for _, conversion := range conversions {
+		if conversion.Status.State == longhorn.DataEngineConversionStateCompleted ||
+			conversion.Status.State == longhorn.DataEngineConversionStateRolledBack ||
+			conversion.Status.State == longhorn.DataEngineConversionStateError {
+			continue
+		}
+		if conversion.Spec.VolumeName == v.Name || conversion.Spec.TargetVolumeName == v.Name {
+			decc.enqueueDataEngineConversion(conversion)
+		}
+	}
+}
+
+func (decc *DataEngineConversionController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer decc.queue.ShutDown()
+
+	decc.logger.Info("Starting Longhorn DataEngineConversion controller")
+	defer decc.logger.Info("Shut down Longhorn DataEngineConversion controller")
+
+	if !cache.WaitForNamedCacheSync(decc.name, stopCh, decc.cacheSyncs...) {
+		return
+	}
+	for i := 0; i < workers; i++ {
+		go wait.Until(decc.worker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+func (decc *DataEngineConversionController) worker() {
+	for decc.processNextWorkItem() {
+	}
+}
+
+func (decc *DataEngineConversionController) processNextWorkItem() bool {
+	key, quit := decc.queue.Get()
+	if quit {
+		return false
+	}
+	defer decc.queue.Done(key)
+	err := decc.syncDataEngineConversion(key.(string))
+	decc.handleErr(err, key)
+	return true
+}
+
+func (decc *DataEngineConversionController) handleErr(err error, key interface{}) {
+	if err == nil {
+		decc.queue.Forget(key)
+		return
+	}
+
+	log := decc.logger.WithField("dataEngineConversion", key)
+	if decc.queue.NumRequeues(key) < maxRetries {
+		handleReconcileErrorLogging(log, err, "Failed to sync Longhorn data engine conversion")
+		decc.queue.AddRateLimited(key)
+		return
+	}
+
+	utilruntime.HandleError(err)
+	handleReconcileErrorLogging(log, err, "Dropping Longhorn data engine conversion out of the queue")
+	decc.queue.Forget(key)
+}
+
+func (decc *DataEngineConversionController) syncDataEngineConversion(key string) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to sync data engine conversion %v", key)
+	}()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	if namespace != decc.namespace {
+		return nil
+	}
+	return decc.reconcile(name)
+}
+
+func getLoggerForDataEngineConversion(logger logrus.FieldLogger, conversion *longhorn.DataEngineConversion) *logrus.Entry {
+	return logger.WithFields(
+		logrus.Fields{
+			"dataEngineConversion": conversion.Name,
+			"volume":               conversion.Spec.VolumeName,
+			"targetDataEngine":     conversion.Spec.TargetDataEngine,
+		},
+	)
+}
+
+// isResponsibleFor prefers the owner node of the source volume, so the copy reads the volume on the node the workload
+// attaches it to.
+func (decc *DataEngineConversionController) isResponsibleFor(conversion *longhorn.DataEngineConversion) (bool, error) {
+	source, err := decc.ds.GetVolumeRO(conversion.Spec.VolumeName)
+	if err != nil && !datastore.ErrorIsNotFound(err) {
+		return false, err
+	}
+	preferredOwnerID := ""
+	if source != nil {
+		preferredOwnerID = source.Status.OwnerID
+	}
+	return isControllerResponsibleFor(decc.controllerID, decc.ds, conversion.Name, preferredOwnerID, conversion.Status.OwnerID), nil
+}
+
+func (decc *DataEngineConversionController) reconcile(name string) (err error) {
+	conversion, err := decc.ds.GetDataEngineConversion(name)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		decc.stopSyncer(name)
+		return nil
+	}
+
+	isResponsible, err := decc.isResponsibleFor(conversion)
+	if err != nil {
+		return err
+	}
+	if !isResponsible {
+		decc.stopSyncer(name)
+		return nil
+	}
+
+	log := getLoggerForDataEngineConversion(decc.logger, conversion)
+
+	if conversion.Status.OwnerID != decc.controllerID {
+		conversion.Status.OwnerID = decc.controllerID
+		conversion, err = decc.ds.UpdateDataEngineConversionStatus(conversion)
+		if err != nil {
+			// we don't mind others coming first
+			if datastore.ErrorIsConflict(errors.Cause(err)) {
+				return nil
+			}
+			return err
+		}
+		log.Infof("Data engine conversion got new owner %v", decc.controllerID)
+	}
+
+	if !conversion.DeletionTimestamp.IsZero() {
+		decc.stopSyncer(name)
+		if _, err := decc.releaseVolumes(conversion); err != nil {
+			return err
+		}
+		cleaned, err := decc.cleanup(conversion)
+		if err != nil || !cleaned {
+			return err
+		}
+		return decc.ds.RemoveFinalizerForDataEngineConversion(conversion)
+	}
+
+	existingConversion := conversion.DeepCopy()
+	defer func() {
+		if err != nil {
+			return
+		}
+		if reflect.DeepEqual(existingConversion.Status, conversion.Status) {
+			return
+		}
+		if _, err = decc.ds.UpdateDataEngineConversionStatus(conversion); err != nil && datastore.ErrorIsConflict(errors.Cause(err)) {
+			log.WithError(err).Debugf("Requeue %v due to conflict", name)
+			decc.enqueueDataEngineConversion(conversion)
+			err = nil
+		}
+	}()
+
+	switch conversion.Status.State {
+	case longhorn.DataEngineConversionStateCompleted,
+		longhorn.DataEngineConversionStateRolledBack,
+		longhorn.DataEngineConversionStateError:
+		decc.stopSyncer(name)
+		_, err = decc.releaseVolumes(conversion)
+		return err
+	case "":
+		conversion.Status.State = longhorn.DataEngineConversionStatePending
+		conversion.Status.StartedAt = util.Now()
+	}
+
+	source, err := decc.ds.GetVolumeRO(conversion.Spec.VolumeName)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		decc.failDataEngineConversion(conversion, fmt.Sprintf("volume %v is not found", conversion.Spec.VolumeName))
+		return nil
+	}
+
+	switch conversion.Status.State {
+	case longhorn.DataEngineConversionStatePending:
+		return decc.createTargetVolume(conversion, source)
+	case longhorn.DataEngineConversionStateCloning:
+		return decc.clone(conversion, source)
+	case longhorn.DataEngineConversionStateVerifying:
+		return decc.verify(conversion, source)
+	case longhorn.DataEngineConversionStateWaitingForDetach:
+		return decc.waitForDetach(conversion, source)
+	case longhorn.DataEngineConversionStateFinalizing:
+		return decc.finalize(conversion, source)
+	case longhorn.DataEngineConversionStateSwapping:
+		return decc.swap(conversion, source)
+	}
+	return nil
+}
+
+func (decc *DataEngineConversionController) failDataEngineConversion(conversion *longhorn.DataEngineConversion, message string) {
+	decc.stopSyncer(conversion.Name)
+	conversion.Status.State = longhorn.DataEngineConversionStateError
+	conversion.Status.Message = message
+	decc.eventRecorder.Event(conversion, corev1.EventTypeWarning, constant.EventReasonDataEngineConversionFailed, message)
+}
+
+// createTargetVolume creates the blank target volume the source volume is copied into.
+func (decc *DataEngineConversionController) createTargetVolume(conversion *longhorn.DataEngineConversion, source *longhorn.Volume) error {
+	target, err := decc.ds.GetVolumeRO(conversion.Spec.TargetVolumeName)
+	if err != nil && !datastore.ErrorIsNotFound(err) {
+		return err
+	}
+	if target != nil {
+		if target.Labels[types.GetLonghornLabelKey(types.LonghornLabelDataEngineConversion)] != conversion.Name {
+			decc.failDataEngineConversion(conversion, fmt.Sprintf("target volume %v already exists", target.Name))
+			return nil
+		}
+	} else {
+		if _, err := decc.ds.CreateVolume(newDataEngineConversionTargetVolume(conversion, source)); err != nil {
+			return errors.Wrapf(err, "failed to create target volume %v", conversion.Spec.TargetVolumeName)
+		}
+		decc.logger.Infof("Created volume %v to convert volume %v to data engine %v", conversion.Spec.TargetVolumeName, source.Name, conversion.Spec.TargetDataEngine)
+	}
+
+	conversion.Status.State = longhorn.DataEngineConversionStateCloning
+	conversion.Status.Message = ""
+	return nil
+}
+
+// newDataEngineConversionTargetVolume returns the volume the source volume is copied into with the target data
+// engine. The other settings are inherited from the source volume or defaulted by the volume mutator.
+func newDataEngineConversionTargetVolume(conversion *longhorn.DataEngineConversion, source *longhorn.Volume) *longhorn.Volume {
+	migratable := source.Spec.Migratable
+	if types.IsDataEngineV2(conversion.Spec.TargetDataEngine) {
+		migratable = false
+	}
+
+	return &longhorn.Volume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   conversion.Spec.TargetVolumeName,
+			Labels: types.GetDataEngineConversionLabels(conversion.Name),
+		},
+		Spec: longhorn.VolumeSpec{
+			Size:                source.Spec.Size,
+			AccessMode:          source.Spec.AccessMode,
+			Migratable:          migratable,
+			Frontend:            longhorn.VolumeFrontendBlockDev,
+			DataEngine:          conversion.Spec.TargetDataEngine,
+			NumberOfReplicas:    source.Spec.NumberOfReplicas,
+			DataLocality:        source.Spec.DataLocality,
+			StaleReplicaTimeout: source.Spec.StaleReplicaTimeout,
+			DiskSelector:        source.Spec.DiskSelector,
+			NodeSelector:        source.Spec.NodeSelector,
+			BackupTargetName:    source.Spec.BackupTargetName,
+		},
+	}
+}
+
+// clone attaches both volumes to this node and copies the block device of the source volume into the target volume
+// while the workload keeps using the source volume. The chunks the workload changes during the copy are copied again
+// by the final sync.
+func (decc *DataEngineConversionController) clone(conversion *longhorn.DataEngineConversion, source *longhorn.Volume) error {
+	target, attached, err := decc.attachVolumes(conversion, source)
+	if err != nil || !attached {
+		return err
+	}
+
+	syncer := decc.getSyncer(conversion.Name)
+	if syncer == nil {
+		decc.startSyncer(conversion, source, target, false)
+		return nil
+	}
+	result, err := decc.syncSyncResult(conversion, source, syncer)
+	if err != nil || result == nil {
+		return err
+	}
+
+	if _, err := decc.releaseVolumes(conversion); err != nil {
+		return err
+	}
+	conversion.Status.State = longhorn.DataEngineConversionStateVerifying
+	decc.eventRecorder.Eventf(conversion, corev1.EventTypeNormal, constant.EventReasonDataEngineConversionCloned,
+		"Copied %v chunks of volume %v into volume %v with data engine %v in %v",
+		result.CopiedChunks, source.Name, target.Name, conversion.Spec.TargetDataEngine, result.Duration)
+	return nil
+}
+
+// verify checks the cloned target volume, and rolls the conversion back if the check fails. The content is compared
+// by the final sync once the workload detaches the source volume.
+func (decc *DataEngineConversionController) verify(conversion *longhorn.DataEngineConversion, source *longhorn.Volume) error {
+	target, err := decc.ds.GetVolumeRO(conversion.Spec.TargetVolumeName)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		decc.failDataEngineConversion(conversion, fmt.Sprintf("target volume %v is deleted during the conversion", conversion.Spec.TargetVolumeName))
+		return nil
+	}
+
+	if issue := getDataEngineConversionVerificationIssue(conversion, source, target); issue != "" {
+		return decc.rollback(conversion, fmt.Sprintf("failed to verify target volume %v: %v", target.Name, issue))
+	}
+
+	conversion.Status.State = longhorn.DataEngineConversionStateWaitingForDetach
+	return decc.waitForDetach(conversion, source)
+}
+
+// getDataEngineConversionVerificationIssue returns the reason the target volume cannot replace the source volume.
+func getDataEngineConversionVerificationIssue(conversion *longhorn.DataEngineConversion, source, target *longhorn.Volume) string {
+	if target.Spec.DataEngine != conversion.Spec.TargetDataEngine {
+		return fmt.Sprintf("data engine %v is not %v", target.Spec.DataEngine, conversion.Spec.TargetDataEngine)
+	}
+	if target.Spec.Size != source.Spec.Size {
+		return fmt.Sprintf("size %v is different from size %v of the source volume", target.Spec.Size, source.Spec.Size)
+	}
+	if target.Status.Robustness == longhorn.VolumeRobustnessFaulted {
+		return "volume is faulted"
+	}
+	return ""
+}
+
+// rollback deletes the target volume. The source volume and its PVC are untouched.
+func (decc *DataEngineConversionController) rollback(conversion *longhorn.DataEngineConversion, message string) error {
+	decc.stopSyncer(conversion.Name)
+	if _, err := decc.releaseVolumes(conversion); err != nil {
+		return err
+	}
+	if err := decc.deleteTargetVolume(conversion); err != nil {
+		return err
+	}
+	conversion.Status.State = longhorn.DataEngineConversionStateRolledBack
+	conversion.Status.Message = message
+	decc.eventRecorder.Event(conversion, corev1.EventTypeWarning, constant.EventReasonDataEngineConversionRolledBack, message)
+	return nil
+}
+
+func (decc *DataEngineConversionController) deleteTargetVolume(conversion *longhorn.DataEngineConversion) error {
+	target, err := decc.ds.GetVolumeRO(conversion.Spec.TargetVolumeName)
+	if err != nil && !datastore.ErrorIsNotFound(err) {
+		return err
+	}
+	if target != nil && target.DeletionTimestamp == nil &&
+		target.Labels[types.GetLonghornLabelKey(types.LonghornLabelDataEngineConversion)] == conversion.Name {
+		if err := decc.ds.DeleteVolume(target.Name); err != nil && !datastore.ErrorIsNotFound(err) {
+			return errors.Wrapf(err, "failed to delete target volume %v", target.Name)
+		}
+	}
+	return nil
+}
+
+// waitForDetach waits for the workload to stop using the source volume, so the data cannot change during the final
+// sync.
+func (decc *DataEngineConversionController) waitForDetach(conversion *longhorn.DataEngineConversion, source *longhorn.Volume) error {
+	inUse, err := decc.isSourceVolumeInUse(source)
+	if err != nil {
+		return err
+	}
+	if inUse || source.Status.State != longhorn.VolumeStateDetached {
+		conversion.Status.Message = fmt.Sprintf("waiting for the workload to detach volume %v", source.Name)
+		return nil
+	}
+
+	conversion.Status.State = longhorn.DataEngineConversionStateFinalizing
+	conversion.Status.Progress = 0
+	conversion.Status.Message = ""
+	return nil
+}
+
+// finalize attaches both volumes to this node and runs the final sync, which copies the changes written to the source
+// volume during the copy into the target volume and compares the content of both volumes. The conversion goes back
+// to wait for the detachment if the workload comes back, and is rolled back if the content differs after the copy.
+func (decc *DataEngineConversionController) finalize(conversion *longhorn.DataEngineConversion, source *longhorn.Volume) error {
+	inUse, err := decc.isSourceVolumeInUse(source)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		decc.stopSyncer(conversion.Name)
+		if _, err := decc.releaseVolumes(conversion); err != nil {
+			return err
+		}
+		conversion.Status.State = longhorn.DataEngineConversionStateWaitingForDetach
+		conversion.Status.Message = fmt.Sprintf("waiting for the workload to detach volume %v", source.Name)
+		return nil
+	}
+
+	target, attached, err := decc.attachVolumes(conversion, source)
+	if err != nil || !attached {
+		return err
+	}
+
+	syncer := decc.getSyncer(conversion.Name)
+	if syncer == nil {
+		decc.startSyncer(conversion, source, target, true)
+		return nil
+	}
+	result, err := decc.syncSyncResult(conversion, source, syncer)
+	if err != nil || result == nil {
+		return err
+	}
+
+	decc.eventRecorder.Eventf(conversion, corev1.EventTypeNormal, constant.EventReasonDataEngineConversionFinalized,
+		"Copied %v chunks changed during the copy from volume %v into volume %v and verified the content in %v",
+		result.CopiedChunks, source.Name, target.Name, result.Duration)
+	return decc.recordSourcePV(conversion, source)
+}
+
+// attachVolumes attaches both volumes to this node. It returns the target volume and whether both volumes are
+// attached.
+func (decc *DataEngineConversionController) attachVolumes(conversion *longhorn.DataEngineConversion, source *longhorn.Volume) (*longhorn.Volume, bool, error) {
+	target, err := decc.ds.GetVolumeRO(conversion.Spec.TargetVolumeName)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return nil, false, err
+		}
+		decc.failDataEngineConversion(conversion, fmt.Sprintf("target volume %v is deleted during the conversion", conversion.Spec.TargetVolumeName))
+		return nil, false, nil
+	}
+
+	attached := true
+	for _, v := range []*longhorn.Volume{source, target} {
+		volumeAttached, err := decc.syncVolumeAttachment(conversion, v)
+		if err != nil {
+			return nil, false, err
+		}
+		attached = attached && volumeAttached
+	}
+	if !attached {
+		conversion.Status.Message = fmt.Sprintf("waiting for volumes %v and %v to be attached to node %v", source.Name, target.Name, decc.controllerID)
+		return nil, false, nil
+	}
+	return target, true, nil
+}
+
+// isSourceVolumeInUse checks if a workload or a user requests the source volume.
+func (decc *DataEngineConversionController) isSourceVolumeInUse(source *longhorn.Volume) (bool, error) {
+	va, err := decc.ds.GetLHVolumeAttachmentByVolumeName(source.Name)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return isVolumeTransferSourceInUse(va), nil
+}
+
+// syncVolumeAttachment attaches the volume to this node with the frontend enabled, so the copy can read from or write
+// to the block device. It returns whether the volume is attached to this node.
+func (decc *DataEngineConversionController) syncVolumeAttachment(conversion *longhorn.DataEngineConversion, v *longhorn.Volume) (bool, error) {
+	va, err := decc.ds.GetLHVolumeAttachmentByVolumeName(v.Name)
+	if err != nil {
+		return false, err
+	}
+	existingVA := va.DeepCopy()
+
+	ticketID := longhorn.GetAttachmentTicketID(longhorn.AttacherTypeDataEngineConversionController, conversion.Name)
+	createOrUpdateAttachmentTicket(va, ticketID, decc.controllerID, longhorn.FalseValue, longhorn.AttacherTypeDataEngineConversionController)
+	if !reflect.DeepEqual(existingVA.Spec, va.Spec) {
+		if _, err := decc.ds.UpdateLHVolumeAttachment(va); err != nil {
+			return false, err
+		}
+	}
+
+	return v.Status.State == longhorn.VolumeStateAttached && v.Status.CurrentNodeID == decc.controllerID, nil
+}
+
+// releaseVolumes removes the attachment tickets of the conversion from both volumes. It returns whether no ticket was
+// left to remove.
+func (decc *DataEngineConversionController) releaseVolumes(conversion *longhorn.DataEngineConversion) (bool, error) {
+	ticketID := longhorn.GetAttachmentTicketID(longhorn.AttacherTypeDataEngineConversionController, conversion.Name)
+	released := true
+	for _, volumeName := range []string{conversion.Spec.VolumeName, conversion.Spec.TargetVolumeName} {
+		va, err := decc.ds.GetLHVolumeAttachmentByVolumeName(volumeName)
+		if err != nil {
+			if datastore.ErrorIsNotFound(err) {
+				continue
+			}
+			return false, err
+		}
+		if _, ok := va.Spec.AttachmentTickets[ticketID]; !ok {
+			continue
+		}
+		va = va.DeepCopy()
+		delete(va.Spec.AttachmentTickets, ticketID)
+		if _, err := decc.ds.UpdateLHVolumeAttachment(va); err != nil {
+			return false, err
+		}
+		released = false
+	}
+	return released, nil
+}
+
+func (decc *DataEngineConversionController) getSyncer(name string) *dataEngineConversionSyncer {
+	decc.syncersLock.Lock()
+	defer decc.syncersLock.Unlock()
+	return decc.syncers[name]
+}
+
+func (decc *DataEngineConversionController) stopSyncer(name string) {
+	decc.syncersLock.Lock()
+	defer decc.syncersLock.Unlock()
+	if syncer, ok := decc.syncers[name]; ok {
+		syncer.cancel()
+		delete(decc.syncers, name)
+	}
+}
+
+// startSyncer copies the block device of the source volume into the one of the target volume in the background. The
+// final sync verifies the content of both volumes after the copy.
+func (decc *DataEngineConversionController) startSyncer(conversion *longhorn.DataEngineConversion, source, target *longhorn.Volume, final bool) {
+	ctx, cancel := context.WithCancel(context.Background())
+	syncer := &dataEngineConversionSyncer{cancel: cancel}
+	decc.syncersLock.Lock()
+	decc.syncers[conversion.Name] = syncer
+	decc.syncersLock.Unlock()
+
+	key := decc.namespace + "/" + conversion.Name
+	sourceDevice := types.GetDataEngineConversionDevicePath(source.Name)
+	targetDevice := types.GetDataEngineConversionDevicePath(target.Name)
+	size := source.Spec.Size
+	copyDevice := dataengineconversion.Copy
+	if final {
+		copyDevice = dataengineconversion.Sync
+	}
+	go func() {
+		result, err := copyDevice(ctx, sourceDevice, targetDevice, size, syncer.update)
+		syncer.finish(result, err)
+		decc.queue.Add(key)
+	}()
+
+	conversion.Status.Progress = 0
+	conversion.Status.Message = ""
+	decc.queue.AddAfter(key, dataEngineConversionSwapCheckInterval)
+}
+
+// syncSyncResult updates the progress of the running copy, and returns the result once the copy succeeded. A copy
+// failing on I/O is retried after an interval, while a content mismatch found by the final sync rolls the conversion
+// back.
+func (decc *DataEngineConversionController) syncSyncResult(conversion *longhorn.DataEngineConversion, source *longhorn.Volume, syncer *dataEngineConversionSyncer) (*dataengineconversion.SyncResult, error) {
+	key := decc.namespace + "/" + conversion.Name
+
+	syncer.lock.RLock()
+	defer syncer.lock.RUnlock()
+
+	if !syncer.done {
+		conversion.Status.Progress = syncer.progress
+		decc.queue.AddAfter(key, dataEngineConversionSwapCheckInterval)
+		return nil, nil
+	}
+
+	if syncer.err != nil {
+		var mismatch *dataengineconversion.ContentMismatchError
+		if errors.As(syncer.err, &mismatch) {
+			return nil, decc.rollback(conversion, fmt.Sprintf("failed to verify target volume %v: %v", conversion.Spec.TargetVolumeName, syncer.err))
+		}
+		conversion.Status.Message = fmt.Sprintf("failed to copy volume %v into volume %v: %v", source.Name, conversion.Spec.TargetVolumeName, syncer.err)
+		if retryAfter := dataEngineConversionSyncRetryInterval - time.Since(syncer.finishedAt); retryAfter > 0 {
+			decc.queue.AddAfter(key, retryAfter)
+			return nil, nil
+		}
+		decc.stopSyncer(conversion.Name)
+		decc.queue.Add(key)
+		return nil, nil
+	}
+
+	decc.stopSyncer(conversion.Name)
+	conversion.Status.Progress = 100
+	conversion.Status.Message = ""
+	return syncer.result, nil
+}
+
+// recordSourcePV records the PV and the PVC of the source volume and starts the swap. The conversion completes if the
+// source volume is not bound to a PV.
+func (decc *DataEngineConversionController) recordSourcePV(conversion *longhorn.DataEngineConversion, source *longhorn.Volume) error {
+	ks := source.Status.KubernetesStatus
+	if ks.PVName == "" {
+		decc.completeDataEngineConversion(conversion, fmt.Sprintf("volume %v is not bound to a PV, target volume %v is ready to use", source.Name, conversion.Spec.TargetVolumeName))
+		return nil
+	}
+	pv, err := decc.ds.GetPersistentVolumeRO(ks.PVName)
+	if err != nil {
+		return err
+	}
+	if pv.Spec.CSI == nil {
+		decc.failDataEngineConversion(conversion, fmt.Sprintf("PV %v of volume %v is not a CSI volume", pv.Name, source.Name))
+		return nil
+	}
+
+	conversion.Status.SourcePVName = pv.Name
+	conversion.Status.SourcePVReclaimPolicy = string(pv.Spec.PersistentVolumeReclaimPolicy)
+	conversion.Status.StorageClassName = pv.Spec.StorageClassName
+	conversion.Status.FSType = pv.Spec.CSI.FSType
+	if pv.Spec.ClaimRef != nil {
+		conversion.Status.PVCName = pv.Spec.ClaimRef.Name
+		conversion.Status.PVCNamespace = pv.Spec.ClaimRef.Namespace
+		pvc, err := decc.ds.GetPersistentVolumeClaimRO(conversion.Status.PVCNamespace, conversion.Status.PVCName)
+		if err != nil && !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		if pvc != nil {
+			conversion.Status.PVCLabels = pvc.Labels
+		}
+	}
+	conversion.Status.State = longhorn.DataEngineConversionStateSwapping
+	conversion.Status.Message = ""
+	return decc.swap(conversion, source)
+}
+
+// swap rebinds the PVC from the source volume to the target volume once the final sync released both volumes. The
+// source PV is retained so that the source volume is kept, then the PVC is recreated with the same name and bound to
+// the PV of the target volume.
+func (decc *DataEngineConversionController) swap(conversion *longhorn.DataEngineConversion, source *longhorn.Volume) error {
+	decc.queue.AddAfter(decc.namespace+"/"+conversion.Name, dataEngineConversionSwapCheckInterval)
+
+	released, err := decc.releaseVolumes(conversion)
+	if err != nil || !released {
+		return err
+	}
+
+	if conversion.Status.PVCName != "" {
+		pvc, err := decc.ds.GetPersistentVolumeClaimRO(conversion.Status.PVCNamespace, conversion.Status.PVCName)
+		if err != nil && !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		if pvc != nil && pvc.Spec.VolumeName == conversion.Status.SourcePVName {
+			inUse, err := decc.isSourceVolumeInUse(source)
+			if err != nil {
+				return err
+			}
+			if inUse {
+				// The workload came back before the swap started, so its new writes need another final sync
+				conversion.Status.State = longhorn.DataEngineConversionStateWaitingForDetach
+				return nil
+			}
+			if err := decc.retainSourcePV(conversion); err != nil {
+				return err
+			}
+			if pvc.DeletionTimestamp == nil {
+				if err := decc.ds.DeletePersistentVolumeClaim(pvc.Namespace, pvc.Name); err != nil && !datastore.ErrorIsNotFound(err) {
+					return errors.Wrapf(err, "failed to delete PVC %v/%v", pvc.Namespace, pvc.Name)
+				}
+			}
+			conversion.Status.Message = fmt.Sprintf("waiting for PVC %v/%v to be deleted", pvc.Namespace, pvc.Name)
+			return nil
+		}
+	}
+
+	if err := decc.retainSourcePV(conversion); err != nil {
+		return err
+	}
+	if err := decc.ds.DeletePersistentVolume(conversion.Status.SourcePVName); err != nil && !datastore.ErrorIsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete PV %v", conversion.Status.SourcePVName)
+	}
+
+	target, err := decc.ds.GetVolumeRO(conversion.Spec.TargetVolumeName)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		decc.failDataEngineConversion(conversion, fmt.Sprintf("target volume %v is deleted during the swap", conversion.Spec.TargetVolumeName))
+		return nil
+	}
+
+	targetPVName := target.Name
+	if _, err := decc.ds.GetPersistentVolumeRO(targetPVName); err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		pv := datastore.NewPVManifestForVolume(target, targetPVName, conversion.Status.StorageClassName, conversion.Status.FSType)
+		if conversion.Status.SourcePVReclaimPolicy != "" {
+			pv.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimPolicy(conversion.Status.SourcePVReclaimPolicy)
+		}
+		if _, err := decc.ds.CreatePersistentVolume(pv); err != nil {
+			return errors.Wrapf(err, "failed to create PV %v", targetPVName)
+		}
+	}
+	conversion.Status.TargetPVName = targetPVName
+
+	if conversion.Status.PVCName == "" {
+		decc.completeDataEngineConversion(conversion, fmt.Sprintf("created PV %v for volume %v", targetPVName, target.Name))
+		return nil
+	}
+
+	pvc, err := decc.ds.GetPersistentVolumeClaimRO(conversion.Status.PVCNamespace, conversion.Status.PVCName)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		pvc = datastore.NewPVCManifestForVolume(target, targetPVName, conversion.Status.PVCNamespace, conversion.Status.PVCName, conversion.Status.StorageClassName)
+		pvc.Labels = conversion.Status.PVCLabels
+		if _, err := decc.ds.CreatePersistentVolumeClaim(pvc.Namespace, pvc); err != nil {
+			return errors.Wrapf(err, "failed to create PVC %v/%v", pvc.Namespace, pvc.Name)
+		}
+		conversion.Status.Message = fmt.Sprintf("waiting for PVC %v/%v to be bound to PV %v", pvc.Namespace, pvc.Name, targetPVName)
+		return nil
+	}
+	if pvc.Spec.VolumeName != targetPVName {
+		decc.failDataEngineConversion(conversion, fmt.Sprintf("PVC %v/%v is recreated with PV %v instead of PV %v", pvc.Namespace, pvc.Name, pvc.Spec.VolumeName, targetPVName))
+		return nil
+	}
+	if pvc.Status.Phase != corev1.ClaimBound {
+		return nil
+	}
+
+	decc.completeDataEngineConversion(conversion, fmt.Sprintf("volume %v is retained and can be deleted once the workload is verified with volume %v", source.Name, target.Name))
+	decc.eventRecorder.Eventf(conversion, corev1.EventTypeNormal, constant.EventReasonDataEngineConversionSwapped,
+		"Rebound PVC %v/%v from volume %v to volume %v with data engine %v",
+		pvc.Namespace, pvc.Name, source.Name, target.Name, conversion.Spec.TargetDataEngine)
+	return nil
+}
+
+// retainSourcePV prevents the source volume from being deleted along with the source PV.
+func (decc *DataEngineConversionController) retainSourcePV(conversion *longhorn.DataEngineConversion) error {
+	pv, err := decc.ds.GetPersistentVolume(conversion.Status.SourcePVName)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if pv.Spec.PersistentVolumeReclaimPolicy == corev1.PersistentVolumeReclaimRetain {
+		return nil
+	}
+	pv.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimRetain
+	if _, err := decc.ds.UpdatePersistentVolume(pv); err != nil {
+		return errors.Wrapf(err, "failed to retain PV %v", pv.Name)
+	}
+	return nil
+}
+
+func (decc *DataEngineConversionController) completeDataEngineConversion(conversion *longhorn.DataEngineConversion, message string) {
+	conversion.Status.State = longhorn.DataEngineConversionStateCompleted
+	conversion.Status.CompletedAt = util.Now()
+	conversion.Status.Message = message
+}
+
+// cleanup deletes the target volume if the swap has not started. It returns whether the cleanup is
+// done.
+func (decc *DataEngineConversionController) cleanup(conversion *longhorn.DataEngineConversion) (bool, error) {
+	if conversion.Status.State == longhorn.DataEngineConversionStateCompleted || conversion.Status.SourcePVName != "" {
+		return true, nil
+	}
+	if err := decc.deleteTargetVolume(conversion); err != nil {
+		return false, err
+	}
+	return true, nil
+}
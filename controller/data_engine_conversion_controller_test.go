@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func TestGetDataEngineConversionVerificationIssue(t *testing.T) {
+	conversion := &longhorn.DataEngineConversion{
+		Spec: longhorn.DataEngineConversionSpec{
+			VolumeName:       TestVolumeName,
+			TargetDataEngine: longhorn.DataEngineTypeV2,
+			TargetVolumeName: TestVolumeName + "-v2",
+		},
+	}
+	source := &longhorn.Volume{
+		ObjectMeta: metav1.ObjectMeta{Name: TestVolumeName},
+		Spec:       longhorn.VolumeSpec{Size: TestVolumeSize, DataEngine: longhorn.DataEngineTypeV1},
+	}
+	newTarget := func() *longhorn.Volume {
+		return &longhorn.Volume{
+			ObjectMeta: metav1.ObjectMeta{Name: TestVolumeName + "-v2"},
+			Spec:       longhorn.VolumeSpec{Size: TestVolumeSize, DataEngine: longhorn.DataEngineTypeV2},
+			Status: longhorn.VolumeStatus{
+				Robustness: longhorn.VolumeRobustnessHealthy,
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		mutate      func(v *longhorn.Volume)
+		expectIssue bool
+	}{
+		"verified": {
+			mutate: func(v *longhorn.Volume) {},
+		},
+		"different data engine": {
+			mutate:      func(v *longhorn.Volume) { v.Spec.DataEngine = longhorn.DataEngineTypeV1 },
+			expectIssue: true,
+		},
+		"different size": {
+			mutate:      func(v *longhorn.Volume) { v.Spec.Size = TestVolumeSize * 2 },
+			expectIssue: true,
+		},
+		"faulted": {
+			mutate:      func(v *longhorn.Volume) { v.Status.Robustness = longhorn.VolumeRobustnessFaulted },
+			expectIssue: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		target := newTarget()
+		tc.mutate(target)
+		issue := getDataEngineConversionVerificationIssue(conversion, source, target)
+		if (issue != "") != tc.expectIssue {
+			t.Errorf("%v: expected issue %v, got %q", name, tc.expectIssue, issue)
+		}
+	}
+}
@@ -0,0 +1,702 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientset "k8s.io/client-go/kubernetes"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/longhorn/backupstore"
+
+	"github.com/longhorn/longhorn-manager/constant"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	disasterRecoveryPlanDefaultFSType       = "ext4"
+	disasterRecoveryPlanDefaultCryptoSecret = "longhorn-crypto"
+)
+
+// DisasterRecoveryPlanController tracks the restore progress of the standby volumes in a DisasterRecoveryPlan, and
+// fails over the group by activating all member volumes and creating their PVs and PVCs once the failover is
+// requested and the members restored the latest backups at a consistent point.
+type DisasterRecoveryPlanController struct {
+	*baseController
+
+	// which namespace controller is running with
+	namespace string
+	// use as the OwnerID of the controller
+	controllerID string
+
+	kubeClient    clientset.Interface
+	eventRecorder record.EventRecorder
+
+	ds *datastore.DataStore
+
+	cacheSyncs []cache.InformerSynced
+}
+
+func NewDisasterRecoveryPlanController(
+	logger logrus.FieldLogger,
+	ds *datastore.DataStore,
+	scheme *runtime.Scheme,
+	kubeClient clientset.Interface,
+	controllerID string,
+	namespace string) (*DisasterRecoveryPlanController, error) {
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(logrus.Infof)
+	// TODO: remove the wrapper when every clients have moved to use the clientset.
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{
+		Interface: v1core.New(kubeClient.CoreV1().RESTClient()).Events(""),
+	})
+
+	drpc := &DisasterRecoveryPlanController{
+		baseController: newBaseController("longhorn-disaster-recovery-plan", logger),
+
+		namespace:    namespace,
+		controllerID: controllerID,
+
+		ds: ds,
+
+		kubeClient:    kubeClient,
+		eventRecorder: eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "longhorn-disaster-recovery-plan-controller"}),
+	}
+
+	var err error
+	if _, err = ds.DisasterRecoveryPlanInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    drpc.enqueueDisasterRecoveryPlan,
+		UpdateFunc: func(old, cur interface{}) { drpc.enqueueDisasterRecoveryPlan(cur) },
+		DeleteFunc: drpc.enqueueDisasterRecoveryPlan,
+	}); err != nil {
+		return nil, err
+	}
+	drpc.cacheSyncs = append(drpc.cacheSyncs, ds.DisasterRecoveryPlanInformer.HasSynced)
+
+	if _, err = ds.VolumeInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { drpc.enqueueActiveDisasterRecoveryPlans() },
+		UpdateFunc: func(old, cur interface{}) { drpc.enqueueActiveDisasterRecoveryPlans() },
+		DeleteFunc: func(obj interface{}) { drpc.enqueueActiveDisasterRecoveryPlans() },
+	}, 0); err != nil {
+		return nil, err
+	}
+	drpc.cacheSyncs = append(drpc.cacheSyncs, ds.VolumeInformer.HasSynced)
+
+	if _, err = ds.EngineInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, cur interface{}) { drpc.enqueueActiveDisasterRecoveryPlans() },
+	}, 0); err != nil {
+		return nil, err
+	}
+	drpc.cacheSyncs = append(drpc.cacheSyncs, ds.EngineInformer.HasSynced)
+
+	return drpc, nil
+}
+
+func (drpc *DisasterRecoveryPlanController) enqueueDisasterRecoveryPlan(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to get key for object %#v: %v", obj, err))
+		return
+	}
+
+	drpc.queue.Add(key)
+}
+
+// enqueueActiveDisasterRecoveryPlans enqueues the plans which are not failed over yet, since any volume may be a member.
+func (drpc *DisasterRecoveryPlanController) enqueueActiveDisasterRecoveryPlans() {
+	plans, err := drpc.ds.ListDisasterRecoveryPlansRO()
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to list disaster recovery plans since %v", err))
+		return
+	}
+
+	for _, plan := range plans {
+		if plan.Status.State != longhorn.DisasterRecoveryPlanStateFailedOver &&
+			plan.Status.State != longhorn.DisasterRecoveryPlanStateError {
+			drpc.enqueueDisasterRecoveryPlan(plan)
+		}
+	}
+}
+
+func (drpc *DisasterRecoveryPlanController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer drpc.queue.ShutDown()
+
+	drpc.logger.Info("Starting Longhorn DisasterRecoveryPlan controller")
+	defer drpc.logger.Info("Shut down Longhorn DisasterRecoveryPlan controller")
+
+	if !cache.WaitForNamedCacheSync(drpc.name, stopCh, drpc.cacheSyncs...) {
+		return
+	}
+	for i := 0; i < workers; i++ {
+		go wait.Until(drpc.worker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+func (drpc *DisasterRecoveryPlanController) worker() {
+	for drpc.processNextWorkItem() {
+	}
+}
+
+func (drpc *DisasterRecoveryPlanController) processNextWorkItem() bool {
+	key, quit := drpc.queue.Get()
+	if quit {
+		return false
+	}
+	defer drpc.queue.Done(key)
+	err := drpc.syncDisasterRecoveryPlan(key.(string))
+	drpc.handleErr(err, key)
+	return true
+}
+
+func (drpc *DisasterRecoveryPlanController) handleErr(err error, key interface{}) {
+	if err == nil {
+		drpc.queue.Forget(key)
+		return
+	}
+
+	log := drpc.logger.WithField("disasterRecoveryPlan", key)
+	if drpc.queue.NumRequeues(key) < maxRetries {
+		handleReconcileErrorLogging(log, err, "Failed to sync Longhorn disaster recovery plan")
+		drpc.queue.AddRateLimited(key)
+		return
+	}
+
+	utilruntime.HandleError(err)
+	handleReconcileErrorLogging(log, err, "Dropping Longhorn disaster recovery plan out of the queue")
+	drpc.queue.Forget(key)
+}
+
+func (drpc *DisasterRecoveryPlanController) syncDisasterRecoveryPlan(key string) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to sync disaster recovery plan %v", key)
+	}()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	if namespace != drpc.namespace {
+		return nil
+	}
+	return drpc.reconcile(name)
+}
+
+func getLoggerForDisasterRecoveryPlan(logger logrus.FieldLogger, plan *longhorn.DisasterRecoveryPlan) *logrus.Entry {
+	return logger.WithFields(
+		logrus.Fields{
+			"disasterRecoveryPlan": plan.Name,
+		},
+	)
+}
+
+func (drpc *DisasterRecoveryPlanController) isResponsibleFor(plan *longhorn.DisasterRecoveryPlan) bool {
+	return isControllerResponsibleFor(drpc.controllerID, drpc.ds, plan.Name, "", plan.Status.OwnerID)
+}
+
+func (drpc *DisasterRecoveryPlanController) reconcile(name string) (err error) {
+	plan, err := drpc.ds.GetDisasterRecoveryPlan(name)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	if !drpc.isResponsibleFor(plan) {
+		return nil
+	}
+
+	log := getLoggerForDisasterRecoveryPlan(drpc.logger, plan)
+
+	if plan.Status.OwnerID != drpc.controllerID {
+		plan.Status.OwnerID = drpc.controllerID
+		plan, err = drpc.ds.UpdateDisasterRecoveryPlanStatus(plan)
+		if err != nil {
+			// we don't mind others coming first
+			if datastore.ErrorIsConflict(errors.Cause(err)) {
+				return nil
+			}
+			return err
+		}
+		log.Infof("Disaster recovery plan got new owner %v", drpc.controllerID)
+	}
+
+	if !plan.DeletionTimestamp.IsZero() {
+		return drpc.ds.RemoveFinalizerForDisasterRecoveryPlan(plan)
+	}
+
+	existingPlan := plan.DeepCopy()
+	defer func() {
+		if err != nil {
+			return
+		}
+		if reflect.DeepEqual(existingPlan.Status, plan.Status) {
+			return
+		}
+		if _, err = drpc.ds.UpdateDisasterRecoveryPlanStatus(plan); err != nil && datastore.ErrorIsConflict(errors.Cause(err)) {
+			log.WithError(err).Debugf("Requeue %v due to conflict", name)
+			drpc.enqueueDisasterRecoveryPlan(plan)
+			err = nil
+		}
+	}()
+
+	switch plan.Status.State {
+	case longhorn.DisasterRecoveryPlanStateFailedOver, longhorn.DisasterRecoveryPlanStateError:
+		return nil
+	case longhorn.DisasterRecoveryPlanStateActivating:
+		return drpc.activateMembers(plan)
+	case longhorn.DisasterRecoveryPlanStateCreatingPVC:
+		return drpc.createMemberPVCs(plan)
+	}
+
+	volumes, err := drpc.getMemberVolumes(plan)
+	if err != nil {
+		return err
+	}
+	isReady, issues := drpc.syncMemberStatus(plan, volumes)
+	if isReady {
+		plan.Status.State = longhorn.DisasterRecoveryPlanStateReady
+		plan.Status.Message = ""
+	} else {
+		plan.Status.State = longhorn.DisasterRecoveryPlanStatePending
+		plan.Status.Message = issues[0]
+	}
+
+	if !plan.Spec.Failover {
+		return nil
+	}
+	if plan.Spec.DryRun {
+		issues = append(issues, drpc.getPVCIssues(plan, volumes)...)
+		plan.Status.Rehearsal = &longhorn.DisasterRecoveryPlanRehearsal{
+			Passed:      len(issues) == 0,
+			Issues:      issues,
+			RehearsedAt: util.Now(),
+		}
+		if existingPlan.Status.Rehearsal != nil &&
+			existingPlan.Status.Rehearsal.Passed == plan.Status.Rehearsal.Passed &&
+			reflect.DeepEqual(existingPlan.Status.Rehearsal.Issues, plan.Status.Rehearsal.Issues) {
+			// Keep the status unchanged if the result is the same
+			plan.Status.Rehearsal.RehearsedAt = existingPlan.Status.Rehearsal.RehearsedAt
+		}
+		return nil
+	}
+	if !isReady {
+		log.Infof("Waiting for the member volumes to be ready for failover: %v", plan.Status.Message)
+		return nil
+	}
+
+	plan.Status.State = longhorn.DisasterRecoveryPlanStateActivating
+	plan.Status.FailoverStartedAt = util.Now()
+	plan.Status.Message = ""
+	drpc.eventRecorder.Eventf(plan, corev1.EventTypeNormal, constant.EventReasonDisasterRecoveryPlanFailoverStarted,
+		"Started failover of %v volumes at recovery point %v", len(plan.Status.Members), plan.Status.RecoveryPoint)
+	return drpc.activateMembers(plan)
+}
+
+// getMemberVolumes returns the listed volumes and the volumes matching the selector. A listed volume is nil if it
+// does not exist.
+func (drpc *DisasterRecoveryPlanController) getMemberVolumes(plan *longhorn.DisasterRecoveryPlan) (map[string]*longhorn.Volume, error) {
+	volumes := map[string]*longhorn.Volume{}
+	for _, volumeName := range plan.Spec.Volumes {
+		v, err := drpc.ds.GetVolumeRO(volumeName)
+		if err != nil {
+			if !datastore.ErrorIsNotFound(err) {
+				return nil, err
+			}
+			v = nil
+		}
+		volumes[volumeName] = v
+	}
+
+	if len(plan.Spec.VolumeSelector) > 0 {
+		selected, err := drpc.ds.ListVolumesBySelectorRO(labels.SelectorFromSet(plan.Spec.VolumeSelector))
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range selected {
+			volumes[v.Name] = v
+		}
+	}
+	return volumes, nil
+}
+
+// syncMemberStatus updates the member statuses and the recovery point of the plan. It returns whether all members
+// are ready for the failover and the issues otherwise.
+func (drpc *DisasterRecoveryPlanController) syncMemberStatus(plan *longhorn.DisasterRecoveryPlan, volumes map[string]*longhorn.Volume) (bool, []string) {
+	issues := []string{}
+	if len(volumes) == 0 {
+		issues = append(issues, "no member volume found")
+	}
+
+	members := map[string]*longhorn.DisasterRecoveryPlanMemberStatus{}
+	restoredAts := []string{}
+	for _, volumeName := range sortedVolumeNames(volumes) {
+		member := drpc.getMemberStatus(volumes[volumeName])
+		members[volumeName] = member
+		if !member.Ready {
+			issues = append(issues, fmt.Sprintf("volume %v: %v", volumeName, member.Message))
+			continue
+		}
+		restoredAts = append(restoredAts, member.LastRestoredBackupAt)
+	}
+	plan.Status.Members = members
+
+	if issue := setRecoveryPoint(plan, restoredAts); issue != "" {
+		issues = append(issues, issue)
+	}
+
+	return len(issues) == 0, issues
+}
+
+// setRecoveryPoint sets the recovery point of the plan to the earliest restored backup time of the members, and the
+// skew to the difference to the latest one. It returns the issue if the skew exceeds the maximum.
+func setRecoveryPoint(plan *longhorn.DisasterRecoveryPlan, restoredAts []string) string {
+	var earliest, latest time.Time
+	for _, restoredAtStr := range restoredAts {
+		restoredAt, err := util.ParseTime(restoredAtStr)
+		if err != nil {
+			continue
+		}
+		if earliest.IsZero() || restoredAt.Before(earliest) {
+			earliest = restoredAt
+		}
+		if latest.IsZero() || restoredAt.After(latest) {
+			latest = restoredAt
+		}
+	}
+
+	plan.Status.RecoveryPoint = ""
+	plan.Status.RecoveryPointSkew = ""
+	if earliest.IsZero() {
+		return ""
+	}
+	skew := latest.Sub(earliest)
+	plan.Status.RecoveryPoint = earliest.UTC().Format(time.RFC3339)
+	plan.Status.RecoveryPointSkew = skew.String()
+	if maxSkew := time.Duration(plan.Spec.MaxRecoveryPointSkew) * time.Minute; maxSkew > 0 && skew > maxSkew {
+		return fmt.Sprintf("recovery point skew %v exceeds the maximum %v", skew, maxSkew)
+	}
+	return ""
+}
+
+// getMemberStatus returns the restore progress of a standby volume. The volume is ready for the failover once it
+// restored the latest backup of the backup volume.
+func (drpc *DisasterRecoveryPlanController) getMemberStatus(v *longhorn.Volume) *longhorn.DisasterRecoveryPlanMemberStatus {
+	member := &longhorn.DisasterRecoveryPlanMemberStatus{}
+	if v == nil {
+		member.Message = "volume is not found"
+		return member
+	}
+	if !v.Spec.Standby || !v.Status.IsStandby {
+		member.Message = "volume is not a standby volume"
+		return member
+	}
+
+	member.LastBackup = v.Status.LastBackup
+	member.LastBackupAt = v.Status.LastBackupAt
+
+	e, err := drpc.ds.GetVolumeCurrentEngine(v.Name)
+	if err != nil {
+		member.Message = fmt.Sprintf("failed to get the engine: %v", err)
+		return member
+	}
+	member.LastRestoredBackup = e.Status.LastRestoredBackup
+	if member.LastRestoredBackup == "" {
+		member.Message = "no backup is restored yet"
+		return member
+	}
+
+	backup, err := drpc.ds.GetBackupRO(member.LastRestoredBackup)
+	if err != nil {
+		member.Message = fmt.Sprintf("failed to get the restored backup %v: %v", member.LastRestoredBackup, err)
+		return member
+	}
+	member.LastRestoredBackupAt = backup.Status.SnapshotCreatedAt
+	member.RestoreLag = getRestoreLag(member.LastBackupAt, member.LastRestoredBackupAt).String()
+
+	switch {
+	case v.Status.Robustness == longhorn.VolumeRobustnessFaulted:
+		member.Message = "volume is faulted"
+	case member.LastBackup != member.LastRestoredBackup:
+		member.Message = fmt.Sprintf("latest backup %v is not restored yet", member.LastBackup)
+	default:
+		member.Ready = true
+	}
+	return member
+}
+
+func getRestoreLag(lastBackupAt, lastRestoredBackupAt string) time.Duration {
+	return time.Duration(getLagSeconds(lastBackupAt, lastRestoredBackupAt)) * time.Second
+}
+
+// getPVCIssues returns the problems which would block creating the PVs and PVCs of the member volumes.
+func (drpc *DisasterRecoveryPlanController) getPVCIssues(plan *longhorn.DisasterRecoveryPlan, volumes map[string]*longhorn.Volume) []string {
+	issues := []string{}
+	for _, volumeName := range sortedVolumeNames(volumes) {
+		v := volumes[volumeName]
+		if v == nil {
+			continue
+		}
+		if v.Status.KubernetesStatus.PVName != "" {
+			issues = append(issues, fmt.Sprintf("volume %v: volume already has PV %v", volumeName, v.Status.KubernetesStatus.PVName))
+		} else if _, err := drpc.ds.GetPersistentVolumeRO(volumeName); err == nil {
+			issues = append(issues, fmt.Sprintf("volume %v: PV %v already exists", volumeName, volumeName))
+		}
+
+		namespace := getDisasterRecoveryPlanPVCNamespace(plan, volumeName)
+		if _, err := drpc.ds.GetNamespace(namespace); err != nil {
+			issues = append(issues, fmt.Sprintf("volume %v: failed to get namespace %v: %v", volumeName, namespace, err))
+		} else if _, err := drpc.ds.GetPersistentVolumeClaimRO(namespace, volumeName); err == nil {
+			issues = append(issues, fmt.Sprintf("volume %v: PVC %v/%v already exists", volumeName, namespace, volumeName))
+		}
+	}
+	return issues
+}
+
+// activateMembers activates all member volumes, and moves on to create the PVCs once all the activations complete.
+// The members are activated at the validated backups without syncing the backup volumes again. A member may still
+// restore a newer backup found by the periodic sync, so the recovery point is checked again after the activation.
+func (drpc *DisasterRecoveryPlanController) activateMembers(plan *longhorn.DisasterRecoveryPlan) error {
+	frontend := plan.Spec.Frontend
+	if frontend == "" {
+		frontend = longhorn.VolumeFrontendBlockDev
+	}
+
+	allActivated := true
+	for _, volumeName := range sortedMemberNames(plan) {
+		member := plan.Status.Members[volumeName]
+		v, err := drpc.ds.GetVolume(volumeName)
+		if err != nil {
+			if !datastore.ErrorIsNotFound(err) {
+				return err
+			}
+			drpc.failFailover(plan, fmt.Sprintf("volume %v is deleted during failover", volumeName))
+			return nil
+		}
+
+		if v.Spec.Standby {
+			v.Spec.Frontend = frontend
+			v.Spec.Standby = false
+			if _, err := drpc.ds.UpdateVolume(v); err != nil {
+				return err
+			}
+			drpc.logger.Infof("Activating volume %v with frontend %v for disaster recovery plan %v", volumeName, frontend, plan.Name)
+			allActivated = false
+			continue
+		}
+
+		member.Activated = !v.Status.IsStandby
+		if !member.Activated {
+			allActivated = false
+			continue
+		}
+		if e, err := drpc.ds.GetVolumeCurrentEngine(volumeName); err == nil {
+			member.LastRestoredBackup = e.Status.LastRestoredBackup
+		}
+	}
+	if !allActivated {
+		return nil
+	}
+
+	restoredAts := []string{}
+	for _, volumeName := range sortedMemberNames(plan) {
+		member := plan.Status.Members[volumeName]
+		if member.LastRestoredBackup == "" {
+			continue
+		}
+		backup, err := drpc.ds.GetBackupRO(member.LastRestoredBackup)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get the restored backup %v of volume %v", member.LastRestoredBackup, volumeName)
+		}
+		member.LastRestoredBackupAt = backup.Status.SnapshotCreatedAt
+		restoredAts = append(restoredAts, member.LastRestoredBackupAt)
+	}
+	if issue := setRecoveryPoint(plan, restoredAts); issue != "" {
+		drpc.failFailover(plan, fmt.Sprintf("activated volumes are inconsistent: %v", issue))
+		return nil
+	}
+
+	plan.Status.State = longhorn.DisasterRecoveryPlanStateCreatingPVC
+	return drpc.createMemberPVCs(plan)
+}
+
+// createMemberPVCs creates the PV and then the PVC of each activated member volume, and completes the failover once
+// all PVCs exist.
+func (drpc *DisasterRecoveryPlanController) createMemberPVCs(plan *longhorn.DisasterRecoveryPlan) error {
+	allCreated := true
+	for _, volumeName := range sortedMemberNames(plan) {
+		member := plan.Status.Members[volumeName]
+		if member.PVCName != "" {
+			continue
+		}
+		allCreated = false
+
+		v, err := drpc.ds.GetVolumeRO(volumeName)
+		if err != nil {
+			if !datastore.ErrorIsNotFound(err) {
+				return err
+			}
+			drpc.failFailover(plan, fmt.Sprintf("volume %v is deleted during failover", volumeName))
+			return nil
+		}
+
+		if member.PVName == "" {
+			pvName := v.Status.KubernetesStatus.PVName
+			if pvName == "" {
+				pvName = volumeName
+				if err := drpc.createPV(plan, v, pvName); err != nil {
+					member.Message = err.Error()
+					continue
+				}
+			}
+			member.PVName = pvName
+		}
+
+		pv, err := drpc.ds.GetPersistentVolumeRO(member.PVName)
+		if err != nil {
+			if !datastore.ErrorIsNotFound(err) {
+				return err
+			}
+			// The PV may not be in the cache yet
+			continue
+		}
+		if pv.Status.Phase != corev1.VolumeAvailable && pv.Status.Phase != corev1.VolumeReleased && pv.Spec.ClaimRef == nil {
+			continue
+		}
+
+		namespace := getDisasterRecoveryPlanPVCNamespace(plan, volumeName)
+		if pv.Spec.ClaimRef == nil {
+			pvc := datastore.NewPVCManifestForVolume(v, pv.Name, namespace, volumeName, pv.Spec.StorageClassName)
+			if _, err := drpc.ds.CreatePersistentVolumeClaim(namespace, pvc); err != nil {
+				member.Message = fmt.Sprintf("failed to create PVC %v/%v: %v", namespace, volumeName, err)
+				continue
+			}
+			drpc.logger.Infof("Created PVC %v/%v for volume %v of disaster recovery plan %v", namespace, volumeName, volumeName, plan.Name)
+		} else {
+			namespace = pv.Spec.ClaimRef.Namespace
+		}
+		member.PVCName = volumeName
+		if pv.Spec.ClaimRef != nil {
+			member.PVCName = pv.Spec.ClaimRef.Name
+		}
+		member.PVCNamespace = namespace
+		member.Message = ""
+	}
+
+	if !allCreated {
+		return nil
+	}
+
+	plan.Status.State = longhorn.DisasterRecoveryPlanStateFailedOver
+	plan.Status.FailoverCompletedAt = util.Now()
+	plan.Status.Message = ""
+	drpc.eventRecorder.Eventf(plan, corev1.EventTypeNormal, constant.EventReasonDisasterRecoveryPlanFailedOver,
+		"Failed over %v volumes at recovery point %v", len(plan.Status.Members), plan.Status.RecoveryPoint)
+	return nil
+}
+
+func (drpc *DisasterRecoveryPlanController) createPV(plan *longhorn.DisasterRecoveryPlan, v *longhorn.Volume, pvName string) error {
+	storageClassName, err := drpc.getStorageClassName(plan, v)
+	if err != nil {
+		return err
+	}
+
+	fsType := plan.Spec.FSType
+	if fsType == "" {
+		fsType = disasterRecoveryPlanDefaultFSType
+	}
+	if fsType == "xfs" && v.Spec.Size < util.MinimalVolumeSizeXFS {
+		return fmt.Errorf("XFS filesystems with size %d, smaller than %d, are not supported", v.Spec.Size, util.MinimalVolumeSizeXFS)
+	}
+
+	pv := datastore.NewPVManifestForVolume(v, pvName, storageClassName, fsType)
+	if v.Spec.Encrypted {
+		secretRef := &corev1.SecretReference{
+			Name:      disasterRecoveryPlanDefaultCryptoSecret,
+			Namespace: drpc.namespace,
+		}
+		pv.Spec.CSI.NodeStageSecretRef = secretRef
+		pv.Spec.CSI.NodePublishSecretRef = secretRef
+	}
+
+	if _, err := drpc.ds.CreatePersistentVolume(pv); err != nil {
+		return errors.Wrapf(err, "failed to create PV %v", pvName)
+	}
+	drpc.logger.Infof("Created PV %v for volume %v of disaster recovery plan %v", pvName, v.Name, plan.Name)
+	return nil
+}
+
+// getStorageClassName returns the storage class in the spec, the storage class of the backup volume or the default
+// static storage class in order.
+func (drpc *DisasterRecoveryPlanController) getStorageClassName(plan *longhorn.DisasterRecoveryPlan, v *longhorn.Volume) (string, error) {
+	if plan.Spec.StorageClassName != "" {
+		return plan.Spec.StorageClassName, nil
+	}
+
+	if v.Spec.FromBackup != "" {
+		_, bvName, _, err := backupstore.DecodeBackupURL(v.Spec.FromBackup)
+		if err == nil {
+			bv, err := drpc.ds.GetBackupVolumeByBackupTargetAndVolumeRO(v.Spec.BackupTargetName, bvName)
+			if err == nil && bv.Status.StorageClassName != "" {
+				return bv.Status.StorageClassName, nil
+			}
+		}
+	}
+
+	return drpc.ds.GetSettingValueExisted(types.SettingNameDefaultLonghornStaticStorageClass)
+}
+
+func (drpc *DisasterRecoveryPlanController) failFailover(plan *longhorn.DisasterRecoveryPlan, message string) {
+	plan.Status.State = longhorn.DisasterRecoveryPlanStateError
+	plan.Status.Message = message
+	drpc.eventRecorder.Event(plan, corev1.EventTypeWarning, constant.EventReasonDisasterRecoveryPlanFailoverFailed, message)
+}
+
+func getDisasterRecoveryPlanPVCNamespace(plan *longhorn.DisasterRecoveryPlan, volumeName string) string {
+	if namespace := plan.Spec.PVCNamespaces[volumeName]; namespace != "" {
+		return namespace
+	}
+	if plan.Spec.TargetNamespace != "" {
+		return plan.Spec.TargetNamespace
+	}
+	return corev1.NamespaceDefault
+}
+
+func sortedVolumeNames(volumes map[string]*longhorn.Volume) []string {
+	names := make([]string, 0, len(volumes))
+	for name := range volumes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedMemberNames(plan *longhorn.DisasterRecoveryPlan) []string {
+	names := make([]string, 0, len(plan.Status.Members))
+	for name := range plan.Status.Members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func TestGetRestoreLag(t *testing.T) {
+	testCases := map[string]struct {
+		lastBackupAt         string
+		lastRestoredBackupAt string
+		lag                  time.Duration
+	}{
+		"latest backup restored": {
+			lastBackupAt:         "2024-01-01T00:00:00Z",
+			lastRestoredBackupAt: "2024-01-01T00:00:00Z",
+		},
+		"latest backup not restored": {
+			lastBackupAt:         "2024-01-01T01:30:00Z",
+			lastRestoredBackupAt: "2024-01-01T00:00:00Z",
+			lag:                  90 * time.Minute,
+		},
+		"restored backup newer than the synced latest backup": {
+			lastBackupAt:         "2024-01-01T00:00:00Z",
+			lastRestoredBackupAt: "2024-01-01T00:10:00Z",
+		},
+		"no backup": {
+			lastRestoredBackupAt: "2024-01-01T00:00:00Z",
+		},
+	}
+
+	for name, tc := range testCases {
+		if lag := getRestoreLag(tc.lastBackupAt, tc.lastRestoredBackupAt); lag != tc.lag {
+			t.Errorf("%v: expected lag %v, got %v", name, tc.lag, lag)
+		}
+	}
+}
+
+func TestGetDisasterRecoveryPlanPVCNamespace(t *testing.T) {
+	plan := &longhorn.DisasterRecoveryPlan{}
+	if namespace := getDisasterRecoveryPlanPVCNamespace(plan, "vol-1"); namespace != "default" {
+		t.Errorf("expected the default namespace, got %v", namespace)
+	}
+
+	plan.Spec.TargetNamespace = "app"
+	plan.Spec.PVCNamespaces = map[string]string{"vol-2": "db"}
+	if namespace := getDisasterRecoveryPlanPVCNamespace(plan, "vol-1"); namespace != "app" {
+		t.Errorf("expected the target namespace, got %v", namespace)
+	}
+	if namespace := getDisasterRecoveryPlanPVCNamespace(plan, "vol-2"); namespace != "db" {
+		t.Errorf("expected the namespace of the volume, got %v", namespace)
+	}
+}
+
+func TestSetRecoveryPoint(t *testing.T) {
+	testCases := map[string]struct {
+		maxSkew       int
+		restoredAts   []string
+		recoveryPoint string
+		skew          string
+		exceeded      bool
+	}{
+		"no restored backup": {
+			maxSkew: 10,
+		},
+		"skew within the maximum": {
+			maxSkew:       10,
+			restoredAts:   []string{"2024-01-01T00:05:00Z", "2024-01-01T00:00:00Z"},
+			recoveryPoint: "2024-01-01T00:00:00Z",
+			skew:          "5m0s",
+		},
+		"skew exceeds the maximum": {
+			maxSkew:       10,
+			restoredAts:   []string{"2024-01-01T00:00:00Z", "2024-01-01T00:30:00Z"},
+			recoveryPoint: "2024-01-01T00:00:00Z",
+			skew:          "30m0s",
+			exceeded:      true,
+		},
+		"skew not checked": {
+			restoredAts:   []string{"2024-01-01T00:00:00Z", "2024-01-01T00:30:00Z"},
+			recoveryPoint: "2024-01-01T00:00:00Z",
+			skew:          "30m0s",
+		},
+	}
+
+	for name, tc := range testCases {
+		plan := &longhorn.DisasterRecoveryPlan{
+			Spec: longhorn.DisasterRecoveryPlanSpec{MaxRecoveryPointSkew: tc.maxSkew},
+		}
+		issue := setRecoveryPoint(plan, tc.restoredAts)
+		if (issue != "") != tc.exceeded {
+			t.Errorf("%v: expected exceeded %v, got issue %q", name, tc.exceeded, issue)
+		}
+		if plan.Status.RecoveryPoint != tc.recoveryPoint || plan.Status.RecoveryPointSkew != tc.skew {
+			t.Errorf("%v: expected recovery point %v with skew %v, got %v with skew %v", name,
+				tc.recoveryPoint, tc.skew, plan.Status.RecoveryPoint, plan.Status.RecoveryPointSkew)
+		}
+	}
+}
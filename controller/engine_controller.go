@@ -17,6 +17,9 @@ import (
 
 	"golang.org/x/time/rate"
 
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
@@ -70,6 +73,13 @@ var (
 	sizeUpdateLimit = 30 * time.Second
 	// number of consecutive actual size updates allowed during bursts
 	sizeUpdateBurst = 3
+
+	// minimum amount of time between the updates of the last write time during periods with continuous writes
+	lastWriteAtUpdateInterval = 1 * time.Minute
+	// minimum amount of time between the updates of the cumulative IO counters
+	ioStatsUpdateInterval = 1 * time.Minute
+	// maximum amount of time a metrics sample accounts for, so a delayed poll does not extrapolate the IO rate
+	ioStatsMaxSampleInterval = 6 * EnginePollInterval
 )
 
 const (
@@ -131,6 +141,11 @@ type EngineMonitor struct {
 	restoringCounterMutex    *sync.Mutex
 
 	sizeUpdateLimiter *rate.Limiter
+
+	// The cumulative IO counters accumulated by this monitor, written to the engine status every ioStatsUpdateInterval
+	ioStats          *longhorn.VolumeIOStats
+	ioStatsSampledAt time.Time
+	ioStatsUpdatedAt time.Time
 }
 
 func NewEngineController(
@@ -890,6 +905,8 @@ func (m *EngineMonitor) refresh(engine *longhorn.Engine) error {
 		engine.Status.SnapshotsError = ""
 	}
 
+	m.updateIOStats(engine, engineClientProxy)
+
 	// TODO: find a more advanced way to handle invocations for incompatible running engines
 	im, err := m.ds.GetInstanceManagerRO(engine.Status.InstanceManagerName)
 	if err != nil {
@@ -1125,6 +1142,10 @@ func (m *EngineMonitor) refresh(engine *longhorn.Engine) error {
 
 	engine.Status.CloneStatus = snapshotCloneStatusMap
 
+	if err := m.restoreRebuildQoSAfterClone(engine, engineClientProxy); err != nil {
+		return err
+	}
+
 	needClone, err := preCloneCheck(engine)
 	if err != nil {
 		return err
@@ -1138,6 +1159,62 @@ func (m *EngineMonitor) refresh(engine *longhorn.Engine) error {
 	return nil
 }
 
+// updateIOStats accumulates the IO rates reported by the engine into the cumulative IO counters of the engine, and
+// records the time a write to the volume is observed, which is used to compute the backup lag of the volume.
+func (m *EngineMonitor) updateIOStats(engine *longhorn.Engine, engineClientProxy engineapi.EngineClientProxy) {
+	metrics, err := engineClientProxy.MetricsGet(engine)
+	if err != nil {
+		m.logger.WithError(err).Debug("Failed to get engine metrics")
+		return
+	}
+
+	now := time.Now()
+	if m.ioStats == nil {
+		// Continue from the counters recorded by the previous monitor of the engine
+		m.ioStats = &longhorn.VolumeIOStats{}
+		if engine.Status.IOStats != nil {
+			*m.ioStats = *engine.Status.IOStats
+		}
+	}
+	if !m.ioStatsSampledAt.IsZero() {
+		accumulateIOStats(m.ioStats, metrics, now.Sub(m.ioStatsSampledAt))
+	}
+	m.ioStatsSampledAt = now
+
+	hasWrite := metrics.WriteIOPS != 0 || metrics.WriteThroughput != 0
+	if hasWrite || metrics.ReadIOPS != 0 || metrics.ReadThroughput != 0 {
+		m.ioStats.LastIOAt = util.Now()
+	}
+	if engine.Status.IOStats == nil || now.Sub(m.ioStatsUpdatedAt) >= ioStatsUpdateInterval {
+		ioStats := *m.ioStats
+		engine.Status.IOStats = &ioStats
+		m.ioStatsUpdatedAt = now
+	}
+
+	if !hasWrite {
+		return
+	}
+	if engine.Status.LastWriteAt != "" && !util.TimestampAfterTimeout(engine.Status.LastWriteAt, lastWriteAtUpdateInterval) {
+		return
+	}
+	engine.Status.LastWriteAt = util.Now()
+}
+
+// accumulateIOStats adds the IO done at the rates of the metrics during the interval to the counters
+func accumulateIOStats(ioStats *longhorn.VolumeIOStats, metrics *engineapi.Metrics, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	if interval > ioStatsMaxSampleInterval {
+		interval = ioStatsMaxSampleInterval
+	}
+	seconds := interval.Seconds()
+	ioStats.ReadBytes += int64(float64(metrics.ReadThroughput) * seconds)
+	ioStats.WriteBytes += int64(float64(metrics.WriteThroughput) * seconds)
+	ioStats.ReadOps += int64(float64(metrics.ReadIOPS) * seconds)
+	ioStats.WriteOps += int64(float64(metrics.WriteIOPS) * seconds)
+}
+
 func (m *EngineMonitor) checkAndApplyRebuildQoS(engine *longhorn.Engine, engineClientProxy engineapi.EngineClientProxy, rebuildStatus map[string]*longhorn.RebuildStatus) error {
 	if !types.IsDataEngineV2(engine.Spec.DataEngine) {
 		return nil
@@ -1181,7 +1258,7 @@ func (m *EngineMonitor) getEffectiveRebuildQoS(engine *longhorn.Engine) (int64,
 		return 0, nil
 	}
 
-	globalQoS, err := m.ds.GetSettingAsIntByDataEngine(types.SettingNameReplicaRebuildingBandwidthLimit, engine.Spec.DataEngine)
+	globalQoS, err := m.ds.GetNodeSettingAsIntByDataEngine(types.SettingNameReplicaRebuildingBandwidthLimit, engine.Spec.NodeID, engine.Spec.DataEngine)
 	if err != nil {
 		return 0, err
 	}
@@ -1664,6 +1741,10 @@ func cloneSnapshot(engine *longhorn.Engine, engineClientProxy engineapi.EngineCl
 		return err
 	}
 
+	if err := applyVolumeCloneBandwidthLimit(engine, engineClientProxy, ds); err != nil {
+		return err
+	}
+
 	sourceEngineControllerURL := imutil.GetURL(sourceEngine.Status.StorageIP, sourceEngine.Status.Port)
 	if err := engineClientProxy.SnapshotClone(engine, snapshotName, sourceEngineControllerURL,
 		sourceEngine.Spec.VolumeName, sourceEngine.Name, fileSyncHTTPClientTimeout, grpcTimeoutSeconds); err != nil {
@@ -1678,6 +1759,59 @@ func cloneSnapshot(engine *longhorn.Engine, engineClientProxy engineapi.EngineCl
 	return nil
 }
 
+// applyVolumeCloneBandwidthLimit sets the QoS of the engine to the clone bandwidth limit before the clone starts. The
+// clone transfers the snapshot data the same way as the replica rebuilding, so the rebuilding QoS limits the clone, and
+// it is restored by restoreRebuildQoSAfterClone once the clone finishes.
+func applyVolumeCloneBandwidthLimit(engine *longhorn.Engine, engineClientProxy engineapi.EngineClientProxy, ds *datastore.DataStore) error {
+	limit, err := ds.GetNodeSettingAsIntByDataEngine(types.SettingNameVolumeCloneBandwidthLimit, engine.Spec.NodeID, engine.Spec.DataEngine)
+	if err != nil {
+		return err
+	}
+	if limit <= 0 || limit == engine.Status.AppliedCloneBandwidthLimit {
+		return nil
+	}
+	if err := engineClientProxy.ReplicaRebuildQosSet(engine, limit); err != nil {
+		// The instance managers of the old v1 data engine cannot throttle the sync-agent
+		if grpcstatus.Code(errors.Cause(err)) == grpccodes.Unimplemented {
+			logrus.WithError(err).Warnf("Cloning snapshot to engine %v without the bandwidth limit", engine.Name)
+			return nil
+		}
+		return err
+	}
+	engine.Status.AppliedCloneBandwidthLimit = limit
+	return nil
+}
+
+// restoreRebuildQoSAfterClone restores the rebuilding QoS of the engine replaced by the clone bandwidth limit once the
+// clone completes or fails.
+func (m *EngineMonitor) restoreRebuildQoSAfterClone(engine *longhorn.Engine, engineClientProxy engineapi.EngineClientProxy) error {
+	if engine.Status.AppliedCloneBandwidthLimit == 0 || !isCloneFinished(engine) {
+		return nil
+	}
+
+	qos, err := m.getEffectiveRebuildQoS(engine)
+	if err != nil {
+		return err
+	}
+	if err := engineClientProxy.ReplicaRebuildQosSet(engine, qos); err != nil {
+		return errors.Wrapf(err, "failed to restore the rebuilding QoS after the clone")
+	}
+	engine.Status.AppliedCloneBandwidthLimit = 0
+	return nil
+}
+
+func isCloneFinished(engine *longhorn.Engine) bool {
+	if engine.Spec.RequestedDataSource == "" {
+		return true
+	}
+	for _, status := range engine.Status.CloneStatus {
+		if status != nil && (status.State == engineapi.ProcessStateComplete || status.State == engineapi.ProcessStateError) {
+			return true
+		}
+	}
+	return false
+}
+
 func (ec *EngineController) ReconcileEngineState(e *longhorn.Engine) error {
 	if err := ec.removeUnknownReplica(e); err != nil {
 		return err
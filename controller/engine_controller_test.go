@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	etypes "github.com/longhorn/longhorn-engine/pkg/types"
+	"github.com/longhorn/longhorn-manager/engineapi"
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 	"github.com/longhorn/longhorn-manager/util"
 )
@@ -132,3 +133,27 @@ func TestNeedStatusUpdate(t *testing.T) {
 		assert.Equal(tc.expectRateLimited, rateLimited, "rateLimited")
 	}
 }
+
+func TestIsCloneFinished(t *testing.T) {
+	assert := require.New(t)
+
+	newEngine := func(requestedDataSource longhorn.VolumeDataSource, states ...string) *longhorn.Engine {
+		e := &longhorn.Engine{
+			Spec: longhorn.EngineSpec{RequestedDataSource: requestedDataSource},
+			Status: longhorn.EngineStatus{
+				CloneStatus: map[string]*longhorn.SnapshotCloneStatus{},
+			},
+		}
+		for i, state := range states {
+			e.Status.CloneStatus[fmt.Sprintf("tcp://10.0.0.%v:10000", i+1)] = &longhorn.SnapshotCloneStatus{State: state}
+		}
+		return e
+	}
+	dataSource := longhorn.VolumeDataSource("vol://source-volume/snapshot-1")
+
+	assert.True(isCloneFinished(newEngine("")))
+	assert.False(isCloneFinished(newEngine(dataSource)))
+	assert.False(isCloneFinished(newEngine(dataSource, engineapi.ProcessStateInProgress)))
+	assert.True(isCloneFinished(newEngine(dataSource, engineapi.ProcessStateComplete)))
+	assert.True(isCloneFinished(newEngine(dataSource, engineapi.ProcessStateError)))
+}
@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -26,6 +29,9 @@ import (
 	clientset "k8s.io/client-go/kubernetes"
 	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	"github.com/longhorn/go-common-libs/multierr"
+
+	"github.com/longhorn/longhorn-manager/constant"
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/engineapi"
 	"github.com/longhorn/longhorn-manager/types"
@@ -362,7 +368,7 @@ func (ic *EngineImageController) syncEngineImage(key string) (err error) {
 		engineImage.Status.State = longhorn.EngineImageStateDeployed
 	}
 
-	if err := ic.handleAutoUpgradeEngineImageToDefaultEngineImage(engineImage.Spec.Image); err != nil {
+	if err := ic.handleAutoUpgradeEngineImageToDefaultEngineImage(engineImage); err != nil {
 		log.WithError(err).Warn("error when handleAutoUpgradeEngineImageToDefaultEngineImage")
 	}
 
@@ -405,7 +411,7 @@ func (ic *EngineImageController) syncNodeDeploymentMap(engineImage *longhorn.Eng
 }
 
 // handleAutoUpgradeEngineImageToDefaultEngineImage automatically upgrades volume's engine image to default engine image when it is applicable
-func (ic *EngineImageController) handleAutoUpgradeEngineImageToDefaultEngineImage(currentProcessingImage string) error {
+func (ic *EngineImageController) handleAutoUpgradeEngineImageToDefaultEngineImage(engineImage *longhorn.EngineImage) error {
 	defaultEngineImage, err := ic.ds.GetSettingValueExisted(types.SettingNameDefaultEngineImage)
 	if err != nil {
 		return err
@@ -413,15 +419,10 @@ func (ic *EngineImageController) handleAutoUpgradeEngineImageToDefaultEngineImag
 
 	// To avoid multiple managers doing upgrade at the same time, only allow the
 	// manager that is responsible for the default engine image to do the upgrade
-	if currentProcessingImage != defaultEngineImage {
+	if engineImage.Spec.Image != defaultEngineImage {
 		return nil
 	}
 
-	defaultEngineImageResource, err := ic.ds.GetEngineImage(types.GetEngineImageChecksumName(defaultEngineImage))
-	if err != nil {
-		return err
-	}
-
 	concurrentAutomaticEngineUpgradePerNodeLimit, err := ic.ds.GetSettingAsInt(types.SettingNameConcurrentAutomaticEngineUpgradePerNodeLimit)
 	if err != nil {
 		return err
@@ -436,27 +437,379 @@ func (ic *EngineImageController) handleAutoUpgradeEngineImageToDefaultEngineImag
 		return err
 	}
 
-	candidates, inProgress := ic.getVolumesForEngineImageUpgrading(volumes, defaultEngineImageResource)
+	candidates, inProgress := ic.getVolumesForEngineImageUpgrading(volumes, engineImage)
+
+	canaryVolumeSelector, err := ic.ds.GetSettingValueExisted(types.SettingNameAutomaticEngineUpgradeCanaryVolumeSelector)
+	if err != nil {
+		return err
+	}
+	if canaryVolumeSelector != "" {
+		return ic.rolloutAutoUpgradeEngineImage(engineImage, volumes, candidates, inProgress, int(concurrentAutomaticEngineUpgradePerNodeLimit), canaryVolumeSelector)
+	}
+	engineImage.Status.AutoUpgradeRollout = nil
 
 	limitedCandidates := limitAutomaticEngineUpgradePerNode(candidates, inProgress, int(concurrentAutomaticEngineUpgradePerNodeLimit))
 
 	for _, vs := range limitedCandidates {
 		for _, v := range vs {
-			ic.logger.WithFields(logrus.Fields{"volume": v.Name, "image": v.Spec.Image}).Infof("Upgrading volume engine image to the default engine image %v automatically", defaultEngineImage)
+			if _, err := ic.upgradeVolumeToDefaultEngineImage(v, defaultEngineImage); err != nil {
+				return err
+			}
+		}
+	}
 
-			if types.IsDataEngineV2(v.Spec.DataEngine) {
-				ic.logger.WithFields(logrus.Fields{"volume": v.Name, "image": v.Spec.Image}).Infof("Skip upgrading volume engine image to the default engine image %v automatically since it is using v2 data engine", defaultEngineImage)
-				continue
+	return nil
+}
+
+// upgradeVolumeToDefaultEngineImage updates the engine image of the volume, and returns false if the volume is skipped.
+func (ic *EngineImageController) upgradeVolumeToDefaultEngineImage(v *longhorn.Volume, defaultEngineImage string) (bool, error) {
+	ic.logger.WithFields(logrus.Fields{"volume": v.Name, "image": v.Spec.Image}).Infof("Upgrading volume engine image to the default engine image %v automatically", defaultEngineImage)
+
+	if types.IsDataEngineV2(v.Spec.DataEngine) {
+		ic.logger.WithFields(logrus.Fields{"volume": v.Name, "image": v.Spec.Image}).Infof("Skip upgrading volume engine image to the default engine image %v automatically since it is using v2 data engine", defaultEngineImage)
+		return false, nil
+	}
+
+	v.Spec.Image = defaultEngineImage
+	if _, err := ic.ds.UpdateVolume(v); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// rolloutAutoUpgradeEngineImage upgrades the volumes to the default engine image in stages. The canary volumes are upgraded
+// first, then the rest of the volumes are upgraded in waves. A stage starts only after the volumes upgraded in the previous
+// stages keep healthy during the health gate period. The rollout is paused or rolled back if an upgraded volume becomes unhealthy.
+func (ic *EngineImageController) rolloutAutoUpgradeEngineImage(engineImage *longhorn.EngineImage, volumes map[string]*longhorn.Volume,
+	candidates, inProgress map[string][]*longhorn.Volume, concurrentLimit int, canaryVolumeSelector string) error {
+	log := ic.logger.WithField("engineImage", engineImage.Name)
+
+	selector, err := labels.Parse(canaryVolumeSelector)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse setting %v", types.SettingNameAutomaticEngineUpgradeCanaryVolumeSelector)
+	}
+	healthGatePeriod, err := ic.ds.GetSettingAsInt(types.SettingNameAutomaticEngineUpgradeHealthGatePeriod)
+	if err != nil {
+		return err
+	}
+	waveSize, err := ic.ds.GetSettingAsInt(types.SettingNameAutomaticEngineUpgradeWaveSize)
+	if err != nil {
+		return err
+	}
+	failureAction, err := ic.ds.GetSettingValueExisted(types.SettingNameAutomaticEngineUpgradeFailureAction)
+	if err != nil {
+		return err
+	}
+
+	rollout := engineImage.Status.AutoUpgradeRollout
+	if rollout == nil || rollout.CanaryVolumeSelector != canaryVolumeSelector {
+		rollout = &longhorn.EngineImageAutoUpgradeRollout{
+			CanaryVolumeSelector: canaryVolumeSelector,
+			UpgradedVolumes:      map[string]string{},
+		}
+		engineImage.Status.AutoUpgradeRollout = rollout
+	}
+	if rollout.UpgradedVolumes == nil {
+		rollout.UpgradedVolumes = map[string]string{}
+	}
+
+	switch rollout.Phase {
+	case longhorn.EngineImageAutoUpgradeRolloutPhaseRollingBack:
+		return ic.rollbackAutoUpgradeEngineImage(engineImage, rollout, volumes)
+	case longhorn.EngineImageAutoUpgradeRolloutPhaseRolledBack:
+		return nil
+	case longhorn.EngineImageAutoUpgradeRolloutPhaseCompleted:
+		// The engine image has been verified by the rollout, so the volumes becoming eligible later are upgraded directly.
+		for _, vs := range limitAutomaticEngineUpgradePerNode(candidates, inProgress, concurrentLimit) {
+			for _, v := range vs {
+				if _, err := ic.upgradeVolumeToDefaultEngineImage(v, engineImage.Spec.Image); err != nil {
+					return err
+				}
 			}
+		}
+		return nil
+	}
 
-			v.Spec.Image = defaultEngineImage
-			_, err = ic.ds.UpdateVolume(v)
-			if err != nil {
+	now := time.Now()
+	var healthGateStartedAt time.Time
+	if rollout.HealthGateStartedAt != "" {
+		if healthGateStartedAt, err = util.ParseTime(rollout.HealthGateStartedAt); err != nil {
+			return errors.Wrapf(err, "failed to parse health gate start time %v", rollout.HealthGateStartedAt)
+		}
+	}
+
+	unhealthyVolumes, err := ic.getUnhealthyAutoUpgradedVolumes(rollout, volumes, healthGateStartedAt)
+	if err != nil {
+		return err
+	}
+	if len(unhealthyVolumes) > 0 {
+		message := fmt.Sprintf("Upgraded volumes are unhealthy: %v", strings.Join(unhealthyVolumes, "; "))
+		if types.AutomaticEngineUpgradeFailureAction(failureAction) == types.AutomaticEngineUpgradeFailureActionRollback {
+			log.Warnf("Rolling back the automatic engine upgrade: %v", message)
+			rollout.Phase = longhorn.EngineImageAutoUpgradeRolloutPhaseRollingBack
+			rollout.Message = message
+			return ic.rollbackAutoUpgradeEngineImage(engineImage, rollout, volumes)
+		}
+		if rollout.Phase != longhorn.EngineImageAutoUpgradeRolloutPhasePaused {
+			// A resume requested before the pause does not count
+			if err := ic.removeAutoUpgradeRolloutResumeAnnotation(engineImage); err != nil {
 				return err
 			}
+			log.Warnf("Pausing the automatic engine upgrade: %v", message)
+			ic.eventRecorder.Eventf(engineImage, corev1.EventTypeWarning, constant.EventReasonRolloutPaused, "Paused the automatic engine upgrade: %v", message)
+		}
+		rollout.Phase = longhorn.EngineImageAutoUpgradeRolloutPhasePaused
+		rollout.Message = message
+		return nil
+	}
+
+	if rollout.Phase == longhorn.EngineImageAutoUpgradeRolloutPhasePaused {
+		// The paused rollout is resumed by the user only, even if the upgraded volumes are healthy again
+		resumeAnnotation := types.GetLonghornLabelKey(types.ResumeAutoUpgradeRollout)
+		if _, ok := engineImage.Annotations[resumeAnnotation]; !ok {
+			rollout.Message = fmt.Sprintf("Upgraded volumes are healthy again, waiting for annotation %v on the engine image to resume the rollout", resumeAnnotation)
+			return nil
+		}
+		if err := ic.removeAutoUpgradeRolloutResumeAnnotation(engineImage); err != nil {
+			return err
+		}
+		log.Info("Resuming the automatic engine upgrade")
+		ic.eventRecorder.Eventf(engineImage, corev1.EventTypeNormal, constant.EventReasonRolloutResumed, "Resumed the automatic engine upgrade")
+		rollout.Phase = longhorn.EngineImageAutoUpgradeRolloutPhaseProgressing
+		if rollout.Wave == 0 {
+			rollout.Phase = longhorn.EngineImageAutoUpgradeRolloutPhaseCanary
+		}
+	}
+
+	switch rollout.Phase {
+	case "":
+		return ic.startAutoUpgradeCanaryStage(rollout, volumes, candidates, inProgress, concurrentLimit, int(waveSize), selector, engineImage.Spec.Image)
+	case longhorn.EngineImageAutoUpgradeRolloutPhaseCanary, longhorn.EngineImageAutoUpgradeRolloutPhaseProgressing:
+		upgrading := 0
+		for name := range rollout.UpgradedVolumes {
+			if v, ok := volumes[name]; ok && v.Spec.Image != v.Status.CurrentImage {
+				upgrading++
+			}
+		}
+		if upgrading > 0 {
+			rollout.Message = fmt.Sprintf("Waiting for %v volumes to finish the engine upgrade", upgrading)
+			return nil
+		}
+		rollout.Phase = longhorn.EngineImageAutoUpgradeRolloutPhaseHealthGate
+		rollout.HealthGateStartedAt = util.Now()
+		rollout.Message = fmt.Sprintf("Waiting %v minutes for the upgraded volumes to stay healthy", healthGatePeriod)
+		ic.enqueueEngineImageAfter(engineImage, time.Duration(healthGatePeriod)*time.Minute)
+		return nil
+	case longhorn.EngineImageAutoUpgradeRolloutPhaseHealthGate:
+		if remaining := healthGateStartedAt.Add(time.Duration(healthGatePeriod) * time.Minute).Sub(now); remaining > 0 {
+			ic.enqueueEngineImageAfter(engineImage, remaining)
+			return nil
+		}
+		if err := ic.startAutoUpgradeWave(rollout, candidates, inProgress, concurrentLimit, int(waveSize), engineImage.Spec.Image); err != nil {
+			return err
+		}
+		if rollout.Phase == longhorn.EngineImageAutoUpgradeRolloutPhaseCompleted {
+			log.Info("Completed the automatic engine upgrade rollout")
+			ic.eventRecorder.Eventf(engineImage, corev1.EventTypeNormal, constant.EventReasonRolloutCompleted, "Completed the automatic engine upgrade of %v volumes", rollout.UpgradedVolumeCount)
+		}
+		return nil
+	}
+
+	return nil
+}
+
+// removeAutoUpgradeRolloutResumeAnnotation removes the annotation resuming the paused rollout, so the next pause needs
+// to be resumed again.
+func (ic *EngineImageController) removeAutoUpgradeRolloutResumeAnnotation(engineImage *longhorn.EngineImage) error {
+	resumeAnnotation := types.GetLonghornLabelKey(types.ResumeAutoUpgradeRollout)
+	if _, ok := engineImage.Annotations[resumeAnnotation]; !ok {
+		return nil
+	}
+
+	existingEngineImage := engineImage.DeepCopy()
+	delete(existingEngineImage.Annotations, resumeAnnotation)
+	updatedEngineImage, err := ic.ds.UpdateEngineImage(existingEngineImage)
+	if err != nil {
+		return errors.Wrapf(err, "failed to remove annotation %v", resumeAnnotation)
+	}
+	// Keep the status in memory, which is updated later
+	engineImage.Annotations = updatedEngineImage.Annotations
+	engineImage.ResourceVersion = updatedEngineImage.ResourceVersion
+	return nil
+}
+
+// startAutoUpgradeCanaryStage upgrades the eligible volumes matching the canary volume selector. If no volume matches the
+// selector, the rollout starts the progressive waves directly.
+func (ic *EngineImageController) startAutoUpgradeCanaryStage(rollout *longhorn.EngineImageAutoUpgradeRollout, volumes map[string]*longhorn.Volume,
+	candidates, inProgress map[string][]*longhorn.Volume, concurrentLimit, waveSize int, selector labels.Selector, defaultEngineImage string) error {
+	pendingCanaries := 0
+	for _, v := range volumes {
+		if v.Spec.Image != defaultEngineImage && !types.IsDataEngineV2(v.Spec.DataEngine) && selector.Matches(labels.Set(v.Labels)) {
+			pendingCanaries++
+		}
+	}
+	if pendingCanaries == 0 {
+		return ic.startAutoUpgradeWave(rollout, candidates, inProgress, concurrentLimit, waveSize, defaultEngineImage)
+	}
+
+	canaryCandidates := map[string][]*longhorn.Volume{}
+	for node, vs := range candidates {
+		for _, v := range vs {
+			if selector.Matches(labels.Set(v.Labels)) {
+				canaryCandidates[node] = append(canaryCandidates[node], v)
+			}
 		}
 	}
+	stage := selectAutoUpgradeStageVolumes(canaryCandidates, inProgress, concurrentLimit, len(volumes))
+	if len(stage) == 0 {
+		rollout.Message = fmt.Sprintf("Waiting for %v canary volumes to become eligible for the engine upgrade", pendingCanaries)
+		return nil
+	}
+
+	rollout.Phase = longhorn.EngineImageAutoUpgradeRolloutPhaseCanary
+	return ic.upgradeAutoUpgradeStageVolumes(rollout, stage, defaultEngineImage)
+}
 
+// startAutoUpgradeWave upgrades the next wave of the eligible volumes, or completes the rollout if there is no eligible volume.
+func (ic *EngineImageController) startAutoUpgradeWave(rollout *longhorn.EngineImageAutoUpgradeRollout, candidates, inProgress map[string][]*longhorn.Volume,
+	concurrentLimit, waveSize int, defaultEngineImage string) error {
+	stage := selectAutoUpgradeStageVolumes(candidates, inProgress, concurrentLimit, waveSize)
+	if len(stage) == 0 {
+		rollout.Phase = longhorn.EngineImageAutoUpgradeRolloutPhaseCompleted
+		rollout.UpgradedVolumes = map[string]string{}
+		rollout.Message = fmt.Sprintf("Upgraded %v volumes", rollout.UpgradedVolumeCount)
+		return nil
+	}
+
+	rollout.Wave++
+	rollout.Phase = longhorn.EngineImageAutoUpgradeRolloutPhaseProgressing
+	return ic.upgradeAutoUpgradeStageVolumes(rollout, stage, defaultEngineImage)
+}
+
+// upgradeAutoUpgradeStageVolumes upgrades the volumes of a new stage. The volumes of the previous stage passed the health
+// gate, so only the volumes of the new stage are kept for the health check and the rollback.
+func (ic *EngineImageController) upgradeAutoUpgradeStageVolumes(rollout *longhorn.EngineImageAutoUpgradeRollout, stage []*longhorn.Volume, defaultEngineImage string) error {
+	rollout.UpgradedVolumes = map[string]string{}
+	rollout.HealthGateStartedAt = ""
+	for _, v := range stage {
+		previousImage := v.Spec.Image
+		upgraded, err := ic.upgradeVolumeToDefaultEngineImage(v, defaultEngineImage)
+		if err != nil {
+			return err
+		}
+		if upgraded {
+			rollout.UpgradedVolumes[v.Name] = previousImage
+			rollout.UpgradedVolumeCount++
+		}
+	}
+	rollout.Message = fmt.Sprintf("Upgrading %v volumes", len(rollout.UpgradedVolumes))
+	return nil
+}
+
+// selectAutoUpgradeStageVolumes returns at most maxCount v1 data engine volumes, respecting the per node concurrent limit.
+func selectAutoUpgradeStageVolumes(candidates, inProgress map[string][]*longhorn.Volume, concurrentLimit, maxCount int) []*longhorn.Volume {
+	v1Candidates := map[string][]*longhorn.Volume{}
+	for node, vs := range candidates {
+		for _, v := range vs {
+			if !types.IsDataEngineV2(v.Spec.DataEngine) {
+				v1Candidates[node] = append(v1Candidates[node], v)
+			}
+		}
+	}
+
+	stage := []*longhorn.Volume{}
+	for _, vs := range limitAutomaticEngineUpgradePerNode(v1Candidates, inProgress, concurrentLimit) {
+		stage = append(stage, vs...)
+	}
+	sort.Slice(stage, func(i, j int) bool {
+		return stage[i].Name < stage[j].Name
+	})
+	if len(stage) > maxCount {
+		stage = stage[:maxCount]
+	}
+	return stage
+}
+
+// getUnhealthyAutoUpgradedVolumes returns the reasons why the volumes upgraded by the rollout are unhealthy. A volume is
+// unhealthy if it is faulted, if it is attached without healthy robustness after the upgrade, or if one of its replicas
+// failed after the health gate started.
+func (ic *EngineImageController) getUnhealthyAutoUpgradedVolumes(rollout *longhorn.EngineImageAutoUpgradeRollout, volumes map[string]*longhorn.Volume, healthGateStartedAt time.Time) ([]string, error) {
+	unhealthyVolumes := []string{}
+	for name := range rollout.UpgradedVolumes {
+		v, ok := volumes[name]
+		if !ok {
+			continue
+		}
+		replicas, err := ic.ds.ListVolumeReplicasRO(name)
+		if err != nil {
+			return nil, err
+		}
+		if reason := getAutoUpgradedVolumeUnhealthyReason(v, replicas, healthGateStartedAt); reason != "" {
+			unhealthyVolumes = append(unhealthyVolumes, fmt.Sprintf("volume %v %v", name, reason))
+		}
+	}
+	sort.Strings(unhealthyVolumes)
+	return unhealthyVolumes, nil
+}
+
+func getAutoUpgradedVolumeUnhealthyReason(v *longhorn.Volume, replicas map[string]*longhorn.Replica, healthGateStartedAt time.Time) string {
+	if v.Status.Robustness == longhorn.VolumeRobustnessFaulted {
+		return "is faulted"
+	}
+	if v.Spec.Image == v.Status.CurrentImage && v.Status.State == longhorn.VolumeStateAttached && v.Status.Robustness != longhorn.VolumeRobustnessHealthy {
+		return fmt.Sprintf("is %v", v.Status.Robustness)
+	}
+	if healthGateStartedAt.IsZero() {
+		return ""
+	}
+	for _, r := range replicas {
+		if r.Spec.FailedAt == "" {
+			continue
+		}
+		failedAt, err := util.ParseTime(r.Spec.FailedAt)
+		if err != nil {
+			continue
+		}
+		if failedAt.After(healthGateStartedAt) {
+			return fmt.Sprintf("has failed replica %v", r.Name)
+		}
+	}
+	return ""
+}
+
+// rollbackAutoUpgradeEngineImage keeps the rollout rolling back until all the volumes upgraded in the current stage are
+// reverted to their previous engine images.
+func (ic *EngineImageController) rollbackAutoUpgradeEngineImage(engineImage *longhorn.EngineImage, rollout *longhorn.EngineImageAutoUpgradeRollout, volumes map[string]*longhorn.Volume) error {
+	if err := ic.rollbackAutoUpgradedVolumes(rollout, volumes, engineImage.Spec.Image); err != nil {
+		return errors.Wrap(err, "failed to roll back the automatic engine upgrade")
+	}
+	rollout.Phase = longhorn.EngineImageAutoUpgradeRolloutPhaseRolledBack
+	ic.eventRecorder.Eventf(engineImage, corev1.EventTypeWarning, constant.EventReasonRolloutRolledBack, "Rolled back the automatic engine upgrade: %v", rollout.Message)
+	return nil
+}
+
+// rollbackAutoUpgradedVolumes reverts the volumes upgraded in the current stage to their previous engine images. The
+// reverted volumes are removed from the rollout, so the ones failing to be reverted are retried by the next sync.
+func (ic *EngineImageController) rollbackAutoUpgradedVolumes(rollout *longhorn.EngineImageAutoUpgradeRollout, volumes map[string]*longhorn.Volume, defaultEngineImage string) error {
+	errs := multierr.NewMultiError()
+	for name, previousImage := range rollout.UpgradedVolumes {
+		v, ok := volumes[name]
+		if !ok || v.Spec.Image != defaultEngineImage {
+			delete(rollout.UpgradedVolumes, name)
+			continue
+		}
+		ic.logger.WithFields(logrus.Fields{"volume": v.Name, "image": previousImage}).Infof("Rolling back volume engine image from %v", defaultEngineImage)
+		v = v.DeepCopy()
+		v.Spec.Image = previousImage
+		if _, err := ic.ds.UpdateVolume(v); err != nil {
+			errs.Append("errors", errors.Wrapf(err, "failed to roll back engine image of volume %v", v.Name))
+			continue
+		}
+		delete(rollout.UpgradedVolumes, name)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%v", errs.ErrorByReason("errors"))
+	}
 	return nil
 }
 
@@ -698,6 +1051,16 @@ func (ic *EngineImageController) enqueueEngineImage(obj interface{}) {
 	ic.queue.Add(key)
 }
 
+func (ic *EngineImageController) enqueueEngineImageAfter(obj interface{}, duration time.Duration) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %#v: %v", obj, err))
+		return
+	}
+
+	ic.queue.AddAfter(key, duration)
+}
+
 func (ic *EngineImageController) enqueueVolumes(volumes ...interface{}) {
 	images := map[string]struct{}{}
 	for _, obj := range volumes {
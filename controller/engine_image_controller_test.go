@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
@@ -16,6 +17,7 @@ import (
 	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/longhorn/longhorn-manager/constant"
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
@@ -294,3 +296,167 @@ func (s *TestSuite) TestEngineImage(c *C) {
 		}
 	}
 }
+
+func (s *TestSuite) TestSelectAutoUpgradeStageVolumes(c *C) {
+	newVolume := func(name string, dataEngine longhorn.DataEngineType) *longhorn.Volume {
+		return &longhorn.Volume{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       longhorn.VolumeSpec{DataEngine: dataEngine},
+		}
+	}
+	candidates := map[string][]*longhorn.Volume{
+		TestNode1: {newVolume("vol-a", longhorn.DataEngineTypeV1), newVolume("vol-b", longhorn.DataEngineTypeV1), newVolume("vol-c", longhorn.DataEngineTypeV2)},
+		TestNode2: {newVolume("vol-d", longhorn.DataEngineTypeV1), newVolume("vol-e", longhorn.DataEngineTypeV1)},
+	}
+	inProgress := map[string][]*longhorn.Volume{
+		TestNode2: {newVolume("vol-f", longhorn.DataEngineTypeV1)},
+	}
+
+	names := func(volumes []*longhorn.Volume) []string {
+		result := []string{}
+		for _, v := range volumes {
+			result = append(result, v.Name)
+		}
+		return result
+	}
+
+	c.Assert(names(selectAutoUpgradeStageVolumes(candidates, inProgress, 2, 10)), DeepEquals, []string{"vol-a", "vol-b", "vol-d"})
+	c.Assert(names(selectAutoUpgradeStageVolumes(candidates, inProgress, 2, 2)), DeepEquals, []string{"vol-a", "vol-b"})
+	c.Assert(names(selectAutoUpgradeStageVolumes(candidates, inProgress, 1, 10)), DeepEquals, []string{"vol-a"})
+}
+
+func (s *TestSuite) TestGetAutoUpgradedVolumeUnhealthyReason(c *C) {
+	healthGateStartedAt, err := util.ParseTime("2025-01-01T00:10:00Z")
+	c.Assert(err, IsNil)
+
+	v := &longhorn.Volume{
+		Spec: longhorn.VolumeSpec{Image: TestEngineImage},
+		Status: longhorn.VolumeStatus{
+			CurrentImage: TestEngineImage,
+			State:        longhorn.VolumeStateAttached,
+			Robustness:   longhorn.VolumeRobustnessHealthy,
+		},
+	}
+	replica := &longhorn.Replica{ObjectMeta: metav1.ObjectMeta{Name: "test-replica-1"}}
+	replicas := map[string]*longhorn.Replica{replica.Name: replica}
+
+	c.Assert(getAutoUpgradedVolumeUnhealthyReason(v, replicas, healthGateStartedAt), Equals, "")
+
+	replica.Spec.FailedAt = "2025-01-01T00:05:00Z"
+	c.Assert(getAutoUpgradedVolumeUnhealthyReason(v, replicas, healthGateStartedAt), Equals, "")
+	replica.Spec.FailedAt = "2025-01-01T00:15:00Z"
+	c.Assert(getAutoUpgradedVolumeUnhealthyReason(v, replicas, healthGateStartedAt), Equals, "has failed replica "+replica.Name)
+	c.Assert(getAutoUpgradedVolumeUnhealthyReason(v, replicas, time.Time{}), Equals, "")
+	replica.Spec.FailedAt = ""
+
+	v.Status.Robustness = longhorn.VolumeRobustnessDegraded
+	c.Assert(getAutoUpgradedVolumeUnhealthyReason(v, replicas, healthGateStartedAt), Equals, "is degraded")
+
+	// The robustness is not checked before the upgrade finishes
+	v.Status.CurrentImage = "longhorn-engine:previous"
+	c.Assert(getAutoUpgradedVolumeUnhealthyReason(v, replicas, healthGateStartedAt), Equals, "")
+
+	v.Status.Robustness = longhorn.VolumeRobustnessFaulted
+	c.Assert(getAutoUpgradedVolumeUnhealthyReason(v, replicas, healthGateStartedAt), Equals, "is faulted")
+}
+
+func (s *TestSuite) TestRollbackAutoUpgradeEngineImage(c *C) {
+	kubeClient := fake.NewSimpleClientset()
+	lhClient := lhfake.NewSimpleClientset()
+	extensionsClient := apiextensionsfake.NewSimpleClientset()
+	informerFactories := util.NewInformerFactories(TestNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+
+	ic, err := newTestEngineImageController(lhClient, kubeClient, extensionsClient, informerFactories)
+	c.Assert(err, IsNil)
+
+	previousImage := "longhorn-engine:previous"
+	engineImage := newEngineImage(TestEngineImage, longhorn.EngineImageStateDeployed)
+	newUpgradedVolume := func(name string) *longhorn.Volume {
+		v := newVolume(name, 2)
+		v.Spec.Image = TestEngineImage
+		return v
+	}
+	volumes := map[string]*longhorn.Volume{
+		"vol-a": newUpgradedVolume("vol-a"),
+		"vol-b": newUpgradedVolume("vol-b"),
+	}
+	_, err = lhClient.LonghornV1beta2().Volumes(TestNamespace).Create(context.TODO(), volumes["vol-a"], metav1.CreateOptions{})
+	c.Assert(err, IsNil)
+
+	rollout := &longhorn.EngineImageAutoUpgradeRollout{
+		Phase:           longhorn.EngineImageAutoUpgradeRolloutPhaseRollingBack,
+		UpgradedVolumes: map[string]string{"vol-a": previousImage, "vol-b": previousImage},
+	}
+
+	// The rollout keeps rolling back the volume failing to be reverted
+	err = ic.rollbackAutoUpgradeEngineImage(engineImage, rollout, volumes)
+	c.Assert(err, NotNil)
+	c.Assert(rollout.Phase, Equals, longhorn.EngineImageAutoUpgradeRolloutPhaseRollingBack)
+	c.Assert(rollout.UpgradedVolumes, DeepEquals, map[string]string{"vol-b": previousImage})
+	v, err := lhClient.LonghornV1beta2().Volumes(TestNamespace).Get(context.TODO(), "vol-a", metav1.GetOptions{})
+	c.Assert(err, IsNil)
+	c.Assert(v.Spec.Image, Equals, previousImage)
+
+	_, err = lhClient.LonghornV1beta2().Volumes(TestNamespace).Create(context.TODO(), volumes["vol-b"], metav1.CreateOptions{})
+	c.Assert(err, IsNil)
+	err = ic.rollbackAutoUpgradeEngineImage(engineImage, rollout, volumes)
+	c.Assert(err, IsNil)
+	c.Assert(rollout.Phase, Equals, longhorn.EngineImageAutoUpgradeRolloutPhaseRolledBack)
+	c.Assert(rollout.UpgradedVolumes, HasLen, 0)
+}
+
+func (s *TestSuite) TestResumePausedAutoUpgradeRollout(c *C) {
+	kubeClient := fake.NewSimpleClientset()
+	lhClient := lhfake.NewSimpleClientset()
+	extensionsClient := apiextensionsfake.NewSimpleClientset()
+	informerFactories := util.NewInformerFactories(TestNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+	sIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().Settings().Informer().GetIndexer()
+
+	ic, err := newTestEngineImageController(lhClient, kubeClient, extensionsClient, informerFactories)
+	c.Assert(err, IsNil)
+	fakeRecorder := ic.eventRecorder.(*record.FakeRecorder)
+
+	setting, err := lhClient.LonghornV1beta2().Settings(TestNamespace).Create(context.TODO(),
+		newSetting(string(types.SettingNameAutomaticEngineUpgradeFailureAction), string(types.AutomaticEngineUpgradeFailureActionPause)), metav1.CreateOptions{})
+	c.Assert(err, IsNil)
+	c.Assert(sIndexer.Add(setting), IsNil)
+
+	canaryVolumeSelector := "tier=canary"
+	engineImage := newEngineImage(TestEngineImage, longhorn.EngineImageStateDeployed)
+	engineImage.Status.AutoUpgradeRollout = &longhorn.EngineImageAutoUpgradeRollout{
+		Phase:                longhorn.EngineImageAutoUpgradeRolloutPhasePaused,
+		CanaryVolumeSelector: canaryVolumeSelector,
+		UpgradedVolumes:      map[string]string{"vol-a": "longhorn-engine:previous"},
+		UpgradedVolumeCount:  1,
+	}
+	engineImage, err = lhClient.LonghornV1beta2().EngineImages(TestNamespace).Create(context.TODO(), engineImage, metav1.CreateOptions{})
+	c.Assert(err, IsNil)
+
+	v := newVolume("vol-a", 2)
+	v.Spec.Image = TestEngineImage
+	v.Status.CurrentImage = TestEngineImage
+	v.Status.State = longhorn.VolumeStateAttached
+	v.Status.Robustness = longhorn.VolumeRobustnessHealthy
+	volumes := map[string]*longhorn.Volume{v.Name: v}
+	rollout := func() *longhorn.EngineImageAutoUpgradeRollout {
+		err := ic.rolloutAutoUpgradeEngineImage(engineImage, volumes, map[string][]*longhorn.Volume{}, map[string][]*longhorn.Volume{}, 1, canaryVolumeSelector)
+		c.Assert(err, IsNil)
+		return engineImage.Status.AutoUpgradeRollout
+	}
+
+	// The rollout stays paused after the upgraded volumes recover
+	c.Assert(rollout().Phase, Equals, longhorn.EngineImageAutoUpgradeRolloutPhasePaused)
+	c.Assert(rollout().Message, Matches, ".*waiting for annotation longhorn.io/resume-auto-upgrade-rollout.*")
+	c.Assert(fakeRecorder.Events, HasLen, 0)
+
+	// The rollout is resumed by the annotation, which is removed once the rollout is resumed
+	engineImage.Annotations = map[string]string{types.GetLonghornLabelKey(types.ResumeAutoUpgradeRollout): ""}
+	engineImage, err = lhClient.LonghornV1beta2().EngineImages(TestNamespace).Update(context.TODO(), engineImage, metav1.UpdateOptions{})
+	c.Assert(err, IsNil)
+	c.Assert(rollout().Phase, Equals, longhorn.EngineImageAutoUpgradeRolloutPhaseHealthGate)
+	c.Assert(<-fakeRecorder.Events, Matches, ".*"+constant.EventReasonRolloutResumed+".*")
+	ei, err := lhClient.LonghornV1beta2().EngineImages(TestNamespace).Get(context.TODO(), engineImage.Name, metav1.GetOptions{})
+	c.Assert(err, IsNil)
+	c.Assert(ei.Annotations, HasLen, 0)
+	c.Assert(engineImage.ResourceVersion, Equals, ei.ResourceVersion)
+}
@@ -0,0 +1,663 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientset "k8s.io/client-go/kubernetes"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/longhorn/longhorn-manager/constant"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	FileRestoreServerContainerName = "file-restore-server"
+)
+
+// FileRestoreSessionController restores a backup or clones a snapshot into a temporary volume, attaches the volume
+// and runs a file server pod on the node so the files can be browsed and downloaded without restoring the whole
+// volume. The session and the temporary resources are deleted once the TTL is reached.
+type FileRestoreSessionController struct {
+	*baseController
+
+	// which namespace controller is running with
+	namespace string
+	// use as the OwnerID of the controller
+	controllerID string
+
+	managerImage string
+
+	kubeClient    clientset.Interface
+	eventRecorder record.EventRecorder
+
+	ds *datastore.DataStore
+
+	cacheSyncs []cache.InformerSynced
+}
+
+func NewFileRestoreSessionController(
+	logger logrus.FieldLogger,
+	ds *datastore.DataStore,
+	scheme *runtime.Scheme,
+	kubeClient clientset.Interface,
+	controllerID string,
+	namespace string,
+	managerImage string) (*FileRestoreSessionController, error) {
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(logrus.Infof)
+	// TODO: remove the wrapper when every clients have moved to use the clientset.
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{
+		Interface: v1core.New(kubeClient.CoreV1().RESTClient()).Events(""),
+	})
+
+	frsc := &FileRestoreSessionController{
+		baseController: newBaseController("longhorn-file-restore-session", logger),
+
+		namespace:    namespace,
+		controllerID: controllerID,
+		managerImage: managerImage,
+
+		ds: ds,
+
+		kubeClient:    kubeClient,
+		eventRecorder: eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "longhorn-file-restore-session-controller"}),
+	}
+
+	var err error
+	if _, err = ds.FileRestoreSessionInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    frsc.enqueueFileRestoreSession,
+		UpdateFunc: func(old, cur interface{}) { frsc.enqueueFileRestoreSession(cur) },
+		DeleteFunc: frsc.enqueueFileRestoreSession,
+	}); err != nil {
+		return nil, err
+	}
+	frsc.cacheSyncs = append(frsc.cacheSyncs, ds.FileRestoreSessionInformer.HasSynced)
+
+	if _, err = ds.VolumeInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		AddFunc:    frsc.enqueueFileRestoreSessionForObject,
+		UpdateFunc: func(old, cur interface{}) { frsc.enqueueFileRestoreSessionForObject(cur) },
+		DeleteFunc: frsc.enqueueFileRestoreSessionForObject,
+	}, 0); err != nil {
+		return nil, err
+	}
+	frsc.cacheSyncs = append(frsc.cacheSyncs, ds.VolumeInformer.HasSynced)
+
+	if _, err = ds.PodInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		AddFunc:    frsc.enqueueFileRestoreSessionForObject,
+		UpdateFunc: func(old, cur interface{}) { frsc.enqueueFileRestoreSessionForObject(cur) },
+		DeleteFunc: frsc.enqueueFileRestoreSessionForObject,
+	}, 0); err != nil {
+		return nil, err
+	}
+	frsc.cacheSyncs = append(frsc.cacheSyncs, ds.PodInformer.HasSynced)
+
+	return frsc, nil
+}
+
+func (frsc *FileRestoreSessionController) enqueueFileRestoreSession(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to get key for object %#v: %v", obj, err))
+		return
+	}
+
+	frsc.queue.Add(key)
+}
+
+// enqueueFileRestoreSessionForObject enqueues the session owning the temporary volume or the file server pod. The
+// session name is stored in the label of the object.
+func (frsc *FileRestoreSessionController) enqueueFileRestoreSessionForObject(obj interface{}) {
+	if deletedState, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = deletedState.Obj
+	}
+
+	object, err := meta.Accessor(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("received unexpected obj: %#v", obj))
+		return
+	}
+
+	sessionName, ok := object.GetLabels()[types.GetLonghornLabelKey(types.LonghornLabelFileRestoreSession)]
+	if !ok {
+		return
+	}
+	frsc.queue.Add(frsc.namespace + "/" + sessionName)
+}
+
+func (frsc *FileRestoreSessionController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer frsc.queue.ShutDown()
+
+	frsc.logger.Info("Starting Longhorn FileRestoreSession controller")
+	defer frsc.logger.Info("Shut down Longhorn FileRestoreSession controller")
+
+	if !cache.WaitForNamedCacheSync(frsc.name, stopCh, frsc.cacheSyncs...) {
+		return
+	}
+	for i := 0; i < workers; i++ {
+		go wait.Until(frsc.worker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+func (frsc *FileRestoreSessionController) worker() {
+	for frsc.processNextWorkItem() {
+	}
+}
+
+func (frsc *FileRestoreSessionController) processNextWorkItem() bool {
+	key, quit := frsc.queue.Get()
+	if quit {
+		return false
+	}
+	defer frsc.queue.Done(key)
+	err := frsc.syncFileRestoreSession(key.(string))
+	frsc.handleErr(err, key)
+	return true
+}
+
+func (frsc *FileRestoreSessionController) handleErr(err error, key interface{}) {
+	if err == nil {
+		frsc.queue.Forget(key)
+		return
+	}
+
+	log := frsc.logger.WithField("fileRestoreSession", key)
+	if frsc.queue.NumRequeues(key) < maxRetries {
+		handleReconcileErrorLogging(log, err, "Failed to sync Longhorn file restore session")
+		frsc.queue.AddRateLimited(key)
+		return
+	}
+
+	utilruntime.HandleError(err)
+	handleReconcileErrorLogging(log, err, "Dropping Longhorn file restore session out of the queue")
+	frsc.queue.Forget(key)
+}
+
+func (frsc *FileRestoreSessionController) syncFileRestoreSession(key string) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to sync file restore session %v", key)
+	}()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	if namespace != frsc.namespace {
+		return nil
+	}
+	return frsc.reconcile(name)
+}
+
+func getLoggerForFileRestoreSession(logger logrus.FieldLogger, session *longhorn.FileRestoreSession) *logrus.Entry {
+	return logger.WithFields(
+		logrus.Fields{
+			"fileRestoreSession": session.Name,
+		},
+	)
+}
+
+func (frsc *FileRestoreSessionController) isResponsibleFor(session *longhorn.FileRestoreSession) bool {
+	return isControllerResponsibleFor(frsc.controllerID, frsc.ds, session.Name, session.Spec.NodeID, session.Status.OwnerID)
+}
+
+func (frsc *FileRestoreSessionController) reconcile(name string) (err error) {
+	session, err := frsc.ds.GetFileRestoreSession(name)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	if !frsc.isResponsibleFor(session) {
+		return nil
+	}
+
+	log := getLoggerForFileRestoreSession(frsc.logger, session)
+
+	if session.Status.OwnerID != frsc.controllerID {
+		session.Status.OwnerID = frsc.controllerID
+		session, err = frsc.ds.UpdateFileRestoreSessionStatus(session)
+		if err != nil {
+			// we don't mind others coming first
+			if datastore.ErrorIsConflict(errors.Cause(err)) {
+				return nil
+			}
+			return err
+		}
+		log.Infof("File restore session got new owner %v", frsc.controllerID)
+	}
+
+	if !session.DeletionTimestamp.IsZero() {
+		cleaned, err := frsc.cleanup(session)
+		if err != nil || !cleaned {
+			return err
+		}
+		return frsc.ds.RemoveFinalizerForFileRestoreSession(session)
+	}
+
+	existingSession := session.DeepCopy()
+	defer func() {
+		if err != nil {
+			return
+		}
+		if reflect.DeepEqual(existingSession.Status, session.Status) {
+			return
+		}
+		if _, err = frsc.ds.UpdateFileRestoreSessionStatus(session); err != nil && datastore.ErrorIsConflict(errors.Cause(err)) {
+			log.WithError(err).Debugf("Requeue %v due to conflict", name)
+			frsc.enqueueFileRestoreSession(session)
+			err = nil
+		}
+	}()
+
+	expiresAt := getFileRestoreSessionExpiration(session)
+	session.Status.ExpiresAt = expiresAt.UTC().Format(time.RFC3339)
+	remaining := time.Until(expiresAt)
+	if remaining <= 0 {
+		log.Infof("Deleting file restore session since it expired at %v", session.Status.ExpiresAt)
+		frsc.eventRecorder.Eventf(session, corev1.EventTypeNormal, constant.EventReasonFileRestoreSessionExpired,
+			"File restore session expired at %v", session.Status.ExpiresAt)
+		return frsc.ds.DeleteFileRestoreSession(session.Name)
+	}
+	frsc.queue.AddAfter(frsc.namespace+"/"+session.Name, remaining)
+
+	if session.Status.State == longhorn.FileRestoreSessionStateError {
+		return nil
+	}
+	if session.Status.State == "" {
+		session.Status.State = longhorn.FileRestoreSessionStatePending
+	}
+
+	v, err := frsc.syncVolume(session)
+	if err != nil {
+		return err
+	}
+	if v == nil || session.Status.State == longhorn.FileRestoreSessionStateError {
+		return nil
+	}
+	if !isFileRestoreSessionVolumeRestored(v) {
+		session.Status.State = longhorn.FileRestoreSessionStateRestoring
+		return nil
+	}
+
+	if isAttached, err := frsc.syncVolumeAttachment(session, v); err != nil || !isAttached {
+		return err
+	}
+
+	return frsc.syncPod(session, v)
+}
+
+// getFileRestoreSessionExpiration returns the time the session is cleaned up, which is the creation time plus the
+// TTL.
+func getFileRestoreSessionExpiration(session *longhorn.FileRestoreSession) time.Time {
+	ttlMinutes := session.Spec.TTLMinutes
+	if ttlMinutes <= 0 {
+		ttlMinutes = types.FileRestoreSessionDefaultTTLMinutes
+	}
+	return session.CreationTimestamp.Add(time.Duration(ttlMinutes) * time.Minute)
+}
+
+func isFileRestoreSessionVolumeRestored(v *longhorn.Volume) bool {
+	if v.Spec.FromBackup != "" {
+		return v.Status.RestoreInitiated && !v.Status.RestoreRequired
+	}
+	return v.Status.CloneStatus.State == longhorn.VolumeCloneStateCompleted
+}
+
+func (frsc *FileRestoreSessionController) failFileRestoreSession(session *longhorn.FileRestoreSession, message string) {
+	session.Status.State = longhorn.FileRestoreSessionStateError
+	session.Status.Message = message
+	frsc.eventRecorder.Event(session, corev1.EventTypeWarning, constant.EventReasonFailedFileRestoreSession, message)
+}
+
+// syncVolume creates the temporary volume if it does not exist. It returns nil if the volume is just created or the
+// session failed.
+func (frsc *FileRestoreSessionController) syncVolume(session *longhorn.FileRestoreSession) (*longhorn.Volume, error) {
+	volumeName := types.GetFileRestoreSessionVolumeName(session.Name)
+	session.Status.VolumeName = volumeName
+
+	v, err := frsc.ds.GetVolumeRO(volumeName)
+	if err == nil {
+		if v.Status.CloneStatus.State == longhorn.VolumeCloneStateFailed {
+			frsc.failFileRestoreSession(session, fmt.Sprintf("failed to clone snapshot %v into volume %v", session.Spec.SnapshotName, volumeName))
+			return nil, nil
+		}
+		return v, nil
+	}
+	if !datastore.ErrorIsNotFound(err) {
+		return nil, err
+	}
+
+	v, message, err := frsc.generateVolume(session, volumeName)
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		frsc.failFileRestoreSession(session, message)
+		return nil, nil
+	}
+	if _, err := frsc.ds.CreateVolume(v); err != nil {
+		return nil, errors.Wrapf(err, "failed to create volume %v", volumeName)
+	}
+	session.Status.State = longhorn.FileRestoreSessionStateRestoring
+	session.Status.Message = ""
+	frsc.eventRecorder.Eventf(session, corev1.EventTypeNormal, constant.EventReasonFileRestoreSessionCreatedVolume,
+		"Created volume %v to restore the files", volumeName)
+	return nil, nil
+}
+
+// generateVolume returns the temporary volume restoring the backup or cloning the snapshot of the session. It
+// returns a message instead if the source cannot be restored.
+func (frsc *FileRestoreSessionController) generateVolume(session *longhorn.FileRestoreSession, volumeName string) (*longhorn.Volume, string, error) {
+	v := &longhorn.Volume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   volumeName,
+			Labels: types.GetFileRestoreSessionLabels(session.Name),
+		},
+		Spec: longhorn.VolumeSpec{
+			Frontend:         longhorn.VolumeFrontendBlockDev,
+			NumberOfReplicas: 1,
+		},
+	}
+
+	if session.Spec.BackupName != "" {
+		backup, err := frsc.ds.GetBackupRO(session.Spec.BackupName)
+		if err != nil {
+			if datastore.ErrorIsNotFound(err) {
+				return nil, fmt.Sprintf("backup %v is not found", session.Spec.BackupName), nil
+			}
+			return nil, "", err
+		}
+		if backup.Status.State != longhorn.BackupStateCompleted || backup.Status.URL == "" {
+			return nil, fmt.Sprintf("backup %v is not completed", backup.Name), nil
+		}
+		size, err := strconv.ParseInt(backup.Status.VolumeSize, 10, 64)
+		if err != nil {
+			return nil, fmt.Sprintf("failed to parse the volume size %v of backup %v", backup.Status.VolumeSize, backup.Name), nil
+		}
+		v.Spec.Size = size
+		v.Spec.FromBackup = backup.Status.URL
+		v.Spec.BackingImage = backup.Status.VolumeBackingImageName
+		v.Spec.BackupTargetName = backup.Status.BackupTargetName
+		if v.Spec.BackupTargetName == "" {
+			v.Spec.BackupTargetName = types.DefaultBackupTargetName
+		}
+		return v, "", nil
+	}
+
+	sourceVolume, err := frsc.ds.GetVolumeRO(session.Spec.SourceVolume)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return nil, fmt.Sprintf("volume %v is not found", session.Spec.SourceVolume), nil
+		}
+		return nil, "", err
+	}
+	if sourceVolume.Spec.Encrypted {
+		return nil, fmt.Sprintf("encrypted volume %v is not supported", sourceVolume.Name), nil
+	}
+	if _, err := frsc.ds.GetSnapshotRO(session.Spec.SnapshotName); err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return nil, fmt.Sprintf("snapshot %v is not found", session.Spec.SnapshotName), nil
+		}
+		return nil, "", err
+	}
+	v.Spec.Size = sourceVolume.Spec.Size
+	v.Spec.DataEngine = sourceVolume.Spec.DataEngine
+	v.Spec.BackingImage = sourceVolume.Spec.BackingImage
+	v.Spec.DataSource = types.NewVolumeDataSourceTypeSnapshot(sourceVolume.Name, session.Spec.SnapshotName)
+	return v, "", nil
+}
+
+// syncVolumeAttachment attaches the restored volume to the node of the session with the frontend enabled, so the file
+// server can mount the block device. It returns whether the volume is attached to the node.
+func (frsc *FileRestoreSessionController) syncVolumeAttachment(session *longhorn.FileRestoreSession, v *longhorn.Volume) (bool, error) {
+	nodeID := session.Spec.NodeID
+	if nodeID == "" {
+		nodeID = frsc.controllerID
+	}
+	session.Status.NodeID = nodeID
+
+	va, err := frsc.ds.GetLHVolumeAttachmentByVolumeName(v.Name)
+	if err != nil {
+		return false, err
+	}
+	existingVA := va.DeepCopy()
+
+	ticketID := longhorn.GetAttachmentTicketID(longhorn.AttacherTypeFileRestoreSessionController, session.Name)
+	createOrUpdateAttachmentTicket(va, ticketID, nodeID, longhorn.FalseValue, longhorn.AttacherTypeFileRestoreSessionController)
+	if !reflect.DeepEqual(existingVA.Spec, va.Spec) {
+		if _, err := frsc.ds.UpdateLHVolumeAttachment(va); err != nil {
+			return false, err
+		}
+	}
+
+	if v.Status.State != longhorn.VolumeStateAttached || v.Status.CurrentNodeID != nodeID {
+		session.Status.State = longhorn.FileRestoreSessionStateAttaching
+		return false, nil
+	}
+	return true, nil
+}
+
+// syncPod creates the file server pod once the volume is attached, and marks the session ready once the pod is
+// running.
+func (frsc *FileRestoreSessionController) syncPod(session *longhorn.FileRestoreSession, v *longhorn.Volume) error {
+	podName := types.GetFileRestoreSessionPodName(session.Name)
+	session.Status.PodName = podName
+
+	if err := frsc.syncTokenSecret(session); err != nil {
+		return err
+	}
+
+	pod, err := frsc.ds.GetPodRO(frsc.namespace, podName)
+	if err != nil {
+		return err
+	}
+	if pod == nil {
+		pod, err := frsc.generateFileRestoreServerPod(session, v)
+		if err != nil {
+			return err
+		}
+		if _, err := frsc.ds.CreatePod(pod); err != nil {
+			return errors.Wrapf(err, "failed to create file server pod %v", podName)
+		}
+		session.Status.State = longhorn.FileRestoreSessionStateStarting
+		session.Status.Endpoint = ""
+		return nil
+	}
+
+	switch pod.Status.Phase {
+	case corev1.PodRunning:
+		if pod.Status.PodIP == "" {
+			session.Status.State = longhorn.FileRestoreSessionStateStarting
+			return nil
+		}
+		endpoint := fmt.Sprintf("%v:%v", pod.Status.PodIP, types.FileRestoreServerPort)
+		if session.Status.State != longhorn.FileRestoreSessionStateReady {
+			frsc.eventRecorder.Eventf(session, corev1.EventTypeNormal, constant.EventReasonFileRestoreSessionReady,
+				"Files of volume %v are served on %v until %v", v.Name, endpoint, session.Status.ExpiresAt)
+		}
+		session.Status.State = longhorn.FileRestoreSessionStateReady
+		session.Status.Endpoint = endpoint
+		session.Status.Message = ""
+	case corev1.PodFailed, corev1.PodSucceeded:
+		session.Status.Endpoint = ""
+		frsc.failFileRestoreSession(session, fmt.Sprintf("file server pod %v exited, the filesystem of volume %v may not be mountable", podName, v.Name))
+	default:
+		session.Status.State = longhorn.FileRestoreSessionStateStarting
+	}
+	return nil
+}
+
+// syncTokenSecret creates the secret holding the token the file server authenticates the requests of the manager with.
+// The secret is owned by the session, so it is garbage collected with the session.
+func (frsc *FileRestoreSessionController) syncTokenSecret(session *longhorn.FileRestoreSession) error {
+	secretName := types.GetFileRestoreSessionSecretName(session.Name)
+	if _, err := frsc.ds.GetSecretRO(frsc.namespace, secretName); err == nil || !datastore.ErrorIsNotFound(err) {
+		return err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            secretName,
+			Namespace:       frsc.namespace,
+			OwnerReferences: datastore.GetOwnerReferencesForFileRestoreSession(session),
+			Labels:          types.GetFileRestoreSessionLabels(session.Name),
+		},
+		Data: map[string][]byte{
+			types.FileRestoreServerTokenKey: []byte(util.UUID()),
+		},
+	}
+	if _, err := frsc.ds.CreateSecret(frsc.namespace, secret); err != nil && !apierrors.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "failed to create token secret %v", secretName)
+	}
+	return nil
+}
+
+func (frsc *FileRestoreSessionController) generateFileRestoreServerPod(session *longhorn.FileRestoreSession, v *longhorn.Volume) (*corev1.Pod, error) {
+	tolerations, err := frsc.ds.GetSettingTaintToleration()
+	if err != nil {
+		return nil, err
+	}
+
+	priorityClass, err := frsc.ds.GetSettingWithAutoFillingRO(types.SettingNamePriorityClass)
+	if err != nil {
+		return nil, err
+	}
+
+	imagePullPolicy, err := frsc.ds.GetSettingImagePullPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{
+		"longhorn-manager", "file-restore-server",
+		"--device", fmt.Sprintf("%v/longhorn/%v", types.FileRestoreServerHostDevDirectory, v.Name),
+		"--port", strconv.Itoa(types.FileRestoreServerPort),
+	}
+
+	privileged := true
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            types.GetFileRestoreSessionPodName(session.Name),
+			Namespace:       frsc.namespace,
+			OwnerReferences: datastore.GetOwnerReferencesForFileRestoreSession(session),
+			Labels:          types.GetFileRestoreSessionLabels(session.Name),
+		},
+		Spec: corev1.PodSpec{
+			Tolerations:       util.GetDistinctTolerations(tolerations),
+			PriorityClassName: priorityClass.Value,
+			Containers: []corev1.Container{
+				{
+					Name:            FileRestoreServerContainerName,
+					Image:           frsc.managerImage,
+					ImagePullPolicy: imagePullPolicy,
+					Command:         cmd,
+					Env: []corev1.EnvVar{
+						{
+							Name: types.FileRestoreServerTokenEnv,
+							ValueFrom: &corev1.EnvVarSource{
+								SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: types.GetFileRestoreSessionSecretName(session.Name),
+									},
+									Key: types.FileRestoreServerTokenKey,
+								},
+							},
+						},
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      "host-dev",
+							MountPath: types.FileRestoreServerHostDevDirectory,
+						},
+					},
+					SecurityContext: &corev1.SecurityContext{
+						Privileged: &privileged,
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: "host-dev",
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{
+							Path: "/dev",
+						},
+					},
+				},
+			},
+			NodeName:      session.Status.NodeID,
+			RestartPolicy: corev1.RestartPolicyNever,
+		},
+	}
+
+	registrySecretSetting, err := frsc.ds.GetSettingWithAutoFillingRO(types.SettingNameRegistrySecret)
+	if err != nil {
+		return nil, err
+	}
+	if registrySecretSetting.Value != "" {
+		pod.Spec.ImagePullSecrets = []corev1.LocalObjectReference{
+			{
+				Name: registrySecretSetting.Value,
+			},
+		}
+	}
+
+	return pod, nil
+}
+
+// cleanup deletes the file server pod and the temporary volume. It returns whether both are gone.
+func (frsc *FileRestoreSessionController) cleanup(session *longhorn.FileRestoreSession) (bool, error) {
+	podName := types.GetFileRestoreSessionPodName(session.Name)
+	pod, err := frsc.ds.GetPodRO(frsc.namespace, podName)
+	if err != nil {
+		return false, err
+	}
+	if pod != nil {
+		if pod.DeletionTimestamp == nil {
+			if err := frsc.ds.DeletePod(podName); err != nil && !datastore.ErrorIsNotFound(err) {
+				return false, errors.Wrapf(err, "failed to delete file server pod %v", podName)
+			}
+		}
+		return false, nil
+	}
+
+	volumeName := types.GetFileRestoreSessionVolumeName(session.Name)
+	v, err := frsc.ds.GetVolumeRO(volumeName)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	if v.DeletionTimestamp == nil {
+		if err := frsc.ds.DeleteVolume(volumeName); err != nil && !datastore.ErrorIsNotFound(err) {
+			return false, errors.Wrapf(err, "failed to delete volume %v", volumeName)
+		}
+	}
+	return false, nil
+}
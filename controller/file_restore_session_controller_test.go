@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kubernetes/pkg/controller"
+
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+)
+
+func TestIsFileRestoreSessionVolumeRestored(t *testing.T) {
+	testCases := map[string]struct {
+		volume   *longhorn.Volume
+		restored bool
+	}{
+		"backup restore not initiated": {
+			volume: &longhorn.Volume{
+				Spec:   longhorn.VolumeSpec{FromBackup: "s3://backupbucket@us-east-1/?backup=backup-1&volume=vol-1"},
+				Status: longhorn.VolumeStatus{RestoreRequired: true},
+			},
+		},
+		"backup restoring": {
+			volume: &longhorn.Volume{
+				Spec:   longhorn.VolumeSpec{FromBackup: "s3://backupbucket@us-east-1/?backup=backup-1&volume=vol-1"},
+				Status: longhorn.VolumeStatus{RestoreInitiated: true, RestoreRequired: true},
+			},
+		},
+		"backup restored": {
+			volume: &longhorn.Volume{
+				Spec:   longhorn.VolumeSpec{FromBackup: "s3://backupbucket@us-east-1/?backup=backup-1&volume=vol-1"},
+				Status: longhorn.VolumeStatus{RestoreInitiated: true},
+			},
+			restored: true,
+		},
+		"snapshot cloning": {
+			volume: &longhorn.Volume{
+				Spec:   longhorn.VolumeSpec{DataSource: "snap://vol-1/snap-1"},
+				Status: longhorn.VolumeStatus{CloneStatus: longhorn.VolumeCloneStatus{State: longhorn.VolumeCloneStateInitiated}},
+			},
+		},
+		"snapshot cloned": {
+			volume: &longhorn.Volume{
+				Spec:   longhorn.VolumeSpec{DataSource: "snap://vol-1/snap-1"},
+				Status: longhorn.VolumeStatus{CloneStatus: longhorn.VolumeCloneStatus{State: longhorn.VolumeCloneStateCompleted}},
+			},
+			restored: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		if restored := isFileRestoreSessionVolumeRestored(tc.volume); restored != tc.restored {
+			t.Errorf("%v: expected restored %v, got %v", name, tc.restored, restored)
+		}
+	}
+}
+
+func TestGetFileRestoreSessionExpiration(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	session := &longhorn.FileRestoreSession{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(createdAt)},
+	}
+
+	if expiresAt := getFileRestoreSessionExpiration(session); !expiresAt.Equal(createdAt.Add(time.Hour)) {
+		t.Errorf("expected the default TTL to expire at %v, got %v", createdAt.Add(time.Hour), expiresAt)
+	}
+
+	session.Spec.TTLMinutes = 15
+	if expiresAt := getFileRestoreSessionExpiration(session); !expiresAt.Equal(createdAt.Add(15 * time.Minute)) {
+		t.Errorf("expected the TTL to expire at %v, got %v", createdAt.Add(15*time.Minute), expiresAt)
+	}
+}
+
+func TestSyncTokenSecret(t *testing.T) {
+	datastore.SkipListerCheck = true
+
+	kubeClient := fake.NewSimpleClientset()
+	lhClient := lhfake.NewSimpleClientset()
+	extensionsClient := apiextensionsfake.NewSimpleClientset()
+	informerFactories := util.NewInformerFactories(TestNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+	ds := datastore.NewDataStore(TestNamespace, lhClient, kubeClient, extensionsClient, informerFactories)
+
+	frsc := &FileRestoreSessionController{
+		baseController: newBaseController("longhorn-file-restore-session", logrus.StandardLogger()),
+		namespace:      TestNamespace,
+		controllerID:   TestNode1,
+		ds:             ds,
+	}
+	session := &longhorn.FileRestoreSession{
+		ObjectMeta: metav1.ObjectMeta{Name: "session-1", Namespace: TestNamespace, UID: "session-1-uid"},
+	}
+
+	if err := frsc.syncTokenSecret(session); err != nil {
+		t.Fatalf("failed to create the token secret: %v", err)
+	}
+	secretName := types.GetFileRestoreSessionSecretName(session.Name)
+	secret, err := kubeClient.CoreV1().Secrets(TestNamespace).Get(context.TODO(), secretName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get the token secret: %v", err)
+	}
+	token := string(secret.Data[types.FileRestoreServerTokenKey])
+	if token == "" {
+		t.Fatalf("expected the token secret to hold a token")
+	}
+	if len(secret.OwnerReferences) != 1 || secret.OwnerReferences[0].UID != session.UID {
+		t.Errorf("expected the token secret to be owned by the session, got %+v", secret.OwnerReferences)
+	}
+
+	// The informer cache may not have the secret yet, so the secret is created again
+	if err := frsc.syncTokenSecret(session); err != nil {
+		t.Fatalf("failed to sync the existing token secret: %v", err)
+	}
+	secret, err = kubeClient.CoreV1().Secrets(TestNamespace).Get(context.TODO(), secretName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get the token secret: %v", err)
+	}
+	if string(secret.Data[types.FileRestoreServerTokenKey]) != token {
+		t.Errorf("expected the token to be kept")
+	}
+}
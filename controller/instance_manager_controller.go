@@ -32,6 +32,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientset "k8s.io/client-go/kubernetes"
 	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
 
 	"github.com/longhorn/go-common-libs/multierr"
 
@@ -42,6 +43,7 @@ import (
 	"github.com/longhorn/longhorn-manager/engineapi"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/util/resourcerecommendation"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -50,6 +52,12 @@ var (
 	mountPropagationHostToContainer = corev1.MountPropagationHostToContainer
 )
 
+const (
+	resourceRecommendationSampleInterval = time.Minute
+
+	instanceManagerV2MinimumMemoryRequest = "128Mi"
+)
+
 type InstanceManagerController struct {
 	*baseController
 
@@ -58,12 +66,17 @@ type InstanceManagerController struct {
 	serviceAccount string
 
 	kubeClient    clientset.Interface
+	metricsClient metricsclientset.Interface
 	eventRecorder record.EventRecorder
 
 	ds *datastore.DataStore
 
 	cacheSyncs []cache.InformerSynced
 
+	// resourceHistories are the usage histories of the instance managers owned by the controller
+	resourceHistories     map[string]*resourcerecommendation.History
+	resourceHistoriesLock sync.Mutex
+
 	instanceManagerMonitorMutex *sync.Mutex
 	instanceManagerMonitorMap   map[string]chan struct{}
 
@@ -124,6 +137,7 @@ func NewInstanceManagerController(
 	ds *datastore.DataStore,
 	scheme *runtime.Scheme,
 	kubeClient clientset.Interface,
+	metricsClient metricsclientset.Interface,
 	namespace, controllerID, serviceAccount string, proxyConnCounter util.Counter,
 ) (*InstanceManagerController, error) {
 
@@ -139,10 +153,13 @@ func NewInstanceManagerController(
 		serviceAccount: serviceAccount,
 
 		kubeClient:    kubeClient,
+		metricsClient: metricsClient,
 		eventRecorder: eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "longhorn-instance-manager-controller"}),
 
 		ds: ds,
 
+		resourceHistories: map[string]*resourcerecommendation.History{},
+
 		instanceManagerMonitorMutex: &sync.Mutex{},
 		instanceManagerMonitorMap:   map[string]chan struct{}{},
 
@@ -221,7 +238,8 @@ func (imc *InstanceManagerController) isResponsibleForSetting(obj interface{}) b
 
 	return types.SettingName(setting.Name) == types.SettingNameKubernetesClusterAutoscalerEnabled ||
 		types.SettingName(setting.Name) == types.SettingNameDataEngineCPUMask ||
-		types.SettingName(setting.Name) == types.SettingNameOrphanResourceAutoDeletion
+		types.SettingName(setting.Name) == types.SettingNameOrphanResourceAutoDeletion ||
+		types.SettingName(setting.Name) == types.SettingNameInstanceManagerResourceRecommendationMode
 }
 
 func isInstanceManagerPod(obj interface{}) bool {
@@ -328,6 +346,7 @@ func (imc *InstanceManagerController) syncInstanceManager(key string) (err error
 	if err != nil {
 		if datastore.ErrorIsNotFound(err) {
 			imc.logger.Warnf("Deleting instance manager pod %v since the instance manager is not found", name)
+			imc.removeResourceHistory(name)
 			return imc.cleanupInstanceManagerPod(name)
 		}
 		return errors.Wrap(err, "failed to get instance manager")
@@ -395,6 +414,10 @@ func (imc *InstanceManagerController) syncInstanceManager(key string) (err error
 		return err
 	}
 
+	if err := imc.syncResourceRecommendation(im); err != nil {
+		return err
+	}
+
 	if err := imc.handlePod(im); err != nil {
 		return err
 	}
@@ -763,6 +786,8 @@ func (imc *InstanceManagerController) areDangerZoneSettingsSyncedToIMPod(im *lon
 			isSettingSynced, err = imc.isSettingDataEngineSynced(settingName, im)
 		case types.SettingNameInstanceManagerPodLivenessProbeTimeout:
 			isSettingSynced, err = imc.isSettingInstanceManagerPodLivenessProbeTimeoutSynced(setting, pod)
+		case types.SettingNameInstanceManagerResourceRecommendationMode:
+			isSettingSynced, err = imc.isSettingInstanceManagerResourceRecommendationModeSynced(im, pod)
 		case types.SettingNameLogPath:
 			// TODO: Support log path for v1 data engine.
 			if types.IsDataEngineV2(im.Spec.DataEngine) {
@@ -823,6 +848,17 @@ func (imc *InstanceManagerController) isSettingGuaranteedInstanceManagerCPUSynce
 	return IsSameGuaranteedCPURequirement(resourceReq, &podResourceReq), nil
 }
 
+// isSettingInstanceManagerResourceRecommendationModeSynced checks the memory request only, since the CPU request is
+// checked along with the guaranteed instance manager CPU.
+func (imc *InstanceManagerController) isSettingInstanceManagerResourceRecommendationModeSynced(im *longhorn.InstanceManager, pod *corev1.Pod) (bool, error) {
+	memoryRequest, err := imc.getInstanceManagerMemoryRequest(im)
+	if err != nil {
+		return false, err
+	}
+	podMemoryRequest := pod.Spec.Containers[0].Resources.Requests[corev1.ResourceMemory]
+	return memoryRequest.Cmp(podMemoryRequest) == 0, nil
+}
+
 func (imc *InstanceManagerController) isSettingPriorityClassSynced(setting *longhorn.Setting, pod *corev1.Pod) (bool, error) {
 	return pod.Spec.PriorityClassName == setting.Value, nil
 }
@@ -1649,11 +1685,6 @@ func (imc *InstanceManagerController) createInstanceManagerPodSpec(im *longhorn.
 			return nil, err
 		}
 
-		if podSpec.Spec.Containers[0].Resources.Requests == nil {
-			podSpec.Spec.Containers[0].Resources.Requests = corev1.ResourceList{}
-		}
-		podSpec.Spec.Containers[0].Resources.Requests[corev1.ResourceMemory] = resource.MustParse("128Mi")
-
 		if podSpec.Spec.Containers[0].Resources.Limits == nil {
 			podSpec.Spec.Containers[0].Resources.Limits = corev1.ResourceList{}
 		}
@@ -1671,6 +1702,17 @@ func (imc *InstanceManagerController) createInstanceManagerPodSpec(im *longhorn.
 		}
 	}
 
+	memoryRequest, err := imc.getInstanceManagerMemoryRequest(im)
+	if err != nil {
+		return nil, err
+	}
+	if !memoryRequest.IsZero() {
+		if podSpec.Spec.Containers[0].Resources.Requests == nil {
+			podSpec.Spec.Containers[0].Resources.Requests = corev1.ResourceList{}
+		}
+		podSpec.Spec.Containers[0].Resources.Requests[corev1.ResourceMemory] = memoryRequest
+	}
+
 	podProbeTimeout, err := imc.ds.GetSettingAsInt(types.SettingNameInstanceManagerPodLivenessProbeTimeout)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get %v setting", types.SettingNameInstanceManagerPodLivenessProbeTimeout)
@@ -2534,3 +2576,115 @@ func (m *InstanceManagerMonitor) categorizeProcesses(instanceMap instanceProcess
 func (imc *InstanceManagerController) isResponsibleFor(im *longhorn.InstanceManager) bool {
 	return isControllerResponsibleFor(imc.controllerID, imc.ds, im.Name, im.Spec.NodeID, im.Status.OwnerID)
 }
+
+// getInstanceManagerMemoryRequest returns the memory request of the instance manager pod. It is zero if there is no memory request.
+func (imc *InstanceManagerController) getInstanceManagerMemoryRequest(im *longhorn.InstanceManager) (resource.Quantity, error) {
+	var memoryRequest resource.Quantity
+	if types.IsDataEngineV2(im.Spec.DataEngine) {
+		memoryRequest = resource.MustParse(instanceManagerV2MinimumMemoryRequest)
+	}
+
+	recommendation, err := GetAppliedInstanceManagerResourceRecommendation(imc.ds, im)
+	if err != nil {
+		return memoryRequest, err
+	}
+	if recommendation != nil {
+		recommended := *resource.NewQuantity(recommendation.MemoryRequest, resource.BinarySI)
+		if recommended.Cmp(memoryRequest) > 0 {
+			memoryRequest = recommended
+		}
+	}
+	return memoryRequest, nil
+}
+
+// syncResourceRecommendation samples the resource usage of the instance manager pod from the metrics server and
+// recommends the resource requests from the usage history.
+func (imc *InstanceManagerController) syncResourceRecommendation(im *longhorn.InstanceManager) error {
+	mode, err := imc.ds.GetSettingValueExisted(types.SettingNameInstanceManagerResourceRecommendationMode)
+	if err != nil {
+		return err
+	}
+	if types.InstanceManagerResourceRecommendationMode(mode) == types.InstanceManagerResourceRecommendationModeDisabled {
+		im.Status.ResourceRecommendation = nil
+		imc.removeResourceHistory(im.Name)
+		return nil
+	}
+	if imc.metricsClient == nil || im.Status.CurrentState != longhorn.InstanceManagerStateRunning {
+		return nil
+	}
+
+	historyWindow, err := imc.ds.GetSettingAsInt(types.SettingNameInstanceManagerResourceRecommendationHistoryWindow)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %v setting", types.SettingNameInstanceManagerResourceRecommendationHistoryWindow)
+	}
+
+	imc.resourceHistoriesLock.Lock()
+	defer imc.resourceHistoriesLock.Unlock()
+
+	history, exists := imc.resourceHistories[im.Name]
+	if !exists {
+		history = &resourcerecommendation.History{}
+		imc.resourceHistories[im.Name] = history
+	}
+
+	// Sample again after the interval even if nothing else triggers the sync
+	defer imc.enqueueInstanceManagerAfter(im, resourceRecommendationSampleInterval)
+
+	now := time.Now()
+	if samples := history.Samples(); len(samples) > 0 && now.Sub(samples[len(samples)-1].Time) < resourceRecommendationSampleInterval {
+		return nil
+	}
+
+	podMetrics, err := imc.metricsClient.MetricsV1beta1().PodMetricses(imc.namespace).Get(context.TODO(), im.Name, metav1.GetOptions{})
+	if err != nil {
+		// The metrics server may not be deployed
+		getLoggerForInstanceManager(imc.logger, im).WithError(err).Debug("Failed to get the metrics of the instance manager pod")
+		return nil
+	}
+
+	sample := resourcerecommendation.Sample{
+		Time:         now,
+		EngineCount:  len(im.Status.InstanceEngines),
+		ReplicaCount: len(im.Status.InstanceReplicas),
+	}
+	for _, container := range podMetrics.Containers {
+		sample.CPUUsageMilli += container.Usage.Cpu().MilliValue()
+		sample.MemoryUsageBytes += container.Usage.Memory().Value()
+	}
+	if !history.Add(sample, resourceRecommendationSampleInterval, time.Duration(historyWindow)*time.Hour) {
+		return nil
+	}
+
+	allocatable := resourcerecommendation.Allocatable{}
+	kubeNode, err := imc.ds.GetKubernetesNodeRO(im.Spec.NodeID)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if kubeNode != nil {
+		allocatable.CPUMilli = kubeNode.Status.Allocatable.Cpu().MilliValue()
+		allocatable.MemoryBytes = kubeNode.Status.Allocatable.Memory().Value()
+	}
+
+	recommendation := resourcerecommendation.Recommend(history.Samples(), sample.EngineCount, sample.ReplicaCount, allocatable)
+	if recommendation != nil && resourcerecommendation.IsSignificantChange(im.Status.ResourceRecommendation, recommendation) {
+		im.Status.ResourceRecommendation = recommendation
+	}
+	return nil
+}
+
+func (imc *InstanceManagerController) removeResourceHistory(imName string) {
+	imc.resourceHistoriesLock.Lock()
+	defer imc.resourceHistoriesLock.Unlock()
+
+	delete(imc.resourceHistories, imName)
+}
+
+func (imc *InstanceManagerController) enqueueInstanceManagerAfter(im *longhorn.InstanceManager, duration time.Duration) {
+	key, err := controller.KeyFunc(im)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to get key for object %#v: %v", im, err))
+		return
+	}
+
+	imc.queue.AddAfter(key, duration)
+}
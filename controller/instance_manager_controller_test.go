@@ -64,7 +64,7 @@ func newTestInstanceManagerController(lhClient *lhfake.Clientset, kubeClient *fa
 	logger := logrus.StandardLogger()
 
 	proxyConnCounter := util.NewAtomicCounter()
-	imc, err := NewInstanceManagerController(logger, ds, scheme.Scheme, kubeClient, TestNamespace, controllerID, TestServiceAccount, proxyConnCounter)
+	imc, err := NewInstanceManagerController(logger, ds, scheme.Scheme, kubeClient, nil, TestNamespace, controllerID, TestServiceAccount, proxyConnCounter)
 	if err != nil {
 		return nil, err
 	}
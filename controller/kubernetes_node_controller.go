@@ -2,6 +2,7 @@ package controller
 
 import (
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"time"
@@ -21,8 +22,12 @@ import (
 	clientset "k8s.io/client-go/kubernetes"
 	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	lhtypes "github.com/longhorn/go-common-libs/types"
+
+	"github.com/longhorn/longhorn-manager/constant"
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util/diskdiscovery"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -83,7 +88,7 @@ func NewKubernetesNodeController(
 
 	if _, err = ds.SettingInformer.AddEventHandlerWithResyncPeriod(
 		cache.FilteringResourceEventHandler{
-			FilterFunc: isSettingCreateDefaultDiskLabeledNodes,
+			FilterFunc: isSettingKubernetesNodeRelated,
 			Handler: cache.ResourceEventHandlerFuncs{
 				AddFunc:    knc.enqueueSetting,
 				UpdateFunc: func(old, cur interface{}) { knc.enqueueSetting(cur) },
@@ -96,7 +101,7 @@ func NewKubernetesNodeController(
 	return knc, nil
 }
 
-func isSettingCreateDefaultDiskLabeledNodes(obj interface{}) bool {
+func isSettingKubernetesNodeRelated(obj interface{}) bool {
 	setting, ok := obj.(*longhorn.Setting)
 	if !ok {
 		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
@@ -111,7 +116,11 @@ func isSettingCreateDefaultDiskLabeledNodes(obj interface{}) bool {
 		}
 	}
 
-	return types.SettingName(setting.Name) == types.SettingNameCreateDefaultDiskLabeledNodes
+	switch types.SettingName(setting.Name) {
+	case types.SettingNameCreateDefaultDiskLabeledNodes, types.SettingNameDiskProvisioningPolicy:
+		return true
+	}
+	return false
 }
 
 func (knc *KubernetesNodeController) Run(workers int, stopCh <-chan struct{}) {
@@ -207,7 +216,15 @@ func (knc *KubernetesNodeController) syncKubernetesNode(key string) (err error)
 	existingNode := node.DeepCopy()
 	defer func() {
 		if err == nil && !reflect.DeepEqual(existingNode.Spec, node.Spec) {
-			_, err = knc.ds.UpdateNode(node)
+			var updatedNode *longhorn.Node
+			updatedNode, err = knc.ds.UpdateNode(node)
+			if err == nil {
+				updatedNode.Status = node.Status
+				node = updatedNode
+			}
+		}
+		if err == nil && !reflect.DeepEqual(existingNode.Status.DiscoveredDevices, node.Status.DiscoveredDevices) {
+			_, err = knc.ds.UpdateNodeStatus(node)
 		}
 		// requeue if it's conflict
 		if apierrors.IsConflict(errors.Cause(err)) {
@@ -227,6 +244,11 @@ func (knc *KubernetesNodeController) syncKubernetesNode(key string) (err error)
 		return err
 	}
 
+	// sync disks matching the disk provisioning policy
+	if err := knc.syncProvisionedDisks(node, kubeNode); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -352,3 +374,121 @@ func (knc *KubernetesNodeController) syncDefaultNodeTags(node *longhorn.Node) er
 	}
 	return nil
 }
+
+// syncProvisionedDisks adds the block devices matching the setting disk-provisioning-policy to the node as disks, and
+// records the discovered devices in the node status. A provisioned disk removed from the node by the user is not added again.
+func (knc *KubernetesNodeController) syncProvisionedDisks(node *longhorn.Node, kubeNode *corev1.Node) error {
+	policy, err := knc.ds.GetSettingValueExisted(types.SettingNameDiskProvisioningPolicy)
+	if err != nil {
+		return err
+	}
+	rules, err := types.UnmarshalDiskProvisioningRules(policy)
+	if err != nil {
+		knc.logger.WithError(err).Warnf("Failed to parse setting %v", types.SettingNameDiskProvisioningPolicy)
+		return nil
+	}
+	rules = types.GetDiskProvisioningRulesForNode(rules, kubeNode.Labels)
+	if len(rules) == 0 {
+		node.Status.DiscoveredDevices = nil
+		return nil
+	}
+
+	mountPoints, err := diskdiscovery.GetMountPoints(filepath.Join(lhtypes.HostProcDirectory, "1", "mounts"))
+	if err != nil {
+		return errors.Wrap(err, "failed to get mount points")
+	}
+	devices, err := diskdiscovery.Discover(diskdiscovery.SysfsDirectory, mountPoints)
+	if err != nil {
+		return errors.Wrap(err, "failed to discover block devices")
+	}
+	if err := diskdiscovery.DetectSignatures(devices); err != nil {
+		return err
+	}
+
+	provisionedDevices := map[string]longhorn.DiscoveredDevice{}
+	for _, device := range node.Status.DiscoveredDevices {
+		if device.Provisioned {
+			provisionedDevices[device.Path] = device
+		}
+	}
+
+	diskNamesByPath := map[string]string{}
+	for diskName, disk := range node.Spec.Disks {
+		diskNamesByPath[disk.Path] = diskName
+	}
+
+	discoveredDevices := []longhorn.DiscoveredDevice{}
+	for _, device := range devices {
+		discoveredDevice := longhorn.DiscoveredDevice{
+			Path:       device.Path,
+			Model:      device.Model,
+			Size:       device.Size,
+			Rotational: device.Rotational,
+		}
+
+		if previous, ok := provisionedDevices[device.Path]; ok {
+			discoveredDevice.Rule = previous.Rule
+			discoveredDevice.DiskName = previous.DiskName
+			discoveredDevice.Provisioned = true
+			if _, exists := node.Spec.Disks[previous.DiskName]; !exists {
+				discoveredDevice.RejectedReason = fmt.Sprintf("disk %v provisioned from the device has been removed from the node", previous.DiskName)
+			}
+			discoveredDevices = append(discoveredDevices, discoveredDevice)
+			continue
+		}
+
+		rule, diskPath, reason := diskdiscovery.MatchRules(rules, device)
+		if rule == nil {
+			discoveredDevice.RejectedReason = reason
+			discoveredDevices = append(discoveredDevices, discoveredDevice)
+			continue
+		}
+		discoveredDevice.Rule = rule.Name
+
+		if diskName, exists := diskNamesByPath[diskPath]; exists {
+			discoveredDevice.DiskName = diskName
+			discoveredDevice.RejectedReason = fmt.Sprintf("path %v is already used by disk %v", diskPath, diskName)
+			discoveredDevices = append(discoveredDevices, discoveredDevice)
+			continue
+		}
+
+		diskName := fmt.Sprintf("%v-%v", rule.Name, device.Name)
+		if _, exists := node.Spec.Disks[diskName]; exists {
+			discoveredDevice.RejectedReason = fmt.Sprintf("disk name %v is already used", diskName)
+			discoveredDevices = append(discoveredDevices, discoveredDevice)
+			continue
+		}
+
+		diskDriver := rule.DiskDriver
+		if diskDriver == "" {
+			diskDriver = longhorn.DiskDriverNone
+			if rule.DiskType == longhorn.DiskTypeBlock {
+				diskDriver = longhorn.DiskDriverAuto
+			}
+		}
+
+		if node.Spec.Disks == nil {
+			node.Spec.Disks = map[string]longhorn.DiskSpec{}
+		}
+		node.Spec.Disks[diskName] = longhorn.DiskSpec{
+			Type:            rule.DiskType,
+			Path:            diskPath,
+			DiskDriver:      diskDriver,
+			AllowScheduling: true,
+			StorageReserved: device.Size * rule.StorageReservedPercentage / 100,
+			Tags:            rule.Tags,
+		}
+		diskNamesByPath[diskPath] = diskName
+
+		discoveredDevice.DiskName = diskName
+		discoveredDevice.Provisioned = true
+		discoveredDevices = append(discoveredDevices, discoveredDevice)
+
+		knc.logger.Infof("Provisioning disk %v with path %v on node %v by disk provisioning rule %v", diskName, diskPath, node.Name, rule.Name)
+		knc.eventRecorder.Eventf(node, corev1.EventTypeNormal, constant.EventReasonDiskProvisioned,
+			"Provisioned disk %v with path %v from device %v by disk provisioning rule %v", diskName, diskPath, device.Path, rule.Name)
+	}
+
+	node.Status.DiscoveredDevices = discoveredDevices
+	return nil
+}
@@ -467,26 +467,19 @@ func (kc *KubernetesPodController) getPodWithConflictedAttachment(pods []*corev1
 // This is necessary because Kubernetes never forcefully deletes pods on a down node,
 // the pods are stuck in terminating state forever and Longhorn volumes are not released.
 // We provide an option for users to help them automatically force delete terminating pods
-// of StatefulSet/Deployment on the downed node. By force deleting, k8s will detach Longhorn volumes
-// and spin up replacement pods on a new node.
+// of StatefulSet/Deployment or other owner kinds on the downed node. By force deleting, k8s will
+// detach Longhorn volumes and spin up replacement pods on a new node.
 //
 // Force delete a pod when all of the below conditions are meet:
-// 1. NodeDownPodDeletionPolicy is different than DoNothing
-// 2. pod belongs to a StatefulSet/Deployment depend on NodeDownPodDeletionPolicy
-// 3. node containing the pod is down
-// 4. the pod is terminating and the DeletionTimestamp has passed.
-// 5. pod has a PV with provisioner driver.longhorn.io
+// 1. the pod is terminating
+// 2. node containing the pod is down
+// 3. the pod or its namespace opts in by label, or the pod is selected by NodeDownPodDeletionPolicy
+// or NodeDownPodDeletionOwnerKinds
+// 4. the node is fenced by the out-of-service taint if NodeDownPodDeletionRequireOutOfServiceTaint is enabled
+// 5. the DeletionTimestamp plus NodeDownPodDeletionGracePeriod has passed.
+// 6. pod has a PV with provisioner driver.longhorn.io
 func (kc *KubernetesPodController) handlePodDeletionIfNodeDown(pod *corev1.Pod, nodeID string, namespace string) error {
-	deletionPolicy := types.NodeDownPodDeletionPolicyDoNothing
-	if deletionSetting, err := kc.ds.GetSettingValueExisted(types.SettingNameNodeDownPodDeletionPolicy); err == nil {
-		deletionPolicy = types.NodeDownPodDeletionPolicy(deletionSetting)
-	}
-
-	shouldDelete := (deletionPolicy == types.NodeDownPodDeletionPolicyDeleteStatefulSetPod && isOwnedByStatefulSet(pod)) ||
-		(deletionPolicy == types.NodeDownPodDeletionPolicyDeleteDeploymentPod && isOwnedByDeployment(pod)) ||
-		(deletionPolicy == types.NodeDownPodDeletionPolicyDeleteBothStatefulsetAndDeploymentPod && (isOwnedByStatefulSet(pod) || isOwnedByDeployment(pod)))
-
-	if !shouldDelete {
+	if pod.DeletionTimestamp == nil {
 		return nil
 	}
 
@@ -498,7 +491,21 @@ func (kc *KubernetesPodController) handlePodDeletionIfNodeDown(pod *corev1.Pod,
 		return nil
 	}
 
-	if pod.DeletionTimestamp == nil {
+	shouldDelete, err := kc.shouldForceDeletePodOnDownNode(pod)
+	if err != nil {
+		return err
+	}
+	if !shouldDelete {
+		return nil
+	}
+
+	isFenced, err := kc.isNodeFencedForPodDeletion(nodeID)
+	if err != nil {
+		return err
+	}
+	if !isFenced {
+		kc.eventRecorder.Eventf(pod, corev1.EventTypeNormal, constant.EventReasonWaitingForNodeFencing,
+			"Waiting for taint %v on downed node %v before force deleting pod", corev1.TaintNodeOutOfService, nodeID)
 		return nil
 	}
 
@@ -515,16 +522,26 @@ func (kc *KubernetesPodController) handlePodDeletionIfNodeDown(pod *corev1.Pod,
 				if datastore.ErrorIsNotFound(err) {
 					continue
 				}
+				kc.eventRecorder.Eventf(pod, corev1.EventTypeWarning, constant.EventReasonFailedDeleting,
+					"Failed to delete volume attachment %v on downed node %v: %v", va.Name, nodeID, err)
 				return err
 			}
 			kc.logger.Infof("%v: deleted volume attachment %v for pod %v on downed node %v", controllerAgentName, va.Name, pod.Name, nodeID)
+			kc.eventRecorder.Eventf(pod, corev1.EventTypeNormal, constant.EventReasonDeletedVolumeAttachment,
+				"Deleted volume attachment %v on downed node %v", va.Name, nodeID)
 		}
 		// wait the volumeattachment object to be deleted
 		kc.logger.Infof("%v: wait for volume attachment %v for pod %v on downed node %v to be deleted", controllerAgentName, va.Name, pod.Name, nodeID)
 		return nil
 	}
 
-	if pod.DeletionTimestamp.After(time.Now()) {
+	gracePeriodSeconds, err := kc.ds.GetSettingAsInt(types.SettingNameNodeDownPodDeletionGracePeriod)
+	if err != nil {
+		return err
+	}
+	forceDeleteAt := pod.DeletionTimestamp.Add(time.Duration(gracePeriodSeconds) * time.Second)
+	if waitDuration := time.Until(forceDeleteAt); waitDuration > 0 {
+		kc.queue.AddAfter(namespace+"/"+pod.Name, waitDuration)
 		return nil
 	}
 
@@ -533,13 +550,99 @@ func (kc *KubernetesPodController) handlePodDeletionIfNodeDown(pod *corev1.Pod,
 		GracePeriodSeconds: &gracePeriod,
 	})
 	if err != nil {
+		kc.eventRecorder.Eventf(pod, corev1.EventTypeWarning, constant.EventReasonFailedForceDeleting,
+			"Failed to force delete pod on downed node %v: %v", nodeID, err)
 		return errors.Wrapf(err, "failed to forcefully delete Pod %v on the downed Node %v in handlePodDeletionIfNodeDown", pod.Name, nodeID)
 	}
 	kc.logger.Infof("%v: Forcefully deleted pod %v on downed node %v", controllerAgentName, pod.Name, nodeID)
+	kc.eventRecorder.Eventf(pod, corev1.EventTypeNormal, constant.EventReasonForceDeleted,
+		"Forcefully deleted pod on downed node %v", nodeID)
 
 	return nil
 }
 
+func (kc *KubernetesPodController) shouldForceDeletePodOnDownNode(pod *corev1.Pod) (bool, error) {
+	var podNamespace *corev1.Namespace
+	if _, ok := pod.Labels[types.GetLonghornLabelKey(types.LonghornLabelNodeDownPodDeletion)]; !ok {
+		ns, err := kc.ds.GetNamespace(pod.Namespace)
+		if err != nil && !datastore.ErrorIsNotFound(err) {
+			return false, errors.Wrapf(err, "failed to get namespace %v of pod %v", pod.Namespace, pod.Name)
+		}
+		if err == nil {
+			podNamespace = ns
+		}
+	}
+
+	deletionPolicy := types.NodeDownPodDeletionPolicyDoNothing
+	if deletionSetting, err := kc.ds.GetSettingValueExisted(types.SettingNameNodeDownPodDeletionPolicy); err == nil {
+		deletionPolicy = types.NodeDownPodDeletionPolicy(deletionSetting)
+	}
+
+	ownerKinds := []string{}
+	if ownerKindsSetting, err := kc.ds.GetSettingValueExisted(types.SettingNameNodeDownPodDeletionOwnerKinds); err == nil {
+		ownerKinds = types.UnmarshalNodeDownPodDeletionOwnerKinds(ownerKindsSetting)
+	}
+
+	return isPodNodeDownDeletionAllowed(pod, podNamespace, deletionPolicy, ownerKinds), nil
+}
+
+// isNodeFencedForPodDeletion returns true if fencing is not required, or the Kubernetes node is
+// either gone or tainted as out-of-service.
+func (kc *KubernetesPodController) isNodeFencedForPodDeletion(nodeID string) (bool, error) {
+	requireOutOfServiceTaint, err := kc.ds.GetSettingAsBool(types.SettingNameNodeDownPodDeletionRequireOutOfServiceTaint)
+	if err != nil {
+		return false, err
+	}
+	if !requireOutOfServiceTaint {
+		return true, nil
+	}
+
+	kubeNode, err := kc.ds.GetKubernetesNodeRO(nodeID)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	for _, taint := range kubeNode.Spec.Taints {
+		if taint.Key == corev1.TaintNodeOutOfService {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isPodNodeDownDeletionAllowed decides if a terminating pod on a downed node can be force deleted.
+// The opt-in label on the pod takes precedence over the one on the namespace, and both take
+// precedence over the deletion policy and the owner kinds.
+func isPodNodeDownDeletionAllowed(pod *corev1.Pod, podNamespace *corev1.Namespace, deletionPolicy types.NodeDownPodDeletionPolicy, ownerKinds []string) bool {
+	labelKey := types.GetLonghornLabelKey(types.LonghornLabelNodeDownPodDeletion)
+	if value, ok := pod.Labels[labelKey]; ok {
+		return value == types.LonghornLabelValueEnabled
+	}
+	if podNamespace != nil {
+		if value, ok := podNamespace.Labels[labelKey]; ok {
+			return value == types.LonghornLabelValueEnabled
+		}
+	}
+
+	switch {
+	case deletionPolicy == types.NodeDownPodDeletionPolicyDeleteStatefulSetPod && isOwnedByStatefulSet(pod),
+		deletionPolicy == types.NodeDownPodDeletionPolicyDeleteDeploymentPod && isOwnedByDeployment(pod),
+		deletionPolicy == types.NodeDownPodDeletionPolicyDeleteBothStatefulsetAndDeploymentPod && (isOwnedByStatefulSet(pod) || isOwnedByDeployment(pod)):
+		return true
+	}
+
+	if ownerRef := metav1.GetControllerOf(pod); ownerRef != nil {
+		for _, ownerKind := range ownerKinds {
+			if ownerRef.Kind == ownerKind {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (kc *KubernetesPodController) getVolumeAttachmentsOfPod(pod *corev1.Pod) ([]*storagev1.VolumeAttachment, error) {
 	var res []*storagev1.VolumeAttachment
 	volumeAttachments, err := kc.ds.ListVolumeAttachmentsRO()
@@ -0,0 +1,88 @@
+package controller
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	. "gopkg.in/check.v1"
+)
+
+func newPodOwnedBy(kind string, labels map[string]string) *corev1.Pod {
+	isController := true
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-pod",
+			Namespace: TestNamespace,
+			Labels:    labels,
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: kind, Name: "test-owner", Controller: &isController},
+			},
+		},
+	}
+}
+
+func (s *TestSuite) TestIsPodNodeDownDeletionAllowed(c *C) {
+	labelKey := types.GetLonghornLabelKey(types.LonghornLabelNodeDownPodDeletion)
+	optedInNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{labelKey: types.LonghornLabelValueEnabled}},
+	}
+	optedOutNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{labelKey: types.LonghornLabelValueDisabled}},
+	}
+
+	testCases := map[string]struct {
+		pod          *corev1.Pod
+		namespace    *corev1.Namespace
+		policy       types.NodeDownPodDeletionPolicy
+		ownerKinds   []string
+		expectDelete bool
+	}{
+		"statefulset pod with statefulset policy": {
+			pod:          newPodOwnedBy(types.KubernetesStatefulSet, nil),
+			policy:       types.NodeDownPodDeletionPolicyDeleteStatefulSetPod,
+			expectDelete: true,
+		},
+		"deployment pod with statefulset policy": {
+			pod:          newPodOwnedBy(types.KubernetesReplicaSet, nil),
+			policy:       types.NodeDownPodDeletionPolicyDeleteStatefulSetPod,
+			expectDelete: false,
+		},
+		"job pod with owner kinds": {
+			pod:          newPodOwnedBy("Job", nil),
+			policy:       types.NodeDownPodDeletionPolicyDoNothing,
+			ownerKinds:   []string{"Job", "VirtualMachineInstance"},
+			expectDelete: true,
+		},
+		"job pod without owner kinds": {
+			pod:          newPodOwnedBy("Job", nil),
+			policy:       types.NodeDownPodDeletionPolicyDeleteBothStatefulsetAndDeploymentPod,
+			expectDelete: false,
+		},
+		"pod opted in by namespace": {
+			pod:          newPodOwnedBy("Job", nil),
+			namespace:    optedInNamespace,
+			policy:       types.NodeDownPodDeletionPolicyDoNothing,
+			expectDelete: true,
+		},
+		"pod opted out by namespace": {
+			pod:          newPodOwnedBy(types.KubernetesStatefulSet, nil),
+			namespace:    optedOutNamespace,
+			policy:       types.NodeDownPodDeletionPolicyDeleteStatefulSetPod,
+			expectDelete: false,
+		},
+		"pod label takes precedence over namespace label": {
+			pod:          newPodOwnedBy(types.KubernetesStatefulSet, map[string]string{labelKey: types.LonghornLabelValueEnabled}),
+			namespace:    optedOutNamespace,
+			policy:       types.NodeDownPodDeletionPolicyDoNothing,
+			expectDelete: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		c.Logf("testing %v", name)
+		deleted := isPodNodeDownDeletionAllowed(tc.pod, tc.namespace, tc.policy, tc.ownerKinds)
+		c.Assert(deleted, Equals, tc.expectDelete, Commentf("test case: %v", name))
+	}
+}
@@ -0,0 +1,355 @@
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	lhns "github.com/longhorn/go-common-libs/ns"
+	lhtypes "github.com/longhorn/go-common-libs/types"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	diskHealthSysfsDirectory = "/sys"
+
+	smartctlBinary  = "smartctl"
+	smartctlTimeout = 30 * time.Second
+
+	// SMART data changes slowly, so it is not collected on every disk monitor sync.
+	diskHealthSMARTCheckInterval = 10 * time.Minute
+
+	// A disk is considered as having a latency outlier only if the average IO latency
+	// exceeds the threshold in consecutive samples.
+	diskHealthLatencyOutlierSampleCount = 3
+
+	// The IO error counter of the kernel is cumulative since the device is attached, so only the IO errors
+	// reported within the window are compared against the threshold.
+	diskHealthIOErrorWindow = time.Hour
+)
+
+// DiskHealthInfo is the health data collected from the block device backing a disk.
+type DiskHealthInfo struct {
+	Device string
+
+	SMARTAvailable bool
+	SMARTPassed    bool
+	SMARTMessage   string
+
+	IOErrorCountAvailable bool
+	IOErrorCount          uint64
+
+	IOStat *DiskIOStat
+}
+
+// DiskIOStat is the accumulated IO count and IO time in milliseconds of the block device.
+type DiskIOStat struct {
+	IOs   uint64
+	Ticks uint64
+}
+
+// diskIOErrorSample is the number of the IO errors reported by the kernel since the previous sample.
+type diskIOErrorSample struct {
+	sampledAt time.Time
+	count     uint64
+}
+
+// diskHealthState keeps the samples required for evaluating the health of a disk across disk monitor syncs.
+type diskHealthState struct {
+	lastIOStat          *DiskIOStat
+	latencyOutlierCount int
+	averageLatencyMs    uint64
+
+	lastIOErrorCount   *uint64
+	ioErrorSamples     []diskIOErrorSample
+	recentIOErrorCount uint64
+
+	smartCheckedAt time.Time
+	smartAvailable bool
+	smartPassed    bool
+	smartMessage   string
+}
+
+type diskHealthThresholds struct {
+	ioErrorCount int64
+	latencyMs    int64
+}
+
+type GetDiskHealthHandler func(longhorn.DiskType, string, bool) (*DiskHealthInfo, error)
+
+// getDiskHealth collects the health data of the block device backing the disk path.
+// SMART data is collected only if checkSMART is true and smartctl is available on the host.
+func getDiskHealth(diskType longhorn.DiskType, diskPath string, checkSMART bool) (*DiskHealthInfo, error) {
+	device, err := getDiskBlockDevice(diskHealthSysfsDirectory, diskType, diskPath)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &DiskHealthInfo{
+		Device: device,
+	}
+
+	if ioErrorCount, err := getDiskIOErrorCount(diskHealthSysfsDirectory, device); err == nil {
+		info.IOErrorCountAvailable = true
+		info.IOErrorCount = ioErrorCount
+	}
+
+	if ioStat, err := getDiskIOStat(diskHealthSysfsDirectory, device); err == nil {
+		info.IOStat = ioStat
+	}
+
+	if checkSMART {
+		info.SMARTAvailable, info.SMARTPassed, info.SMARTMessage = getDiskSMARTHealth(device)
+	}
+
+	return info, nil
+}
+
+// getDiskBlockDevice returns the name of the whole block device, for example sda, backing the disk path.
+func getDiskBlockDevice(sysfsDir string, diskType longhorn.DiskType, diskPath string) (string, error) {
+	var stat unix.Stat_t
+	if err := unix.Stat(diskPath, &stat); err != nil {
+		return "", errors.Wrapf(err, "failed to stat %v", diskPath)
+	}
+
+	dev := stat.Dev
+	if diskType == longhorn.DiskTypeBlock {
+		dev = stat.Rdev
+	}
+
+	devPath := filepath.Join(sysfsDir, "dev", "block", fmt.Sprintf("%d:%d", unix.Major(dev), unix.Minor(dev)))
+	realPath, err := filepath.EvalSymlinks(devPath)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to find the block device of %v", diskPath)
+	}
+
+	// The statistics and the error counters are only available on the whole device rather than the partition.
+	if _, err := os.Stat(filepath.Join(realPath, "partition")); err == nil {
+		realPath = filepath.Dir(realPath)
+	}
+
+	return filepath.Base(realPath), nil
+}
+
+func getDiskIOErrorCount(sysfsDir, device string) (uint64, error) {
+	content, err := os.ReadFile(filepath.Join(sysfsDir, "block", device, "device", "ioerr_cnt"))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimPrefix(strings.TrimSpace(string(content)), "0x"), 16, 64)
+}
+
+// getDiskIOStat parses /sys/block/<device>/stat. See https://www.kernel.org/doc/Documentation/block/stat.txt
+func getDiskIOStat(sysfsDir, device string) (*DiskIOStat, error) {
+	content, err := os.ReadFile(filepath.Join(sysfsDir, "block", device, "stat"))
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Fields(string(content))
+	if len(fields) < 8 {
+		return nil, fmt.Errorf("invalid block device stat %q", string(content))
+	}
+
+	values := make([]uint64, 8)
+	for i := range values {
+		if values[i], err = strconv.ParseUint(fields[i], 10, 64); err != nil {
+			return nil, errors.Wrapf(err, "invalid block device stat field %v", fields[i])
+		}
+	}
+
+	return &DiskIOStat{
+		IOs:   values[0] + values[4],
+		Ticks: values[3] + values[7],
+	}, nil
+}
+
+type smartctlOutput struct {
+	SmartStatus *struct {
+		Passed bool `json:"passed"`
+	} `json:"smart_status"`
+	ATASmartAttributes struct {
+		Table []struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+			Raw  struct {
+				Value int64 `json:"value"`
+			} `json:"raw"`
+		} `json:"table"`
+	} `json:"ata_smart_attributes"`
+	NVMeSmartHealthInformationLog *struct {
+		MediaErrors int64 `json:"media_errors"`
+	} `json:"nvme_smart_health_information_log"`
+}
+
+// getDiskSMARTHealth runs smartctl in the host namespace. SMART is considered unavailable if smartctl
+// is not installed on the host or the device does not report the SMART overall-health.
+func getDiskSMARTHealth(device string) (available, passed bool, message string) {
+	namespaces := []lhtypes.Namespace{lhtypes.NamespaceMnt}
+	nsexec, err := lhns.NewNamespaceExecutor(lhtypes.ProcessNone, lhtypes.HostProcDirectory, namespaces)
+	if err != nil {
+		return false, false, ""
+	}
+
+	// smartctl uses the exit status bits to report the disk problems, so the output is parsed regardless of the error.
+	output, _ := nsexec.Execute(nil, smartctlBinary, []string{"-H", "-A", "-j", filepath.Join("/dev", device)}, smartctlTimeout)
+	return parseSMARTHealth(output)
+}
+
+func parseSMARTHealth(output string) (available, passed bool, message string) {
+	smart := &smartctlOutput{}
+	if err := json.Unmarshal([]byte(output), smart); err != nil || smart.SmartStatus == nil {
+		return false, false, ""
+	}
+
+	if !smart.SmartStatus.Passed {
+		return true, false, "SMART overall-health self-assessment failed"
+	}
+
+	for _, attr := range smart.ATASmartAttributes.Table {
+		switch attr.ID {
+		// Reallocated_Sector_Ct, Current_Pending_Sector and Offline_Uncorrectable
+		case 5, 197, 198:
+			if attr.Raw.Value > 0 {
+				message = appendDiskHealthMessage(message, fmt.Sprintf("SMART attribute %v is %v", attr.Name, attr.Raw.Value))
+			}
+		}
+	}
+	if smart.NVMeSmartHealthInformationLog != nil && smart.NVMeSmartHealthInformationLog.MediaErrors > 0 {
+		message = appendDiskHealthMessage(message, fmt.Sprintf("NVMe media errors is %v", smart.NVMeSmartHealthInformationLog.MediaErrors))
+	}
+
+	return true, true, message
+}
+
+func appendDiskHealthMessage(message, item string) string {
+	if message == "" {
+		return item
+	}
+	return message + ", " + item
+}
+
+// updateIOErrorSamples records the IO errors reported since the previous sample, and sums up the ones within the window.
+// The first sample only sets the baseline, since the errors counted before it may have been fixed long ago.
+func (state *diskHealthState) updateIOErrorSamples(ioErrorCount uint64, now time.Time) {
+	if state.lastIOErrorCount != nil && ioErrorCount > *state.lastIOErrorCount {
+		state.ioErrorSamples = append(state.ioErrorSamples, diskIOErrorSample{sampledAt: now, count: ioErrorCount - *state.lastIOErrorCount})
+	}
+	// A smaller counter means the device was reset, so the counter restarts from the new value
+	state.lastIOErrorCount = &ioErrorCount
+
+	state.recentIOErrorCount = 0
+	samples := []diskIOErrorSample{}
+	for _, sample := range state.ioErrorSamples {
+		if now.Sub(sample.sampledAt) < diskHealthIOErrorWindow {
+			samples = append(samples, sample)
+			state.recentIOErrorCount += sample.count
+		}
+	}
+	state.ioErrorSamples = samples
+}
+
+// evaluateDiskHealth updates the health state with the collected health data and returns the Healthy condition of the disk.
+func evaluateDiskHealth(diskName string, info *DiskHealthInfo, state *diskHealthState, thresholds diskHealthThresholds, now time.Time) *longhorn.Condition {
+	if info.IOErrorCountAvailable {
+		state.updateIOErrorSamples(info.IOErrorCount, now)
+	}
+
+	if info.IOStat != nil {
+		if state.lastIOStat != nil && info.IOStat.IOs > state.lastIOStat.IOs && info.IOStat.Ticks >= state.lastIOStat.Ticks {
+			state.averageLatencyMs = (info.IOStat.Ticks - state.lastIOStat.Ticks) / (info.IOStat.IOs - state.lastIOStat.IOs)
+			if thresholds.latencyMs > 0 && state.averageLatencyMs > uint64(thresholds.latencyMs) {
+				state.latencyOutlierCount++
+			} else {
+				state.latencyOutlierCount = 0
+			}
+		}
+		state.lastIOStat = info.IOStat
+	}
+
+	condition := &longhorn.Condition{
+		Type:   longhorn.DiskConditionTypeHealthy,
+		Status: longhorn.ConditionStatusTrue,
+	}
+
+	switch {
+	case state.smartAvailable && !state.smartPassed:
+		condition.Status = longhorn.ConditionStatusFalse
+		condition.Reason = longhorn.DiskConditionReasonSMARTFailed
+		condition.Message = fmt.Sprintf("Disk %v on device %v is unhealthy: %v", diskName, info.Device, state.smartMessage)
+	case info.IOErrorCountAvailable && thresholds.ioErrorCount > 0 && state.recentIOErrorCount >= uint64(thresholds.ioErrorCount):
+		condition.Status = longhorn.ConditionStatusFalse
+		condition.Reason = longhorn.DiskConditionReasonIOErrors
+		condition.Message = fmt.Sprintf("Disk %v on device %v is unhealthy: the kernel reported %v IO errors in the last %v minutes",
+			diskName, info.Device, state.recentIOErrorCount, diskHealthIOErrorWindow.Minutes())
+	case state.latencyOutlierCount >= diskHealthLatencyOutlierSampleCount:
+		condition.Status = longhorn.ConditionStatusFalse
+		condition.Reason = longhorn.DiskConditionReasonIOLatencyOutlier
+		condition.Message = fmt.Sprintf("Disk %v on device %v is unhealthy: the average IO latency %vms exceeds %vms in %v consecutive samples",
+			diskName, info.Device, state.averageLatencyMs, thresholds.latencyMs, state.latencyOutlierCount)
+	default:
+		condition.Message = fmt.Sprintf("Disk %v on device %v is healthy", diskName, info.Device)
+		if state.smartAvailable && state.smartMessage != "" {
+			condition.Message = fmt.Sprintf("%v: %v", condition.Message, state.smartMessage)
+		}
+	}
+
+	return condition
+}
+
+// getDiskHealthThresholds returns the thresholds for evaluating the disk health, or nil if the disk health monitoring is disabled.
+func (m *DiskMonitor) getDiskHealthThresholds() *diskHealthThresholds {
+	policy, err := m.ds.GetSettingValueExisted(types.SettingNameDiskHealthPolicy)
+	if err != nil {
+		m.logger.WithError(err).Warnf("Failed to get %v setting", types.SettingNameDiskHealthPolicy)
+		return nil
+	}
+	if types.DiskHealthPolicy(policy) == types.DiskHealthPolicyDisabled {
+		return nil
+	}
+
+	thresholds := &diskHealthThresholds{}
+	if thresholds.ioErrorCount, err = m.ds.GetSettingAsInt(types.SettingNameDiskHealthIOErrorThreshold); err != nil {
+		m.logger.WithError(err).Warnf("Failed to get %v setting", types.SettingNameDiskHealthIOErrorThreshold)
+	}
+	if thresholds.latencyMs, err = m.ds.GetSettingAsInt(types.SettingNameDiskHealthIOLatencyThreshold); err != nil {
+		m.logger.WithError(err).Warnf("Failed to get %v setting", types.SettingNameDiskHealthIOLatencyThreshold)
+	}
+	return thresholds
+}
+
+// collectDiskHealth returns the Healthy condition of the disk.
+func (m *DiskMonitor) collectDiskHealth(diskName, diskUUID string, disk longhorn.DiskSpec, thresholds diskHealthThresholds) *longhorn.Condition {
+	state, ok := m.diskHealthStates[diskUUID]
+	if !ok {
+		state = &diskHealthState{}
+		m.diskHealthStates[diskUUID] = state
+	}
+
+	checkSMART := time.Since(state.smartCheckedAt) >= diskHealthSMARTCheckInterval
+	info, err := m.getDiskHealthHandler(disk.Type, disk.Path, checkSMART)
+	if err != nil {
+		return &longhorn.Condition{
+			Type:    longhorn.DiskConditionTypeHealthy,
+			Status:  longhorn.ConditionStatusUnknown,
+			Reason:  longhorn.DiskConditionReasonNoDiskHealthInfo,
+			Message: fmt.Sprintf("Failed to collect the health data of disk %v(%v): %v", diskName, disk.Path, err),
+		}
+	}
+	if checkSMART {
+		state.smartCheckedAt = time.Now()
+		state.smartAvailable, state.smartPassed, state.smartMessage = info.SMARTAvailable, info.SMARTPassed, info.SMARTMessage
+	}
+
+	return evaluateDiskHealth(diskName, info, state, thresholds, time.Now())
+}
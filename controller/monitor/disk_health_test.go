@@ -0,0 +1,105 @@
+package monitor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func TestParseSMARTHealth(t *testing.T) {
+	assert := require.New(t)
+
+	available, _, _ := parseSMARTHealth("smartctl: command not found")
+	assert.False(available)
+
+	available, passed, message := parseSMARTHealth(`{"smart_status": {"passed": false}}`)
+	assert.True(available)
+	assert.False(passed)
+	assert.NotEmpty(message)
+
+	available, passed, message = parseSMARTHealth(`{"smart_status": {"passed": true}, "ata_smart_attributes": {"table": [
+		{"id": 5, "name": "Reallocated_Sector_Ct", "raw": {"value": 8}},
+		{"id": 9, "name": "Power_On_Hours", "raw": {"value": 1000}}]}}`)
+	assert.True(available)
+	assert.True(passed)
+	assert.Equal("SMART attribute Reallocated_Sector_Ct is 8", message)
+}
+
+func TestGetDiskIOStatAndErrorCount(t *testing.T) {
+	assert := require.New(t)
+
+	sysfsDir := t.TempDir()
+	deviceDir := filepath.Join(sysfsDir, "block", "sda")
+	assert.NoError(os.MkdirAll(filepath.Join(deviceDir, "device"), 0755))
+	assert.NoError(os.WriteFile(filepath.Join(deviceDir, "stat"),
+		[]byte("     100        0     2000      300      50        0     1000      200        0      400      500\n"), 0644))
+	assert.NoError(os.WriteFile(filepath.Join(deviceDir, "device", "ioerr_cnt"), []byte("0x1a\n"), 0644))
+
+	ioStat, err := getDiskIOStat(sysfsDir, "sda")
+	assert.NoError(err)
+	assert.Equal(uint64(150), ioStat.IOs)
+	assert.Equal(uint64(500), ioStat.Ticks)
+
+	ioErrorCount, err := getDiskIOErrorCount(sysfsDir, "sda")
+	assert.NoError(err)
+	assert.Equal(uint64(26), ioErrorCount)
+}
+
+func TestEvaluateDiskHealth(t *testing.T) {
+	assert := require.New(t)
+
+	thresholds := diskHealthThresholds{ioErrorCount: 10, latencyMs: 100}
+
+	now := time.Now()
+	newIOErrorInfo := func(ioErrorCount uint64) *DiskHealthInfo {
+		return &DiskHealthInfo{Device: "sda", IOErrorCountAvailable: true, IOErrorCount: ioErrorCount}
+	}
+
+	// The IO errors counted before the first sample are ignored.
+	state := &diskHealthState{}
+	condition := evaluateDiskHealth("disk-1", newIOErrorInfo(100), state, thresholds, now)
+	assert.Equal(longhorn.ConditionStatusTrue, condition.Status)
+
+	condition = evaluateDiskHealth("disk-1", newIOErrorInfo(109), state, thresholds, now.Add(time.Minute))
+	assert.Equal(longhorn.ConditionStatusTrue, condition.Status)
+
+	condition = evaluateDiskHealth("disk-1", newIOErrorInfo(110), state, thresholds, now.Add(2*time.Minute))
+	assert.Equal(longhorn.ConditionStatusFalse, condition.Status)
+	assert.Equal(longhorn.DiskConditionReasonIOErrors, condition.Reason)
+
+	// The disk is healthy again once the IO errors are out of the window.
+	condition = evaluateDiskHealth("disk-1", newIOErrorInfo(110), state, thresholds, now.Add(diskHealthIOErrorWindow+2*time.Minute))
+	assert.Equal(longhorn.ConditionStatusTrue, condition.Status)
+
+	// The counter restarts after the device is reset.
+	condition = evaluateDiskHealth("disk-1", newIOErrorInfo(5), state, thresholds, now.Add(diskHealthIOErrorWindow+3*time.Minute))
+	assert.Equal(longhorn.ConditionStatusTrue, condition.Status)
+	condition = evaluateDiskHealth("disk-1", newIOErrorInfo(15), state, thresholds, now.Add(diskHealthIOErrorWindow+4*time.Minute))
+	assert.Equal(longhorn.ConditionStatusFalse, condition.Status)
+
+	// The latency outlier is reported only after the consecutive samples exceed the threshold.
+	state = &diskHealthState{}
+	ioStat := &DiskIOStat{}
+	for i := 0; i <= diskHealthLatencyOutlierSampleCount; i++ {
+		condition = evaluateDiskHealth("disk-1", &DiskHealthInfo{Device: "sda", IOStat: ioStat}, state, thresholds, now)
+		if i < diskHealthLatencyOutlierSampleCount {
+			assert.Equal(longhorn.ConditionStatusTrue, condition.Status)
+		}
+		ioStat = &DiskIOStat{IOs: ioStat.IOs + 10, Ticks: ioStat.Ticks + 2000}
+	}
+	assert.Equal(longhorn.ConditionStatusFalse, condition.Status)
+	assert.Equal(longhorn.DiskConditionReasonIOLatencyOutlier, condition.Reason)
+
+	condition = evaluateDiskHealth("disk-1", &DiskHealthInfo{Device: "sda", IOStat: &DiskIOStat{IOs: ioStat.IOs + 10, Ticks: ioStat.Ticks + 10}}, state, thresholds, now)
+	assert.Equal(longhorn.ConditionStatusTrue, condition.Status)
+
+	state = &diskHealthState{smartAvailable: true, smartPassed: false, smartMessage: "SMART overall-health self-assessment failed"}
+	condition = evaluateDiskHealth("disk-1", &DiskHealthInfo{Device: "sda"}, state, thresholds, now)
+	assert.Equal(longhorn.ConditionStatusFalse, condition.Status)
+	assert.Equal(longhorn.DiskConditionReasonSMARTFailed, condition.Reason)
+}
@@ -46,10 +46,14 @@ type DiskMonitor struct {
 
 	syncCallback func(key string)
 
+	// diskHealthStates is keyed by the disk UUID and only accessed by the disk monitor sync.
+	diskHealthStates map[string]*diskHealthState
+
 	getDiskStatHandler          GetDiskStatHandler
 	getDiskConfigHandler        GetDiskConfigHandler
 	generateDiskConfigHandler   GenerateDiskConfigHandler
 	getReplicaDataStoresHandler GetReplicaDataStoresHandler
+	getDiskHealthHandler        GetDiskHealthHandler
 }
 
 type CollectedDiskInfo struct {
@@ -62,6 +66,7 @@ type CollectedDiskInfo struct {
 	Condition                 *longhorn.Condition
 	OrphanedReplicaDataStores map[string]string
 	InstanceManagerName       string
+	HealthCondition           *longhorn.Condition
 }
 
 type GetDiskStatHandler func(longhorn.DiskType, string, string, longhorn.DiskDriver, *DiskServiceClient) (*lhtypes.DiskStat, error)
@@ -83,10 +88,13 @@ func NewDiskMonitor(logger logrus.FieldLogger, ds *datastore.DataStore, nodeName
 
 		syncCallback: syncCallback,
 
+		diskHealthStates: make(map[string]*diskHealthState),
+
 		getDiskStatHandler:          getDiskStat,
 		getDiskConfigHandler:        getDiskConfig,
 		generateDiskConfigHandler:   generateDiskConfig,
 		getReplicaDataStoresHandler: getReplicaDataStores,
+		getDiskHealthHandler:        getDiskHealth,
 	}
 
 	go m.Start()
@@ -225,6 +233,9 @@ func (m *DiskMonitor) collectDiskData(node *longhorn.Node) map[string]*Collected
 		m.closeDiskServiceClients(diskServiceClients)
 	}()
 
+	diskHealthThresholds := m.getDiskHealthThresholds()
+	defer m.cleanupDiskHealthStates(diskInfoMap, diskHealthThresholds != nil)
+
 	for diskName, disk := range node.Spec.Disks {
 		dataEngine := util.GetDataEngineForDiskType(disk.Type)
 		diskServiceClient := diskServiceClients[dataEngine]
@@ -323,11 +334,29 @@ func (m *DiskMonitor) collectDiskData(node *longhorn.Node) map[string]*Collected
 
 		diskInfoMap[diskName] = NewDiskInfo(diskConfig.DiskName, diskConfig.DiskUUID, disk.Path, diskConfig.DiskDriver, nodeOrDiskEvicted, stat,
 			orphanedReplicaDataStores, instanceManagerName, string(longhorn.DiskConditionReasonNoDiskInfo), "")
+		if diskHealthThresholds != nil {
+			diskInfoMap[diskName].HealthCondition = m.collectDiskHealth(diskName, diskConfig.DiskUUID, disk, *diskHealthThresholds)
+		}
 	}
 
 	return diskInfoMap
 }
 
+// cleanupDiskHealthStates drops the health states of the disks that are no longer collected.
+func (m *DiskMonitor) cleanupDiskHealthStates(diskInfoMap map[string]*CollectedDiskInfo, diskHealthEnabled bool) {
+	diskUUIDs := map[string]struct{}{}
+	for _, diskInfo := range diskInfoMap {
+		if diskHealthEnabled && diskInfo.HealthCondition != nil {
+			diskUUIDs[diskInfo.DiskUUID] = struct{}{}
+		}
+	}
+	for diskUUID := range m.diskHealthStates {
+		if _, ok := diskUUIDs[diskUUID]; !ok {
+			delete(m.diskHealthStates, diskUUID)
+		}
+	}
+}
+
 func isNodeOrDiskEvicted(node *longhorn.Node, disk longhorn.DiskSpec) bool {
 	return node.Spec.EvictionRequested || disk.EvictionRequested
 }
@@ -153,6 +153,35 @@ func (m *EnvironmentCheckMonitor) collectEnvironmentCheckData(node *longhorn.Nod
 }
 
 func (m *EnvironmentCheckMonitor) environmentCheck(kubeNode *corev1.Node) *CollectedEnvironmentCheckInfo {
+	isV2DataEngine, err := m.ds.GetSettingAsBool(types.SettingNameV2DataEngine)
+	if err != nil {
+		m.logger.WithError(err).Debug("Failed to fetch v2-data-engine setting")
+		isV2DataEngine = false
+	}
+
+	hugePageLimitInMiB := int64(defaultHugePageLimitInMiB)
+	if isV2DataEngine {
+		hugePageLimitInMiB, err = m.ds.GetSettingAsIntByDataEngine(types.SettingNameDataEngineHugepageLimit, longhorn.DataEngineTypeV2)
+		if err != nil {
+			m.logger.Warnf("Failed to get setting %v for data engine %v, using default value %d",
+				types.SettingNameDataEngineHugepageLimit, longhorn.DataEngineTypeV2, defaultHugePageLimitInMiB)
+			hugePageLimitInMiB = defaultHugePageLimitInMiB
+		}
+	}
+
+	return m.runEnvironmentChecks(kubeNode, isV2DataEngine, hugePageLimitInMiB)
+}
+
+// RunEnvironmentChecks runs the environment checks of the node without the datastore, and returns the results as
+// the node conditions.
+func RunEnvironmentChecks(logger logrus.FieldLogger, kubeNode *corev1.Node, isV2DataEngine bool, hugePageLimitInMiB int64) []longhorn.Condition {
+	m := &EnvironmentCheckMonitor{
+		baseMonitor: &baseMonitor{logger: logger},
+	}
+	return m.runEnvironmentChecks(kubeNode, isV2DataEngine, hugePageLimitInMiB).conditions
+}
+
+func (m *EnvironmentCheckMonitor) runEnvironmentChecks(kubeNode *corev1.Node, isV2DataEngine bool, hugePageLimitInMiB int64) *CollectedEnvironmentCheckInfo {
 	collectedData := &CollectedEnvironmentCheckInfo{
 		conditions: []longhorn.Condition{},
 	}
@@ -163,16 +192,10 @@ func (m *EnvironmentCheckMonitor) environmentCheck(kubeNode *corev1.Node) *Colle
 	m.syncMultipathd(namespaces, collectedData)
 	m.syncNFSClientVersion(kubeNode, collectedData)
 
-	isV2DataEngine, err := m.ds.GetSettingAsBool(types.SettingNameV2DataEngine)
-	if err != nil {
-		m.logger.WithError(err).Debug("Failed to fetch v2-data-engine setting")
-		isV2DataEngine = false
-	}
-
 	m.checkKernelModulesLoaded(kubeNode, isV2DataEngine, collectedData)
 
 	if isV2DataEngine {
-		m.checkHugePages(kubeNode, collectedData)
+		m.checkHugePages(kubeNode, hugePageLimitInMiB, collectedData)
 	}
 
 	return collectedData
@@ -361,14 +384,7 @@ func (m *EnvironmentCheckMonitor) checkPackageInstalled(packageProbeExecutables
 	return installed, notInstalled, nil
 }
 
-func (m *EnvironmentCheckMonitor) checkHugePages(kubeNode *corev1.Node, collectedData *CollectedEnvironmentCheckInfo) {
-	hugePageLimitInMiB, err := m.ds.GetSettingAsIntByDataEngine(types.SettingNameDataEngineHugepageLimit, longhorn.DataEngineTypeV2)
-	if err != nil {
-		m.logger.Warnf("Failed to get setting %v for data engine %v, using default value %d",
-			types.SettingNameDataEngineHugepageLimit, longhorn.DataEngineTypeV2, defaultHugePageLimitInMiB)
-		hugePageLimitInMiB = defaultHugePageLimitInMiB
-	}
-
+func (m *EnvironmentCheckMonitor) checkHugePages(kubeNode *corev1.Node, hugePageLimitInMiB int64, collectedData *CollectedEnvironmentCheckInfo) {
 	capacity := kubeNode.Status.Capacity
 	hugepages2MiCapacity := capacity["hugepages-2Mi"]
 	if hugepages2MiCapacity.IsZero() {
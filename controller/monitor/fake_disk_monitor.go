@@ -35,10 +35,13 @@ func NewFakeDiskMonitor(logger logrus.FieldLogger, ds *datastore.DataStore, node
 
 		syncCallback: syncCallback,
 
+		diskHealthStates: make(map[string]*diskHealthState),
+
 		getDiskStatHandler:          fakeGetDiskStat,
 		getDiskConfigHandler:        fakeGetDiskConfig,
 		generateDiskConfigHandler:   fakeGenerateDiskConfig,
 		getReplicaDataStoresHandler: fakeGetReplicaDataStores,
+		getDiskHealthHandler:        fakeGetDiskHealth,
 	}
 
 	return m, nil
@@ -50,6 +53,12 @@ func fakeGetReplicaDataStores(diskType longhorn.DiskType, node *longhorn.Node, d
 	}, nil
 }
 
+func fakeGetDiskHealth(diskType longhorn.DiskType, diskPath string, checkSMART bool) (*DiskHealthInfo, error) {
+	return &DiskHealthInfo{
+		Device: "sda",
+	}, nil
+}
+
 func fakeGetDiskStat(diskType longhorn.DiskType, name, directory string, diskDriver longhorn.DiskDriver, client *DiskServiceClient) (*lhtypes.DiskStat, error) {
 	switch diskType {
 	case longhorn.DiskTypeFilesystem:
@@ -0,0 +1,369 @@
+package monitor
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/client-go/rest"
+
+	corev1 "k8s.io/api/core/v1"
+	clientset "k8s.io/client-go/kubernetes"
+
+	"github.com/longhorn/go-iscsi-helper/iscsi"
+
+	lhns "github.com/longhorn/go-common-libs/ns"
+	lhtypes "github.com/longhorn/go-common-libs/types"
+	iscsiutil "github.com/longhorn/go-iscsi-helper/util"
+
+	"github.com/longhorn/longhorn-manager/csi"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	PreflightCheckNameDiskPathWritable = "DiskPathWritable"
+	PreflightCheckNameMountPropagation = "MountPropagation"
+	PreflightCheckNameISCSIDReachable  = "ISCSIDReachable"
+	PreflightCheckNameCSISocketPaths   = "CSISocketPaths"
+	PreflightCheckNameTimeSkew         = "TimeSkew"
+
+	// DefaultPreflightMountPropagationPath is the Longhorn directory mounted with the bidirectional propagation in the manager container.
+	DefaultPreflightMountPropagationPath = "/var/lib/longhorn/"
+
+	preflightTimeSkewWarnThreshold = 2 * time.Second
+	preflightTimeSkewFailThreshold = 30 * time.Second
+
+	selfMountInfoPath = "/proc/self/mountinfo"
+)
+
+// PreflightOptions are the inputs of the preflight checks of a node.
+type PreflightOptions struct {
+	KubeNode           *corev1.Node
+	V2DataEngine       bool
+	HugePageLimitInMiB int64
+	// DiskPaths are the paths on the host checked for writability.
+	DiskPaths []string
+	// KubeletRootDir is the root directory of kubelet on the host.
+	KubeletRootDir string
+	// MountPropagationPath is the path in the container expected to be mounted with the bidirectional propagation.
+	MountPropagationPath string
+	// ReferenceTime returns the time of the reference clock. The time skew is not checked if it is nil.
+	ReferenceTime func() (time.Time, error)
+}
+
+// RunPreflightChecks runs the environment checks and the installation requirement checks on the node, and returns
+// the report of the node.
+func RunPreflightChecks(logger logrus.FieldLogger, opts *PreflightOptions) *longhorn.PreflightNodeReport {
+	report := &longhorn.PreflightNodeReport{
+		Checks: []longhorn.PreflightCheckItem{},
+	}
+
+	conditions := RunEnvironmentChecks(logger, opts.KubeNode, opts.V2DataEngine, opts.HugePageLimitInMiB)
+	for _, condition := range conditions {
+		report.Checks = append(report.Checks, getPreflightCheckItemFromCondition(condition, opts.V2DataEngine))
+	}
+
+	for _, diskPath := range opts.DiskPaths {
+		report.Checks = append(report.Checks, checkDiskPathWritable(diskPath))
+	}
+	report.Checks = append(report.Checks, checkMountPropagation(selfMountInfoPath, opts.MountPropagationPath))
+	report.Checks = append(report.Checks, checkISCSIDReachable())
+	report.Checks = append(report.Checks, checkCSISocketPaths(opts.KubeletRootDir))
+	report.Checks = append(report.Checks, checkTimeSkew(opts.ReferenceTime))
+
+	report.Result = GetWorstPreflightCheckResult(report.Checks)
+	report.CheckedAt = util.Now()
+	return report
+}
+
+// GetWorstPreflightCheckResult returns the worst result of the checks, or pass if there is no check.
+func GetWorstPreflightCheckResult(checks []longhorn.PreflightCheckItem) longhorn.PreflightCheckResult {
+	result := longhorn.PreflightCheckResultPass
+	for _, check := range checks {
+		result = WorsePreflightCheckResult(result, check.Result)
+	}
+	return result
+}
+
+// WorsePreflightCheckResult returns the worse one of the results.
+func WorsePreflightCheckResult(a, b longhorn.PreflightCheckResult) longhorn.PreflightCheckResult {
+	rank := func(result longhorn.PreflightCheckResult) int {
+		switch result {
+		case longhorn.PreflightCheckResultFail:
+			return 2
+		case longhorn.PreflightCheckResultWarn:
+			return 1
+		}
+		return 0
+	}
+	if rank(b) > rank(a) {
+		return b
+	}
+	return a
+}
+
+func getPreflightCheckItemFromCondition(condition longhorn.Condition, isV2DataEngine bool) longhorn.PreflightCheckItem {
+	item := longhorn.PreflightCheckItem{
+		Name:    condition.Type,
+		Result:  longhorn.PreflightCheckResultPass,
+		Message: condition.Message,
+	}
+	if condition.Status == longhorn.ConditionStatusTrue {
+		return item
+	}
+
+	switch condition.Type {
+	case longhorn.NodeConditionTypeMultipathd, longhorn.NodeConditionTypeNFSClientInstalled:
+		// Only the volumes with specific configurations or the RWX volumes are affected
+		item.Result = longhorn.PreflightCheckResultWarn
+	case longhorn.NodeConditionTypeKernelModulesLoaded:
+		item.Result = longhorn.PreflightCheckResultWarn
+		if isV2DataEngine {
+			item.Result = longhorn.PreflightCheckResultFail
+		}
+	default:
+		item.Result = longhorn.PreflightCheckResultFail
+	}
+	if item.Message == "" {
+		item.Message = condition.Reason
+	}
+	return item
+}
+
+func checkDiskPathWritable(diskPath string) longhorn.PreflightCheckItem {
+	item := longhorn.PreflightCheckItem{
+		Name:   PreflightCheckNameDiskPathWritable,
+		Result: longhorn.PreflightCheckResultFail,
+	}
+
+	hostPath := types.GetReplicaMountedDataPath(diskPath)
+	info, err := os.Stat(hostPath)
+	if err != nil {
+		item.Message = fmt.Sprintf("Failed to stat disk path %v: %v", diskPath, err)
+		return item
+	}
+	if !info.IsDir() {
+		item.Message = fmt.Sprintf("Disk path %v is not a directory", diskPath)
+		return item
+	}
+
+	if err := writeTempFile(hostPath); err != nil {
+		item.Message = fmt.Sprintf("Disk path %v is not writable: %v", diskPath, err)
+		return item
+	}
+
+	item.Result = longhorn.PreflightCheckResultPass
+	item.Message = fmt.Sprintf("Disk path %v is writable", diskPath)
+	return item
+}
+
+func writeTempFile(dir string) (err error) {
+	f, err := os.CreateTemp(dir, ".longhorn-preflight-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if errRemove := os.Remove(f.Name()); errRemove != nil && err == nil {
+			err = errRemove
+		}
+	}()
+
+	if _, err := f.Write([]byte("longhorn")); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func checkMountPropagation(mountInfoPath, path string) longhorn.PreflightCheckItem {
+	item := longhorn.PreflightCheckItem{
+		Name:   PreflightCheckNameMountPropagation,
+		Result: longhorn.PreflightCheckResultFail,
+	}
+
+	f, err := os.Open(mountInfoPath)
+	if err != nil {
+		item.Message = fmt.Sprintf("Failed to read %v: %v", mountInfoPath, err)
+		return item
+	}
+	defer f.Close()
+
+	mountPoint, shared, err := isMountShared(f, path)
+	if err != nil {
+		item.Message = fmt.Sprintf("Failed to check the mount propagation of %v: %v", path, err)
+		return item
+	}
+	if !shared {
+		item.Message = fmt.Sprintf("Mount point %v of %v is not shared, the bidirectional mount propagation is required", mountPoint, path)
+		return item
+	}
+
+	item.Result = longhorn.PreflightCheckResultPass
+	item.Message = fmt.Sprintf("Mount point %v of %v is shared", mountPoint, path)
+	return item
+}
+
+// isMountShared finds the mount point of the path in the mountinfo and checks if the mount point is a shared mount,
+// which is the result of the bidirectional mount propagation.
+func isMountShared(mountInfo io.Reader, path string) (mountPoint string, shared bool, err error) {
+	path = filepath.Clean(path)
+
+	scanner := bufio.NewScanner(mountInfo)
+	for scanner.Scan() {
+		// 36 35 98:0 /mnt1 /mnt2 rw,noatime master:1 - ext3 /dev/root rw,errors=continue
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 7 {
+			continue
+		}
+		candidate := fields[4]
+		if candidate != "/" && path != candidate && !strings.HasPrefix(path, candidate+"/") {
+			continue
+		}
+		// The later mount points hide the earlier ones on the same path
+		if len(candidate) < len(mountPoint) {
+			continue
+		}
+
+		mountPoint = candidate
+		shared = false
+		for _, field := range fields[6:] {
+			if field == "-" {
+				break
+			}
+			if strings.HasPrefix(field, "shared:") {
+				shared = true
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", false, err
+	}
+	if mountPoint == "" {
+		return "", false, fmt.Errorf("no mount point found for %v", path)
+	}
+	return mountPoint, shared, nil
+}
+
+func checkISCSIDReachable() longhorn.PreflightCheckItem {
+	item := longhorn.PreflightCheckItem{
+		Name:   PreflightCheckNameISCSIDReachable,
+		Result: longhorn.PreflightCheckResultFail,
+	}
+
+	namespaces := []lhtypes.Namespace{lhtypes.NamespaceMnt, lhtypes.NamespaceNet}
+	nsexec, err := lhns.NewNamespaceExecutor(iscsiutil.ISCSIdProcess, lhtypes.HostProcDirectory, namespaces)
+	if err != nil {
+		item.Message = fmt.Sprintf("Failed to find %v: %v", iscsiutil.ISCSIdProcess, err)
+		return item
+	}
+	if err := iscsi.CheckForInitiatorExistence(nsexec); err != nil {
+		item.Message = fmt.Sprintf("Failed to reach %v: %v", iscsiutil.ISCSIdProcess, err)
+		return item
+	}
+
+	item.Result = longhorn.PreflightCheckResultPass
+	item.Message = fmt.Sprintf("%v is reachable", iscsiutil.ISCSIdProcess)
+	return item
+}
+
+func checkCSISocketPaths(kubeletRootDir string) longhorn.PreflightCheckItem {
+	item := longhorn.PreflightCheckItem{
+		Name:   PreflightCheckNameCSISocketPaths,
+		Result: longhorn.PreflightCheckResultFail,
+	}
+
+	for _, dir := range []string{kubeletRootDir, csi.GetCSIRegistrationDir(kubeletRootDir)} {
+		info, err := os.Stat(types.GetReplicaMountedDataPath(dir))
+		if err != nil {
+			item.Message = fmt.Sprintf("Failed to stat %v, the kubelet root directory may be incorrect: %v", dir, err)
+			return item
+		}
+		if !info.IsDir() {
+			item.Message = fmt.Sprintf("%v is not a directory", dir)
+			return item
+		}
+	}
+
+	item.Result = longhorn.PreflightCheckResultPass
+	socketPath := csi.GetCSISocketFilePath(kubeletRootDir)
+	if _, err := os.Stat(types.GetReplicaMountedDataPath(socketPath)); err != nil {
+		item.Message = fmt.Sprintf("The CSI registration directory is found under %v, and the CSI socket %v will be created by the CSI plugin", kubeletRootDir, socketPath)
+		return item
+	}
+	item.Message = fmt.Sprintf("The CSI registration directory and the CSI socket %v are found", socketPath)
+	return item
+}
+
+func checkTimeSkew(referenceTime func() (time.Time, error)) longhorn.PreflightCheckItem {
+	item := longhorn.PreflightCheckItem{
+		Name:   PreflightCheckNameTimeSkew,
+		Result: longhorn.PreflightCheckResultWarn,
+	}
+	if referenceTime == nil {
+		item.Message = "No reference clock to check the time skew"
+		return item
+	}
+
+	start := time.Now()
+	reference, err := referenceTime()
+	if err != nil {
+		item.Message = fmt.Sprintf("Failed to get the reference time: %v", err)
+		return item
+	}
+	end := time.Now()
+
+	// Assume the reference time is taken in the middle of the round trip
+	local := start.Add(end.Sub(start) / 2)
+	skew := local.Sub(reference)
+	if skew < 0 {
+		skew = -skew
+	}
+	skew = skew.Round(time.Second)
+
+	switch {
+	case skew > preflightTimeSkewFailThreshold:
+		item.Result = longhorn.PreflightCheckResultFail
+	case skew > preflightTimeSkewWarnThreshold:
+		item.Result = longhorn.PreflightCheckResultWarn
+	default:
+		item.Result = longhorn.PreflightCheckResultPass
+	}
+	item.Message = fmt.Sprintf("The clock of the node is off by %v from the reference clock", skew)
+	return item
+}
+
+// GetKubernetesAPIServerTime returns the time of the Kubernetes API server from the Date header of a response.
+func GetKubernetesAPIServerTime(kubeClient clientset.Interface) (time.Time, error) {
+	restClient, ok := kubeClient.Discovery().RESTClient().(*rest.RESTClient)
+	if !ok || restClient == nil || restClient.Client == nil {
+		return time.Time{}, fmt.Errorf("unsupported Kubernetes client")
+	}
+
+	url := restClient.Get().AbsPath("/version").URL()
+	resp, err := restClient.Client.Get(url.String())
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to get the version of the Kubernetes API server")
+	}
+	defer resp.Body.Close()
+
+	date := resp.Header.Get("Date")
+	if date == "" {
+		return time.Time{}, fmt.Errorf("no Date header in the response of the Kubernetes API server")
+	}
+	return http.ParseTime(date)
+}
@@ -0,0 +1,85 @@
+package monitor
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func TestIsMountShared(t *testing.T) {
+	assert := require.New(t)
+
+	mountInfo := `22 1 8:1 / / rw,relatime shared:1 - ext4 /dev/sda1 rw
+30 22 8:1 /var/lib/longhorn /var/lib/longhorn rw,relatime master:1 - ext4 /dev/sda1 rw
+31 22 8:2 / /var/lib/longhorn rw,relatime shared:5 - ext4 /dev/sdb1 rw
+32 22 8:3 / /data rw,relatime - xfs /dev/sdc1 rw
+`
+
+	mountPoint, shared, err := isMountShared(strings.NewReader(mountInfo), "/var/lib/longhorn/")
+	assert.NoError(err)
+	assert.Equal("/var/lib/longhorn", mountPoint)
+	assert.True(shared)
+
+	mountPoint, shared, err = isMountShared(strings.NewReader(mountInfo), "/data/disk-1")
+	assert.NoError(err)
+	assert.Equal("/data", mountPoint)
+	assert.False(shared)
+
+	mountPoint, shared, err = isMountShared(strings.NewReader(mountInfo), "/datastore")
+	assert.NoError(err)
+	assert.Equal("/", mountPoint)
+	assert.True(shared)
+
+	_, _, err = isMountShared(strings.NewReader(""), "/data")
+	assert.Error(err)
+}
+
+func TestGetPreflightCheckItemFromCondition(t *testing.T) {
+	assert := require.New(t)
+
+	item := getPreflightCheckItemFromCondition(longhorn.Condition{
+		Type:   longhorn.NodeConditionTypeRequiredPackages,
+		Status: longhorn.ConditionStatusTrue,
+	}, false)
+	assert.Equal(longhorn.PreflightCheckResultPass, item.Result)
+
+	item = getPreflightCheckItemFromCondition(longhorn.Condition{
+		Type:    longhorn.NodeConditionTypeRequiredPackages,
+		Status:  longhorn.ConditionStatusFalse,
+		Message: "Missing packages: [open-iscsi]",
+	}, false)
+	assert.Equal(longhorn.PreflightCheckResultFail, item.Result)
+	assert.Equal("Missing packages: [open-iscsi]", item.Message)
+
+	item = getPreflightCheckItemFromCondition(longhorn.Condition{
+		Type:   longhorn.NodeConditionTypeKernelModulesLoaded,
+		Status: longhorn.ConditionStatusFalse,
+	}, false)
+	assert.Equal(longhorn.PreflightCheckResultWarn, item.Result)
+
+	item = getPreflightCheckItemFromCondition(longhorn.Condition{
+		Type:   longhorn.NodeConditionTypeKernelModulesLoaded,
+		Status: longhorn.ConditionStatusFalse,
+	}, true)
+	assert.Equal(longhorn.PreflightCheckResultFail, item.Result)
+}
+
+func TestCheckTimeSkew(t *testing.T) {
+	assert := require.New(t)
+
+	item := checkTimeSkew(func() (time.Time, error) { return time.Now(), nil })
+	assert.Equal(longhorn.PreflightCheckResultPass, item.Result)
+
+	item = checkTimeSkew(func() (time.Time, error) { return time.Now().Add(10 * time.Second), nil })
+	assert.Equal(longhorn.PreflightCheckResultWarn, item.Result)
+
+	item = checkTimeSkew(func() (time.Time, error) { return time.Now().Add(-time.Minute), nil })
+	assert.Equal(longhorn.PreflightCheckResultFail, item.Result)
+
+	assert.Equal(longhorn.PreflightCheckResultFail, WorsePreflightCheckResult(longhorn.PreflightCheckResultWarn, longhorn.PreflightCheckResultFail))
+	assert.Equal(longhorn.PreflightCheckResultWarn, WorsePreflightCheckResult(longhorn.PreflightCheckResultWarn, longhorn.PreflightCheckResultPass))
+}
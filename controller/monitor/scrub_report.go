@@ -0,0 +1,323 @@
+package monitor
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/client-go/util/retry"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	etypes "github.com/longhorn/longhorn-engine/pkg/types"
+
+	"github.com/longhorn/longhorn-manager/constant"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/engineapi"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const scrubReportNameTimeFormat = "20060102-150405"
+
+func newScrubSnapshotResult(snapshotName string, verdict longhorn.ScrubVerdict, message string) *longhorn.ScrubSnapshotResult {
+	return &longhorn.ScrubSnapshotResult{
+		SnapshotName: snapshotName,
+		Verdict:      verdict,
+		Message:      message,
+	}
+}
+
+func newScrubSnapshotResultFromHashStatus(engine *longhorn.Engine, snapshotName string, hashStatus map[string]*longhorn.HashStatus) *longhorn.ScrubSnapshotResult {
+	replicaNames := map[string]string{}
+	for replicaName, address := range engine.Status.CurrentReplicaAddressMap {
+		replicaNames[strings.TrimPrefix(address, "tcp://")] = replicaName
+	}
+
+	result := newScrubSnapshotResult(snapshotName, "", "")
+	for address, status := range hashStatus {
+		result.Replicas = append(result.Replicas, longhorn.ScrubReplicaResult{
+			ReplicaName:       replicaNames[strings.TrimPrefix(address, "tcp://")],
+			Address:           address,
+			Checksum:          status.Checksum,
+			SilentlyCorrupted: status.SilentlyCorrupted,
+		})
+	}
+	sort.Slice(result.Replicas, func(i, j int) bool {
+		return result.Replicas[i].Address < result.Replicas[j].Address
+	})
+	return result
+}
+
+func setScrubSnapshotResultVerdicts(result *longhorn.ScrubSnapshotResult, checksum string) {
+	result.Checksum = checksum
+	result.Verdict = longhorn.ScrubVerdictHealthy
+	for i := range result.Replicas {
+		if result.Replicas[i].Checksum == checksum {
+			result.Replicas[i].Verdict = longhorn.ScrubVerdictHealthy
+			continue
+		}
+		result.Replicas[i].Verdict = longhorn.ScrubVerdictCorrupted
+		result.Verdict = longhorn.ScrubVerdictCorrupted
+	}
+}
+
+// repairCorruptedReplicas marks the corrupted replicas as failed according to the corrupted replica repair policy,
+// so the replicas will be rebuilt from the healthy ones.
+func (m *SnapshotMonitor) repairCorruptedReplicas(engine *longhorn.Engine, engineClientProxy engineapi.EngineClientProxy, result *longhorn.ScrubSnapshotResult) {
+	log := m.logger.WithField("monitor", monitorName)
+
+	corruptedReplicas := []*longhorn.ScrubReplicaResult{}
+	for i := range result.Replicas {
+		if result.Replicas[i].Verdict != longhorn.ScrubVerdictCorrupted {
+			continue
+		}
+		corruptedReplicas = append(corruptedReplicas, &result.Replicas[i])
+		m.eventRecorder.Eventf(engine, corev1.EventTypeWarning, constant.EventReasonFaulted, "Detected corrupted replica %v", result.Replicas[i].Address)
+	}
+	if len(corruptedReplicas) == 0 {
+		return
+	}
+
+	policy := types.CorruptedReplicaRepairPolicyImmediate
+	policyValue, err := m.ds.GetSettingValueExisted(types.SettingNameCorruptedReplicaRepairPolicy)
+	if err != nil {
+		log.WithError(err).Warnf("Failed to get %v setting, repairing corrupted replicas immediately", types.SettingNameCorruptedReplicaRepairPolicy)
+	} else {
+		policy = types.CorruptedReplicaRepairPolicy(policyValue)
+	}
+
+	switch policy {
+	case types.CorruptedReplicaRepairPolicyDisabled:
+		for _, replica := range corruptedReplicas {
+			replica.RepairAction = longhorn.ScrubRepairActionNone
+			replica.Message = "corrupted replica repair is disabled"
+		}
+	case types.CorruptedReplicaRepairPolicyRateLimited:
+		m.repairCorruptedReplicasRateLimited(engine, engineClientProxy, corruptedReplicas)
+	default:
+		for _, replica := range corruptedReplicas {
+			m.markCorruptedReplicaFailed(engine, engineClientProxy, replica)
+		}
+	}
+}
+
+// repairCorruptedReplicasRateLimited repairs at most one corrupted replica of the volume within the repair interval,
+// and only if the volume keeps the minimal number of healthy replicas.
+func (m *SnapshotMonitor) repairCorruptedReplicasRateLimited(engine *longhorn.Engine, engineClientProxy engineapi.EngineClientProxy,
+	corruptedReplicas []*longhorn.ScrubReplicaResult) {
+	deferAll := func(message string) {
+		for _, replica := range corruptedReplicas {
+			replica.RepairAction = longhorn.ScrubRepairActionDeferred
+			replica.Message = message
+		}
+	}
+
+	minimalHealthyReplicas, err := m.ds.GetSettingAsInt(types.SettingNameCorruptedReplicaRepairMinimalHealthyReplicas)
+	if err != nil {
+		deferAll(fmt.Sprintf("failed to get %v setting: %v", types.SettingNameCorruptedReplicaRepairMinimalHealthyReplicas, err))
+		return
+	}
+	interval, err := m.ds.GetSettingAsInt(types.SettingNameCorruptedReplicaRepairInterval)
+	if err != nil {
+		deferAll(fmt.Sprintf("failed to get %v setting: %v", types.SettingNameCorruptedReplicaRepairInterval, err))
+		return
+	}
+
+	volumeName := engine.Spec.VolumeName
+
+	m.lastCorruptedReplicaRepairedAtLock.Lock()
+	defer m.lastCorruptedReplicaRepairedAtLock.Unlock()
+
+	if lastRepairedAt, ok := m.lastCorruptedReplicaRepairedAt[volumeName]; ok {
+		nextRepairAt := lastRepairedAt.Add(time.Duration(interval) * time.Minute)
+		if time.Now().Before(nextRepairAt) {
+			deferAll(fmt.Sprintf("the next corrupted replica repair of the volume is allowed after %v", nextRepairAt.UTC().Format(time.RFC3339)))
+			return
+		}
+	}
+
+	if numOfHealthyReplicas := getNumberOfHealthyReplicas(engine); int64(numOfHealthyReplicas-1) < minimalHealthyReplicas {
+		deferAll(fmt.Sprintf("the volume would have less than %v healthy replicas", minimalHealthyReplicas))
+		return
+	}
+
+	m.markCorruptedReplicaFailed(engine, engineClientProxy, corruptedReplicas[0])
+	if corruptedReplicas[0].RepairAction == longhorn.ScrubRepairActionRebuilding {
+		m.lastCorruptedReplicaRepairedAt[volumeName] = time.Now()
+	}
+	for _, replica := range corruptedReplicas[1:] {
+		replica.RepairAction = longhorn.ScrubRepairActionDeferred
+		replica.Message = "at most one corrupted replica of the volume is repaired at a time"
+	}
+}
+
+func (m *SnapshotMonitor) markCorruptedReplicaFailed(engine *longhorn.Engine, engineClientProxy engineapi.EngineClientProxy, replica *longhorn.ScrubReplicaResult) {
+	if err := engineClientProxy.ReplicaModeUpdate(engine, replica.Address, string(etypes.ERR)); err != nil {
+		m.logger.WithField("monitor", monitorName).WithError(err).Errorf("failed to update replica %v mode to ERR", replica.Address)
+		replica.RepairAction = longhorn.ScrubRepairActionFailed
+		replica.Message = err.Error()
+		return
+	}
+	replica.RepairAction = longhorn.ScrubRepairActionRebuilding
+}
+
+// createScrubReport creates the scrub report for the periodic snapshot check run of the volume and cleans up the
+// outdated scrub reports. No scrub report is created if there is no snapshot to be checked or the history limit is 0.
+func (m *SnapshotMonitor) createScrubReport(engine *longhorn.Engine, snapshotNames []string) (string, error) {
+	if len(snapshotNames) == 0 {
+		return "", nil
+	}
+
+	historyLimit, err := m.ds.GetSettingAsInt(types.SettingNameScrubReportHistoryLimit)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get %v setting", types.SettingNameScrubReportHistoryLimit)
+	}
+	if historyLimit == 0 {
+		return "", nil
+	}
+
+	volume, err := m.ds.GetVolumeRO(engine.Spec.VolumeName)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get volume %v", engine.Spec.VolumeName)
+	}
+
+	scrubReport, err := m.ds.CreateScrubReport(&longhorn.ScrubReport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("%s-scrub-%s", volume.Name, time.Now().UTC().Format(scrubReportNameTimeFormat)),
+			Labels:          types.GetVolumeLabels(volume.Name),
+			OwnerReferences: datastore.GetOwnerReferencesForVolume(volume),
+		},
+		Spec: longhorn.ScrubReportSpec{
+			VolumeName: volume.Name,
+			Snapshots:  snapshotNames,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	scrubReport.Status.OwnerID = m.nodeName
+	scrubReport.Status.State = longhorn.ScrubReportStateInProgress
+	scrubReport.Status.StartedAt = util.Now()
+	if _, err := m.ds.UpdateScrubReportStatus(scrubReport); err != nil {
+		return "", errors.Wrapf(err, "failed to update status for scrub report %v", scrubReport.Name)
+	}
+
+	if err := m.cleanupScrubReports(volume.Name, scrubReport.Name, historyLimit); err != nil {
+		m.logger.WithField("monitor", monitorName).WithError(err).Warnf("Failed to clean up scrub reports for volume %v", volume.Name)
+	}
+
+	return scrubReport.Name, nil
+}
+
+// cleanupScrubReports aborts the unfinished previous scrub reports and deletes the oldest scrub reports exceeding the history limit.
+func (m *SnapshotMonitor) cleanupScrubReports(volumeName, currentScrubReportName string, historyLimit int64) error {
+	scrubReports, err := m.ds.ListVolumeScrubReportsRO(volumeName)
+	if err != nil {
+		return err
+	}
+	sort.Slice(scrubReports, func(i, j int) bool {
+		if !scrubReports[i].CreationTimestamp.Equal(&scrubReports[j].CreationTimestamp) {
+			return scrubReports[i].CreationTimestamp.Before(&scrubReports[j].CreationTimestamp)
+		}
+		return scrubReports[i].Name < scrubReports[j].Name
+	})
+
+	for i, scrubReport := range scrubReports {
+		if scrubReport.Name == currentScrubReportName {
+			continue
+		}
+		if int64(len(scrubReports)-i) > historyLimit {
+			if err := m.ds.DeleteScrubReport(scrubReport.Name); err != nil && !datastore.ErrorIsNotFound(err) {
+				return errors.Wrapf(err, "failed to delete scrub report %v", scrubReport.Name)
+			}
+			continue
+		}
+		if scrubReport.Status.State == longhorn.ScrubReportStateInProgress {
+			abortedScrubReport := scrubReport.DeepCopy()
+			abortedScrubReport.Status.State = longhorn.ScrubReportStateAborted
+			abortedScrubReport.Status.CompletedAt = util.Now()
+			if _, err := m.ds.UpdateScrubReportStatus(abortedScrubReport); err != nil {
+				return errors.Wrapf(err, "failed to abort scrub report %v", scrubReport.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// recordScrubSnapshotResult adds the check result of the snapshot to the scrub report of the task.
+// The scrub report is completed once all snapshots of the run are recorded. Other snapshots of the run are recorded
+// concurrently, so the report is read from the API server again on conflicts.
+func (m *SnapshotMonitor) recordScrubSnapshotResult(task snapshotCheckTask, result *longhorn.ScrubSnapshotResult, checkErr error) error {
+	if task.scrubReportName == "" {
+		return nil
+	}
+
+	if result == nil {
+		result = newScrubSnapshotResult(task.snapshotName, longhorn.ScrubVerdictError, "")
+	}
+	if checkErr != nil {
+		if result.Verdict == "" {
+			result.Verdict = longhorn.ScrubVerdictError
+		}
+		if result.Message == "" {
+			result.Message = checkErr.Error()
+		}
+	}
+	result.CheckedAt = util.Now()
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		scrubReport, err := m.ds.GetScrubReportUncached(task.scrubReportName)
+		if err != nil {
+			if datastore.ErrorIsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if scrubReport.Status.State != longhorn.ScrubReportStateInProgress {
+			return nil
+		}
+
+		scrubReport.Status.Snapshots = append(scrubReport.Status.Snapshots, *result)
+		updateScrubReportCounts(scrubReport)
+		if len(scrubReport.Status.Snapshots) >= len(scrubReport.Spec.Snapshots) {
+			scrubReport.Status.State = longhorn.ScrubReportStateCompleted
+			scrubReport.Status.CompletedAt = util.Now()
+		}
+
+		_, err = m.ds.UpdateScrubReportStatus(scrubReport)
+		return err
+	})
+	return errors.Wrapf(err, "failed to record the result of snapshot %v in scrub report %v", task.snapshotName, task.scrubReportName)
+}
+
+func updateScrubReportCounts(scrubReport *longhorn.ScrubReport) {
+	scrubReport.Status.CorruptedSnapshotCount = 0
+	scrubReport.Status.CorruptedReplicaCount = 0
+	scrubReport.Status.RepairedReplicaCount = 0
+
+	corruptedReplicas := map[string]struct{}{}
+	repairedReplicas := map[string]struct{}{}
+	for _, snapshot := range scrubReport.Status.Snapshots {
+		if snapshot.Verdict == longhorn.ScrubVerdictCorrupted {
+			scrubReport.Status.CorruptedSnapshotCount++
+		}
+		for _, replica := range snapshot.Replicas {
+			if replica.Verdict == longhorn.ScrubVerdictCorrupted {
+				corruptedReplicas[replica.Address] = struct{}{}
+			}
+			if replica.RepairAction == longhorn.ScrubRepairActionRebuilding {
+				repairedReplicas[replica.Address] = struct{}{}
+			}
+		}
+	}
+	scrubReport.Status.CorruptedReplicaCount = len(corruptedReplicas)
+	scrubReport.Status.RepairedReplicaCount = len(repairedReplicas)
+}
@@ -0,0 +1,121 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kubernetes/pkg/controller"
+
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntimeschema "k8s.io/apimachinery/pkg/runtime/schema"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+)
+
+func TestScrubSnapshotResultVerdicts(t *testing.T) {
+	assert := require.New(t)
+
+	engine := &longhorn.Engine{
+		Status: longhorn.EngineStatus{
+			CurrentReplicaAddressMap: map[string]string{
+				"replica-a": "10.0.0.1:10000",
+				"replica-b": "10.0.0.2:10000",
+				"replica-c": "10.0.0.3:10000",
+			},
+		},
+	}
+	hashStatus := map[string]*longhorn.HashStatus{
+		"tcp://10.0.0.3:10000": {State: "Completed", Checksum: "abc"},
+		"tcp://10.0.0.1:10000": {State: "Completed", Checksum: "abc"},
+		"tcp://10.0.0.2:10000": {State: "Completed", Checksum: "def", SilentlyCorrupted: true},
+	}
+
+	result := newScrubSnapshotResultFromHashStatus(engine, "snap-01", hashStatus)
+	setScrubSnapshotResultVerdicts(result, "abc")
+
+	assert.Equal("abc", result.Checksum)
+	assert.Equal(longhorn.ScrubVerdictCorrupted, result.Verdict)
+	assert.Len(result.Replicas, 3)
+	assert.Equal("replica-a", result.Replicas[0].ReplicaName)
+	assert.Equal(longhorn.ScrubVerdictHealthy, result.Replicas[0].Verdict)
+	assert.Equal("replica-b", result.Replicas[1].ReplicaName)
+	assert.Equal(longhorn.ScrubVerdictCorrupted, result.Replicas[1].Verdict)
+	assert.True(result.Replicas[1].SilentlyCorrupted)
+	assert.Equal(longhorn.ScrubVerdictHealthy, result.Replicas[2].Verdict)
+
+	result.Replicas[1].RepairAction = longhorn.ScrubRepairActionRebuilding
+	scrubReport := &longhorn.ScrubReport{
+		Status: longhorn.ScrubReportStatus{
+			Snapshots: []longhorn.ScrubSnapshotResult{
+				*result,
+				*result,
+				*newScrubSnapshotResult("snap-02", longhorn.ScrubVerdictHealthy, ""),
+			},
+		},
+	}
+	updateScrubReportCounts(scrubReport)
+	assert.Equal(2, scrubReport.Status.CorruptedSnapshotCount)
+	assert.Equal(1, scrubReport.Status.CorruptedReplicaCount)
+	assert.Equal(1, scrubReport.Status.RepairedReplicaCount)
+}
+
+const testScrubReportNamespace = "longhorn-system"
+
+func TestRecordScrubSnapshotResultOnConflict(t *testing.T) {
+	assert := require.New(t)
+	datastore.SkipListerCheck = true
+
+	newMonitor := func(conflicts int) (*SnapshotMonitor, *lhfake.Clientset) {
+		kubeClient := fake.NewSimpleClientset()
+		lhClient := lhfake.NewSimpleClientset()
+		extensionsClient := apiextensionsfake.NewSimpleClientset()
+		informerFactories := util.NewInformerFactories(testScrubReportNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+		ds := datastore.NewDataStore(testScrubReportNamespace, lhClient, kubeClient, extensionsClient, informerFactories)
+
+		_, err := lhClient.LonghornV1beta2().ScrubReports(testScrubReportNamespace).Create(context.TODO(), &longhorn.ScrubReport{
+			ObjectMeta: metav1.ObjectMeta{Name: "scrub-report", Namespace: testScrubReportNamespace},
+			Spec:       longhorn.ScrubReportSpec{VolumeName: "volume", Snapshots: []string{"snap-01", "snap-02"}},
+			Status:     longhorn.ScrubReportStatus{State: longhorn.ScrubReportStateInProgress},
+		}, metav1.CreateOptions{})
+		assert.NoError(err)
+
+		// Other writers update the report first for the given number of times
+		lhClient.PrependReactor("update", "scrubreports", func(action clienttesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "status" || conflicts == 0 {
+				return false, nil, nil
+			}
+			conflicts--
+			return true, nil, apierrors.NewConflict(k8sruntimeschema.GroupResource{Resource: "scrubreports"}, "scrub-report", nil)
+		})
+
+		return &SnapshotMonitor{baseMonitor: &baseMonitor{logger: logrus.StandardLogger(), ds: ds}}, lhClient
+	}
+	task := snapshotCheckTask{volumeName: "volume", snapshotName: "snap-01", scrubReportName: "scrub-report"}
+
+	m, lhClient := newMonitor(2)
+	err := m.recordScrubSnapshotResult(task, newScrubSnapshotResult("snap-01", longhorn.ScrubVerdictHealthy, ""), nil)
+	assert.NoError(err)
+	scrubReport, err := lhClient.LonghornV1beta2().ScrubReports(testScrubReportNamespace).Get(context.TODO(), "scrub-report", metav1.GetOptions{})
+	assert.NoError(err)
+	assert.Len(scrubReport.Status.Snapshots, 1)
+	assert.Equal("snap-01", scrubReport.Status.Snapshots[0].SnapshotName)
+	assert.Equal(longhorn.ScrubReportStateInProgress, scrubReport.Status.State)
+
+	m, _ = newMonitor(100)
+	err = m.recordScrubSnapshotResult(task, newScrubSnapshotResult("snap-01", longhorn.ScrubVerdictHealthy, ""), nil)
+	assert.Error(err)
+	assert.True(apierrors.IsConflict(errors.Cause(err)))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -52,9 +53,10 @@ type SnapshotChangeEvent struct {
 }
 
 type snapshotCheckTask struct {
-	volumeName   string
-	snapshotName string
-	changeEvent  bool
+	volumeName      string
+	snapshotName    string
+	changeEvent     bool
+	scrubReportName string
 }
 
 type SnapshotMonitorStatus struct {
@@ -78,6 +80,9 @@ type SnapshotMonitor struct {
 
 	existingDataIntegrityCronJob string
 
+	lastCorruptedReplicaRepairedAt     map[string]time.Time
+	lastCorruptedReplicaRepairedAtLock sync.Mutex
+
 	syncCallback func(key string)
 
 	proxyConnCounter util.Counter
@@ -108,6 +113,8 @@ func NewSnapshotMonitor(logger logrus.FieldLogger, ds *datastore.DataStore, node
 
 		inProgressSnapshotCheckTasks: map[string]struct{}{},
 
+		lastCorruptedReplicaRepairedAt: map[string]time.Time{},
+
 		syncCallback:     syncCallback,
 		proxyConnCounter: util.NewAtomicCounter(),
 	}
@@ -173,19 +180,29 @@ func (m *SnapshotMonitor) checkSnapshots() {
 }
 
 func (m *SnapshotMonitor) populateEngineSnapshots(engine *longhorn.Engine) {
-	snapshots := engine.Status.Snapshots
-	for _, snapshot := range snapshots {
+	snapshotNames := []string{}
+	for _, snapshot := range engine.Status.Snapshots {
 		// Skip volume-head because it is not a real snapshot.
 		// A system-generated snapshot is also ignored, because the prune operations the snapshots are out of
 		// sync during replica rebuilding. More investigation is in https://github.com/longhorn/longhorn/issues/4513
 		if snapshot.Name == etypes.VolumeHeadName || !snapshot.UserCreated {
 			continue
 		}
+		snapshotNames = append(snapshotNames, snapshot.Name)
+	}
+	sort.Strings(snapshotNames)
 
+	scrubReportName, err := m.createScrubReport(engine, snapshotNames)
+	if err != nil {
+		m.logger.WithField("monitor", monitorName).WithError(err).Warnf("Failed to create scrub report for volume %v", engine.Spec.VolumeName)
+	}
+
+	for _, snapshotName := range snapshotNames {
 		m.snapshotCheckTaskQueue.Add(snapshotCheckTask{
-			volumeName:   engine.Spec.VolumeName,
-			snapshotName: snapshot.Name,
-			changeEvent:  false,
+			volumeName:      engine.Spec.VolumeName,
+			snapshotName:    snapshotName,
+			changeEvent:     false,
+			scrubReportName: scrubReportName,
 		})
 	}
 }
@@ -198,43 +215,54 @@ func (m *SnapshotMonitor) processNextWorkItem(id int) bool {
 	defer m.snapshotCheckTaskQueue.Done(key)
 
 	task := key.(snapshotCheckTask)
+	recordScrubSnapshotResult := func(result *longhorn.ScrubSnapshotResult, checkErr error) {
+		if err := m.recordScrubSnapshotResult(task, result, checkErr); err != nil {
+			m.logger.WithField("monitor", monitorName).WithError(err).Warn("Failed to record scrub snapshot result")
+		}
+	}
 
 	dataIntegrity, err := m.ds.GetVolumeSnapshotDataIntegrity(task.volumeName)
 	if err != nil {
+		recordScrubSnapshotResult(nil, err)
 		return true
 	}
 
 	if dataIntegrity == longhorn.SnapshotDataIntegrityDisabled {
+		recordScrubSnapshotResult(newScrubSnapshotResult(task.snapshotName, longhorn.ScrubVerdictSkipped, "snapshot data integrity check is disabled"), nil)
 		return true
 	}
 
-	err = m.run(task)
-	m.handleErr(err, key)
+	result, err := m.run(task)
+	if requeued := m.handleErr(err, key); !requeued {
+		recordScrubSnapshotResult(result, err)
+	}
 
 	return true
 }
 
-func (m *SnapshotMonitor) handleErr(err error, key interface{}) {
+// handleErr returns true if the task is requeued for retry.
+func (m *SnapshotMonitor) handleErr(err error, key interface{}) bool {
 	if err == nil {
 		m.snapshotCheckTaskQueue.Forget(key)
-		return
+		return false
 	}
 
 	if !strings.Contains(err.Error(), etypes.CannotRequestHashingSnapshotPrefix) {
 		m.snapshotCheckTaskQueue.Forget(key)
-		return
+		return false
 	}
 
 	if m.snapshotCheckTaskQueue.NumRequeues(key) < snapshotHashMaxRetries {
 		m.logger.WithError(err).Warnf("Error syncing snapshot check task %v", key)
 		m.snapshotCheckTaskQueue.AddRateLimited(key)
-		return
+		return true
 	}
 
 	utilruntime.HandleError(err)
 
 	m.logger.WithError(err).Warnf("Dropping hashing request of snapshot %v", key)
 	m.snapshotCheckTaskQueue.Forget(key)
+	return false
 }
 
 func (m *SnapshotMonitor) snapshotCheckWorker(id int) {
@@ -326,47 +354,47 @@ func getNumberOfHealthyReplicas(e *longhorn.Engine) int {
 	return numOfHealthyReplicas
 }
 
-func (m *SnapshotMonitor) run(arg interface{}) error {
+func (m *SnapshotMonitor) run(arg interface{}) (*longhorn.ScrubSnapshotResult, error) {
 	task, ok := arg.(snapshotCheckTask)
 	if !ok {
-		return fmt.Errorf("failed to assert value: %v", arg)
+		return nil, fmt.Errorf("failed to assert value: %v", arg)
 	}
 
 	if !m.shouldAddToInProgressSnapshotCheckTasks(task.snapshotName) {
-		return nil
+		return newScrubSnapshotResult(task.snapshotName, longhorn.ScrubVerdictSkipped, "snapshot is being checked by another task"), nil
 	}
 	defer m.deleteFromInProgressSnapshotCheckTasks(task.snapshotName)
 
 	engine, err := m.ds.GetVolumeCurrentEngine(task.volumeName)
 	if err != nil {
-		return errors.Wrapf(err, "failed to get engine for volume %v", task.volumeName)
+		return nil, errors.Wrapf(err, "failed to get engine for volume %v", task.volumeName)
 	}
 
 	// Skip snapshot hashing if the volume has less than 2 healthy replicas
 	numOfHealthyReplicas := getNumberOfHealthyReplicas(engine)
 	if numOfHealthyReplicas < 2 {
 		m.logger.WithField("monitor", monitorName).Debugf("Skipping snapshot calculation for volume %v since it has less than 2 healthy replicas", task.volumeName)
-		return nil
+		return newScrubSnapshotResult(task.snapshotName, longhorn.ScrubVerdictSkipped, "volume has less than 2 healthy replicas"), nil
 	}
 
 	if err := m.canRequestSnapshotHash(engine); err != nil {
-		return errors.Wrapf(err, etypes.CannotRequestHashingSnapshotPrefix)
+		return nil, errors.Wrapf(err, etypes.CannotRequestHashingSnapshotPrefix)
 	}
 
 	engineCliClient, err := engineapi.GetEngineBinaryClient(m.ds, engine.Spec.VolumeName, m.nodeName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	engineClientProxy, err := engineapi.GetCompatibleClient(engine, engineCliClient, m.ds, m.logger, m.proxyConnCounter)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer engineClientProxy.Close()
 
 	err = m.requestSnapshotHashing(engine, engineClientProxy, task.snapshotName, task.changeEvent)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	return m.waitAndHandleSnapshotHashing(engine, engineClientProxy, task.snapshotName)
@@ -413,7 +441,7 @@ func (m *SnapshotMonitor) requestSnapshotHashing(engine *longhorn.Engine, engine
 }
 
 func (m *SnapshotMonitor) waitAndHandleSnapshotHashing(engine *longhorn.Engine, engineClientProxy engineapi.EngineClientProxy,
-	snapshotName string) error {
+	snapshotName string) (*longhorn.ScrubSnapshotResult, error) {
 	opts := []retry.Option{
 		retry.Context(m.ctx),
 		retry.Attempts(snapshotHashSyncStatusAttempts),
@@ -429,13 +457,15 @@ func (m *SnapshotMonitor) waitAndHandleSnapshotHashing(engine *longhorn.Engine,
 	}
 
 	// retry does periodically fetching and syncing the snapshot hashing status.
+	var result *longhorn.ScrubSnapshotResult
 	if err := retry.Do(func() (err error) {
-		return m.syncHashStatusFromEngineReplicas(engine, engineClientProxy, snapshotName)
+		result, err = m.syncHashStatusFromEngineReplicas(engine, engineClientProxy, snapshotName)
+		return err
 	}, opts...); err != nil {
-		return errors.Wrapf(err, "failed to sync hash status for snapshot %v since %v", snapshotName, err)
+		return result, errors.Wrapf(err, "failed to sync hash status for snapshot %v since %v", snapshotName, err)
 	}
 
-	return nil
+	return result, nil
 }
 
 func (m *SnapshotMonitor) checkVolumeNotInMigration(volumeName string) error {
@@ -468,61 +498,52 @@ func (m *SnapshotMonitor) checkVolumeIsNotRestoring(engine *longhorn.Engine) err
 }
 
 func (m *SnapshotMonitor) syncHashStatusFromEngineReplicas(engine *longhorn.Engine, engineClientProxy engineapi.EngineClientProxy,
-	snapshotName string) error {
+	snapshotName string) (*longhorn.ScrubSnapshotResult, error) {
 	hashStatus, err := engineClientProxy.SnapshotHashStatus(engine, snapshotName)
 	if err != nil {
-		return errors.Wrapf(err, "failed to get hash status for snapshot %v", snapshotName)
+		return nil, errors.Wrapf(err, "failed to get hash status for snapshot %v", snapshotName)
 	}
 
 	for _, status := range hashStatus {
 		if status.State == string(longhorn.SnapshotHashStatusError) {
-			return fmt.Errorf("failed to hash snapshot %v since %v", snapshotName, status.Error)
+			return nil, fmt.Errorf("failed to hash snapshot %v since %v", snapshotName, status.Error)
 		}
 
 		if status.State == string(engineapi.ProcessStateInProgress) {
-			return errors.New(string(engineapi.ProcessStateInProgress))
+			return nil, errors.New(string(engineapi.ProcessStateInProgress))
 		}
 	}
 
 	snapshot, err := m.ds.GetSnapshot(snapshotName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	existingSnapshot := snapshot.DeepCopy()
 
+	result := newScrubSnapshotResultFromHashStatus(engine, snapshotName, hashStatus)
+
 	checksum, err := determineChecksumFromHashStatus(m.logger, snapshotName, snapshot.Status.Checksum, hashStatus)
 	if err != nil {
 		m.eventRecorder.Eventf(engine, corev1.EventTypeWarning, constant.EventReasonFailedSnapshotDataIntegrityCheck,
 			"Failed to check the data integrity of snapshot %v for volume %v", snapshotName, engine.Spec.VolumeName)
-		return errors.Wrapf(err, "failed to determine checksum for snapshot %v", snapshotName)
+		result.Verdict = longhorn.ScrubVerdictUndetermined
+		result.Message = err.Error()
+		return result, errors.Wrapf(err, "failed to determine checksum for snapshot %v", snapshotName)
 	}
 
 	snapshot.Status.Checksum = checksum
 
 	if !reflect.DeepEqual(existingSnapshot.Status, snapshot.Status) {
 		if _, err := m.ds.UpdateSnapshotStatus(snapshot); err != nil {
-			return errors.Wrapf(err, "failed to update status for snapshot %v", snapshotName)
+			return nil, errors.Wrapf(err, "failed to update status for snapshot %v", snapshotName)
 		}
 	}
 
-	m.kickOutCorruptedReplicas(engine, engineClientProxy, checksum, hashStatus)
-
-	return nil
-}
-
-func (m *SnapshotMonitor) kickOutCorruptedReplicas(engine *longhorn.Engine, engineClientProxy engineapi.EngineClientProxy,
-	checksum string, hashStatus map[string]*longhorn.HashStatus) {
-	for address, status := range hashStatus {
-		if status.Checksum == checksum {
-			continue
-		}
+	setScrubSnapshotResultVerdicts(result, checksum)
 
-		m.eventRecorder.Eventf(engine, corev1.EventTypeWarning, constant.EventReasonFaulted, "Detected corrupted replica %v", address)
+	m.repairCorruptedReplicas(engine, engineClientProxy, result)
 
-		if err := engineClientProxy.ReplicaModeUpdate(engine, address, string(etypes.ERR)); err != nil {
-			m.logger.WithField("monitor", monitorName).Errorf("failed to update replica %v mode to ERR", address)
-		}
-	}
+	return result, nil
 }
 
 func determineChecksumFromHashStatus(log logrus.FieldLogger, snapshotName, existingChecksum string, hashStatus map[string]*longhorn.HashStatus) (string, error) {
@@ -2,7 +2,9 @@ package controller
 
 import (
 	"fmt"
+	"math"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -32,6 +34,7 @@ import (
 	"github.com/longhorn/longhorn-manager/scheduler"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/util/capacityforecast"
 
 	"github.com/longhorn/longhorn-manager/controller/monitor"
 
@@ -45,6 +48,10 @@ const (
 	unknownDiskID = "UNKNOWN_DISKID"
 
 	snapshotChangeEventQueueMax = 1048576
+
+	capacityForecastSampleInterval = 10 * time.Minute
+	// capacityHistorySaveInterval is the interval of the samples saved in the disk status
+	capacityHistorySaveInterval = time.Hour
 )
 
 type NodeController struct {
@@ -71,6 +78,10 @@ type NodeController struct {
 	topologyLabelsChecker TopologyLabelsChecker
 
 	scheduler *scheduler.ReplicaScheduler
+
+	// capacityHistories are the usage histories of the disks on the current node, keyed by the disk UUIDs
+	capacityHistories     map[string]*capacityforecast.History
+	capacityHistoriesLock sync.Mutex
 }
 
 type TopologyLabelsChecker func(kubeClient clientset.Interface, vers string) (bool, error)
@@ -101,6 +112,8 @@ func NewNodeController(
 		topologyLabelsChecker: util.IsKubernetesVersionAtLeast,
 
 		snapshotChangeEventQueue: workqueue.NewTyped[any](),
+
+		capacityHistories: map[string]*capacityforecast.History{},
 	}
 
 	nc.scheduler = scheduler.NewReplicaScheduler(ds)
@@ -196,7 +209,11 @@ func (nc *NodeController) isResponsibleForSetting(obj interface{}) bool {
 	return types.SettingName(setting.Name) == types.SettingNameStorageMinimalAvailablePercentage ||
 		types.SettingName(setting.Name) == types.SettingNameBackingImageCleanupWaitInterval ||
 		types.SettingName(setting.Name) == types.SettingNameOrphanResourceAutoDeletion ||
-		types.SettingName(setting.Name) == types.SettingNameNodeDrainPolicy
+		types.SettingName(setting.Name) == types.SettingNameNodeDrainPolicy ||
+		types.SettingName(setting.Name) == types.SettingNameNodeSettingOverrides ||
+		types.SettingName(setting.Name) == types.SettingNameDiskHealthPolicy ||
+		types.SettingName(setting.Name) == types.SettingNameCapacityForecastWarningThreshold ||
+		types.SettingName(setting.Name) == types.SettingNameReplicaCountLimitPerDisk
 }
 
 func (nc *NodeController) isResponsibleForReplica(obj interface{}) bool {
@@ -502,6 +519,10 @@ func (nc *NodeController) syncNode(key string) (err error) {
 		return err
 	}
 
+	if err := nc.syncCapacityForecast(node); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -654,11 +675,13 @@ func (nc *NodeController) syncDiskStatus(node *longhorn.Node, collectedDataInfo
 
 	for _, diskInfoMap := range notReadyDiskInfoMap {
 		nc.updateNotReadyDiskStatusReadyCondition(node, diskInfoMap)
+		nc.updateDiskStatusHealthyCondition(node, diskInfoMap, false)
 	}
 
 	for _, diskInfoMap := range readyDiskInfoMap {
 		nc.updateReadyDiskStatusReadyCondition(node, diskInfoMap)
 		nc.updateDiskStatusFileSystemType(node, diskInfoMap)
+		nc.updateDiskStatusHealthyCondition(node, diskInfoMap, true)
 	}
 
 	return nc.updateDiskStatusSchedulableCondition(node)
@@ -820,6 +843,39 @@ func (nc *NodeController) updateReadyDiskStatusReadyCondition(node *longhorn.Nod
 	}
 }
 
+// updateDiskStatusHealthyCondition sets the Healthy condition collected by the disk monitor. The condition is
+// removed if the disk is not ready or the disk health monitoring is disabled.
+func (nc *NodeController) updateDiskStatusHealthyCondition(node *longhorn.Node, diskInfoMap map[string]*monitor.CollectedDiskInfo, diskReady bool) {
+	for diskName, info := range diskInfoMap {
+		diskStatus, ok := node.Status.DiskStatus[diskName]
+		if !ok {
+			continue
+		}
+		if !diskReady || info.HealthCondition == nil || diskStatus.DiskUUID != info.DiskUUID {
+			diskStatus.Conditions = types.RemoveCondition(diskStatus.Conditions, longhorn.DiskConditionTypeHealthy)
+			continue
+		}
+
+		// Only record the transitions from or to unhealthy.
+		existingStatus := types.GetCondition(diskStatus.Conditions, longhorn.DiskConditionTypeHealthy).Status
+		if info.HealthCondition.Status != longhorn.ConditionStatusFalse && existingStatus != longhorn.ConditionStatusFalse {
+			diskStatus.Conditions = types.SetCondition(diskStatus.Conditions,
+				longhorn.DiskConditionTypeHealthy, info.HealthCondition.Status,
+				info.HealthCondition.Reason, info.HealthCondition.Message)
+			continue
+		}
+
+		eventType := corev1.EventTypeNormal
+		if info.HealthCondition.Status == longhorn.ConditionStatusFalse {
+			eventType = corev1.EventTypeWarning
+		}
+		diskStatus.Conditions = types.SetConditionAndRecord(diskStatus.Conditions,
+			longhorn.DiskConditionTypeHealthy, info.HealthCondition.Status,
+			info.HealthCondition.Reason, info.HealthCondition.Message,
+			nc.eventRecorder, node, eventType)
+	}
+}
+
 func (nc *NodeController) updateDiskStatusFileSystemType(node *longhorn.Node, diskInfoMap map[string]*monitor.CollectedDiskInfo) {
 	diskStatusMap := node.Status.DiskStatus
 	for diskName, info := range diskInfoMap {
@@ -848,6 +904,7 @@ func (nc *NodeController) updateDiskStatusSchedulableCondition(node *longhorn.No
 		if types.GetCondition(diskStatus.Conditions, longhorn.DiskConditionTypeReady).Status != longhorn.ConditionStatusTrue {
 			diskStatus.StorageScheduled = 0
 			diskStatus.ScheduledReplica = map[string]int64{}
+			diskStatus.ReplicaCount = 0
 			diskStatus.Conditions = types.SetConditionAndRecord(diskStatus.Conditions,
 				longhorn.DiskConditionTypeSchedulable, longhorn.ConditionStatusFalse,
 				string(longhorn.DiskConditionReasonDiskNotReady),
@@ -904,9 +961,16 @@ func (nc *NodeController) updateDiskStatusSchedulableCondition(node *longhorn.No
 			diskStatus.StorageScheduled = storageScheduled
 			diskStatus.ScheduledReplica = scheduledReplica
 			diskStatus.ScheduledBackingImage = scheduledBackingImage
+			diskStatus.ReplicaCount = len(scheduledReplica)
+
+			replicaCountLimit, err := nc.scheduler.GetDiskReplicaCountLimit(node.Name, disk)
+			if err != nil {
+				return err
+			}
+			diskStatus.ReplicaCountLimit = replicaCountLimit
 
 			// check disk pressure
-			info, err := nc.scheduler.GetDiskSchedulingInfo(disk, diskStatus)
+			info, err := nc.scheduler.GetDiskSchedulingInfo(node.Name, disk, diskStatus)
 			if err != nil {
 				return err
 			}
@@ -1727,6 +1791,15 @@ func (nc *NodeController) syncReplicaEvictionRequested(node *longhorn.Node, kube
 	if err != nil {
 		return errors.Wrapf(err, "failed to get %v setting", types.SettingNameNodeDrainPolicy)
 	}
+	diskHealthPolicy, err := nc.ds.GetSettingValueExisted(types.SettingNameDiskHealthPolicy)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %v setting", types.SettingNameDiskHealthPolicy)
+	}
+	if types.DiskHealthPolicy(diskHealthPolicy) == types.DiskHealthPolicyEvictReplicas {
+		if err := nc.requestUnhealthyDiskEviction(node); err != nil {
+			return err
+		}
+	}
 
 	type replicaToSync struct {
 		*longhorn.Replica
@@ -1782,6 +1855,43 @@ func (nc *NodeController) syncReplicaEvictionRequested(node *longhorn.Node, kube
 	return nil
 }
 
+// requestUnhealthyDiskEviction disables the scheduling and requests the eviction of the disks found unhealthy, so the
+// eviction is shown on the disks and stays requested until the user re-enables the disks after fixing them.
+func (nc *NodeController) requestUnhealthyDiskEviction(node *longhorn.Node) error {
+	unhealthyDisks := map[string]string{}
+	for diskName, diskSpec := range node.Spec.Disks {
+		diskStatus := node.Status.DiskStatus[diskName]
+		if diskSpec.EvictionRequested || diskStatus == nil {
+			continue
+		}
+		condition := types.GetCondition(diskStatus.Conditions, longhorn.DiskConditionTypeHealthy)
+		if condition.Status != longhorn.ConditionStatusFalse {
+			continue
+		}
+		diskSpec.AllowScheduling = false
+		diskSpec.EvictionRequested = true
+		node.Spec.Disks[diskName] = diskSpec
+		unhealthyDisks[diskName] = condition.Message
+	}
+	if len(unhealthyDisks) == 0 {
+		return nil
+	}
+
+	updatedNode, err := nc.ds.UpdateNode(node)
+	if err != nil {
+		return errors.Wrapf(err, "failed to request the eviction of unhealthy disks on node %v", node.Name)
+	}
+	// Keep the status update of the sync from conflicting with the spec update
+	node.ResourceVersion = updatedNode.ResourceVersion
+
+	for diskName, message := range unhealthyDisks {
+		getLoggerForNode(nc.logger, node).WithField("disk", diskName).Warnf("Requesting eviction of unhealthy disk: %v", message)
+		nc.eventRecorder.Eventf(node, corev1.EventTypeWarning, constant.EventReasonEvictionAutomatic,
+			"Disabled scheduling and requested eviction of unhealthy disk %v on node %v: %v", diskName, node.Name, message)
+	}
+	return nil
+}
+
 func (nc *NodeController) shouldEvictReplica(node *longhorn.Node, kubeNode *corev1.Node, diskSpec *longhorn.DiskSpec,
 	replica *longhorn.Replica, nodeDrainPolicy string) (bool, string, error) {
 	// Replica eviction was cancelled on down or deleted nodes in previous implementations. It seems safest to continue
@@ -1798,6 +1908,13 @@ func (nc *NodeController) shouldEvictReplica(node *longhorn.Node, kubeNode *core
 	if node.Spec.EvictionRequested || diskSpec.EvictionRequested {
 		return true, constant.EventReasonEvictionUserRequested, nil
 	}
+	if volume, err := nc.ds.GetVolumeRO(replica.Spec.VolumeName); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, "", err
+		}
+	} else if isReplicaTieringMigrating(volume, replica) {
+		return true, constant.EventReasonEvictionTiering, nil
+	}
 	if !kubeNode.Spec.Unschedulable {
 		// Node drain policy only takes effect on cordoned nodes.
 		return false, constant.EventReasonEvictionCanceled, nil
@@ -1995,3 +2112,204 @@ func (nc *NodeController) clearDelinquentLeasesIfNodeNotReady(node *longhorn.Nod
 
 	return storedError
 }
+
+// syncCapacityForecast samples the storage usage of the disks, fits the growth trends and estimates when the disks run out of storage.
+func (nc *NodeController) syncCapacityForecast(node *longhorn.Node) error {
+	historyWindow, err := nc.ds.GetSettingAsInt(types.SettingNameCapacityForecastHistoryWindow)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %v setting", types.SettingNameCapacityForecastHistoryWindow)
+	}
+	overProvisioningPercentage, err := nc.ds.GetNodeSettingAsInt(types.SettingNameStorageOverProvisioningPercentage, node.Name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %v setting", types.SettingNameStorageOverProvisioningPercentage)
+	}
+
+	actualSizes, err := nc.getDiskActualSizes(node)
+	if err != nil {
+		return err
+	}
+
+	nc.capacityHistoriesLock.Lock()
+	defer nc.capacityHistoriesLock.Unlock()
+
+	now := time.Now().UTC()
+	existingDiskUUIDs := map[string]bool{}
+	for diskName, diskStatus := range node.Status.DiskStatus {
+		diskSpec, exists := node.Spec.Disks[diskName]
+		if !exists || diskStatus.DiskUUID == "" || diskStatus.StorageMaximum == 0 {
+			diskStatus.CapacityForecast = nil
+			continue
+		}
+		existingDiskUUIDs[diskStatus.DiskUUID] = true
+
+		history, exists := nc.capacityHistories[diskStatus.DiskUUID]
+		if !exists {
+			// Restore the history saved by the previous Longhorn manager owning the node
+			history = capacityforecast.NewHistory(getSavedCapacitySamples(diskStatus))
+			nc.capacityHistories[diskStatus.DiskUUID] = history
+		}
+
+		sample := capacityforecast.Sample{
+			Time:       now,
+			Used:       diskStatus.StorageMaximum - diskStatus.StorageAvailable,
+			ActualSize: actualSizes[diskStatus.DiskUUID],
+			Scheduled:  diskStatus.StorageScheduled,
+		}
+		if !history.Add(sample, capacityForecastSampleInterval, time.Duration(historyWindow)*time.Hour) {
+			// Only refresh the forecast when a new sample is taken
+			continue
+		}
+
+		diskStatus.CapacityHistory = newCapacityHistory(diskStatus.DiskUUID, capacityforecast.Downsample(history.Samples(), capacityHistorySaveInterval))
+		diskStatus.CapacityForecast = getDiskCapacityForecast(history.Samples(), diskSpec, diskStatus, overProvisioningPercentage)
+	}
+
+	for diskUUID := range nc.capacityHistories {
+		if !existingDiskUUIDs[diskUUID] {
+			delete(nc.capacityHistories, diskUUID)
+		}
+	}
+
+	node.Status.CapacityForecast = capacityforecast.SumDisks([]*longhorn.Node{node})
+
+	return nc.syncCapacitySufficientCondition(node)
+}
+
+func (nc *NodeController) getDiskActualSizes(node *longhorn.Node) (map[string]int64, error) {
+	replicas, err := nc.ds.ListReplicasByNodeRO(node.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list replicas on node %v", node.Name)
+	}
+
+	actualSizes := map[string]int64{}
+	for _, replica := range replicas {
+		volume, err := nc.ds.GetVolumeRO(replica.Spec.VolumeName)
+		if err != nil {
+			if datastore.ErrorIsNotFound(err) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to get volume %v", replica.Spec.VolumeName)
+		}
+		actualSizes[replica.Spec.DiskID] += volume.Status.ActualSize
+	}
+	return actualSizes, nil
+}
+
+// getSavedCapacitySamples returns the samples saved in the disk status. The samples of another disk that used to
+// have the same name are ignored.
+func getSavedCapacitySamples(diskStatus *longhorn.DiskStatus) []capacityforecast.Sample {
+	if diskStatus.CapacityHistory == nil || diskStatus.CapacityHistory.DiskUUID != diskStatus.DiskUUID {
+		return nil
+	}
+
+	samples := []capacityforecast.Sample{}
+	for _, saved := range diskStatus.CapacityHistory.Samples {
+		sampleTime, err := time.Parse(time.RFC3339, saved.Time)
+		if err != nil {
+			continue
+		}
+		samples = append(samples, capacityforecast.Sample{
+			Time:       sampleTime,
+			Used:       saved.Used,
+			ActualSize: saved.ActualSize,
+			Scheduled:  saved.Scheduled,
+		})
+	}
+	return samples
+}
+
+func newCapacityHistory(diskUUID string, samples []capacityforecast.Sample) *longhorn.CapacityHistory {
+	history := &longhorn.CapacityHistory{
+		DiskUUID: diskUUID,
+		Samples:  make([]longhorn.CapacitySample, 0, len(samples)),
+	}
+	for _, sample := range samples {
+		history.Samples = append(history.Samples, longhorn.CapacitySample{
+			Time:       sample.Time.Format(time.RFC3339),
+			Used:       sample.Used,
+			ActualSize: sample.ActualSize,
+			Scheduled:  sample.Scheduled,
+		})
+	}
+	return history
+}
+
+func getDiskCapacityForecast(samples []capacityforecast.Sample, diskSpec longhorn.DiskSpec, diskStatus *longhorn.DiskStatus, overProvisioningPercentage int64) *longhorn.CapacityForecast {
+	if len(samples) < capacityforecast.MinimumSamples {
+		return nil
+	}
+
+	usageGrowth := capacityforecast.GrowthPerDay(samples, func(sample capacityforecast.Sample) int64 { return sample.Used })
+	actualSizeGrowth := capacityforecast.GrowthPerDay(samples, func(sample capacityforecast.Sample) int64 { return sample.ActualSize })
+	scheduledGrowth := capacityforecast.GrowthPerDay(samples, func(sample capacityforecast.Sample) int64 { return sample.Scheduled })
+
+	overProvisioningLimit := int64(float64(diskStatus.StorageMaximum-diskSpec.StorageReserved) * float64(overProvisioningPercentage) / 100)
+	forecast := &longhorn.CapacityForecast{
+		UsageGrowthPerDay:      int64(usageGrowth),
+		ActualSizeGrowthPerDay: int64(actualSizeGrowth),
+		ScheduledGrowthPerDay:  int64(scheduledGrowth),
+		StorageRemaining:       diskStatus.StorageAvailable,
+		SchedulableRemaining:   overProvisioningLimit - diskStatus.StorageScheduled,
+		SampleCount:            len(samples),
+		LastUpdatedAt:          util.Now(),
+	}
+	// The used storage may be shared with the data not managed by Longhorn,
+	// so the faster one of the used storage and the volume actual size growth is used.
+	forecast.DaysUntilFull = capacityforecast.DaysUntil(forecast.StorageRemaining, math.Max(usageGrowth, actualSizeGrowth))
+	forecast.DaysUntilOverProvisioningLimit = capacityforecast.DaysUntil(forecast.SchedulableRemaining, scheduledGrowth)
+	return forecast
+}
+
+func (nc *NodeController) syncCapacitySufficientCondition(node *longhorn.Node) error {
+	warningThreshold, err := nc.ds.GetSettingAsInt(types.SettingNameCapacityForecastWarningThreshold)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %v setting", types.SettingNameCapacityForecastWarningThreshold)
+	}
+
+	if warningThreshold == 0 || node.Status.CapacityForecast == nil {
+		node.Status.Conditions = types.RemoveCondition(node.Status.Conditions, longhorn.NodeConditionTypeCapacitySufficient)
+		return nil
+	}
+
+	diskNames := []string{}
+	for diskName := range node.Status.DiskStatus {
+		diskNames = append(diskNames, diskName)
+	}
+	sort.Strings(diskNames)
+
+	fullDisks := []string{}
+	overProvisionedDisks := []string{}
+	for _, diskName := range diskNames {
+		forecast := node.Status.DiskStatus[diskName].CapacityForecast
+		if forecast == nil {
+			continue
+		}
+		if forecast.DaysUntilFull != capacityforecast.DaysNotGrowing && forecast.DaysUntilFull < warningThreshold {
+			fullDisks = append(fullDisks, fmt.Sprintf("%v in %v days", diskName, forecast.DaysUntilFull))
+		}
+		if forecast.DaysUntilOverProvisioningLimit != capacityforecast.DaysNotGrowing && forecast.DaysUntilOverProvisioningLimit < warningThreshold {
+			overProvisionedDisks = append(overProvisionedDisks, fmt.Sprintf("%v in %v days", diskName, forecast.DaysUntilOverProvisioningLimit))
+		}
+	}
+
+	switch {
+	case len(fullDisks) > 0:
+		node.Status.Conditions = types.SetConditionAndRecord(node.Status.Conditions,
+			longhorn.NodeConditionTypeCapacitySufficient, longhorn.ConditionStatusFalse,
+			longhorn.NodeConditionReasonDiskFullForecast,
+			fmt.Sprintf("Disks are forecast to be full: %v", strings.Join(fullDisks, ", ")),
+			nc.eventRecorder, node, corev1.EventTypeWarning)
+	case len(overProvisionedDisks) > 0:
+		node.Status.Conditions = types.SetConditionAndRecord(node.Status.Conditions,
+			longhorn.NodeConditionTypeCapacitySufficient, longhorn.ConditionStatusFalse,
+			longhorn.NodeConditionReasonOverProvisioningLimitForecast,
+			fmt.Sprintf("Disks are forecast to reach the over-provisioning limit: %v", strings.Join(overProvisionedDisks, ", ")),
+			nc.eventRecorder, node, corev1.EventTypeWarning)
+	default:
+		node.Status.Conditions = types.SetConditionAndRecord(node.Status.Conditions,
+			longhorn.NodeConditionTypeCapacitySufficient, longhorn.ConditionStatusTrue,
+			"", fmt.Sprintf("No disk is forecast to run out of storage within %v days", warningThreshold),
+			nc.eventRecorder, node, corev1.EventTypeNormal)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	. "gopkg.in/check.v1"
@@ -21,9 +22,11 @@ import (
 	clientset "k8s.io/client-go/kubernetes"
 	fake "k8s.io/client-go/kubernetes/fake"
 
+	"github.com/longhorn/longhorn-manager/constant"
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/util/capacityforecast"
 
 	monitor "github.com/longhorn/longhorn-manager/controller/monitor"
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
@@ -578,6 +581,7 @@ func (s *NodeControllerSuite) TestUpdateDiskStatus(c *C) {
 						ScheduledReplica: map[string]int64{
 							fixture.lhReplicas[0].Name: fixture.lhReplicas[0].Spec.VolumeSize,
 						},
+						ReplicaCount:          1,
 						ScheduledBackingImage: map[string]int64{},
 						DiskName:              TestDiskID1,
 						DiskUUID:              TestDiskID1,
@@ -2158,6 +2162,44 @@ CONFIG_NFS_V4_2=y`
 
 // -- Helpers --
 
+func (s *NodeControllerSuite) TestRequestUnhealthyDiskEviction(c *C) {
+	node := newNode(TestNode1, TestNamespace, true, longhorn.ConditionStatusTrue, "")
+	node.Spec.Disks[TestDiskID2] = node.Spec.Disks[TestDiskID1]
+	node.Status.DiskStatus[TestDiskID2] = node.Status.DiskStatus[TestDiskID1].DeepCopy()
+	node.Status.DiskStatus[TestDiskID1].Conditions = append(node.Status.DiskStatus[TestDiskID1].Conditions,
+		newNodeCondition(longhorn.DiskConditionTypeHealthy, longhorn.ConditionStatusFalse, longhorn.DiskConditionReasonIOErrors))
+	node.Status.DiskStatus[TestDiskID2].Conditions = append(node.Status.DiskStatus[TestDiskID2].Conditions,
+		newNodeCondition(longhorn.DiskConditionTypeHealthy, longhorn.ConditionStatusTrue, ""))
+
+	s.initTest(c, &NodeControllerFixture{
+		lhNodes: map[string]*longhorn.Node{TestNode1: node},
+	})
+
+	node, err := s.lhClient.LonghornV1beta2().Nodes(TestNamespace).Get(context.TODO(), TestNode1, metav1.GetOptions{})
+	c.Assert(err, IsNil)
+	err = s.controller.requestUnhealthyDiskEviction(node)
+	c.Assert(err, IsNil)
+
+	node, err = s.lhClient.LonghornV1beta2().Nodes(TestNamespace).Get(context.TODO(), TestNode1, metav1.GetOptions{})
+	c.Assert(err, IsNil)
+	c.Assert(node.Spec.Disks[TestDiskID1].EvictionRequested, Equals, true)
+	c.Assert(node.Spec.Disks[TestDiskID1].AllowScheduling, Equals, false)
+	c.Assert(node.Spec.Disks[TestDiskID2].EvictionRequested, Equals, false)
+	c.Assert(node.Spec.Disks[TestDiskID2].AllowScheduling, Equals, true)
+	c.Assert(s.eventRecorder.Events, HasLen, 1)
+	c.Assert(<-s.eventRecorder.Events, Matches, "Warning "+constant.EventReasonEvictionAutomatic+" .*"+TestDiskID1+".*")
+
+	// The eviction is not requested again after the user cancels it
+	disk := node.Spec.Disks[TestDiskID1]
+	disk.EvictionRequested = false
+	node.Spec.Disks[TestDiskID1] = disk
+	node.Status.DiskStatus[TestDiskID1].Conditions = types.SetCondition(node.Status.DiskStatus[TestDiskID1].Conditions,
+		longhorn.DiskConditionTypeHealthy, longhorn.ConditionStatusTrue, "", "")
+	err = s.controller.requestUnhealthyDiskEviction(node)
+	c.Assert(err, IsNil)
+	c.Assert(node.Spec.Disks[TestDiskID1].EvictionRequested, Equals, false)
+}
+
 func (s *NodeControllerSuite) checkNodeConditions(c *C, expectation *NodeControllerExpectation, node *longhorn.Node) {
 	// Check that all node status conditions match the expected node status
 	// conditions - save for the last transition timestamp and the actual
@@ -2364,3 +2406,41 @@ func newTestNodeController(lhClient *lhfake.Clientset, kubeClient *fake.Clientse
 func fakeTopologyLabelsChecker(kubeClient clientset.Interface, vers string) (bool, error) {
 	return false, nil
 }
+
+func (s *NodeControllerSuite) TestRestoreCapacityHistory(c *C) {
+	start := time.Now().UTC().Truncate(time.Hour).Add(-3 * time.Hour)
+	samples := []capacityforecast.Sample{}
+	for i := 0; i < 18; i++ {
+		samples = append(samples, capacityforecast.Sample{
+			Time: start.Add(time.Duration(i) * capacityForecastSampleInterval),
+			Used: int64(i) * 100,
+		})
+	}
+
+	// Only the first sample of every hour is saved
+	diskStatus := &longhorn.DiskStatus{
+		DiskUUID:         "disk-uuid-1",
+		StorageMaximum:   100000,
+		StorageAvailable: 98300,
+		CapacityHistory:  newCapacityHistory("disk-uuid-1", capacityforecast.Downsample(samples, capacityHistorySaveInterval)),
+	}
+	c.Assert(diskStatus.CapacityHistory.Samples, HasLen, 3)
+	c.Assert(diskStatus.CapacityHistory.Samples[1].Time, Equals, start.Add(time.Hour).Format(time.RFC3339))
+	c.Assert(diskStatus.CapacityHistory.Samples[1].Used, Equals, int64(600))
+
+	// The history restored after the restart is enough for the forecast once a new sample is taken
+	history := capacityforecast.NewHistory(getSavedCapacitySamples(diskStatus))
+	c.Assert(history.Samples(), HasLen, 3)
+	c.Assert(history.Add(capacityforecast.Sample{Time: start.Add(3 * time.Hour), Used: 1800}, capacityForecastSampleInterval, 168*time.Hour), Equals, true)
+	forecast := getDiskCapacityForecast(history.Samples(), longhorn.DiskSpec{}, diskStatus, 100)
+	c.Assert(forecast, NotNil)
+	c.Assert(forecast.SampleCount, Equals, 4)
+	c.Assert(forecast.UsageGrowthPerDay, Equals, int64(600*24))
+
+	// The forecast is unavailable without enough samples
+	c.Assert(getDiskCapacityForecast(history.Samples()[:2], longhorn.DiskSpec{}, diskStatus, 100), IsNil)
+
+	// The samples of another disk are not restored
+	diskStatus.DiskUUID = "disk-uuid-2"
+	c.Assert(getSavedCapacitySamples(diskStatus), HasLen, 0)
+}
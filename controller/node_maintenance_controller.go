@@ -0,0 +1,636 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientset "k8s.io/client-go/kubernetes"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/longhorn/longhorn-manager/constant"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+type NodeMaintenanceController struct {
+	*baseController
+
+	// which namespace controller is running with
+	namespace string
+	// use as the OwnerID of the controller
+	controllerID string
+
+	kubeClient    clientset.Interface
+	eventRecorder record.EventRecorder
+
+	ds *datastore.DataStore
+
+	cacheSyncs []cache.InformerSynced
+}
+
+func NewNodeMaintenanceController(
+	logger logrus.FieldLogger,
+	ds *datastore.DataStore,
+	scheme *runtime.Scheme,
+	kubeClient clientset.Interface,
+	controllerID string,
+	namespace string) (*NodeMaintenanceController, error) {
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(logrus.Infof)
+	// TODO: remove the wrapper when every clients have moved to use the clientset.
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{
+		Interface: v1core.New(kubeClient.CoreV1().RESTClient()).Events(""),
+	})
+
+	nmc := &NodeMaintenanceController{
+		baseController: newBaseController("longhorn-node-maintenance", logger),
+
+		namespace:    namespace,
+		controllerID: controllerID,
+
+		ds: ds,
+
+		kubeClient:    kubeClient,
+		eventRecorder: eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "longhorn-node-maintenance-controller"}),
+	}
+
+	var err error
+	if _, err = ds.NodeMaintenanceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    nmc.enqueueNodeMaintenance,
+		UpdateFunc: func(old, cur interface{}) { nmc.enqueueNodeMaintenance(cur) },
+		DeleteFunc: nmc.enqueueNodeMaintenance,
+	}); err != nil {
+		return nil, err
+	}
+	nmc.cacheSyncs = append(nmc.cacheSyncs, ds.NodeMaintenanceInformer.HasSynced)
+
+	if _, err = ds.NodeInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, cur interface{}) { nmc.enqueueForLonghornNode(cur) },
+	}, 0); err != nil {
+		return nil, err
+	}
+	nmc.cacheSyncs = append(nmc.cacheSyncs, ds.NodeInformer.HasSynced)
+
+	if _, err = ds.ReplicaInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, cur interface{}) { nmc.enqueueForReplica(cur) },
+		DeleteFunc: nmc.enqueueForReplica,
+	}, 0); err != nil {
+		return nil, err
+	}
+	nmc.cacheSyncs = append(nmc.cacheSyncs, ds.ReplicaInformer.HasSynced)
+
+	if _, err = ds.VolumeInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, cur interface{}) { nmc.enqueueAllNodeMaintenances() },
+	}, 0); err != nil {
+		return nil, err
+	}
+	nmc.cacheSyncs = append(nmc.cacheSyncs, ds.VolumeInformer.HasSynced)
+
+	return nmc, nil
+}
+
+func (nmc *NodeMaintenanceController) enqueueNodeMaintenance(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to get key for object %#v: %v", obj, err))
+		return
+	}
+
+	nmc.queue.Add(key)
+}
+
+func (nmc *NodeMaintenanceController) enqueueNodeMaintenanceAfter(obj interface{}, duration time.Duration) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to get key for object %#v: %v", obj, err))
+		return
+	}
+
+	nmc.queue.AddAfter(key, duration)
+}
+
+func (nmc *NodeMaintenanceController) enqueueNodeMaintenancesForNode(nodeName string) {
+	nodeMaintenances, err := nmc.ds.ListNodeMaintenancesByNodeRO(nodeName)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to list node maintenances of node %v since %v", nodeName, err))
+		return
+	}
+
+	for _, nodeMaintenance := range nodeMaintenances {
+		nmc.enqueueNodeMaintenance(nodeMaintenance)
+	}
+}
+
+func (nmc *NodeMaintenanceController) enqueueAllNodeMaintenances() {
+	nodeMaintenances, err := nmc.ds.ListNodeMaintenancesRO()
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to list node maintenances since %v", err))
+		return
+	}
+
+	for _, nodeMaintenance := range nodeMaintenances {
+		nmc.enqueueNodeMaintenance(nodeMaintenance)
+	}
+}
+
+func (nmc *NodeMaintenanceController) enqueueForLonghornNode(obj interface{}) {
+	node, ok := obj.(*longhorn.Node)
+	if !ok {
+		utilruntime.HandleError(fmt.Errorf("received unexpected obj: %#v", obj))
+		return
+	}
+
+	nmc.enqueueNodeMaintenancesForNode(node.Name)
+}
+
+func (nmc *NodeMaintenanceController) enqueueForReplica(obj interface{}) {
+	replica, ok := obj.(*longhorn.Replica)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("received unexpected obj: %#v", obj))
+			return
+		}
+		// use the last known state, to enqueue, dependent objects
+		replica, ok = deletedState.Obj.(*longhorn.Replica)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("DeletedFinalStateUnknown contained invalid object: %#v", deletedState.Obj))
+			return
+		}
+	}
+
+	nmc.enqueueNodeMaintenancesForNode(replica.Spec.NodeID)
+}
+
+func (nmc *NodeMaintenanceController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer nmc.queue.ShutDown()
+
+	nmc.logger.Info("Starting Longhorn NodeMaintenance controller")
+	defer nmc.logger.Info("Shut down Longhorn NodeMaintenance controller")
+
+	if !cache.WaitForNamedCacheSync(nmc.name, stopCh, nmc.cacheSyncs...) {
+		return
+	}
+	for i := 0; i < workers; i++ {
+		go wait.Until(nmc.worker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+func (nmc *NodeMaintenanceController) worker() {
+	for nmc.processNextWorkItem() {
+	}
+}
+
+func (nmc *NodeMaintenanceController) processNextWorkItem() bool {
+	key, quit := nmc.queue.Get()
+	if quit {
+		return false
+	}
+	defer nmc.queue.Done(key)
+	err := nmc.syncNodeMaintenance(key.(string))
+	nmc.handleErr(err, key)
+	return true
+}
+
+func (nmc *NodeMaintenanceController) handleErr(err error, key interface{}) {
+	if err == nil {
+		nmc.queue.Forget(key)
+		return
+	}
+
+	log := nmc.logger.WithField("nodeMaintenance", key)
+	if nmc.queue.NumRequeues(key) < maxRetries {
+		handleReconcileErrorLogging(log, err, "Failed to sync Longhorn node maintenance")
+		nmc.queue.AddRateLimited(key)
+		return
+	}
+
+	utilruntime.HandleError(err)
+	handleReconcileErrorLogging(log, err, "Dropping Longhorn node maintenance out of the queue")
+	nmc.queue.Forget(key)
+}
+
+func (nmc *NodeMaintenanceController) syncNodeMaintenance(key string) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to sync node maintenance %v", key)
+	}()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	if namespace != nmc.namespace {
+		return nil
+	}
+	return nmc.reconcile(name)
+}
+
+func getLoggerForNodeMaintenance(logger logrus.FieldLogger, nodeMaintenance *longhorn.NodeMaintenance) *logrus.Entry {
+	return logger.WithFields(
+		logrus.Fields{
+			"nodeMaintenance": nodeMaintenance.Name,
+			"node":            nodeMaintenance.Spec.NodeID,
+		},
+	)
+}
+
+func (nmc *NodeMaintenanceController) isResponsibleFor(nodeMaintenance *longhorn.NodeMaintenance) bool {
+	return isControllerResponsibleFor(nmc.controllerID, nmc.ds, nodeMaintenance.Name, nodeMaintenance.Spec.NodeID, nodeMaintenance.Status.OwnerID)
+}
+
+func isNodeMaintenanceEnded(nodeMaintenance *longhorn.NodeMaintenance) bool {
+	return nodeMaintenance.Status.State == longhorn.NodeMaintenanceStateAborted ||
+		nodeMaintenance.Status.State == longhorn.NodeMaintenanceStateTimedOut
+}
+
+func (nmc *NodeMaintenanceController) reconcile(name string) (err error) {
+	nodeMaintenance, err := nmc.ds.GetNodeMaintenance(name)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	log := getLoggerForNodeMaintenance(nmc.logger, nodeMaintenance)
+
+	if !nmc.isResponsibleFor(nodeMaintenance) {
+		return nil
+	}
+
+	if nodeMaintenance.Status.OwnerID != nmc.controllerID {
+		nodeMaintenance.Status.OwnerID = nmc.controllerID
+		nodeMaintenance, err = nmc.ds.UpdateNodeMaintenanceStatus(nodeMaintenance)
+		if err != nil {
+			// we don't mind others coming first
+			if datastore.ErrorIsConflict(errors.Cause(err)) {
+				return nil
+			}
+			return err
+		}
+		log.Infof("Node maintenance got new owner %v", nmc.controllerID)
+	}
+
+	if !nodeMaintenance.DeletionTimestamp.IsZero() {
+		if !isNodeMaintenanceEnded(nodeMaintenance) {
+			if err := nmc.restoreNodeMaintenance(nodeMaintenance); err != nil {
+				return err
+			}
+			log.Info("Ended node maintenance")
+			nmc.eventRecorder.Eventf(nodeMaintenance, corev1.EventTypeNormal, constant.EventReasonRestored, "Restored node %v and volumes after the maintenance", nodeMaintenance.Spec.NodeID)
+		}
+		return nmc.ds.RemoveFinalizerForNodeMaintenance(nodeMaintenance)
+	}
+
+	existingNodeMaintenance := nodeMaintenance.DeepCopy()
+	defer func() {
+		if reflect.DeepEqual(existingNodeMaintenance.Status, nodeMaintenance.Status) {
+			return
+		}
+		// The status is saved on failures too, so the progress made before the failure is not lost
+		_, updateErr := nmc.ds.UpdateNodeMaintenanceStatus(nodeMaintenance)
+		if updateErr == nil {
+			return
+		}
+		if err != nil {
+			log.WithError(updateErr).Warn("Failed to update node maintenance status")
+			return
+		}
+		err = updateErr
+		if datastore.ErrorIsConflict(errors.Cause(err)) {
+			log.WithError(err).Debugf("Requeue %v due to conflict", name)
+			nmc.enqueueNodeMaintenance(nodeMaintenance)
+			err = nil
+		}
+	}()
+
+	if isNodeMaintenanceEnded(nodeMaintenance) {
+		return nil
+	}
+
+	if nodeMaintenance.Spec.Abort {
+		if err := nmc.restoreNodeMaintenance(nodeMaintenance); err != nil {
+			return err
+		}
+		log.Info("Aborted node maintenance")
+		nodeMaintenance.Status.State = longhorn.NodeMaintenanceStateAborted
+		nodeMaintenance.Status.Message = "The maintenance is aborted"
+		nmc.eventRecorder.Eventf(nodeMaintenance, corev1.EventTypeNormal, constant.EventReasonNodeMaintenanceAborted, "Aborted the maintenance of node %v", nodeMaintenance.Spec.NodeID)
+		return nil
+	}
+
+	if nodeMaintenance.Status.State == "" || nodeMaintenance.Status.State == longhorn.NodeMaintenanceStateError {
+		node, err := nmc.ds.GetNodeRO(nodeMaintenance.Spec.NodeID)
+		if err != nil {
+			if !datastore.ErrorIsNotFound(err) {
+				return err
+			}
+			nodeMaintenance.Status.State = longhorn.NodeMaintenanceStateError
+			nodeMaintenance.Status.Message = fmt.Sprintf("Node %v is not found", nodeMaintenance.Spec.NodeID)
+			return nil
+		}
+		log.Info("Starting node maintenance")
+		nodeMaintenance.Status.State = longhorn.NodeMaintenanceStateDraining
+		nodeMaintenance.Status.StartedAt = util.Now()
+		nodeMaintenance.Status.OriginalAllowScheduling = node.Spec.AllowScheduling
+		nodeMaintenance.Status.OriginalEvictionRequested = node.Spec.EvictionRequested
+		nodeMaintenance.Status.Message = ""
+	}
+
+	if nodeMaintenance.Spec.Timeout > 0 && nodeMaintenance.Status.State == longhorn.NodeMaintenanceStateDraining {
+		startedAt, err := util.ParseTime(nodeMaintenance.Status.StartedAt)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse start time %v", nodeMaintenance.Status.StartedAt)
+		}
+		remaining := time.Until(startedAt.Add(time.Duration(nodeMaintenance.Spec.Timeout) * time.Minute))
+		if remaining <= 0 {
+			if err := nmc.restoreNodeMaintenance(nodeMaintenance); err != nil {
+				return err
+			}
+			log.Warnf("Node maintenance timed out after %v minutes", nodeMaintenance.Spec.Timeout)
+			nodeMaintenance.Status.State = longhorn.NodeMaintenanceStateTimedOut
+			nodeMaintenance.Status.Message = fmt.Sprintf("The node is not drained in %v minutes", nodeMaintenance.Spec.Timeout)
+			nmc.eventRecorder.Eventf(nodeMaintenance, corev1.EventTypeWarning, constant.EventReasonNodeMaintenanceTimedOut, "The maintenance of node %v timed out and was reverted", nodeMaintenance.Spec.NodeID)
+			return nil
+		}
+		nmc.enqueueNodeMaintenanceAfter(nodeMaintenance, remaining)
+	}
+
+	drained, err := nmc.drainNode(nodeMaintenance)
+	if err != nil {
+		return err
+	}
+	if drained && nodeMaintenance.Status.State != longhorn.NodeMaintenanceStateDrained {
+		log.Info("Drained node for maintenance")
+		nodeMaintenance.Status.State = longhorn.NodeMaintenanceStateDrained
+		nodeMaintenance.Status.Message = "The node is ready for maintenance"
+		nmc.eventRecorder.Eventf(nodeMaintenance, corev1.EventTypeNormal, constant.EventReasonNodeMaintenanceDrained, "Node %v is drained and ready for maintenance", nodeMaintenance.Spec.NodeID)
+	} else if !drained {
+		nodeMaintenance.Status.State = longhorn.NodeMaintenanceStateDraining
+	}
+
+	return nil
+}
+
+// drainNode disables the scheduling of the node, moves the replicas off the node and detaches the manually attached volumes.
+// It returns true if there is no replica on the node or volume waiting to be detached.
+func (nmc *NodeMaintenanceController) drainNode(nodeMaintenance *longhorn.NodeMaintenance) (bool, error) {
+	nodeName := nodeMaintenance.Spec.NodeID
+
+	node, err := nmc.ds.GetNode(nodeName)
+	if err != nil {
+		return false, err
+	}
+	existingNode := node.DeepCopy()
+	node.Spec.AllowScheduling = false
+	if nodeMaintenance.Spec.ReplicaPolicy == longhorn.NodeMaintenanceReplicaPolicyEvict {
+		node.Spec.EvictionRequested = true
+	}
+	if !reflect.DeepEqual(existingNode.Spec, node.Spec) {
+		if _, err := nmc.ds.UpdateNode(node); err != nil {
+			return false, err
+		}
+	}
+
+	if nodeMaintenance.Spec.DetachManuallyAttachedVolumes {
+		if err := nmc.detachManuallyAttachedVolumes(nodeMaintenance); err != nil {
+			return false, err
+		}
+	}
+
+	if nodeMaintenance.Status.Volumes == nil {
+		nodeMaintenance.Status.Volumes = map[string]*longhorn.NodeMaintenanceVolumeStatus{}
+	}
+	for _, volumeStatus := range nodeMaintenance.Status.Volumes {
+		volumeStatus.State = longhorn.NodeMaintenanceVolumeStateDrained
+		volumeStatus.RemainingReplicas = 0
+		volumeStatus.Message = ""
+	}
+
+	replicas, err := nmc.ds.ListReplicasByNodeRO(nodeName)
+	if err != nil {
+		return false, err
+	}
+	replicasByVolume := map[string][]*longhorn.Replica{}
+	for _, r := range replicas {
+		replicasByVolume[r.Spec.VolumeName] = append(replicasByVolume[r.Spec.VolumeName], r)
+	}
+
+	drained := true
+	for volumeName, volumeReplicas := range replicasByVolume {
+		drained = false
+		volumeStatus := &longhorn.NodeMaintenanceVolumeStatus{RemainingReplicas: len(volumeReplicas)}
+		nodeMaintenance.Status.Volumes[volumeName] = volumeStatus
+
+		if nodeMaintenance.Spec.ReplicaPolicy == longhorn.NodeMaintenanceReplicaPolicyEvict {
+			volumeStatus.State = longhorn.NodeMaintenanceVolumeStateEvicting
+			continue
+		}
+		if err := nmc.rebuildReplicasOffNode(volumeName, volumeReplicas, volumeStatus); err != nil {
+			return false, err
+		}
+	}
+
+	for _, ticket := range nodeMaintenance.Status.DetachedTickets {
+		v, err := nmc.ds.GetVolumeRO(ticket.Volume)
+		if err != nil {
+			if datastore.ErrorIsNotFound(err) {
+				continue
+			}
+			return false, err
+		}
+		if v.Status.State == longhorn.VolumeStateDetached || v.Status.CurrentNodeID != nodeName {
+			if _, ok := nodeMaintenance.Status.Volumes[ticket.Volume]; !ok {
+				nodeMaintenance.Status.Volumes[ticket.Volume] = &longhorn.NodeMaintenanceVolumeStatus{State: longhorn.NodeMaintenanceVolumeStateDrained}
+			}
+			continue
+		}
+		drained = false
+		volumeStatus, ok := nodeMaintenance.Status.Volumes[ticket.Volume]
+		if !ok {
+			volumeStatus = &longhorn.NodeMaintenanceVolumeStatus{}
+			nodeMaintenance.Status.Volumes[ticket.Volume] = volumeStatus
+		}
+		if volumeStatus.State == longhorn.NodeMaintenanceVolumeStateDrained {
+			volumeStatus.State = longhorn.NodeMaintenanceVolumeStateDetaching
+			volumeStatus.Message = fmt.Sprintf("Waiting for the volume to be detached from node %v", nodeName)
+		}
+	}
+
+	return drained, nil
+}
+
+// rebuildReplicasOffNode deletes one replica of the volume on the node at a time, so that the volume rebuilds it on another
+// node from the remaining healthy replicas.
+func (nmc *NodeMaintenanceController) rebuildReplicasOffNode(volumeName string, replicas []*longhorn.Replica, volumeStatus *longhorn.NodeMaintenanceVolumeStatus) error {
+	volumeStatus.State = longhorn.NodeMaintenanceVolumeStateRebuilding
+
+	v, err := nmc.ds.GetVolumeRO(volumeName)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			volumeStatus.Message = "Waiting for the replicas of the deleted volume to be cleaned up"
+			return nil
+		}
+		return err
+	}
+	if v.Status.State != longhorn.VolumeStateAttached {
+		volumeStatus.State = longhorn.NodeMaintenanceVolumeStateBlocked
+		volumeStatus.Message = "The replicas of a detached volume can only be moved by the evict replica policy"
+		return nil
+	}
+	if v.Status.Robustness != longhorn.VolumeRobustnessHealthy {
+		volumeStatus.Message = fmt.Sprintf("Waiting for the %v volume to become healthy", v.Status.Robustness)
+		return nil
+	}
+
+	volumeReplicas, err := nmc.ds.ListVolumeReplicasRO(volumeName)
+	if err != nil {
+		return err
+	}
+	healthyReplicasOffNode := 0
+	for _, r := range volumeReplicas {
+		if r.Spec.NodeID != replicas[0].Spec.NodeID && r.Spec.FailedAt == "" && r.Spec.HealthyAt != "" {
+			healthyReplicasOffNode++
+		}
+	}
+	if healthyReplicasOffNode == 0 {
+		volumeStatus.State = longhorn.NodeMaintenanceVolumeStateBlocked
+		volumeStatus.Message = "The volume has no healthy replica on other nodes, use the evict replica policy instead"
+		return nil
+	}
+
+	replica := replicas[0]
+	if replica.DeletionTimestamp != nil {
+		volumeStatus.Message = fmt.Sprintf("Waiting for replica %v to be deleted", replica.Name)
+		return nil
+	}
+	nmc.logger.WithFields(logrus.Fields{"volume": volumeName, "replica": replica.Name}).Info("Deleting replica on the node under maintenance to rebuild it on another node")
+	if err := nmc.ds.DeleteReplica(replica.Name); err != nil && !datastore.ErrorIsNotFound(err) {
+		return err
+	}
+	volumeStatus.Message = fmt.Sprintf("Deleted replica %v to rebuild it on another node", replica.Name)
+	return nil
+}
+
+// detachManuallyAttachedVolumes removes the attachment tickets created by the Longhorn UI or API for the node,
+// and records them in the status to be restored when the maintenance ends. The record is saved before the tickets
+// are removed, so a detached volume is always attached again even if a later step fails.
+func (nmc *NodeMaintenanceController) detachManuallyAttachedVolumes(nodeMaintenance *longhorn.NodeMaintenance) error {
+	vas, err := nmc.ds.ListLHVolumeAttachmentsRO()
+	if err != nil {
+		return err
+	}
+
+	for _, vaRO := range vas {
+		va := vaRO.DeepCopy()
+		detachedTickets := []longhorn.NodeMaintenanceDetachedTicket{}
+		for ticketID, ticket := range va.Spec.AttachmentTickets {
+			if ticket.Type != longhorn.AttacherTypeLonghornAPI || ticket.NodeID != nodeMaintenance.Spec.NodeID {
+				continue
+			}
+			// The ticket is recorded already if the attachment was not updated after the record was saved
+			if !isNodeMaintenanceTicketDetached(nodeMaintenance, va.Spec.Volume, ticketID) {
+				detachedTickets = append(detachedTickets, longhorn.NodeMaintenanceDetachedTicket{
+					Volume:     va.Spec.Volume,
+					TicketID:   ticketID,
+					Parameters: ticket.Parameters,
+				})
+			}
+			delete(va.Spec.AttachmentTickets, ticketID)
+		}
+		if reflect.DeepEqual(vaRO.Spec, va.Spec) {
+			continue
+		}
+
+		if len(detachedTickets) > 0 {
+			nodeMaintenance.Status.DetachedTickets = append(nodeMaintenance.Status.DetachedTickets, detachedTickets...)
+			updated, err := nmc.ds.UpdateNodeMaintenanceStatus(nodeMaintenance)
+			if err != nil {
+				return errors.Wrapf(err, "failed to record the detached tickets of volume %v", va.Spec.Volume)
+			}
+			nodeMaintenance.ResourceVersion = updated.ResourceVersion
+		}
+
+		nmc.logger.WithField("volume", va.Spec.Volume).Infof("Detaching manually attached volume from node %v for maintenance", nodeMaintenance.Spec.NodeID)
+		if _, err := nmc.ds.UpdateLHVolumeAttachment(va); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isNodeMaintenanceTicketDetached(nodeMaintenance *longhorn.NodeMaintenance, volumeName, ticketID string) bool {
+	for _, ticket := range nodeMaintenance.Status.DetachedTickets {
+		if ticket.Volume == volumeName && ticket.TicketID == ticketID {
+			return true
+		}
+	}
+	return false
+}
+
+// restoreNodeMaintenance reverts the scheduling and eviction settings of the node and attaches the detached volumes again.
+func (nmc *NodeMaintenanceController) restoreNodeMaintenance(nodeMaintenance *longhorn.NodeMaintenance) error {
+	if nodeMaintenance.Status.StartedAt == "" {
+		return nil
+	}
+
+	node, err := nmc.ds.GetNode(nodeMaintenance.Spec.NodeID)
+	if err != nil && !datastore.ErrorIsNotFound(err) {
+		return err
+	}
+	if node != nil && (node.Spec.AllowScheduling != nodeMaintenance.Status.OriginalAllowScheduling ||
+		node.Spec.EvictionRequested != nodeMaintenance.Status.OriginalEvictionRequested) {
+		node.Spec.AllowScheduling = nodeMaintenance.Status.OriginalAllowScheduling
+		node.Spec.EvictionRequested = nodeMaintenance.Status.OriginalEvictionRequested
+		if _, err := nmc.ds.UpdateNode(node); err != nil {
+			return err
+		}
+	}
+
+	for _, ticket := range nodeMaintenance.Status.DetachedTickets {
+		va, err := nmc.ds.GetLHVolumeAttachmentByVolumeName(ticket.Volume)
+		if err != nil {
+			if datastore.ErrorIsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if _, ok := va.Spec.AttachmentTickets[ticket.TicketID]; ok {
+			continue
+		}
+		if va.Spec.AttachmentTickets == nil {
+			va.Spec.AttachmentTickets = map[string]*longhorn.AttachmentTicket{}
+		}
+		va.Spec.AttachmentTickets[ticket.TicketID] = &longhorn.AttachmentTicket{
+			ID:         ticket.TicketID,
+			Type:       longhorn.AttacherTypeLonghornAPI,
+			NodeID:     nodeMaintenance.Spec.NodeID,
+			Parameters: ticket.Parameters,
+		}
+		nmc.logger.WithField("volume", ticket.Volume).Infof("Attaching volume to node %v again after maintenance", nodeMaintenance.Spec.NodeID)
+		if _, err := nmc.ds.UpdateLHVolumeAttachment(va); err != nil {
+			return err
+		}
+	}
+	nodeMaintenance.Status.DetachedTickets = nil
+
+	return nil
+}
@@ -0,0 +1,408 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	. "gopkg.in/check.v1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/longhorn/longhorn-manager/constant"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+)
+
+const (
+	TestNodeMaintenanceName = "test-node-maintenance"
+	TestDetachedTicketID    = "longhorn-ui"
+)
+
+type NodeMaintenanceControllerSuite struct {
+	kubeClient       *fake.Clientset
+	lhClient         *lhfake.Clientset
+	extensionsClient *apiextensionsfake.Clientset
+
+	informerFactories *util.InformerFactories
+
+	lhNodeMaintenanceIndexer  cache.Indexer
+	lhNodeIndexer             cache.Indexer
+	lhReplicaIndexer          cache.Indexer
+	lhVolumeIndexer           cache.Indexer
+	lhVolumeAttachmentIndexer cache.Indexer
+
+	eventRecorder *record.FakeRecorder
+
+	controller *NodeMaintenanceController
+}
+
+// NodeMaintenanceControllerFixture contains the resources that exist in the cluster before the maintenance is reconciled
+type NodeMaintenanceControllerFixture struct {
+	nodeMaintenance     *longhorn.NodeMaintenance
+	lhNodes             []*longhorn.Node
+	lhReplicas          []*longhorn.Replica
+	lhVolumes           []*longhorn.Volume
+	lhVolumeAttachments []*longhorn.VolumeAttachment
+}
+
+var _ = Suite(&NodeMaintenanceControllerSuite{})
+
+func (s *NodeMaintenanceControllerSuite) SetUpTest(c *C) {
+	datastore.SkipListerCheck = true
+
+	s.kubeClient = fake.NewSimpleClientset()
+	s.lhClient = lhfake.NewSimpleClientset()
+	s.extensionsClient = apiextensionsfake.NewSimpleClientset()
+
+	s.informerFactories = util.NewInformerFactories(TestNamespace, s.kubeClient, s.lhClient, controller.NoResyncPeriodFunc())
+
+	lhInformers := s.informerFactories.LhInformerFactory.Longhorn().V1beta2()
+	s.lhNodeMaintenanceIndexer = lhInformers.NodeMaintenances().Informer().GetIndexer()
+	s.lhNodeIndexer = lhInformers.Nodes().Informer().GetIndexer()
+	s.lhReplicaIndexer = lhInformers.Replicas().Informer().GetIndexer()
+	s.lhVolumeIndexer = lhInformers.Volumes().Informer().GetIndexer()
+	s.lhVolumeAttachmentIndexer = lhInformers.VolumeAttachments().Informer().GetIndexer()
+
+	s.eventRecorder = record.NewFakeRecorder(eventRecorderBufferSize)
+
+	ds := datastore.NewDataStore(TestNamespace, s.lhClient, s.kubeClient, s.extensionsClient, s.informerFactories)
+	var err error
+	s.controller, err = NewNodeMaintenanceController(logrus.StandardLogger(), ds, scheme.Scheme, s.kubeClient, TestNode1, TestNamespace)
+	c.Assert(err, IsNil)
+	s.controller.eventRecorder = s.eventRecorder
+	for index := range s.controller.cacheSyncs {
+		s.controller.cacheSyncs[index] = alwaysReady
+	}
+}
+
+func newNodeMaintenance(nodeID string, replicaPolicy longhorn.NodeMaintenanceReplicaPolicy) *longhorn.NodeMaintenance {
+	return &longhorn.NodeMaintenance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       TestNodeMaintenanceName,
+			Namespace:  TestNamespace,
+			Finalizers: []string{longhorn.SchemeGroupVersion.Group},
+		},
+		Spec: longhorn.NodeMaintenanceSpec{
+			NodeID:        nodeID,
+			ReplicaPolicy: replicaPolicy,
+		},
+	}
+}
+
+// newStartedNodeMaintenance returns a maintenance draining the node since startedAt, which disabled the scheduling
+// of the node and detached a manually attached volume.
+func newStartedNodeMaintenance(startedAt time.Time, volumeName string) *longhorn.NodeMaintenance {
+	nodeMaintenance := newNodeMaintenance(TestNode1, longhorn.NodeMaintenanceReplicaPolicyEvict)
+	nodeMaintenance.Spec.DetachManuallyAttachedVolumes = true
+	nodeMaintenance.Status = longhorn.NodeMaintenanceStatus{
+		OwnerID:                   TestNode1,
+		State:                     longhorn.NodeMaintenanceStateDraining,
+		StartedAt:                 startedAt.UTC().Format(time.RFC3339),
+		OriginalAllowScheduling:   true,
+		OriginalEvictionRequested: false,
+		DetachedTickets: []longhorn.NodeMaintenanceDetachedTicket{
+			{
+				Volume:     volumeName,
+				TicketID:   TestDetachedTicketID,
+				Parameters: map[string]string{longhorn.AttachmentParameterDisableFrontend: longhorn.FalseValue},
+			},
+		},
+	}
+	return nodeMaintenance
+}
+
+// newNodeUnderMaintenance returns the node as drained by the maintenance.
+func newNodeUnderMaintenance() *longhorn.Node {
+	node := newNode(TestNode1, TestNamespace, false, longhorn.ConditionStatusTrue, "")
+	node.Spec.EvictionRequested = true
+	return node
+}
+
+func (s *NodeMaintenanceControllerSuite) initTest(c *C, fixture *NodeMaintenanceControllerFixture) {
+	for _, node := range fixture.lhNodes {
+		n, err := s.lhClient.LonghornV1beta2().Nodes(TestNamespace).Create(context.TODO(), node, metav1.CreateOptions{})
+		c.Assert(err, IsNil)
+		err = s.lhNodeIndexer.Add(n)
+		c.Assert(err, IsNil)
+	}
+
+	for _, replica := range fixture.lhReplicas {
+		replica.Namespace = TestNamespace
+		r, err := s.lhClient.LonghornV1beta2().Replicas(TestNamespace).Create(context.TODO(), replica, metav1.CreateOptions{})
+		c.Assert(err, IsNil)
+		err = s.lhReplicaIndexer.Add(r)
+		c.Assert(err, IsNil)
+	}
+
+	for _, volume := range fixture.lhVolumes {
+		volume.Namespace = TestNamespace
+		v, err := s.lhClient.LonghornV1beta2().Volumes(TestNamespace).Create(context.TODO(), volume, metav1.CreateOptions{})
+		c.Assert(err, IsNil)
+		err = s.lhVolumeIndexer.Add(v)
+		c.Assert(err, IsNil)
+	}
+
+	for _, volumeAttachment := range fixture.lhVolumeAttachments {
+		va, err := s.lhClient.LonghornV1beta2().VolumeAttachments(TestNamespace).Create(context.TODO(), volumeAttachment, metav1.CreateOptions{})
+		c.Assert(err, IsNil)
+		err = s.lhVolumeAttachmentIndexer.Add(va)
+		c.Assert(err, IsNil)
+	}
+
+	nm, err := s.lhClient.LonghornV1beta2().NodeMaintenances(TestNamespace).Create(context.TODO(), fixture.nodeMaintenance, metav1.CreateOptions{})
+	c.Assert(err, IsNil)
+	err = s.lhNodeMaintenanceIndexer.Add(nm)
+	c.Assert(err, IsNil)
+}
+
+func (s *NodeMaintenanceControllerSuite) getNodeMaintenance(c *C) *longhorn.NodeMaintenance {
+	nodeMaintenance, err := s.lhClient.LonghornV1beta2().NodeMaintenances(TestNamespace).Get(context.TODO(), TestNodeMaintenanceName, metav1.GetOptions{})
+	c.Assert(err, IsNil)
+	return nodeMaintenance
+}
+
+func (s *NodeMaintenanceControllerSuite) getNode(c *C) *longhorn.Node {
+	node, err := s.lhClient.LonghornV1beta2().Nodes(TestNamespace).Get(context.TODO(), TestNode1, metav1.GetOptions{})
+	c.Assert(err, IsNil)
+	return node
+}
+
+// checkNodeRestored verifies the node is schedulable again and the detached volume is attached to the node again.
+func (s *NodeMaintenanceControllerSuite) checkNodeRestored(c *C, volumeName string) {
+	node := s.getNode(c)
+	c.Assert(node.Spec.AllowScheduling, Equals, true)
+	c.Assert(node.Spec.EvictionRequested, Equals, false)
+
+	va, err := s.lhClient.LonghornV1beta2().VolumeAttachments(TestNamespace).Get(context.TODO(), types.GetLHVolumeAttachmentNameFromVolumeName(volumeName), metav1.GetOptions{})
+	c.Assert(err, IsNil)
+	ticket, ok := va.Spec.AttachmentTickets[TestDetachedTicketID]
+	c.Assert(ok, Equals, true)
+	c.Assert(ticket.Type, Equals, longhorn.AttacherTypeLonghornAPI)
+	c.Assert(ticket.NodeID, Equals, TestNode1)
+	c.Assert(ticket.Parameters[longhorn.AttachmentParameterDisableFrontend], Equals, longhorn.FalseValue)
+}
+
+func (s *NodeMaintenanceControllerSuite) TestDrainNode(c *C) {
+	volume := newVolume(TestVolumeName, 2)
+	engine := newEngineForVolume(volume)
+	replicaOnNode := newReplicaForVolume(volume, engine, TestNode1, TestDiskID1)
+	replicaOffNode := newReplicaForVolume(volume, engine, TestNode2, TestDiskID1)
+
+	s.initTest(c, &NodeMaintenanceControllerFixture{
+		nodeMaintenance: newNodeMaintenance(TestNode1, longhorn.NodeMaintenanceReplicaPolicyEvict),
+		lhNodes: []*longhorn.Node{
+			newNode(TestNode1, TestNamespace, true, longhorn.ConditionStatusTrue, ""),
+			newNode(TestNode2, TestNamespace, true, longhorn.ConditionStatusTrue, ""),
+		},
+		lhReplicas: []*longhorn.Replica{replicaOnNode, replicaOffNode},
+		lhVolumes:  []*longhorn.Volume{volume},
+	})
+
+	err := s.controller.reconcile(TestNodeMaintenanceName)
+	c.Assert(err, IsNil)
+
+	nodeMaintenance := s.getNodeMaintenance(c)
+	c.Assert(nodeMaintenance.Status.State, Equals, longhorn.NodeMaintenanceStateDraining)
+	c.Assert(nodeMaintenance.Status.StartedAt, Not(Equals), "")
+	c.Assert(nodeMaintenance.Status.OriginalAllowScheduling, Equals, true)
+	c.Assert(nodeMaintenance.Status.OriginalEvictionRequested, Equals, false)
+	c.Assert(nodeMaintenance.Status.Volumes, HasLen, 1)
+	c.Assert(nodeMaintenance.Status.Volumes[TestVolumeName].State, Equals, longhorn.NodeMaintenanceVolumeStateEvicting)
+	c.Assert(nodeMaintenance.Status.Volumes[TestVolumeName].RemainingReplicas, Equals, 1)
+
+	node := s.getNode(c)
+	c.Assert(node.Spec.AllowScheduling, Equals, false)
+	c.Assert(node.Spec.EvictionRequested, Equals, true)
+	c.Assert(s.eventRecorder.Events, HasLen, 0)
+}
+
+func (s *NodeMaintenanceControllerSuite) TestDrainedNode(c *C) {
+	s.initTest(c, &NodeMaintenanceControllerFixture{
+		nodeMaintenance: newNodeMaintenance(TestNode1, longhorn.NodeMaintenanceReplicaPolicyRebuild),
+		lhNodes: []*longhorn.Node{
+			newNode(TestNode1, TestNamespace, true, longhorn.ConditionStatusTrue, ""),
+		},
+	})
+
+	err := s.controller.reconcile(TestNodeMaintenanceName)
+	c.Assert(err, IsNil)
+
+	nodeMaintenance := s.getNodeMaintenance(c)
+	c.Assert(nodeMaintenance.Status.State, Equals, longhorn.NodeMaintenanceStateDrained)
+
+	// The rebuild policy only disables the scheduling of the node
+	node := s.getNode(c)
+	c.Assert(node.Spec.AllowScheduling, Equals, false)
+	c.Assert(node.Spec.EvictionRequested, Equals, false)
+	c.Assert(s.eventRecorder.Events, HasLen, 1)
+	c.Assert(<-s.eventRecorder.Events, Matches, "Normal "+constant.EventReasonNodeMaintenanceDrained+" .*")
+}
+
+func (s *NodeMaintenanceControllerSuite) TestReattachAfterFailedDrain(c *C) {
+	volume := newVolume(TestVolumeName, 2)
+	volume.Status.State = longhorn.VolumeStateAttached
+	volume.Status.Robustness = longhorn.VolumeRobustnessHealthy
+	volume.Status.CurrentNodeID = TestNode1
+	engine := newEngineForVolume(volume)
+	replicaOnNode := newReplicaForVolume(volume, engine, TestNode1, TestDiskID1)
+	replicaOffNode := newReplicaForVolume(volume, engine, TestNode2, TestDiskID1)
+	replicaOffNode.Spec.HealthyAt = getTestNow()
+
+	va := newVolumeAttachment(TestVolumeName)
+	va.Spec.AttachmentTickets = map[string]*longhorn.AttachmentTicket{
+		TestDetachedTicketID: {
+			ID:         TestDetachedTicketID,
+			Type:       longhorn.AttacherTypeLonghornAPI,
+			NodeID:     TestNode1,
+			Parameters: map[string]string{longhorn.AttachmentParameterDisableFrontend: longhorn.FalseValue},
+		},
+	}
+
+	nodeMaintenance := newNodeMaintenance(TestNode1, longhorn.NodeMaintenanceReplicaPolicyRebuild)
+	nodeMaintenance.Spec.DetachManuallyAttachedVolumes = true
+	s.initTest(c, &NodeMaintenanceControllerFixture{
+		nodeMaintenance: nodeMaintenance,
+		lhNodes: []*longhorn.Node{
+			newNode(TestNode1, TestNamespace, true, longhorn.ConditionStatusTrue, ""),
+			newNode(TestNode2, TestNamespace, true, longhorn.ConditionStatusTrue, ""),
+		},
+		lhReplicas:          []*longhorn.Replica{replicaOnNode, replicaOffNode},
+		lhVolumes:           []*longhorn.Volume{volume},
+		lhVolumeAttachments: []*longhorn.VolumeAttachment{va},
+	})
+
+	// Rebuilding the replica off the node fails after the volume is detached
+	s.lhClient.PrependReactor("delete", "replicas", func(action testing.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("failed to delete replica")
+	})
+
+	err := s.controller.reconcile(TestNodeMaintenanceName)
+	c.Assert(err, NotNil)
+
+	va, err = s.lhClient.LonghornV1beta2().VolumeAttachments(TestNamespace).Get(context.TODO(), types.GetLHVolumeAttachmentNameFromVolumeName(TestVolumeName), metav1.GetOptions{})
+	c.Assert(err, IsNil)
+	c.Assert(va.Spec.AttachmentTickets, HasLen, 0)
+	nodeMaintenance = s.getNodeMaintenance(c)
+	c.Assert(nodeMaintenance.Status.DetachedTickets, HasLen, 1)
+	c.Assert(nodeMaintenance.Status.DetachedTickets[0].TicketID, Equals, TestDetachedTicketID)
+
+	// The volume is attached again once the maintenance is aborted
+	nodeMaintenance.Spec.Abort = true
+	nodeMaintenance, err = s.lhClient.LonghornV1beta2().NodeMaintenances(TestNamespace).Update(context.TODO(), nodeMaintenance, metav1.UpdateOptions{})
+	c.Assert(err, IsNil)
+	err = s.lhNodeMaintenanceIndexer.Update(nodeMaintenance)
+	c.Assert(err, IsNil)
+	err = s.lhVolumeAttachmentIndexer.Update(va)
+	c.Assert(err, IsNil)
+	err = s.lhNodeIndexer.Update(s.getNode(c))
+	c.Assert(err, IsNil)
+
+	err = s.controller.reconcile(TestNodeMaintenanceName)
+	c.Assert(err, IsNil)
+
+	nodeMaintenance = s.getNodeMaintenance(c)
+	c.Assert(nodeMaintenance.Status.State, Equals, longhorn.NodeMaintenanceStateAborted)
+	s.checkNodeRestored(c, TestVolumeName)
+}
+
+func (s *NodeMaintenanceControllerSuite) TestAbortNodeMaintenance(c *C) {
+	nodeMaintenance := newStartedNodeMaintenance(time.Now(), TestVolumeName)
+	nodeMaintenance.Spec.Abort = true
+
+	s.initTest(c, &NodeMaintenanceControllerFixture{
+		nodeMaintenance:     nodeMaintenance,
+		lhNodes:             []*longhorn.Node{newNodeUnderMaintenance()},
+		lhVolumeAttachments: []*longhorn.VolumeAttachment{newVolumeAttachment(TestVolumeName)},
+	})
+
+	err := s.controller.reconcile(TestNodeMaintenanceName)
+	c.Assert(err, IsNil)
+
+	nodeMaintenance = s.getNodeMaintenance(c)
+	c.Assert(nodeMaintenance.Status.State, Equals, longhorn.NodeMaintenanceStateAborted)
+	c.Assert(nodeMaintenance.Status.DetachedTickets, HasLen, 0)
+	s.checkNodeRestored(c, TestVolumeName)
+	c.Assert(s.eventRecorder.Events, HasLen, 1)
+	c.Assert(<-s.eventRecorder.Events, Matches, "Normal "+constant.EventReasonNodeMaintenanceAborted+" .*")
+}
+
+func (s *NodeMaintenanceControllerSuite) TestRevertNodeMaintenanceOnDeletion(c *C) {
+	nodeMaintenance := newStartedNodeMaintenance(time.Now(), TestVolumeName)
+	now := metav1.Now()
+	nodeMaintenance.DeletionTimestamp = &now
+
+	s.initTest(c, &NodeMaintenanceControllerFixture{
+		nodeMaintenance:     nodeMaintenance,
+		lhNodes:             []*longhorn.Node{newNodeUnderMaintenance()},
+		lhVolumeAttachments: []*longhorn.VolumeAttachment{newVolumeAttachment(TestVolumeName)},
+	})
+
+	err := s.controller.reconcile(TestNodeMaintenanceName)
+	c.Assert(err, IsNil)
+
+	nodeMaintenance = s.getNodeMaintenance(c)
+	c.Assert(nodeMaintenance.Finalizers, HasLen, 0)
+	s.checkNodeRestored(c, TestVolumeName)
+	c.Assert(s.eventRecorder.Events, HasLen, 1)
+	c.Assert(<-s.eventRecorder.Events, Matches, "Normal "+constant.EventReasonRestored+" .*")
+}
+
+func (s *NodeMaintenanceControllerSuite) TestRevertNodeMaintenanceOnTimeout(c *C) {
+	nodeMaintenance := newStartedNodeMaintenance(time.Now().Add(-time.Hour), TestVolumeName)
+	nodeMaintenance.Spec.Timeout = 30
+
+	s.initTest(c, &NodeMaintenanceControllerFixture{
+		nodeMaintenance:     nodeMaintenance,
+		lhNodes:             []*longhorn.Node{newNodeUnderMaintenance()},
+		lhVolumeAttachments: []*longhorn.VolumeAttachment{newVolumeAttachment(TestVolumeName)},
+	})
+
+	err := s.controller.reconcile(TestNodeMaintenanceName)
+	c.Assert(err, IsNil)
+
+	nodeMaintenance = s.getNodeMaintenance(c)
+	c.Assert(nodeMaintenance.Status.State, Equals, longhorn.NodeMaintenanceStateTimedOut)
+	s.checkNodeRestored(c, TestVolumeName)
+	c.Assert(s.eventRecorder.Events, HasLen, 1)
+	c.Assert(<-s.eventRecorder.Events, Matches, "Warning "+constant.EventReasonNodeMaintenanceTimedOut+" .*")
+}
+
+func (s *NodeMaintenanceControllerSuite) TestNoRevertAfterAbort(c *C) {
+	// The maintenance is already aborted, and the user disabled the scheduling of the node again afterwards
+	nodeMaintenance := newStartedNodeMaintenance(time.Now(), TestVolumeName)
+	nodeMaintenance.Spec.Abort = true
+	nodeMaintenance.Status.State = longhorn.NodeMaintenanceStateAborted
+	nodeMaintenance.Status.DetachedTickets = nil
+	now := metav1.Now()
+	nodeMaintenance.DeletionTimestamp = &now
+
+	s.initTest(c, &NodeMaintenanceControllerFixture{
+		nodeMaintenance: nodeMaintenance,
+		lhNodes:         []*longhorn.Node{newNodeUnderMaintenance()},
+	})
+
+	err := s.controller.reconcile(TestNodeMaintenanceName)
+	c.Assert(err, IsNil)
+
+	nodeMaintenance = s.getNodeMaintenance(c)
+	c.Assert(nodeMaintenance.Finalizers, HasLen, 0)
+	node := s.getNode(c)
+	c.Assert(node.Spec.AllowScheduling, Equals, false)
+	c.Assert(node.Spec.EvictionRequested, Equals, true)
+	c.Assert(s.eventRecorder.Events, HasLen, 0)
+}
@@ -0,0 +1,618 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/kubernetes/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientset "k8s.io/client-go/kubernetes"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/longhorn/longhorn-manager/constant"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/util/notification"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	// maxPendingNotifications is the maximum number of the notifications queued for a sink, including the ones waiting
+	// for a retry. The oldest ones are dropped if the sink cannot keep up.
+	maxPendingNotifications = 1000
+
+	notificationSendInitBackoff = 5 * time.Second
+	notificationSendMaxBackoff  = 5 * time.Minute
+)
+
+// NotificationSinkController watches the Longhorn resources for the subscribed event classes, then deduplicates,
+// rate limits and delivers the notifications to the sinks owned by the manager.
+type NotificationSinkController struct {
+	*baseController
+
+	// which namespace controller is running with
+	namespace string
+	// use as the OwnerID of the controller
+	controllerID string
+
+	kubeClient    clientset.Interface
+	eventRecorder record.EventRecorder
+
+	ds *datastore.DataStore
+
+	cacheSyncs []cache.InformerSynced
+
+	lock    sync.Mutex
+	pending map[string][]*notification.Notification
+	// failed are the notifications passing the throttle but failing to be sent, which are retried with the backoff
+	failed    map[string][]*notification.Notification
+	throttles map[string]*notification.Throttle
+
+	sendBackoff *flowcontrol.Backoff
+}
+
+func NewNotificationSinkController(
+	logger logrus.FieldLogger,
+	ds *datastore.DataStore,
+	scheme *runtime.Scheme,
+	kubeClient clientset.Interface,
+	controllerID string,
+	namespace string) (*NotificationSinkController, error) {
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(logrus.Infof)
+	// TODO: remove the wrapper when every clients have moved to use the clientset.
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{
+		Interface: v1core.New(kubeClient.CoreV1().RESTClient()).Events(""),
+	})
+
+	nsc := &NotificationSinkController{
+		baseController: newBaseController("longhorn-notification-sink", logger),
+
+		namespace:    namespace,
+		controllerID: controllerID,
+
+		ds: ds,
+
+		kubeClient:    kubeClient,
+		eventRecorder: eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "longhorn-notification-sink-controller"}),
+
+		pending:   map[string][]*notification.Notification{},
+		failed:    map[string][]*notification.Notification{},
+		throttles: map[string]*notification.Throttle{},
+
+		sendBackoff: flowcontrol.NewBackOff(notificationSendInitBackoff, notificationSendMaxBackoff),
+	}
+
+	var err error
+	if _, err = ds.NotificationSinkInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    nsc.enqueueNotificationSink,
+		UpdateFunc: func(old, cur interface{}) { nsc.enqueueNotificationSink(cur) },
+		DeleteFunc: nsc.enqueueNotificationSink,
+	}); err != nil {
+		return nil, err
+	}
+	nsc.cacheSyncs = append(nsc.cacheSyncs, ds.NotificationSinkInformer.HasSynced)
+
+	if _, err = ds.VolumeInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, cur interface{}) { nsc.notify(getVolumeNotifications(old, cur)) },
+	}, 0); err != nil {
+		return nil, err
+	}
+	nsc.cacheSyncs = append(nsc.cacheSyncs, ds.VolumeInformer.HasSynced)
+
+	if _, err = ds.BackupInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, cur interface{}) { nsc.notify(nsc.getBackupNotifications(old, cur)) },
+	}, 0); err != nil {
+		return nil, err
+	}
+	nsc.cacheSyncs = append(nsc.cacheSyncs, ds.BackupInformer.HasSynced)
+
+	if _, err = ds.NodeInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, cur interface{}) { nsc.notify(getDiskNotifications(old, cur)) },
+	}, 0); err != nil {
+		return nil, err
+	}
+	nsc.cacheSyncs = append(nsc.cacheSyncs, ds.NodeInformer.HasSynced)
+
+	if _, err = ds.ScrubReportInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, cur interface{}) { nsc.notify(nsc.getReplicaCorruptionNotifications(old, cur)) },
+	}, 0); err != nil {
+		return nil, err
+	}
+	nsc.cacheSyncs = append(nsc.cacheSyncs, ds.ScrubReportInformer.HasSynced)
+
+	if _, err = ds.SystemBackupInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, cur interface{}) { nsc.notify(getSystemBackupNotifications(old, cur)) },
+	}, 0); err != nil {
+		return nil, err
+	}
+	nsc.cacheSyncs = append(nsc.cacheSyncs, ds.SystemBackupInformer.HasSynced)
+
+	return nsc, nil
+}
+
+func (nsc *NotificationSinkController) enqueueNotificationSink(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to get key for object %#v: %v", obj, err))
+		return
+	}
+
+	nsc.queue.Add(key)
+}
+
+// notify queues the notifications for the sinks owned by the controller which subscribe them.
+func (nsc *NotificationSinkController) notify(notifications []*notification.Notification) {
+	if len(notifications) == 0 {
+		return
+	}
+
+	sinks, err := nsc.ds.ListNotificationSinksRO()
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to list notification sinks since %v", err))
+		return
+	}
+
+	for _, sink := range sinks {
+		if sink.DeletionTimestamp != nil || !nsc.isResponsibleFor(sink) {
+			continue
+		}
+
+		queued := false
+		for _, n := range notifications {
+			subscribed, err := notification.IsSubscribed(sink, n)
+			if err != nil {
+				nsc.logger.WithError(err).Warnf("Failed to filter notification for sink %v", sink.Name)
+				break
+			}
+			if !subscribed {
+				continue
+			}
+			nsc.queueNotification(sink.Name, n)
+			queued = true
+		}
+		if queued {
+			nsc.enqueueNotificationSink(sink)
+		}
+	}
+}
+
+func (nsc *NotificationSinkController) queueNotification(sinkName string, n *notification.Notification) {
+	nsc.lock.Lock()
+	defer nsc.lock.Unlock()
+
+	pending := append(nsc.pending[sinkName], n)
+	if limit := maxPendingNotifications - len(nsc.failed[sinkName]); len(pending) > limit {
+		pending = pending[len(pending)-limit:]
+	}
+	nsc.pending[sinkName] = pending
+}
+
+// takePendingNotifications returns the notifications failing to be sent before, and the new notifications.
+func (nsc *NotificationSinkController) takePendingNotifications(sinkName string) (failed, pending []*notification.Notification) {
+	nsc.lock.Lock()
+	defer nsc.lock.Unlock()
+
+	failed = nsc.failed[sinkName]
+	pending = nsc.pending[sinkName]
+	delete(nsc.failed, sinkName)
+	delete(nsc.pending, sinkName)
+	return failed, pending
+}
+
+// requeueFailedNotifications keeps the notifications failing to be sent for the retry. It returns the number of the
+// notifications dropped to keep the queue bounded.
+func (nsc *NotificationSinkController) requeueFailedNotifications(sinkName string, notifications []*notification.Notification) int {
+	nsc.lock.Lock()
+	defer nsc.lock.Unlock()
+
+	failed := append(notifications, nsc.failed[sinkName]...)
+	dropped := 0
+	if overflow := len(failed) + len(nsc.pending[sinkName]) - maxPendingNotifications; overflow > 0 {
+		dropped = min(overflow, len(failed))
+		failed = failed[dropped:]
+	}
+	nsc.failed[sinkName] = failed
+	return dropped
+}
+
+func (nsc *NotificationSinkController) getThrottle(sinkName string) *notification.Throttle {
+	nsc.lock.Lock()
+	defer nsc.lock.Unlock()
+
+	throttle, ok := nsc.throttles[sinkName]
+	if !ok {
+		throttle = notification.NewThrottle()
+		nsc.throttles[sinkName] = throttle
+	}
+	return throttle
+}
+
+func (nsc *NotificationSinkController) cleanupNotificationSink(sinkName string) {
+	nsc.lock.Lock()
+	defer nsc.lock.Unlock()
+
+	delete(nsc.pending, sinkName)
+	delete(nsc.failed, sinkName)
+	delete(nsc.throttles, sinkName)
+	nsc.sendBackoff.DeleteEntry(sinkName)
+}
+
+func (nsc *NotificationSinkController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer nsc.queue.ShutDown()
+
+	nsc.logger.Info("Starting Longhorn NotificationSink controller")
+	defer nsc.logger.Info("Shut down Longhorn NotificationSink controller")
+
+	if !cache.WaitForNamedCacheSync(nsc.name, stopCh, nsc.cacheSyncs...) {
+		return
+	}
+	for i := 0; i < workers; i++ {
+		go wait.Until(nsc.worker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+func (nsc *NotificationSinkController) worker() {
+	for nsc.processNextWorkItem() {
+	}
+}
+
+func (nsc *NotificationSinkController) processNextWorkItem() bool {
+	key, quit := nsc.queue.Get()
+	if quit {
+		return false
+	}
+	defer nsc.queue.Done(key)
+	err := nsc.syncNotificationSink(key.(string))
+	nsc.handleErr(err, key)
+	return true
+}
+
+func (nsc *NotificationSinkController) handleErr(err error, key interface{}) {
+	if err == nil {
+		nsc.queue.Forget(key)
+		return
+	}
+
+	log := nsc.logger.WithField("notificationSink", key)
+	if nsc.queue.NumRequeues(key) < maxRetries {
+		handleReconcileErrorLogging(log, err, "Failed to sync Longhorn notification sink")
+		nsc.queue.AddRateLimited(key)
+		return
+	}
+
+	utilruntime.HandleError(err)
+	handleReconcileErrorLogging(log, err, "Dropping Longhorn notification sink out of the queue")
+	nsc.queue.Forget(key)
+}
+
+func (nsc *NotificationSinkController) syncNotificationSink(key string) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to sync notification sink %v", key)
+	}()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	if namespace != nsc.namespace {
+		return nil
+	}
+	return nsc.reconcile(name)
+}
+
+func getLoggerForNotificationSink(logger logrus.FieldLogger, sink *longhorn.NotificationSink) *logrus.Entry {
+	return logger.WithFields(
+		logrus.Fields{
+			"notificationSink": sink.Name,
+			"type":             sink.Spec.Type,
+		},
+	)
+}
+
+func (nsc *NotificationSinkController) isResponsibleFor(sink *longhorn.NotificationSink) bool {
+	return isControllerResponsibleFor(nsc.controllerID, nsc.ds, sink.Name, "", sink.Status.OwnerID)
+}
+
+func (nsc *NotificationSinkController) reconcile(name string) (err error) {
+	sink, err := nsc.ds.GetNotificationSink(name)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		nsc.cleanupNotificationSink(name)
+		return nil
+	}
+
+	log := getLoggerForNotificationSink(nsc.logger, sink)
+
+	if !nsc.isResponsibleFor(sink) {
+		nsc.cleanupNotificationSink(name)
+		return nil
+	}
+
+	if sink.Status.OwnerID != nsc.controllerID {
+		sink.Status.OwnerID = nsc.controllerID
+		sink, err = nsc.ds.UpdateNotificationSinkStatus(sink)
+		if err != nil {
+			// we don't mind others coming first
+			if datastore.ErrorIsConflict(errors.Cause(err)) {
+				return nil
+			}
+			return err
+		}
+		log.Infof("Notification sink got new owner %v", nsc.controllerID)
+	}
+
+	if !sink.DeletionTimestamp.IsZero() {
+		nsc.cleanupNotificationSink(name)
+		return nsc.ds.RemoveFinalizerForNotificationSink(sink)
+	}
+
+	existingSink := sink.DeepCopy()
+	defer func() {
+		if err != nil {
+			return
+		}
+		if reflect.DeepEqual(existingSink.Status, sink.Status) {
+			return
+		}
+		if _, err = nsc.ds.UpdateNotificationSinkStatus(sink); err != nil && datastore.ErrorIsConflict(errors.Cause(err)) {
+			log.WithError(err).Debugf("Requeue %v due to conflict", name)
+			nsc.enqueueNotificationSink(sink)
+			err = nil
+		}
+	}()
+
+	// The notifications are kept queued and sent after the backoff
+	now := time.Now()
+	if nsc.sendBackoff.IsInBackOffSinceUpdate(name, now) {
+		nsc.queue.AddAfter(nsc.namespace+"/"+name, nsc.sendBackoff.Get(name))
+		return nil
+	}
+
+	sender, err := nsc.getSender(sink)
+	if err != nil {
+		sink.Status.State = longhorn.NotificationSinkStateError
+		sink.Status.Message = err.Error()
+		nsc.backoffNotificationSink(name, now)
+		return nil
+	}
+	sink.Status.State = longhorn.NotificationSinkStateReady
+	sink.Status.Message = ""
+
+	failed, notifications := nsc.takePendingNotifications(name)
+
+	throttle := nsc.getThrottle(name)
+	deduplicationWindow := time.Duration(sink.Spec.DeduplicationWindow) * time.Minute
+	// The failed notifications passed the throttle before
+	allowed := failed
+	for _, n := range notifications {
+		switch throttle.Allow(n, now, deduplicationWindow, sink.Spec.RateLimit) {
+		case notification.VerdictAllowed:
+			allowed = append(allowed, n)
+		case notification.VerdictDeduplicated:
+			sink.Status.DeduplicatedCount++
+		case notification.VerdictRateLimited:
+			sink.Status.RateLimitedCount++
+		}
+	}
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	if err := sender.Send(allowed); err != nil {
+		log.WithError(err).Warnf("Failed to send %v notifications, will retry", len(allowed))
+		sink.Status.FailedCount += int64(nsc.requeueFailedNotifications(name, allowed))
+		sink.Status.LastError = err.Error()
+		nsc.eventRecorder.Eventf(sink, corev1.EventTypeWarning, constant.EventReasonNotificationFailed, "Failed to send %v notifications: %v", len(allowed), err)
+		nsc.backoffNotificationSink(name, now)
+		return nil
+	}
+	nsc.sendBackoff.DeleteEntry(name)
+	sink.Status.SentCount += int64(len(allowed))
+	sink.Status.LastSentAt = util.Now()
+	return nil
+}
+
+// backoffNotificationSink delays the next delivery to the sink after a failure.
+func (nsc *NotificationSinkController) backoffNotificationSink(name string, now time.Time) {
+	nsc.sendBackoff.Next(name, now)
+	nsc.queue.AddAfter(nsc.namespace+"/"+name, nsc.sendBackoff.Get(name))
+}
+
+func (nsc *NotificationSinkController) getSender(sink *longhorn.NotificationSink) (notification.Sender, error) {
+	credentials := map[string][]byte{}
+	if sink.Spec.CredentialSecret != "" {
+		secret, err := nsc.ds.GetSecretRO(nsc.namespace, sink.Spec.CredentialSecret)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get credential secret %v", sink.Spec.CredentialSecret)
+		}
+		credentials = secret.Data
+	}
+	return notification.NewSender(sink, credentials)
+}
+
+func getVolumeNotifications(old, cur interface{}) []*notification.Notification {
+	oldVolume, ok := old.(*longhorn.Volume)
+	if !ok {
+		return nil
+	}
+	volume, ok := cur.(*longhorn.Volume)
+	if !ok {
+		return nil
+	}
+	if oldVolume.Status.Robustness == volume.Status.Robustness {
+		return nil
+	}
+
+	n := &notification.Notification{
+		Kind:                types.LonghornKindVolume,
+		Name:                volume.Name,
+		KubernetesNamespace: volume.Status.KubernetesStatus.Namespace,
+		Time:                time.Now(),
+	}
+	switch volume.Status.Robustness {
+	case longhorn.VolumeRobustnessDegraded:
+		n.Class = longhorn.NotificationEventClassVolumeDegraded
+		n.Severity = notification.SeverityWarning
+		n.Message = fmt.Sprintf("Volume %v is degraded", volume.Name)
+	case longhorn.VolumeRobustnessFaulted:
+		n.Class = longhorn.NotificationEventClassVolumeFaulted
+		n.Severity = notification.SeverityCritical
+		n.Message = fmt.Sprintf("Volume %v is faulted", volume.Name)
+	default:
+		return nil
+	}
+	return []*notification.Notification{n}
+}
+
+func (nsc *NotificationSinkController) getBackupNotifications(old, cur interface{}) []*notification.Notification {
+	oldBackup, ok := old.(*longhorn.Backup)
+	if !ok {
+		return nil
+	}
+	backup, ok := cur.(*longhorn.Backup)
+	if !ok {
+		return nil
+	}
+	if oldBackup.Status.State == longhorn.BackupStateError || backup.Status.State != longhorn.BackupStateError {
+		return nil
+	}
+
+	return []*notification.Notification{
+		{
+			Class:               longhorn.NotificationEventClassBackupFailed,
+			Severity:            notification.SeverityWarning,
+			Kind:                types.LonghornKindBackup,
+			Name:                backup.Name,
+			KubernetesNamespace: nsc.getVolumeKubernetesNamespace(backup.Status.VolumeName),
+			Message:             fmt.Sprintf("Backup %v of volume %v failed: %v", backup.Name, backup.Status.VolumeName, backup.Status.Error),
+			Time:                time.Now(),
+		},
+	}
+}
+
+func getDiskNotifications(old, cur interface{}) []*notification.Notification {
+	oldNode, ok := old.(*longhorn.Node)
+	if !ok {
+		return nil
+	}
+	node, ok := cur.(*longhorn.Node)
+	if !ok {
+		return nil
+	}
+
+	notifications := []*notification.Notification{}
+	for diskName, diskStatus := range node.Status.DiskStatus {
+		if diskStatus == nil {
+			continue
+		}
+		condition := types.GetCondition(diskStatus.Conditions, longhorn.DiskConditionTypeSchedulable)
+		if condition.Status != longhorn.ConditionStatusFalse {
+			continue
+		}
+		// The disks added to the node are not schedulable until they are checked
+		oldDiskStatus, ok := oldNode.Status.DiskStatus[diskName]
+		if !ok || oldDiskStatus == nil {
+			continue
+		}
+		oldCondition := types.GetCondition(oldDiskStatus.Conditions, longhorn.DiskConditionTypeSchedulable)
+		if oldCondition.Status != longhorn.ConditionStatusTrue {
+			continue
+		}
+		notifications = append(notifications, &notification.Notification{
+			Class:    longhorn.NotificationEventClassDiskUnschedulable,
+			Severity: notification.SeverityWarning,
+			Kind:     types.LonghornKindNode,
+			Name:     fmt.Sprintf("%v/%v", node.Name, diskName),
+			Message:  fmt.Sprintf("Disk %v on node %v is unschedulable: %v", diskName, node.Name, condition.Message),
+			Time:     time.Now(),
+		})
+	}
+	return notifications
+}
+
+func (nsc *NotificationSinkController) getReplicaCorruptionNotifications(old, cur interface{}) []*notification.Notification {
+	oldScrubReport, ok := old.(*longhorn.ScrubReport)
+	if !ok {
+		return nil
+	}
+	scrubReport, ok := cur.(*longhorn.ScrubReport)
+	if !ok {
+		return nil
+	}
+	if scrubReport.Status.CorruptedReplicaCount <= oldScrubReport.Status.CorruptedReplicaCount {
+		return nil
+	}
+
+	return []*notification.Notification{
+		{
+			Class:               longhorn.NotificationEventClassReplicaCorruption,
+			Severity:            notification.SeverityCritical,
+			Kind:                types.LonghornKindVolume,
+			Name:                scrubReport.Spec.VolumeName,
+			KubernetesNamespace: nsc.getVolumeKubernetesNamespace(scrubReport.Spec.VolumeName),
+			Message: fmt.Sprintf("Scrub %v found %v corrupted replicas of volume %v",
+				scrubReport.Name, scrubReport.Status.CorruptedReplicaCount, scrubReport.Spec.VolumeName),
+			Time: time.Now(),
+		},
+	}
+}
+
+func getSystemBackupNotifications(old, cur interface{}) []*notification.Notification {
+	oldSystemBackup, ok := old.(*longhorn.SystemBackup)
+	if !ok {
+		return nil
+	}
+	systemBackup, ok := cur.(*longhorn.SystemBackup)
+	if !ok {
+		return nil
+	}
+	if oldSystemBackup.Status.State == longhorn.SystemBackupStateError || systemBackup.Status.State != longhorn.SystemBackupStateError {
+		return nil
+	}
+
+	message := fmt.Sprintf("System backup %v failed", systemBackup.Name)
+	if condition := types.GetCondition(systemBackup.Status.Conditions, longhorn.SystemBackupConditionTypeError); condition.Message != "" {
+		message = fmt.Sprintf("%v: %v", message, condition.Message)
+	}
+	return []*notification.Notification{
+		{
+			Class:    longhorn.NotificationEventClassSystemBackupFailed,
+			Severity: notification.SeverityCritical,
+			Kind:     types.LonghornKindSystemBackup,
+			Name:     systemBackup.Name,
+			Message:  message,
+			Time:     time.Now(),
+		},
+	}
+}
+
+func (nsc *NotificationSinkController) getVolumeKubernetesNamespace(volumeName string) string {
+	if volumeName == "" {
+		return ""
+	}
+	volume, err := nsc.ds.GetVolumeRO(volumeName)
+	if err != nil {
+		return ""
+	}
+	return volume.Status.KubernetesStatus.Namespace
+}
@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/longhorn/longhorn-manager/util/notification"
+)
+
+func newTestNotifications(count int) []*notification.Notification {
+	notifications := make([]*notification.Notification, count)
+	for i := range notifications {
+		notifications[i] = &notification.Notification{}
+	}
+	return notifications
+}
+
+func TestRequeueFailedNotifications(t *testing.T) {
+	sinkName := "test-sink"
+
+	nsc := &NotificationSinkController{
+		pending: map[string][]*notification.Notification{},
+		failed:  map[string][]*notification.Notification{},
+	}
+
+	failed := newTestNotifications(10)
+	if dropped := nsc.requeueFailedNotifications(sinkName, failed); dropped != 0 {
+		t.Fatalf("expected no dropped notifications, got %v", dropped)
+	}
+	for _, n := range newTestNotifications(maxPendingNotifications) {
+		nsc.queueNotification(sinkName, n)
+	}
+	if len(nsc.pending[sinkName]) != maxPendingNotifications-len(failed) {
+		t.Fatalf("expected %v pending notifications, got %v", maxPendingNotifications-len(failed), len(nsc.pending[sinkName]))
+	}
+
+	retried, pending := nsc.takePendingNotifications(sinkName)
+	if len(retried) != len(failed) || retried[0] != failed[0] {
+		t.Fatalf("expected the failed notifications to be retried first")
+	}
+
+	// The queue is refilled while the notifications are sent
+	for _, n := range newTestNotifications(5) {
+		nsc.queueNotification(sinkName, n)
+	}
+	dropped := nsc.requeueFailedNotifications(sinkName, append(retried, pending...))
+	if dropped != 5 {
+		t.Fatalf("expected 5 dropped notifications, got %v", dropped)
+	}
+	if len(nsc.failed[sinkName])+len(nsc.pending[sinkName]) != maxPendingNotifications {
+		t.Fatalf("expected %v queued notifications, got %v", maxPendingNotifications, len(nsc.failed[sinkName])+len(nsc.pending[sinkName]))
+	}
+	if nsc.failed[sinkName][len(nsc.failed[sinkName])-1] != pending[len(pending)-1] {
+		t.Fatalf("expected the newest failed notifications to be kept")
+	}
+}
@@ -0,0 +1,394 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientset "k8s.io/client-go/kubernetes"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/longhorn/longhorn-manager/constant"
+	"github.com/longhorn/longhorn-manager/controller/monitor"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	preflightCheckNameManagerAvailable = "ManagerAvailable"
+)
+
+// PreflightCheckController runs the preflight checks of a PreflightCheck on the node of the manager. The owner of
+// the PreflightCheck decides the nodes to be checked and completes the check once all the nodes report.
+type PreflightCheckController struct {
+	*baseController
+
+	// which namespace controller is running with
+	namespace string
+	// use as the OwnerID of the controller
+	controllerID string
+
+	kubeClient    clientset.Interface
+	eventRecorder record.EventRecorder
+
+	ds *datastore.DataStore
+
+	cacheSyncs []cache.InformerSynced
+}
+
+func NewPreflightCheckController(
+	logger logrus.FieldLogger,
+	ds *datastore.DataStore,
+	scheme *runtime.Scheme,
+	kubeClient clientset.Interface,
+	controllerID string,
+	namespace string) (*PreflightCheckController, error) {
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(logrus.Infof)
+	// TODO: remove the wrapper when every clients have moved to use the clientset.
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{
+		Interface: v1core.New(kubeClient.CoreV1().RESTClient()).Events(""),
+	})
+
+	pcc := &PreflightCheckController{
+		baseController: newBaseController("longhorn-preflight-check", logger),
+
+		namespace:    namespace,
+		controllerID: controllerID,
+
+		ds: ds,
+
+		kubeClient:    kubeClient,
+		eventRecorder: eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "longhorn-preflight-check-controller"}),
+	}
+
+	var err error
+	if _, err = ds.PreflightCheckInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    pcc.enqueuePreflightCheck,
+		UpdateFunc: func(old, cur interface{}) { pcc.enqueuePreflightCheck(cur) },
+		DeleteFunc: pcc.enqueuePreflightCheck,
+	}); err != nil {
+		return nil, err
+	}
+	pcc.cacheSyncs = append(pcc.cacheSyncs, ds.PreflightCheckInformer.HasSynced)
+
+	if _, err = ds.NodeInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, cur interface{}) { pcc.enqueueRunningPreflightChecks() },
+		DeleteFunc: func(obj interface{}) { pcc.enqueueRunningPreflightChecks() },
+	}, 0); err != nil {
+		return nil, err
+	}
+	pcc.cacheSyncs = append(pcc.cacheSyncs, ds.NodeInformer.HasSynced)
+
+	return pcc, nil
+}
+
+func (pcc *PreflightCheckController) enqueuePreflightCheck(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to get key for object %#v: %v", obj, err))
+		return
+	}
+
+	pcc.queue.Add(key)
+}
+
+func (pcc *PreflightCheckController) enqueueRunningPreflightChecks() {
+	preflightChecks, err := pcc.ds.ListPreflightChecksRO()
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to list preflight checks since %v", err))
+		return
+	}
+
+	for _, preflightCheck := range preflightChecks {
+		if preflightCheck.Status.State == longhorn.PreflightCheckStateRunning {
+			pcc.enqueuePreflightCheck(preflightCheck)
+		}
+	}
+}
+
+func (pcc *PreflightCheckController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer pcc.queue.ShutDown()
+
+	pcc.logger.Info("Starting Longhorn PreflightCheck controller")
+	defer pcc.logger.Info("Shut down Longhorn PreflightCheck controller")
+
+	if !cache.WaitForNamedCacheSync(pcc.name, stopCh, pcc.cacheSyncs...) {
+		return
+	}
+	for i := 0; i < workers; i++ {
+		go wait.Until(pcc.worker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+func (pcc *PreflightCheckController) worker() {
+	for pcc.processNextWorkItem() {
+	}
+}
+
+func (pcc *PreflightCheckController) processNextWorkItem() bool {
+	key, quit := pcc.queue.Get()
+	if quit {
+		return false
+	}
+	defer pcc.queue.Done(key)
+	err := pcc.syncPreflightCheck(key.(string))
+	pcc.handleErr(err, key)
+	return true
+}
+
+func (pcc *PreflightCheckController) handleErr(err error, key interface{}) {
+	if err == nil {
+		pcc.queue.Forget(key)
+		return
+	}
+
+	log := pcc.logger.WithField("preflightCheck", key)
+	if pcc.queue.NumRequeues(key) < maxRetries {
+		handleReconcileErrorLogging(log, err, "Failed to sync Longhorn preflight check")
+		pcc.queue.AddRateLimited(key)
+		return
+	}
+
+	utilruntime.HandleError(err)
+	handleReconcileErrorLogging(log, err, "Dropping Longhorn preflight check out of the queue")
+	pcc.queue.Forget(key)
+}
+
+func (pcc *PreflightCheckController) syncPreflightCheck(key string) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to sync preflight check %v", key)
+	}()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	if namespace != pcc.namespace {
+		return nil
+	}
+	return pcc.reconcile(name)
+}
+
+func getLoggerForPreflightCheck(logger logrus.FieldLogger, preflightCheck *longhorn.PreflightCheck) *logrus.Entry {
+	return logger.WithFields(
+		logrus.Fields{
+			"preflightCheck": preflightCheck.Name,
+		},
+	)
+}
+
+func (pcc *PreflightCheckController) isResponsibleFor(preflightCheck *longhorn.PreflightCheck) bool {
+	return isControllerResponsibleFor(pcc.controllerID, pcc.ds, preflightCheck.Name, "", preflightCheck.Status.OwnerID)
+}
+
+func (pcc *PreflightCheckController) reconcile(name string) (err error) {
+	preflightCheck, err := pcc.ds.GetPreflightCheck(name)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	log := getLoggerForPreflightCheck(pcc.logger, preflightCheck)
+
+	isOwner := pcc.isResponsibleFor(preflightCheck)
+	if isOwner && preflightCheck.Status.OwnerID != pcc.controllerID {
+		preflightCheck.Status.OwnerID = pcc.controllerID
+		preflightCheck, err = pcc.ds.UpdatePreflightCheckStatus(preflightCheck)
+		if err != nil {
+			// we don't mind others coming first
+			if datastore.ErrorIsConflict(errors.Cause(err)) {
+				return nil
+			}
+			return err
+		}
+		log.Infof("Preflight check got new owner %v", pcc.controllerID)
+	}
+
+	if !preflightCheck.DeletionTimestamp.IsZero() {
+		if !isOwner {
+			return nil
+		}
+		return pcc.ds.RemoveFinalizerForPreflightCheck(preflightCheck)
+	}
+
+	existingPreflightCheck := preflightCheck.DeepCopy()
+	defer func() {
+		if err != nil {
+			return
+		}
+		if reflect.DeepEqual(existingPreflightCheck.Status, preflightCheck.Status) {
+			return
+		}
+		if _, err = pcc.ds.UpdatePreflightCheckStatus(preflightCheck); err != nil && datastore.ErrorIsConflict(errors.Cause(err)) {
+			log.WithError(err).Debugf("Requeue %v due to conflict", name)
+			pcc.enqueuePreflightCheck(preflightCheck)
+			err = nil
+		}
+	}()
+
+	switch preflightCheck.Status.State {
+	case longhorn.PreflightCheckStateCompleted:
+		return nil
+	case "":
+		if !isOwner {
+			return nil
+		}
+		return pcc.startPreflightCheck(preflightCheck)
+	}
+
+	if report, ok := preflightCheck.Status.Nodes[pcc.controllerID]; ok && report.Result == "" {
+		log.Info("Running preflight checks on the node")
+		preflightCheck.Status.Nodes[pcc.controllerID] = pcc.runPreflightChecks(preflightCheck)
+	}
+
+	if !isOwner {
+		return nil
+	}
+	return pcc.completePreflightCheck(preflightCheck)
+}
+
+// startPreflightCheck decides the nodes to be checked. Each node fills in its own report.
+func (pcc *PreflightCheckController) startPreflightCheck(preflightCheck *longhorn.PreflightCheck) error {
+	nodeNames := preflightCheck.Spec.Nodes
+	if len(nodeNames) == 0 {
+		nodes, err := pcc.ds.ListNodesRO()
+		if err != nil {
+			return err
+		}
+		for _, node := range nodes {
+			nodeNames = append(nodeNames, node.Name)
+		}
+	}
+
+	preflightCheck.Status.Nodes = map[string]*longhorn.PreflightNodeReport{}
+	for _, nodeName := range nodeNames {
+		preflightCheck.Status.Nodes[nodeName] = &longhorn.PreflightNodeReport{}
+	}
+	preflightCheck.Status.State = longhorn.PreflightCheckStateRunning
+	preflightCheck.Status.StartedAt = util.Now()
+	return nil
+}
+
+// completePreflightCheck reports the nodes unable to run the checks, and completes the check once all nodes report.
+func (pcc *PreflightCheckController) completePreflightCheck(preflightCheck *longhorn.PreflightCheck) error {
+	result := longhorn.PreflightCheckResultPass
+	for nodeName, report := range preflightCheck.Status.Nodes {
+		if report.Result == "" {
+			isUnavailable, err := pcc.ds.IsNodeDownOrDeletedOrMissingManager(nodeName)
+			if err != nil && !datastore.ErrorIsNotFound(err) {
+				return err
+			}
+			if err == nil && !isUnavailable {
+				return nil
+			}
+			report = &longhorn.PreflightNodeReport{
+				Result: longhorn.PreflightCheckResultFail,
+				Checks: []longhorn.PreflightCheckItem{
+					{
+						Name:    preflightCheckNameManagerAvailable,
+						Result:  longhorn.PreflightCheckResultFail,
+						Message: fmt.Sprintf("Node %v is down, deleted or missing the Longhorn manager", nodeName),
+					},
+				},
+				CheckedAt: util.Now(),
+			}
+			preflightCheck.Status.Nodes[nodeName] = report
+		}
+		result = monitor.WorsePreflightCheckResult(result, report.Result)
+	}
+
+	preflightCheck.Status.State = longhorn.PreflightCheckStateCompleted
+	preflightCheck.Status.Result = result
+	preflightCheck.Status.CompletedAt = util.Now()
+	pcc.eventRecorder.Eventf(preflightCheck, corev1.EventTypeNormal, constant.EventReasonPreflightCheckCompleted,
+		"Completed preflight check of %v nodes with result %v", len(preflightCheck.Status.Nodes), result)
+	return nil
+}
+
+func (pcc *PreflightCheckController) runPreflightChecks(preflightCheck *longhorn.PreflightCheck) *longhorn.PreflightNodeReport {
+	kubeNode, err := pcc.ds.GetKubernetesNodeRO(pcc.controllerID)
+	if err != nil {
+		return &longhorn.PreflightNodeReport{
+			Result: longhorn.PreflightCheckResultFail,
+			Checks: []longhorn.PreflightCheckItem{
+				{
+					Name:    preflightCheckNameManagerAvailable,
+					Result:  longhorn.PreflightCheckResultFail,
+					Message: fmt.Sprintf("Failed to get Kubernetes node %v: %v", pcc.controllerID, err),
+				},
+			},
+			CheckedAt: util.Now(),
+		}
+	}
+
+	isV2DataEngine := preflightCheck.Spec.V2DataEngine
+	if !isV2DataEngine {
+		if isV2DataEngine, err = pcc.ds.GetSettingAsBool(types.SettingNameV2DataEngine); err != nil {
+			pcc.logger.WithError(err).Warnf("Failed to get setting %v", types.SettingNameV2DataEngine)
+		}
+	}
+	hugePageLimitInMiB, err := pcc.ds.GetSettingAsIntByDataEngine(types.SettingNameDataEngineHugepageLimit, longhorn.DataEngineTypeV2)
+	if err != nil {
+		pcc.logger.WithError(err).Warnf("Failed to get setting %v", types.SettingNameDataEngineHugepageLimit)
+	}
+
+	kubeletRootDir := preflightCheck.Spec.KubeletRootDir
+	if kubeletRootDir == "" {
+		kubeletRootDir = types.DefaultKubeletRootDir
+	}
+
+	return monitor.RunPreflightChecks(pcc.logger, &monitor.PreflightOptions{
+		KubeNode:             kubeNode,
+		V2DataEngine:         isV2DataEngine,
+		HugePageLimitInMiB:   hugePageLimitInMiB,
+		DiskPaths:            pcc.getPreflightDiskPaths(preflightCheck),
+		KubeletRootDir:       kubeletRootDir,
+		MountPropagationPath: monitor.DefaultPreflightMountPropagationPath,
+		ReferenceTime: func() (time.Time, error) {
+			return monitor.GetKubernetesAPIServerTime(pcc.kubeClient)
+		},
+	})
+}
+
+// getPreflightDiskPaths returns the paths of the filesystem disks of the node and the paths in the spec.
+func (pcc *PreflightCheckController) getPreflightDiskPaths(preflightCheck *longhorn.PreflightCheck) []string {
+	paths := map[string]struct{}{}
+	for _, path := range preflightCheck.Spec.DiskPaths {
+		paths[path] = struct{}{}
+	}
+	if node, err := pcc.ds.GetNodeRO(pcc.controllerID); err == nil {
+		for _, disk := range node.Spec.Disks {
+			if disk.Type == longhorn.DiskTypeFilesystem {
+				paths[disk.Path] = struct{}{}
+			}
+		}
+	}
+
+	diskPaths := []string{}
+	for path := range paths {
+		diskPaths = append(diskPaths, path)
+	}
+	sort.Strings(diskPaths)
+	return diskPaths
+}
@@ -458,7 +458,7 @@ func IsRebuildingReplica(r *longhorn.Replica) bool {
 func (rc *ReplicaController) CanStartRebuildingReplica(r *longhorn.Replica) (bool, error) {
 	log := getLoggerForReplica(rc.logger, r)
 
-	concurrentRebuildingLimit, err := rc.ds.GetSettingAsInt(types.SettingNameConcurrentReplicaRebuildPerNodeLimit)
+	concurrentRebuildingLimit, err := rc.ds.GetNodeSettingAsInt(types.SettingNameConcurrentReplicaRebuildPerNodeLimit, r.Spec.NodeID)
 	if err != nil {
 		return false, err
 	}
@@ -848,7 +848,8 @@ func (rc *ReplicaController) enqueueSettingChange(obj interface{}) {
 		}
 	}
 
-	if types.SettingName(setting.Name) != types.SettingNameConcurrentReplicaRebuildPerNodeLimit {
+	if types.SettingName(setting.Name) != types.SettingNameConcurrentReplicaRebuildPerNodeLimit &&
+		types.SettingName(setting.Name) != types.SettingNameNodeSettingOverrides {
 		return
 	}
 
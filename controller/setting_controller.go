@@ -227,6 +227,10 @@ func (sc *SettingController) syncSetting(key string) (err error) {
 		}
 	}()
 
+	if err := sc.syncSettingHistory(types.SettingName(name)); err != nil {
+		return err
+	}
+
 	if err := sc.syncNonDangerZoneSettingsForManagedComponents(types.SettingName(name)); err != nil {
 		return err
 	}
@@ -234,6 +238,92 @@ func (sc *SettingController) syncSetting(key string) (err error) {
 	return sc.syncDangerZoneSettingsForManagedComponents(types.SettingName(name))
 }
 
+// syncSettingHistory records the latest value change annotated by the setting webhook into the SettingHistory
+func (sc *SettingController) syncSettingHistory(settingName types.SettingName) error {
+	responsibleNodeID, err := getResponsibleNodeID(sc.ds)
+	if err != nil {
+		return errors.Wrap(err, "failed to select node for recording setting history")
+	}
+	if responsibleNodeID != sc.controllerID {
+		return nil
+	}
+
+	setting, err := sc.ds.GetSettingExactRO(settingName)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	pending, err := types.GetPendingSettingRevisions(setting.Annotations)
+	if err != nil {
+		sc.logger.WithError(err).Warnf("Dropping invalid pending revisions of setting %v", setting.Name)
+	}
+	if err == nil && len(pending) == 0 {
+		return nil
+	}
+
+	limit, err := sc.ds.GetSettingAsInt(types.SettingNameSettingHistoryLimit)
+	if err != nil {
+		return err
+	}
+	if limit > 0 && len(pending) > 0 {
+		if err := sc.recordSettingRevisions(setting, pending, int(limit)); err != nil {
+			return err
+		}
+	}
+
+	// The revisions noted after this sync fail the update with a conflict, and are recorded in the next sync.
+	setting = setting.DeepCopy()
+	delete(setting.Annotations, types.GetLonghornLabelKey(types.SettingPendingRevisions))
+	_, err = sc.ds.UpdateSetting(setting)
+	return err
+}
+
+// recordSettingRevisions appends the revisions newer than the current revision to the setting history, and only
+// retains the latest revisions up to the limit.
+func (sc *SettingController) recordSettingRevisions(setting *longhorn.Setting, revisions []longhorn.SettingRevision, limit int) error {
+	history, err := sc.ds.GetSettingHistory(setting.Name)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		history, err = sc.ds.CreateSettingHistory(&longhorn.SettingHistory{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            setting.Name,
+				OwnerReferences: datastore.GetOwnerReferencesForSetting(setting),
+			},
+			Spec: longhorn.SettingHistorySpec{
+				SettingName: setting.Name,
+			},
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create setting history for %v", setting.Name)
+		}
+	}
+
+	existingStatus := history.Status.DeepCopy()
+	for _, revision := range revisions {
+		// The revision is already recorded by a previous sync that failed to clean up the pending revisions
+		if revision.Revision <= history.Status.CurrentRevision {
+			continue
+		}
+		history.Status.CurrentRevision = revision.Revision
+		history.Status.Revisions = append(history.Status.Revisions, revision)
+		sc.logger.Infof("Recorded revision %v of setting %v changed by %v", revision.Revision, setting.Name, revision.Requester)
+	}
+	if len(history.Status.Revisions) > limit {
+		history.Status.Revisions = history.Status.Revisions[len(history.Status.Revisions)-limit:]
+	}
+	if reflect.DeepEqual(existingStatus, &history.Status) {
+		return nil
+	}
+
+	_, err = sc.ds.UpdateSettingHistoryStatus(history)
+	return err
+}
+
 func (sc *SettingController) syncNonDangerZoneSettingsForManagedComponents(settingName types.SettingName) error {
 	switch settingName {
 	case types.SettingNameUpgradeChecker:
@@ -1546,6 +1636,7 @@ func (info *ClusterInfo) collectSettings() error {
 		types.SettingNamePriorityClass:                       true,
 		types.SettingNameSnapshotDataIntegrityCronJob:        true,
 		types.SettingNameStorageNetwork:                      true,
+		types.SettingNameNodeSettingOverrides:                true,
 	}
 
 	include := map[types.SettingName]bool{
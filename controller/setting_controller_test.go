@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kubernetes/pkg/controller"
+
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+
+	. "gopkg.in/check.v1"
+)
+
+type SettingHistoryTestCase struct {
+	historyLimit      int
+	recordedRevisions []int64
+	pendingRevisions  []int64
+
+	expectedRevisions       []int64
+	expectedCurrentRevision int64
+}
+
+func newSettingRevision(revision int64) longhorn.SettingRevision {
+	return longhorn.SettingRevision{
+		Revision:  revision,
+		OldValue:  strconv.FormatInt(revision-1, 10),
+		NewValue:  strconv.FormatInt(revision, 10),
+		Requester: TestServiceAccount,
+		Source:    longhorn.SettingUpdateSourceKubernetes,
+		UpdatedAt: getTestNow(),
+	}
+}
+
+func (s *TestSuite) TestSyncSettingHistory(c *C) {
+	datastore.SkipListerCheck = true
+
+	settingName := string(types.SettingNameBackupConcurrentLimit)
+
+	testCases := map[string]SettingHistoryTestCase{
+		"record pending revisions": {
+			historyLimit:            20,
+			pendingRevisions:        []int64{1, 2},
+			expectedRevisions:       []int64{1, 2},
+			expectedCurrentRevision: 2,
+		},
+		"skip revisions recorded by a previous sync": {
+			historyLimit:            20,
+			recordedRevisions:       []int64{1, 2},
+			pendingRevisions:        []int64{2, 3},
+			expectedRevisions:       []int64{1, 2, 3},
+			expectedCurrentRevision: 3,
+		},
+		"trim revisions to the history limit": {
+			historyLimit:            2,
+			recordedRevisions:       []int64{1, 2},
+			pendingRevisions:        []int64{3, 4},
+			expectedRevisions:       []int64{3, 4},
+			expectedCurrentRevision: 4,
+		},
+		"drop pending revisions if the history is disabled": {
+			historyLimit:     0,
+			pendingRevisions: []int64{1},
+		},
+	}
+
+	for name, tc := range testCases {
+		fmt.Printf("testing %v\n", name)
+
+		kubeClient := fake.NewSimpleClientset()
+		lhClient := lhfake.NewSimpleClientset()
+		extensionsClient := apiextensionsfake.NewSimpleClientset()
+
+		informerFactories := util.NewInformerFactories(TestNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+		lhInformerFactory := informerFactories.LhInformerFactory
+		ds := datastore.NewDataStore(TestNamespace, lhClient, kubeClient, extensionsClient, informerFactories)
+
+		sc := &SettingController{
+			baseController: newBaseController("longhorn-setting", logrus.StandardLogger()),
+			namespace:      TestNamespace,
+			controllerID:   TestNode1,
+			ds:             ds,
+		}
+
+		node := newNode(TestNode1, TestNamespace, true, longhorn.ConditionStatusTrue, "")
+		err := lhInformerFactory.Longhorn().V1beta2().Nodes().Informer().GetIndexer().Add(node)
+		c.Assert(err, IsNil)
+
+		limitSetting := newSetting(string(types.SettingNameSettingHistoryLimit), strconv.Itoa(tc.historyLimit))
+		err = lhInformerFactory.Longhorn().V1beta2().Settings().Informer().GetIndexer().Add(limitSetting)
+		c.Assert(err, IsNil)
+
+		pending := []longhorn.SettingRevision{}
+		for _, r := range tc.pendingRevisions {
+			pending = append(pending, newSettingRevision(r))
+		}
+		pendingBytes, err := json.Marshal(pending)
+		c.Assert(err, IsNil)
+		latestRevision := tc.pendingRevisions[len(tc.pendingRevisions)-1]
+		setting := newSetting(settingName, strconv.FormatInt(latestRevision, 10))
+		setting.Annotations = map[string]string{
+			types.GetLonghornLabelKey(types.SettingPendingRevisions): string(pendingBytes),
+			types.GetLonghornLabelKey(types.SettingLatestRevision):   strconv.FormatInt(latestRevision, 10),
+		}
+		setting, err = lhClient.LonghornV1beta2().Settings(TestNamespace).Create(context.TODO(), setting, metav1.CreateOptions{})
+		c.Assert(err, IsNil)
+		err = lhInformerFactory.Longhorn().V1beta2().Settings().Informer().GetIndexer().Add(setting)
+		c.Assert(err, IsNil)
+
+		if len(tc.recordedRevisions) > 0 {
+			history := &longhorn.SettingHistory{
+				ObjectMeta: metav1.ObjectMeta{Name: settingName, Namespace: TestNamespace},
+				Spec:       longhorn.SettingHistorySpec{SettingName: settingName},
+			}
+			for _, r := range tc.recordedRevisions {
+				history.Status.Revisions = append(history.Status.Revisions, newSettingRevision(r))
+				history.Status.CurrentRevision = r
+			}
+			history, err = lhClient.LonghornV1beta2().SettingHistories(TestNamespace).Create(context.TODO(), history, metav1.CreateOptions{})
+			c.Assert(err, IsNil)
+			err = lhInformerFactory.Longhorn().V1beta2().SettingHistories().Informer().GetIndexer().Add(history)
+			c.Assert(err, IsNil)
+		}
+
+		err = sc.syncSettingHistory(types.SettingName(settingName))
+		c.Assert(err, IsNil)
+
+		setting, err = lhClient.LonghornV1beta2().Settings(TestNamespace).Get(context.TODO(), settingName, metav1.GetOptions{})
+		c.Assert(err, IsNil)
+		_, isPending := setting.Annotations[types.GetLonghornLabelKey(types.SettingPendingRevisions)]
+		c.Assert(isPending, Equals, false)
+		c.Assert(setting.Annotations[types.GetLonghornLabelKey(types.SettingLatestRevision)], Equals, strconv.FormatInt(latestRevision, 10))
+
+		history, err := lhClient.LonghornV1beta2().SettingHistories(TestNamespace).Get(context.TODO(), settingName, metav1.GetOptions{})
+		if len(tc.expectedRevisions) == 0 {
+			c.Assert(apierrors.IsNotFound(err), Equals, true)
+			continue
+		}
+		c.Assert(err, IsNil)
+		c.Assert(history.Status.CurrentRevision, Equals, tc.expectedCurrentRevision)
+		revisions := []int64{}
+		for _, r := range history.Status.Revisions {
+			revisions = append(revisions, r.Revision)
+			c.Assert(r, DeepEquals, newSettingRevision(r.Revision))
+		}
+		c.Assert(revisions, DeepEquals, tc.expectedRevisions)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"time"
@@ -31,6 +33,7 @@ import (
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/util/supportbundle"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -82,6 +85,8 @@ type SupportBundleController struct {
 	cacheSyncs []cache.InformerSynced
 
 	httpClient rest.HTTPClient
+	// downloadClient downloads the support bundle to be processed, which may take much longer than a status request
+	downloadClient rest.HTTPClient
 }
 
 func NewSupportBundleController(
@@ -108,8 +113,9 @@ func NewSupportBundleController(
 
 		ds: ds,
 
-		kubeClient: kubeClient,
-		httpClient: &http.Client{Timeout: 30 * time.Second},
+		kubeClient:     kubeClient,
+		httpClient:     &http.Client{Timeout: 30 * time.Second},
+		downloadClient: &http.Client{Timeout: types.SupportBundleDownloadTimeout},
 
 		eventRecorder: eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "longhorn-support-bundle-controller"}),
 	}
@@ -407,10 +413,19 @@ func (c *SupportBundleController) reconcile(name string) (err error) {
 			return nil
 		}
 
-		message := fmt.Sprintf(longhorn.SupportBundleMsgGeneratedFmt,
-			supportBundle.Status.Filename,
-			fmt.Sprintf(types.SupportBundleURLDownloadFmt, supportBundleManager.podIP, types.SupportBundleURLPort),
-		)
+		downloadURL := fmt.Sprintf(types.SupportBundleURLDownloadFmt, supportBundleManager.podIP, types.SupportBundleURLPort)
+		if supportbundle.IsRequired(supportBundle.Spec.Scope, supportBundle.Spec.RedactionPolicy) {
+			if err = c.processSupportBundle(supportBundle, downloadURL); err != nil {
+				return c.updateSupportBundleRecord(record,
+					supportBundleRecordError, longhorn.SupportBundleStateError,
+					fmt.Sprintf(constant.EventReasonFailedCreatingFmt, types.LonghornKindSupportBundle, supportBundle.Name),
+					err.Error(),
+				)
+			}
+			downloadURL = types.GetProcessedSupportBundlePath(supportBundle.Name)
+		}
+
+		message := fmt.Sprintf(longhorn.SupportBundleMsgGeneratedFmt, supportBundle.Status.Filename, downloadURL)
 		err = c.updateSupportBundleRecord(record,
 			supportBundleRecordNormal, longhorn.SupportBundleStateReady,
 			constant.EventReasonCreate, message,
@@ -419,6 +434,19 @@ func (c *SupportBundleController) reconcile(name string) (err error) {
 			log.WithError(err).WithFields(logrus.Fields{"record": record}).Warn("Failed to update support bundle record")
 		}
 
+	case longhorn.SupportBundleStateReady:
+		if !supportbundle.IsRequired(supportBundle.Spec.Scope, supportBundle.Spec.RedactionPolicy) {
+			return nil
+		}
+		// The processed support bundle is lost if the owner longhorn-manager is restarted or replaced
+		if _, err = os.Stat(types.GetProcessedSupportBundlePath(supportBundle.Name)); err != nil {
+			return c.updateSupportBundleRecord(record,
+				supportBundleRecordError, longhorn.SupportBundleStateError,
+				fmt.Sprintf(constant.EventReasonFailedCreatingFmt, types.LonghornKindSupportBundle, supportBundle.Name),
+				fmt.Sprintf("processed support bundle is not found on node %v: %v", c.controllerID, err),
+			)
+		}
+
 	case longhorn.SupportBundleStateDeleting:
 		log.Info("Deleting SupportBundle")
 
@@ -620,7 +648,80 @@ func (c *SupportBundleController) isResponsibleFor(supportBundle *longhorn.Suppo
 	return isControllerResponsibleFor(c.controllerID, c.ds, supportBundle.Name, supportBundle.Spec.NodeID, supportBundle.Status.OwnerID)
 }
 
+// processSupportBundle downloads the support bundle from the support bundle manager, limits it to the scope and scrubs
+// the sensitive data. The processed support bundle is kept on this node until the support bundle is deleted or purged,
+// and the support bundle manager is deleted so the original support bundle cannot be downloaded anymore.
+func (c *SupportBundleController) processSupportBundle(supportBundle *longhorn.SupportBundle, downloadURL string) error {
+	processor, err := c.newSupportBundleProcessor(supportBundle)
+	if err != nil {
+		return err
+	}
+
+	processedPath := types.GetProcessedSupportBundlePath(supportBundle.Name)
+	if err := os.MkdirAll(filepath.Dir(processedPath), 0700); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.downloadClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to download support bundle")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download support bundle: unexpected status code: %d", resp.StatusCode)
+	}
+
+	size, err := processor.ProcessFile(resp.Body, processedPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to process support bundle")
+	}
+	supportBundle.Status.Filesize = size
+	supportBundle.Status.IP = ""
+
+	return c.deleteSupportBundleManager(supportBundle)
+}
+
+// newSupportBundleProcessor creates the processor limiting the support bundle to its scope and
+// scrubbing the sensitive data according to its redaction policy.
+func (c *SupportBundleController) newSupportBundleProcessor(supportBundle *longhorn.SupportBundle) (*supportbundle.Processor, error) {
+	hostnames := []string{}
+	if supportBundle.Spec.RedactionPolicy.Hostnames {
+		nodes, err := c.ds.ListNodesRO()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list nodes for hostname redaction")
+		}
+		for _, node := range nodes {
+			hostnames = append(hostnames, node.Name)
+		}
+	}
+
+	volumeNames := []string{}
+	if len(supportBundle.Spec.Scope.VolumeNames) > 0 {
+		volumes, err := c.ds.ListVolumesRO()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list volumes for the volume scope")
+		}
+		for _, volume := range volumes {
+			volumeNames = append(volumeNames, volume.Name)
+		}
+	}
+
+	return supportbundle.NewProcessor(supportBundle.Spec.Scope, supportBundle.Spec.RedactionPolicy, hostnames, volumeNames)
+}
+
+// cleanupSupportBundle deletes the processed support bundle and the support bundle manager.
 func (c *SupportBundleController) cleanupSupportBundle(supportBundle *longhorn.SupportBundle) error {
+	if err := os.Remove(types.GetProcessedSupportBundlePath(supportBundle.Name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return c.deleteSupportBundleManager(supportBundle)
+}
+
+func (c *SupportBundleController) deleteSupportBundleManager(supportBundle *longhorn.SupportBundle) error {
 	supportBundleManagerName := GetSupportBundleManagerName(supportBundle)
 	deployment, err := c.ds.GetDeployment(supportBundleManagerName)
 	if err != nil {
@@ -785,6 +886,22 @@ func (c *SupportBundleController) newSupportBundleManager(supportBundle *longhor
 									Name:  "LONGHORN_LOG_PATH",
 									Value: strings.TrimSuffix(logPath, "/"),
 								},
+								{
+									Name:  "SUPPORT_BUNDLE_SCOPE_VOLUMES",
+									Value: strings.Join(supportBundle.Spec.Scope.VolumeNames, ","),
+								},
+								{
+									Name:  "SUPPORT_BUNDLE_SCOPE_NODES",
+									Value: strings.Join(supportBundle.Spec.Scope.NodeNames, ","),
+								},
+								{
+									Name:  "SUPPORT_BUNDLE_SCOPE_SINCE",
+									Value: supportBundle.Spec.Scope.Since,
+								},
+								{
+									Name:  "SUPPORT_BUNDLE_SCOPE_UNTIL",
+									Value: supportBundle.Spec.Scope.Until,
+								},
 							},
 							Ports: []corev1.ContainerPort{
 								{
@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"archive/zip"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -214,6 +215,64 @@ func (s *TestSuite) TestReconcileSupportBundle(c *C) {
 	}
 }
 
+func (s *TestSuite) TestProcessedSupportBundleStaysReady(c *C) {
+	kubeClient := fake.NewSimpleClientset()
+	lhClient := lhfake.NewSimpleClientset()
+	extensionsClient := apiextensionsfake.NewSimpleClientset()
+
+	informerFactories := util.NewInformerFactories(TestNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+
+	fakeSupportBundleNamespace(c, informerFactories.KubeInformerFactory, kubeClient)
+	fakeSupportBundleManagerImageSetting(c, informerFactories.LhInformerFactory, lhClient)
+	fakeSupportBundleFailedHistoryLimitSetting("1", c, informerFactories.LhInformerFactory, lhClient)
+
+	supportBundleController, err := newFakeSupportBundleController(lhClient, kubeClient, extensionsClient, informerFactories, TestNode1)
+	c.Assert(err, IsNil)
+	supportBundleController.downloadClient = &fakeSupportBundleDownloadClient{
+		files: map[string]string{"supportbundle/logs/longhorn-manager.log": "started"},
+	}
+
+	supportBundle := newSupportBundle(TestSupportBundleName, "", "", TestNode1, longhorn.SupportBundleStateGenerating, &metav1.Time{})
+	supportBundle.Spec.RedactionPolicy.Secrets = true
+	err = util.AddFinalizer(longhornFinalizerKey, supportBundle)
+	c.Assert(err, IsNil)
+	supportBundle, err = lhClient.LonghornV1beta2().SupportBundles(TestNamespace).Create(context.TODO(), supportBundle, metav1.CreateOptions{})
+	c.Assert(err, IsNil)
+	indexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().SupportBundles().Informer().GetIndexer()
+	err = indexer.Add(supportBundle)
+	c.Assert(err, IsNil)
+	fakeSupportBundleManagerPod(TestSupportBundleManagerPodName, supportBundle, supportBundleController.ds, c, informerFactories.KubeInformerFactory, kubeClient)
+
+	processedPath := types.GetProcessedSupportBundlePath(TestSupportBundleName)
+	defer func() {
+		_ = os.Remove(processedPath)
+	}()
+
+	err = supportBundleController.reconcile(TestSupportBundleName)
+	c.Assert(err, IsNil)
+	supportBundle, err = lhClient.LonghornV1beta2().SupportBundles(TestNamespace).Get(context.TODO(), TestSupportBundleName, metav1.GetOptions{})
+	c.Assert(err, IsNil)
+	c.Assert(supportBundle.Status.State, Equals, longhorn.SupportBundleStateReady)
+
+	// The processed support bundle is kept after the support bundle manager is deleted
+	err = indexer.Update(supportBundle)
+	c.Assert(err, IsNil)
+	err = supportBundleController.reconcile(TestSupportBundleName)
+	c.Assert(err, IsNil)
+	supportBundle, err = lhClient.LonghornV1beta2().SupportBundles(TestNamespace).Get(context.TODO(), TestSupportBundleName, metav1.GetOptions{})
+	c.Assert(err, IsNil)
+	c.Assert(supportBundle.Status.State, Equals, longhorn.SupportBundleStateReady)
+
+	zipReader, err := zip.OpenReader(processedPath)
+	c.Assert(err, IsNil)
+	defer zipReader.Close()
+	c.Assert(zipReader.File, HasLen, 1)
+	c.Assert(zipReader.File[0].Name, Equals, "supportbundle/logs/longhorn-manager.log")
+	info, err := os.Stat(processedPath)
+	c.Assert(err, IsNil)
+	c.Assert(supportBundle.Status.Filesize, Equals, info.Size())
+}
+
 func newFakeSupportBundleController(lhClient *lhfake.Clientset, kubeClient *fake.Clientset, extensionsClient *apiextensionsfake.Clientset,
 	informerFactories *util.InformerFactories, controllerID string) (*SupportBundleController, error) {
 	ds := datastore.NewDataStore(TestNamespace, lhClient, kubeClient, extensionsClient, informerFactories)
@@ -366,3 +425,29 @@ func (m *fakeSupportBundleHTTPClient) Do(req *http.Request) (*http.Response, err
 	}
 	return &http.Response{}, nil
 }
+
+// fakeSupportBundleDownloadClient serves a support bundle zip holding the files.
+type fakeSupportBundleDownloadClient struct {
+	files map[string]string
+}
+
+func (m *fakeSupportBundleDownloadClient) Do(req *http.Request) (*http.Response, error) {
+	buf := &bytes.Buffer{}
+	zipWriter := zip.NewWriter(buf)
+	for name, content := range m.files {
+		w, err := zipWriter.Create(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			return nil, err
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(buf),
+	}, nil
+}
@@ -46,6 +46,14 @@ const (
 	CRDRecurringJobName           = "recurringjobs.longhorn.io"
 	CRDOrphanName                 = "orphans.longhorn.io"
 	CRDSnapshotName               = "snapshots.longhorn.io"
+	CRDNodeMaintenanceName        = "nodemaintenances.longhorn.io"
+	CRDNotificationSinkName       = "notificationsinks.longhorn.io"
+	CRDPreflightCheckName         = "preflightchecks.longhorn.io"
+	CRDDisasterRecoveryPlanName   = "disasterrecoveryplans.longhorn.io"
+	CRDFileRestoreSessionName     = "filerestoresessions.longhorn.io"
+	CRDDataEngineConversionName   = "dataengineconversions.longhorn.io"
+	CRDVolumeTransferName         = "volumetransfers.longhorn.io"
+	CRDVolumeShrinkName           = "volumeshrinks.longhorn.io"
 
 	EnvLonghornNamespace = "LONGHORN_NAMESPACE"
 )
@@ -203,6 +211,54 @@ func NewUninstallController(
 		}
 		cacheSyncs = append(cacheSyncs, ds.SnapshotInformer.HasSynced)
 	}
+	if _, err := extensionsClient.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), CRDNodeMaintenanceName, metav1.GetOptions{}); err == nil {
+		if _, err = ds.NodeMaintenanceInformer.AddEventHandler(c.controlleeHandler()); err != nil {
+			return nil, err
+		}
+		cacheSyncs = append(cacheSyncs, ds.NodeMaintenanceInformer.HasSynced)
+	}
+	if _, err := extensionsClient.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), CRDNotificationSinkName, metav1.GetOptions{}); err == nil {
+		if _, err = ds.NotificationSinkInformer.AddEventHandler(c.controlleeHandler()); err != nil {
+			return nil, err
+		}
+		cacheSyncs = append(cacheSyncs, ds.NotificationSinkInformer.HasSynced)
+	}
+	if _, err := extensionsClient.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), CRDPreflightCheckName, metav1.GetOptions{}); err == nil {
+		if _, err = ds.PreflightCheckInformer.AddEventHandler(c.controlleeHandler()); err != nil {
+			return nil, err
+		}
+		cacheSyncs = append(cacheSyncs, ds.PreflightCheckInformer.HasSynced)
+	}
+	if _, err := extensionsClient.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), CRDDisasterRecoveryPlanName, metav1.GetOptions{}); err == nil {
+		if _, err = ds.DisasterRecoveryPlanInformer.AddEventHandler(c.controlleeHandler()); err != nil {
+			return nil, err
+		}
+		cacheSyncs = append(cacheSyncs, ds.DisasterRecoveryPlanInformer.HasSynced)
+	}
+	if _, err := extensionsClient.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), CRDFileRestoreSessionName, metav1.GetOptions{}); err == nil {
+		if _, err = ds.FileRestoreSessionInformer.AddEventHandler(c.controlleeHandler()); err != nil {
+			return nil, err
+		}
+		cacheSyncs = append(cacheSyncs, ds.FileRestoreSessionInformer.HasSynced)
+	}
+	if _, err := extensionsClient.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), CRDDataEngineConversionName, metav1.GetOptions{}); err == nil {
+		if _, err = ds.DataEngineConversionInformer.AddEventHandler(c.controlleeHandler()); err != nil {
+			return nil, err
+		}
+		cacheSyncs = append(cacheSyncs, ds.DataEngineConversionInformer.HasSynced)
+	}
+	if _, err := extensionsClient.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), CRDVolumeTransferName, metav1.GetOptions{}); err == nil {
+		if _, err = ds.VolumeTransferInformer.AddEventHandler(c.controlleeHandler()); err != nil {
+			return nil, err
+		}
+		cacheSyncs = append(cacheSyncs, ds.VolumeTransferInformer.HasSynced)
+	}
+	if _, err := extensionsClient.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), CRDVolumeShrinkName, metav1.GetOptions{}); err == nil {
+		if _, err = ds.VolumeShrinkInformer.AddEventHandler(c.controlleeHandler()); err != nil {
+			return nil, err
+		}
+		cacheSyncs = append(cacheSyncs, ds.VolumeShrinkInformer.HasSynced)
+	}
 
 	c.cacheSyncs = cacheSyncs
 
@@ -605,6 +661,62 @@ func (c *UninstallController) deleteCRs() (bool, error) {
 		return true, c.deleteRecurringJobs(recurringJobs)
 	}
 
+	if nodeMaintenances, err := c.ds.ListNodeMaintenancesRO(); err != nil {
+		return true, err
+	} else if len(nodeMaintenances) > 0 {
+		c.logger.Infof("Found %d node maintenances remaining", len(nodeMaintenances))
+		return true, c.deleteNodeMaintenances(nodeMaintenances)
+	}
+
+	if notificationSinks, err := c.ds.ListNotificationSinksRO(); err != nil {
+		return true, err
+	} else if len(notificationSinks) > 0 {
+		c.logger.Infof("Found %d notification sinks remaining", len(notificationSinks))
+		return true, c.deleteNotificationSinks(notificationSinks)
+	}
+
+	if preflightChecks, err := c.ds.ListPreflightChecksRO(); err != nil {
+		return true, err
+	} else if len(preflightChecks) > 0 {
+		c.logger.Infof("Found %d preflight checks remaining", len(preflightChecks))
+		return true, c.deletePreflightChecks(preflightChecks)
+	}
+
+	if disasterRecoveryPlans, err := c.ds.ListDisasterRecoveryPlansRO(); err != nil {
+		return true, err
+	} else if len(disasterRecoveryPlans) > 0 {
+		c.logger.Infof("Found %d disaster recovery plans remaining", len(disasterRecoveryPlans))
+		return true, c.deleteDisasterRecoveryPlans(disasterRecoveryPlans)
+	}
+
+	if fileRestoreSessions, err := c.ds.ListFileRestoreSessionsRO(); err != nil {
+		return true, err
+	} else if len(fileRestoreSessions) > 0 {
+		c.logger.Infof("Found %d file restore sessions remaining", len(fileRestoreSessions))
+		return true, c.deleteFileRestoreSessions(fileRestoreSessions)
+	}
+
+	if dataEngineConversions, err := c.ds.ListDataEngineConversionsRO(); err != nil {
+		return true, err
+	} else if len(dataEngineConversions) > 0 {
+		c.logger.Infof("Found %d data engine conversions remaining", len(dataEngineConversions))
+		return true, c.deleteDataEngineConversions(dataEngineConversions)
+	}
+
+	if volumeTransfers, err := c.ds.ListVolumeTransfersRO(); err != nil {
+		return true, err
+	} else if len(volumeTransfers) > 0 {
+		c.logger.Infof("Found %d volume transfers remaining", len(volumeTransfers))
+		return true, c.deleteVolumeTransfers(volumeTransfers)
+	}
+
+	if volumeShrinks, err := c.ds.ListVolumeShrinksRO(); err != nil {
+		return true, err
+	} else if len(volumeShrinks) > 0 {
+		c.logger.Infof("Found %d volume shrinks remaining", len(volumeShrinks))
+		return true, c.deleteVolumeShrinks(volumeShrinks)
+	}
+
 	if nodes, err := c.ds.ListNodes(); err != nil {
 		return true, err
 	} else if len(nodes) > 0 {
@@ -1177,6 +1289,182 @@ func (c *UninstallController) deleteOrphans(orphans map[string]*longhorn.Orphan)
 	return nil
 }
 
+func (c *UninstallController) deleteNodeMaintenances(nodeMaintenances []*longhorn.NodeMaintenance) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to delete node maintenances")
+	}()
+	for _, nodeMaintenance := range nodeMaintenances {
+		log := getLoggerForNodeMaintenance(c.logger, nodeMaintenance)
+		if nodeMaintenance.DeletionTimestamp == nil {
+			if errDelete := c.ds.DeleteNodeMaintenance(nodeMaintenance.Name); errDelete != nil {
+				if datastore.ErrorIsNotFound(errDelete) {
+					log.Info("NodeMaintenance is not found")
+				} else {
+					err = errors.Wrap(errDelete, "failed to mark for deletion")
+					return
+				}
+			} else {
+				log.Info("Marked for deletion")
+			}
+		}
+	}
+	return nil
+}
+
+func (c *UninstallController) deleteNotificationSinks(notificationSinks []*longhorn.NotificationSink) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to delete notification sinks")
+	}()
+	for _, notificationSink := range notificationSinks {
+		log := getLoggerForNotificationSink(c.logger, notificationSink)
+		if notificationSink.DeletionTimestamp == nil {
+			if errDelete := c.ds.DeleteNotificationSink(notificationSink.Name); errDelete != nil {
+				if datastore.ErrorIsNotFound(errDelete) {
+					log.Info("NotificationSink is not found")
+				} else {
+					err = errors.Wrap(errDelete, "failed to mark for deletion")
+					return
+				}
+			} else {
+				log.Info("Marked for deletion")
+			}
+		}
+	}
+	return nil
+}
+
+func (c *UninstallController) deletePreflightChecks(preflightChecks []*longhorn.PreflightCheck) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to delete preflight checks")
+	}()
+	for _, preflightCheck := range preflightChecks {
+		log := getLoggerForPreflightCheck(c.logger, preflightCheck)
+		if preflightCheck.DeletionTimestamp == nil {
+			if errDelete := c.ds.DeletePreflightCheck(preflightCheck.Name); errDelete != nil {
+				if datastore.ErrorIsNotFound(errDelete) {
+					log.Info("PreflightCheck is not found")
+				} else {
+					err = errors.Wrap(errDelete, "failed to mark for deletion")
+					return
+				}
+			} else {
+				log.Info("Marked for deletion")
+			}
+		}
+	}
+	return nil
+}
+
+func (c *UninstallController) deleteDisasterRecoveryPlans(disasterRecoveryPlans []*longhorn.DisasterRecoveryPlan) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to delete disaster recovery plans")
+	}()
+	for _, disasterRecoveryPlan := range disasterRecoveryPlans {
+		log := getLoggerForDisasterRecoveryPlan(c.logger, disasterRecoveryPlan)
+		if disasterRecoveryPlan.DeletionTimestamp == nil {
+			if errDelete := c.ds.DeleteDisasterRecoveryPlan(disasterRecoveryPlan.Name); errDelete != nil {
+				if datastore.ErrorIsNotFound(errDelete) {
+					log.Info("DisasterRecoveryPlan is not found")
+				} else {
+					err = errors.Wrap(errDelete, "failed to mark for deletion")
+					return
+				}
+			} else {
+				log.Info("Marked for deletion")
+			}
+		}
+	}
+	return nil
+}
+
+func (c *UninstallController) deleteFileRestoreSessions(fileRestoreSessions []*longhorn.FileRestoreSession) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to delete file restore sessions")
+	}()
+	for _, fileRestoreSession := range fileRestoreSessions {
+		log := getLoggerForFileRestoreSession(c.logger, fileRestoreSession)
+		if fileRestoreSession.DeletionTimestamp == nil {
+			if errDelete := c.ds.DeleteFileRestoreSession(fileRestoreSession.Name); errDelete != nil {
+				if datastore.ErrorIsNotFound(errDelete) {
+					log.Info("FileRestoreSession is not found")
+				} else {
+					err = errors.Wrap(errDelete, "failed to mark for deletion")
+					return
+				}
+			} else {
+				log.Info("Marked for deletion")
+			}
+		}
+	}
+	return nil
+}
+
+func (c *UninstallController) deleteDataEngineConversions(dataEngineConversions []*longhorn.DataEngineConversion) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to delete data engine conversions")
+	}()
+	for _, dataEngineConversion := range dataEngineConversions {
+		log := getLoggerForDataEngineConversion(c.logger, dataEngineConversion)
+		if dataEngineConversion.DeletionTimestamp == nil {
+			if errDelete := c.ds.DeleteDataEngineConversion(dataEngineConversion.Name); errDelete != nil {
+				if datastore.ErrorIsNotFound(errDelete) {
+					log.Info("DataEngineConversion is not found")
+				} else {
+					err = errors.Wrap(errDelete, "failed to mark for deletion")
+					return
+				}
+			} else {
+				log.Info("Marked for deletion")
+			}
+		}
+	}
+	return nil
+}
+
+func (c *UninstallController) deleteVolumeTransfers(volumeTransfers []*longhorn.VolumeTransfer) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to delete volume transfers")
+	}()
+	for _, volumeTransfer := range volumeTransfers {
+		log := getLoggerForVolumeTransfer(c.logger, volumeTransfer)
+		if volumeTransfer.DeletionTimestamp == nil {
+			if errDelete := c.ds.DeleteVolumeTransfer(volumeTransfer.Name); errDelete != nil {
+				if datastore.ErrorIsNotFound(errDelete) {
+					log.Info("VolumeTransfer is not found")
+				} else {
+					err = errors.Wrap(errDelete, "failed to mark for deletion")
+					return
+				}
+			} else {
+				log.Info("Marked for deletion")
+			}
+		}
+	}
+	return nil
+}
+
+func (c *UninstallController) deleteVolumeShrinks(volumeShrinks []*longhorn.VolumeShrink) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to delete volume shrinks")
+	}()
+	for _, volumeShrink := range volumeShrinks {
+		log := getLoggerForVolumeShrink(c.logger, volumeShrink)
+		if volumeShrink.DeletionTimestamp == nil {
+			if errDelete := c.ds.DeleteVolumeShrink(volumeShrink.Name); errDelete != nil {
+				if datastore.ErrorIsNotFound(errDelete) {
+					log.Info("VolumeShrink is not found")
+				} else {
+					err = errors.Wrap(errDelete, "failed to mark for deletion")
+					return
+				}
+			} else {
+				log.Info("Marked for deletion")
+			}
+		}
+	}
+	return nil
+}
+
 func (c *UninstallController) deleteSystemRestores(systemRestores map[string]*longhorn.SystemRestore) (err error) {
 	defer func() {
 		err = errors.Wrapf(err, "failed to delete SystemRestores")
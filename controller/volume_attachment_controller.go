@@ -328,6 +328,8 @@ func (vac *VolumeAttachmentController) reconcile(vaName string) (err error) {
 	// Note that in this controller the desire state is recorded in VA.Spec
 	// and the current state of the world is recorded inside volume CR
 
+	vac.handleExpiredAttachmentTickets(va)
+
 	vac.handleNodeCordoned(va, vol)
 
 	vac.handleVolumeDetachment(va, vol)
@@ -376,6 +378,33 @@ func (vac *VolumeAttachmentController) handleNodeCordoned(va *longhorn.VolumeAtt
 	}
 }
 
+// handleExpiredAttachmentTickets deletes the external attachment tickets whose TTL has passed, and requeues the va
+// for the next expiration
+func (vac *VolumeAttachmentController) handleExpiredAttachmentTickets(va *longhorn.VolumeAttachment) {
+	log := getLoggerForLHVolumeAttachment(vac.logger, va)
+
+	now := time.Now()
+	var nextExpiration time.Duration
+	for _, attachmentTicket := range va.Spec.AttachmentTickets {
+		expiresAt, ok := getAttachmentTicketExpiration(attachmentTicket)
+		if !ok {
+			continue
+		}
+		if !expiresAt.After(now) {
+			log.Infof("Deleting attachment ticket %v due to it expired at %v", attachmentTicket.ID, expiresAt.Format(time.RFC3339))
+			delete(va.Spec.AttachmentTickets, attachmentTicket.ID)
+			continue
+		}
+		if remaining := expiresAt.Sub(now); nextExpiration == 0 || remaining < nextExpiration {
+			nextExpiration = remaining
+		}
+	}
+
+	if nextExpiration > 0 {
+		vac.enqueueVolumeAttachmentAfter(va, nextExpiration)
+	}
+}
+
 func (vac *VolumeAttachmentController) handleVolumeMigration(va *longhorn.VolumeAttachment, vol *longhorn.Volume) {
 	if !util.IsMigratableVolume(vol) {
 		return
@@ -418,6 +447,9 @@ func (vac *VolumeAttachmentController) handleVolumeMigrationStart(va *longhorn.V
 
 	if attachmentTicket := getCSIAttachmentTicketNotRequestingNode(vol.Spec.NodeID, va, vol); attachmentTicket != nil {
 		// Found one csi attachmentTicket that is requesting volume to attach to a different node
+		if reservedNodeID := getReservedNodeID(va); reservedNodeID != "" && reservedNodeID != attachmentTicket.NodeID {
+			return
+		}
 		vol.Spec.MigrationNodeID = attachmentTicket.NodeID
 		log := getLoggerForMigratingLHVolumeAttachment(vac.logger, va, vol)
 		log.Info("Starting migration")
@@ -573,9 +605,15 @@ func (vac *VolumeAttachmentController) shouldDoDetach(va *longhorn.VolumeAttachm
 		return false
 	}
 
+	reservedNodeID := getReservedNodeID(va)
+
 	currentAttachmentTickets := map[string]*longhorn.AttachmentTicket{}
 	attachmentTicketsOnOtherNodes := map[string]*longhorn.AttachmentTicket{}
 	for _, attachmentTicket := range va.Spec.AttachmentTickets {
+		// A reservation ticket does not request an attachment, it only keeps the volume away from the other nodes
+		if longhorn.IsReservationAttachmentTicket(attachmentTicket) {
+			continue
+		}
 		// For the RWX volume attachment, VolumeAttachment controller will not directly handle
 		// the tickets from the CSI plugin. Instead, ShareManager controller will add a
 		// AttacherTypeShareManagerController ticket (as the summarization of CSI tickets) then
@@ -587,7 +625,7 @@ func (vac *VolumeAttachmentController) shouldDoDetach(va *longhorn.VolumeAttachm
 		if attachmentTicket.NodeID == vol.Spec.NodeID && verifyAttachmentParameters(attachmentTicket.Parameters, vol) {
 			currentAttachmentTickets[attachmentTicket.ID] = attachmentTicket
 		}
-		if attachmentTicket.NodeID != vol.Spec.NodeID {
+		if attachmentTicket.NodeID != vol.Spec.NodeID && (reservedNodeID == "" || attachmentTicket.NodeID == reservedNodeID) {
 			attachmentTicketsOnOtherNodes[attachmentTicket.ID] = attachmentTicket
 		}
 	}
@@ -665,17 +703,26 @@ func (vac *VolumeAttachmentController) selectAttachmentTicketToAttach(va *longho
 	vol *longhorn.Volume) *longhorn.AttachmentTicket {
 	log := getLoggerForLHVolumeAttachment(vac.logger, va)
 
+	reservedNodeID := getReservedNodeID(va)
+
 	ticketCandidates := []*longhorn.AttachmentTicket{}
 	for _, attachmentTicket := range va.Spec.AttachmentTickets {
 		if isCSIAttacherTicketOfRegularRWXVolume(attachmentTicket, vol) {
 			continue
 		}
+		if longhorn.IsReservationAttachmentTicket(attachmentTicket) {
+			continue
+		}
+		// The volume is reserved for a node, so the tickets requesting the other nodes have to wait
+		if reservedNodeID != "" && attachmentTicket.NodeID != reservedNodeID {
+			continue
+		}
 		ticketCandidates = append(ticketCandidates, attachmentTicket)
 	}
 
 	maxAttacherPriorityLevel := 0
 	for _, attachmentTicket := range ticketCandidates {
-		priorityLevel := longhorn.GetAttacherPriorityLevel(attachmentTicket.Type)
+		priorityLevel := longhorn.GetAttachmentTicketPriorityLevel(attachmentTicket)
 		if priorityLevel > maxAttacherPriorityLevel {
 			maxAttacherPriorityLevel = priorityLevel
 		}
@@ -683,7 +730,7 @@ func (vac *VolumeAttachmentController) selectAttachmentTicketToAttach(va *longho
 
 	highPriorityTicketCandidates := []*longhorn.AttachmentTicket{}
 	for _, attachmentTicket := range ticketCandidates {
-		priorityLevel := longhorn.GetAttacherPriorityLevel(attachmentTicket.Type)
+		priorityLevel := longhorn.GetAttachmentTicketPriorityLevel(attachmentTicket)
 		if priorityLevel == maxAttacherPriorityLevel {
 			highPriorityTicketCandidates = append(highPriorityTicketCandidates, attachmentTicket)
 		}
@@ -783,6 +830,29 @@ func (vac *VolumeAttachmentController) updateStatusForDesiredAttachingAttachment
 		return
 	}
 
+	if longhorn.IsReservationAttachmentTicket(attachmentTicket) {
+		if vol.Status.CurrentNodeID != "" && vol.Status.CurrentNodeID != attachmentTicket.NodeID {
+			attachmentTicketStatus.Satisfied = false
+			attachmentTicketStatus.Conditions = types.SetCondition(
+				attachmentTicketStatus.Conditions,
+				longhorn.AttachmentStatusConditionTypeSatisfied,
+				longhorn.ConditionStatusFalse,
+				"",
+				fmt.Sprintf("waiting for volume to detach from node %v", vol.Status.CurrentNodeID),
+			)
+			return
+		}
+		attachmentTicketStatus.Satisfied = true
+		attachmentTicketStatus.Conditions = types.SetCondition(
+			attachmentTicketStatus.Conditions,
+			longhorn.AttachmentStatusConditionTypeSatisfied,
+			longhorn.ConditionStatusTrue,
+			"",
+			"The reservation attachment ticket is satisfied",
+		)
+		return
+	}
+
 	if isMigratingCSIAttacherTicket(attachmentTicket, vol) {
 		if vac.isVolumeAvailableOnNode(vol.Name, attachmentTicket.NodeID) {
 			attachmentTicketStatus.Satisfied = true
@@ -938,6 +1008,32 @@ func isMigratingCSIAttacherTicket(attachmentTicket *longhorn.AttachmentTicket, v
 	return util.IsMigratableVolume(vol) && util.IsVolumeMigrating(vol) && isCSIAttacherTicket && isMigratingTicket
 }
 
+// getReservedNodeID returns the node the volume is reserved for by a reservation ticket. The webhook makes sure all
+// reservation tickets of a volume request the same node.
+func getReservedNodeID(va *longhorn.VolumeAttachment) string {
+	for _, attachmentTicket := range va.Spec.AttachmentTickets {
+		if longhorn.IsReservationAttachmentTicket(attachmentTicket) {
+			return attachmentTicket.NodeID
+		}
+	}
+	return ""
+}
+
+func getAttachmentTicketExpiration(attachmentTicket *longhorn.AttachmentTicket) (time.Time, bool) {
+	if attachmentTicket == nil || attachmentTicket.Type != longhorn.AttacherTypeExternal {
+		return time.Time{}, false
+	}
+	expiresAt, ok := attachmentTicket.Parameters[longhorn.AttachmentParameterExpiresAt]
+	if !ok || expiresAt == "" {
+		return time.Time{}, false
+	}
+	t, err := util.ParseTime(expiresAt)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
 func isVolumeShareAvailable(vol *longhorn.Volume) bool {
 	return vol.Spec.AccessMode == longhorn.AccessModeReadWriteMany &&
 		vol.Status.ShareState == longhorn.ShareManagerStateRunning &&
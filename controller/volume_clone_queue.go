@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"sort"
+	"time"
+
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	volumeCloneQueueCheckInterval = 30 * time.Second
+)
+
+// volumeCloneQueueEntry is a clone from a source volume attached to the node of the queue.
+type volumeCloneQueueEntry struct {
+	volumeName       string
+	sourceVolumeName string
+	createdAt        time.Time
+	running          bool
+}
+
+// getVolumeCloneQueueEntries returns the running and the waiting clones of the source volumes attached to the node.
+func getVolumeCloneQueueEntries(volumes []*longhorn.Volume, nodeID string) []volumeCloneQueueEntry {
+	sourceNodeIDs := map[string]string{}
+	for _, v := range volumes {
+		if v.Status.State == longhorn.VolumeStateAttached {
+			sourceNodeIDs[v.Name] = v.Status.CurrentNodeID
+		}
+	}
+
+	entries := []volumeCloneQueueEntry{}
+	for _, v := range volumes {
+		if !isTargetVolumeOfAnActiveCloning(v) && !isVolumeCloneRetryPending(v) {
+			continue
+		}
+		sourceVolumeName := types.GetVolumeName(v.Spec.DataSource)
+		if sourceNodeIDs[sourceVolumeName] != nodeID {
+			continue
+		}
+		entries = append(entries, volumeCloneQueueEntry{
+			volumeName:       v.Name,
+			sourceVolumeName: sourceVolumeName,
+			createdAt:        v.CreationTimestamp.Time,
+			running:          v.Status.CloneStatus.State == longhorn.VolumeCloneStateInitiated,
+		})
+	}
+	return entries
+}
+
+// isVolumeCloneRetryPending checks if the failed clone of the volume will be retried.
+func isVolumeCloneRetryPending(v *longhorn.Volume) bool {
+	return types.IsDataFromVolume(v.Spec.DataSource) &&
+		v.Status.CloneStatus.State == longhorn.VolumeCloneStateFailed &&
+		v.Status.CloneStatus.AttemptCount < maxCloneRetry
+}
+
+// getVolumeCloneQueuePosition returns 0 if the clone of the volume can start, or the position of the clone in the
+// queue otherwise. The waiting clones start in the creation order of the volumes. A clone blocked by the limit of its
+// source volume does not hold back the clones of the other source volumes. A limit of 0 means no limit.
+func getVolumeCloneQueuePosition(volumeName string, entries []volumeCloneQueueEntry, perNodeLimit, perSourceVolumeLimit int64) int {
+	runningCount := int64(0)
+	runningCountPerSourceVolume := map[string]int64{}
+	waiting := []volumeCloneQueueEntry{}
+	for _, entry := range entries {
+		if entry.running {
+			runningCount++
+			runningCountPerSourceVolume[entry.sourceVolumeName]++
+			continue
+		}
+		waiting = append(waiting, entry)
+	}
+	sort.Slice(waiting, func(i, j int) bool {
+		if !waiting[i].createdAt.Equal(waiting[j].createdAt) {
+			return waiting[i].createdAt.Before(waiting[j].createdAt)
+		}
+		return waiting[i].volumeName < waiting[j].volumeName
+	})
+
+	position := 0
+	for _, entry := range waiting {
+		canStart := (perNodeLimit == 0 || runningCount < perNodeLimit) &&
+			(perSourceVolumeLimit == 0 || runningCountPerSourceVolume[entry.sourceVolumeName] < perSourceVolumeLimit)
+		if canStart {
+			if entry.volumeName == volumeName {
+				return 0
+			}
+			// The clones before the volume start first
+			runningCount++
+			runningCountPerSourceVolume[entry.sourceVolumeName]++
+			continue
+		}
+		position++
+		if entry.volumeName == volumeName {
+			return position
+		}
+	}
+	return 0
+}
+
+// getAverageVolumeCloneDuration returns the average duration of the completed clones, or 0 if there is none.
+func getAverageVolumeCloneDuration(volumes []*longhorn.Volume) time.Duration {
+	var total time.Duration
+	count := 0
+	for _, v := range volumes {
+		if v.Status.CloneStatus.State != longhorn.VolumeCloneStateCompleted {
+			continue
+		}
+		startedAt, err := util.ParseTime(v.Status.CloneStatus.StartedAt)
+		if err != nil {
+			continue
+		}
+		completedAt, err := util.ParseTime(v.Status.CloneStatus.CompletedAt)
+		if err != nil || completedAt.Before(startedAt) {
+			continue
+		}
+		total += completedAt.Sub(startedAt)
+		count++
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / time.Duration(count)
+}
+
+// estimateVolumeCloneStartAt estimates the start of the clone at the position of the queue, assuming the clones run in
+// batches of the concurrency and each batch takes the average clone duration.
+func estimateVolumeCloneStartAt(now time.Time, position int, concurrency int64, averageDuration time.Duration) string {
+	if position <= 0 || averageDuration <= 0 {
+		return ""
+	}
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	batches := (int64(position) + concurrency - 1) / concurrency
+	return now.Add(time.Duration(batches) * averageDuration).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func newTestCloneVolume(name, sourceVolumeName string, createdAt time.Time, state longhorn.VolumeCloneState) *longhorn.Volume {
+	return &longhorn.Volume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(createdAt),
+		},
+		Spec: longhorn.VolumeSpec{
+			DataSource: types.NewVolumeDataSourceTypeVolume(sourceVolumeName),
+		},
+		Status: longhorn.VolumeStatus{
+			CloneStatus: longhorn.VolumeCloneStatus{State: state},
+		},
+	}
+}
+
+func newTestCloneSourceVolume(name, nodeID string) *longhorn.Volume {
+	return &longhorn.Volume{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: longhorn.VolumeStatus{
+			State:         longhorn.VolumeStateAttached,
+			CurrentNodeID: nodeID,
+		},
+	}
+}
+
+func TestGetVolumeCloneQueuePosition(t *testing.T) {
+	now := time.Now()
+	volumes := []*longhorn.Volume{
+		newTestCloneSourceVolume("golden-1", TestNode1),
+		newTestCloneSourceVolume("golden-2", TestNode1),
+		newTestCloneSourceVolume("golden-3", TestNode2),
+		newTestCloneVolume("running-1", "golden-1", now.Add(-time.Hour), longhorn.VolumeCloneStateInitiated),
+		newTestCloneVolume("completed-1", "golden-1", now.Add(-time.Hour), longhorn.VolumeCloneStateCompleted),
+		newTestCloneVolume("clone-1", "golden-1", now.Add(time.Second), longhorn.VolumeCloneStateEmpty),
+		newTestCloneVolume("clone-2", "golden-1", now.Add(2*time.Second), longhorn.VolumeCloneStateEmpty),
+		newTestCloneVolume("clone-3", "golden-2", now.Add(3*time.Second), longhorn.VolumeCloneStateEmpty),
+		newTestCloneVolume("clone-4", "golden-3", now, longhorn.VolumeCloneStateEmpty),
+	}
+	entries := getVolumeCloneQueueEntries(volumes, TestNode1)
+	if len(entries) != 4 {
+		t.Fatalf("expected 4 clones from the sources on %v, got %+v", TestNode1, entries)
+	}
+
+	testCases := []struct {
+		name                 string
+		volumeName           string
+		perNodeLimit         int64
+		perSourceVolumeLimit int64
+		expectedPosition     int
+	}{
+		{"no limit", "clone-2", 0, 0, 0},
+		{"first waiting clone starts", "clone-1", 2, 0, 0},
+		{"node limit reached", "clone-2", 2, 0, 1},
+		{"node limit reached for later clone", "clone-3", 2, 0, 2},
+		{"source volume limit reached", "clone-1", 0, 1, 1},
+		{"source volume limit does not block other sources", "clone-3", 0, 1, 0},
+		{"both limits", "clone-3", 2, 1, 0},
+		{"both limits for blocked clone", "clone-2", 2, 1, 2},
+	}
+	for _, tc := range testCases {
+		position := getVolumeCloneQueuePosition(tc.volumeName, entries, tc.perNodeLimit, tc.perSourceVolumeLimit)
+		if position != tc.expectedPosition {
+			t.Errorf("%v: expected position %v, got %v", tc.name, tc.expectedPosition, position)
+		}
+	}
+}
+
+func TestEstimateVolumeCloneStartAt(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	completed := newTestCloneVolume("completed", "golden", now, longhorn.VolumeCloneStateCompleted)
+	completed.Status.CloneStatus.StartedAt = "2024-01-01T00:00:00Z"
+	completed.Status.CloneStatus.CompletedAt = "2024-01-01T00:10:00Z"
+	running := newTestCloneVolume("running", "golden", now, longhorn.VolumeCloneStateInitiated)
+	running.Status.CloneStatus.StartedAt = "2024-01-01T00:00:00Z"
+	averageDuration := getAverageVolumeCloneDuration([]*longhorn.Volume{completed, running})
+	if averageDuration != 10*time.Minute {
+		t.Fatalf("expected average duration 10m, got %v", averageDuration)
+	}
+
+	if startAt := estimateVolumeCloneStartAt(now, 3, 2, averageDuration); startAt != "2024-01-01T00:20:00Z" {
+		t.Errorf("unexpected estimated start %v", startAt)
+	}
+	if startAt := estimateVolumeCloneStartAt(now, 1, 0, averageDuration); startAt != "2024-01-01T00:10:00Z" {
+		t.Errorf("unexpected estimated start %v", startAt)
+	}
+	if startAt := estimateVolumeCloneStartAt(now, 1, 2, 0); startAt != "" {
+		t.Errorf("expected no estimated start without completed clones, got %v", startAt)
+	}
+}
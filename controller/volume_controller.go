@@ -532,6 +532,14 @@ func (c *VolumeController) syncVolume(key string) (err error) {
 		return err
 	}
 
+	if err := c.ReconcileRecoveryPointState(volume, engines); err != nil {
+		return err
+	}
+
+	if err := c.ReconcileActivityState(volume, engines); err != nil {
+		return err
+	}
+
 	if err := c.ReconcileVolumeState(volume, engines, replicas); err != nil {
 		return err
 	}
@@ -861,6 +869,7 @@ func (c *VolumeController) ReconcileEngineReplicaState(v *longhorn.Volume, es ma
 
 		if status.State == engineapi.ProcessStateComplete && v.Status.CloneStatus.State != longhorn.VolumeCloneStateCompleted {
 			v.Status.CloneStatus.State = longhorn.VolumeCloneStateCompleted
+			v.Status.CloneStatus.CompletedAt = util.Now()
 			c.eventRecorder.Eventf(v, corev1.EventTypeNormal, constant.EventReasonVolumeCloneCompleted,
 				"finished cloning snapshot %v from source volume %v",
 				v.Status.CloneStatus.Snapshot, v.Status.CloneStatus.SourceVolume)
@@ -1623,6 +1632,7 @@ func (c *VolumeController) reconcileAttachDetachStateMachine(v *longhorn.Volume,
 				if c.areVolumeDependentResourcesOpened(e, rs) {
 					v.Status.CurrentNodeID = v.Spec.NodeID
 					v.Status.State = longhorn.VolumeStateAttached
+					setVolumeLastAttachedAt(v, util.Now())
 					c.eventRecorder.Eventf(v, corev1.EventTypeNormal, constant.EventReasonAttached, "volume %v has been attached to %v", v.Name, v.Status.CurrentNodeID)
 				}
 			}
@@ -2540,7 +2550,7 @@ func (c *VolumeController) getReplicasUnderDiskPressure() (map[string]bool, erro
 		for diskName, diskStatus := range node.Status.DiskStatus {
 			diskSpec := node.Spec.Disks[diskName]
 
-			diskInfo, err := c.scheduler.GetDiskSchedulingInfo(diskSpec, diskStatus)
+			diskInfo, err := c.scheduler.GetDiskSchedulingInfo(node.Name, diskSpec, diskStatus)
 			if err != nil {
 				return nil, err
 			}
@@ -2636,7 +2646,7 @@ func (c *VolumeController) checkReplicaDiskPressuredSchedulableCandidates(volume
 			continue
 		}
 
-		diskInfo, err := c.scheduler.GetDiskSchedulingInfo(diskSpec, diskStatus)
+		diskInfo, err := c.scheduler.GetDiskSchedulingInfo(nodeCandidate.Name, diskSpec, diskStatus)
 		if err != nil {
 			log.WithError(err).Debugf("Failed to get disk scheduling info for disk %v on node %v", diskName, nodeCandidate.Name)
 			continue
@@ -3593,6 +3603,11 @@ func (c *VolumeController) checkAndInitVolumeClone(v *longhorn.Volume, e *longho
 		return nil
 	}
 
+	queued, err := c.checkVolumeCloneQueue(v, sourceVol)
+	if err != nil || queued {
+		return err
+	}
+
 	// Prepare engine for new a clone or a cloning retry
 	if e.Spec.RequestedDataSource != "" {
 		e.Spec.RequestedDataSource = ""
@@ -3631,6 +3646,8 @@ func (c *VolumeController) checkAndInitVolumeClone(v *longhorn.Volume, e *longho
 	v.Status.CloneStatus.SourceVolume = sourceVolName
 	v.Status.CloneStatus.Snapshot = snapshotName
 	v.Status.CloneStatus.State = longhorn.VolumeCloneStateInitiated
+	v.Status.CloneStatus.StartedAt = util.Now()
+	v.Status.CloneStatus.CompletedAt = ""
 	d := time.Duration(math.Exp2(float64(v.Status.CloneStatus.AttemptCount))) * initialCloneRetryInterval
 	v.Status.CloneStatus.NextAllowedAttemptAt = util.TimestampAfterDuration(d)
 	v.Status.CloneStatus.AttemptCount += 1
@@ -3639,6 +3656,42 @@ func (c *VolumeController) checkAndInitVolumeClone(v *longhorn.Volume, e *longho
 	return nil
 }
 
+// checkVolumeCloneQueue returns true and records the queue position of the clone if the clones from the node of the
+// source volume or from the source volume reach the concurrency limits.
+func (c *VolumeController) checkVolumeCloneQueue(v, sourceVol *longhorn.Volume) (bool, error) {
+	nodeID := sourceVol.Status.CurrentNodeID
+	perNodeLimit, err := c.ds.GetNodeSettingAsInt(types.SettingNameConcurrentVolumeClonePerNodeLimit, nodeID)
+	if err != nil {
+		return false, err
+	}
+	perSourceVolumeLimit, err := c.ds.GetSettingAsInt(types.SettingNameConcurrentVolumeClonePerSourceVolumeLimit)
+	if err != nil {
+		return false, err
+	}
+	volumes, err := c.ds.ListVolumesRO()
+	if err != nil {
+		return false, err
+	}
+
+	position := getVolumeCloneQueuePosition(v.Name, getVolumeCloneQueueEntries(volumes, nodeID), perNodeLimit, perSourceVolumeLimit)
+	if position == 0 {
+		v.Status.CloneStatus.QueuePosition = 0
+		v.Status.CloneStatus.EstimatedStartAt = ""
+		return false, nil
+	}
+
+	if position != v.Status.CloneStatus.QueuePosition || v.Status.CloneStatus.EstimatedStartAt == "" {
+		concurrency := perNodeLimit
+		if perSourceVolumeLimit > 0 && (concurrency == 0 || perSourceVolumeLimit < concurrency) {
+			concurrency = perSourceVolumeLimit
+		}
+		v.Status.CloneStatus.QueuePosition = position
+		v.Status.CloneStatus.EstimatedStartAt = estimateVolumeCloneStartAt(time.Now(), position, concurrency, getAverageVolumeCloneDuration(volumes))
+	}
+	c.enqueueVolumeAfter(v, volumeCloneQueueCheckInterval)
+	return true, nil
+}
+
 func (c *VolumeController) getInfoFromBackupURL(v *longhorn.Volume) (string, string, error) {
 	if v.Spec.FromBackup == "" {
 		return "", "", nil
@@ -4863,6 +4916,193 @@ func (c *VolumeController) ReconcileBackupVolumeState(volume *longhorn.Volume) e
 	return nil
 }
 
+// ReconcileRecoveryPointState computes the restore lag of the standby volume and the backup lag of other volumes, and
+// sets the condition RecoveryPointObjectiveExceeded if the lag exceeds the recovery point objective of the volume.
+func (c *VolumeController) ReconcileRecoveryPointState(v *longhorn.Volume, es map[string]*longhorn.Engine) error {
+	recoveryPoint := &longhorn.VolumeRecoveryPointStatus{}
+	if v.Status.RecoveryPoint != nil {
+		// Keep the last write time while the volume is detached
+		recoveryPoint.LastWriteAt = v.Status.RecoveryPoint.LastWriteAt
+	}
+
+	e, err := c.ds.PickVolumeCurrentEngine(v, es)
+	if err != nil {
+		return err
+	}
+	if e != nil && e.Status.LastWriteAt != "" {
+		if isAfter, err := util.TimestampAfterTimestamp(e.Status.LastWriteAt, recoveryPoint.LastWriteAt); err != nil || isAfter {
+			recoveryPoint.LastWriteAt = e.Status.LastWriteAt
+		}
+	}
+
+	lastBackupSnapshotAt := c.getBackupSnapshotCreatedAt(v.Status.LastBackup, v.Status.LastBackupAt)
+	if v.Status.IsStandby {
+		if e != nil && e.Status.LastRestoredBackup != "" {
+			recoveryPoint.LastRestoredBackup = e.Status.LastRestoredBackup
+			recoveryPoint.LastRestoredBackupAt = c.getBackupSnapshotCreatedAt(e.Status.LastRestoredBackup, "")
+			recoveryPoint.RestoreLag = getLagSeconds(lastBackupSnapshotAt, recoveryPoint.LastRestoredBackupAt)
+		}
+	} else {
+		recoveryPoint.LastBackupSnapshotAt = lastBackupSnapshotAt
+		recoveryPoint.BackupLag = getLagSeconds(recoveryPoint.LastWriteAt, lastBackupSnapshotAt)
+	}
+
+	if reflect.DeepEqual(*recoveryPoint, longhorn.VolumeRecoveryPointStatus{}) {
+		v.Status.RecoveryPoint = nil
+	} else {
+		v.Status.RecoveryPoint = recoveryPoint
+	}
+
+	if v.Spec.RecoveryPointObjective == 0 {
+		v.Status.Conditions = types.RemoveCondition(v.Status.Conditions, longhorn.VolumeConditionTypeRecoveryPointObjectiveExceeded)
+		return nil
+	}
+
+	isExceeded, reason, message := isRecoveryPointObjectiveExceeded(v)
+	if isExceeded {
+		v.Status.Conditions = types.SetConditionAndRecord(v.Status.Conditions,
+			longhorn.VolumeConditionTypeRecoveryPointObjectiveExceeded, longhorn.ConditionStatusTrue,
+			reason, message, c.eventRecorder, v, corev1.EventTypeWarning)
+	} else {
+		v.Status.Conditions = types.SetCondition(v.Status.Conditions,
+			longhorn.VolumeConditionTypeRecoveryPointObjectiveExceeded, longhorn.ConditionStatusFalse,
+			"", "")
+	}
+	return nil
+}
+
+// getBackupSnapshotCreatedAt returns the snapshot creation time of the backup, which is the point in time the backup
+// can restore the volume to.
+func (c *VolumeController) getBackupSnapshotCreatedAt(backupName, defaultTime string) string {
+	if backupName == "" {
+		return defaultTime
+	}
+	backup, err := c.ds.GetBackupRO(backupName)
+	if err != nil || backup.Status.SnapshotCreatedAt == "" {
+		return defaultTime
+	}
+	return backup.Status.SnapshotCreatedAt
+}
+
+// getLagSeconds returns how many seconds the earlier timestamp is behind the later one, or 0 if either cannot be
+// parsed.
+func getLagSeconds(later, earlier string) int64 {
+	laterTime, err := util.ParseTime(later)
+	if err != nil {
+		return 0
+	}
+	earlierTime, err := util.ParseTime(earlier)
+	if err != nil || !laterTime.After(earlierTime) {
+		return 0
+	}
+	return int64(laterTime.Sub(earlierTime).Seconds())
+}
+
+func isRecoveryPointObjectiveExceeded(v *longhorn.Volume) (isExceeded bool, reason, message string) {
+	objective := time.Duration(v.Spec.RecoveryPointObjective) * time.Minute
+	if objective == 0 || v.Status.RecoveryPoint == nil {
+		return false, "", ""
+	}
+
+	recoveryPoint := v.Status.RecoveryPoint
+	if v.Status.IsStandby {
+		restoreLag := time.Duration(recoveryPoint.RestoreLag) * time.Second
+		if restoreLag > objective {
+			return true, longhorn.VolumeConditionReasonRestoreLagExceeded,
+				fmt.Sprintf("Restore lag %v exceeds the recovery point objective %v", restoreLag, objective)
+		}
+		return false, "", ""
+	}
+
+	if recoveryPoint.LastWriteAt != "" && recoveryPoint.LastBackupSnapshotAt == "" {
+		return true, longhorn.VolumeConditionReasonBackupLagExceeded,
+			fmt.Sprintf("Volume is written at %v but has no backup", recoveryPoint.LastWriteAt)
+	}
+	backupLag := time.Duration(recoveryPoint.BackupLag) * time.Second
+	if backupLag > objective {
+		return true, longhorn.VolumeConditionReasonBackupLagExceeded,
+			fmt.Sprintf("Backup lag %v exceeds the recovery point objective %v", backupLag, objective)
+	}
+	return false, "", ""
+}
+
+// ReconcileActivityState adds the IO of the current engine to the cumulative IO of the volume, and records the
+// workload using the volume.
+func (c *VolumeController) ReconcileActivityState(v *longhorn.Volume, es map[string]*longhorn.Engine) error {
+	activity := &longhorn.VolumeActivityStatus{}
+	if v.Status.Activity != nil {
+		*activity = *v.Status.Activity
+	}
+
+	e, err := c.ds.PickVolumeCurrentEngine(v, es)
+	if err != nil {
+		return err
+	}
+	if e != nil && e.Status.IOStats != nil {
+		addEngineIOStats(activity, e.Name, e.Status.IOStats)
+	}
+
+	if v.Status.State == longhorn.VolumeStateAttached {
+		if workload := getVolumeWorkload(v); workload != "" {
+			activity.LastAttachedBy = workload
+		}
+	}
+
+	if reflect.DeepEqual(*activity, longhorn.VolumeActivityStatus{}) {
+		v.Status.Activity = nil
+	} else {
+		v.Status.Activity = activity
+	}
+	return nil
+}
+
+// addEngineIOStats adds the growth of the engine IO counters since they were last observed to the IO of the volume.
+// The counters of a different engine are added as a whole.
+func addEngineIOStats(activity *longhorn.VolumeActivityStatus, engineName string, engineIO *longhorn.VolumeIOStats) {
+	observed := longhorn.VolumeIOStats{}
+	if activity.ObservedEngine == engineName {
+		observed = activity.ObservedEngineIO
+	}
+	if engineIO.ReadBytes < observed.ReadBytes || engineIO.WriteBytes < observed.WriteBytes ||
+		engineIO.ReadOps < observed.ReadOps || engineIO.WriteOps < observed.WriteOps {
+		// The counters of the engine started over
+		observed = longhorn.VolumeIOStats{}
+	}
+
+	activity.IO.ReadBytes += engineIO.ReadBytes - observed.ReadBytes
+	activity.IO.WriteBytes += engineIO.WriteBytes - observed.WriteBytes
+	activity.IO.ReadOps += engineIO.ReadOps - observed.ReadOps
+	activity.IO.WriteOps += engineIO.WriteOps - observed.WriteOps
+	if engineIO.LastIOAt != "" {
+		if isAfter, err := util.TimestampAfterTimestamp(engineIO.LastIOAt, activity.IO.LastIOAt); err != nil || isAfter {
+			activity.IO.LastIOAt = engineIO.LastIOAt
+		}
+	}
+
+	activity.ObservedEngine = engineName
+	activity.ObservedEngineIO = *engineIO
+}
+
+func setVolumeLastAttachedAt(v *longhorn.Volume, attachedAt string) {
+	if v.Status.Activity == nil {
+		v.Status.Activity = &longhorn.VolumeActivityStatus{}
+	}
+	v.Status.Activity.LastAttachedAt = attachedAt
+}
+
+// getVolumeWorkload returns the workload of the first pod using the volume, or the pod if it has no workload
+func getVolumeWorkload(v *longhorn.Volume) string {
+	for _, workload := range v.Status.KubernetesStatus.WorkloadsStatus {
+		if workload.WorkloadName != "" && workload.WorkloadType != "" {
+			return fmt.Sprintf("%v/%v", workload.WorkloadType, workload.WorkloadName)
+		}
+		if workload.PodName != "" {
+			return fmt.Sprintf("%v/%v", types.KubernetesKindPod, workload.PodName)
+		}
+	}
+	return ""
+}
+
 // TODO: this block of code is duplicated of CreateSnapshot in MANAGER package.
 // Once we have Snapshot CR, we should refactor this
 
@@ -267,6 +267,7 @@ func (s *TestSuite) TestVolumeLifeCycle(c *C) {
 		r.Spec.HealthyAt = getTestNow()
 		r.Spec.LastHealthyAt = r.Spec.HealthyAt
 	}
+	tc.expectVolume.Status.Activity = &longhorn.VolumeActivityStatus{}
 	testCases["volume attached"] = tc
 
 	tc = generateVolumeTestCaseTemplate()
@@ -364,6 +365,7 @@ func (s *TestSuite) TestVolumeLifeCycle(c *C) {
 	tc.expectVolume.Status.Robustness = longhorn.VolumeRobustnessHealthy
 	tc.expectVolume.Status.Conditions = setVolumeConditionWithoutTimestamp(tc.volume.Status.Conditions,
 		longhorn.VolumeConditionTypeRestore, longhorn.ConditionStatusTrue, longhorn.VolumeConditionReasonRestoreInProgress, "")
+	tc.expectVolume.Status.Activity = &longhorn.VolumeActivityStatus{}
 	testCases["newly restored volume attaching to attached"] = tc
 
 	// Newly restored volume is waiting for restoration completed
@@ -674,6 +676,9 @@ func (s *TestSuite) TestVolumeLifeCycle(c *C) {
 	tc.expectVolume.Status.Robustness = longhorn.VolumeRobustnessFaulted
 	tc.expectVolume.Status.Conditions = setVolumeConditionWithoutTimestamp(tc.expectVolume.Status.Conditions,
 		longhorn.VolumeConditionTypeRestore, longhorn.ConditionStatusFalse, longhorn.VolumeConditionReasonRestoreFailure, "All replica restore failed and the volume became Faulted")
+	tc.expectVolume.Status.RecoveryPoint = &longhorn.VolumeRecoveryPointStatus{
+		LastRestoredBackup: TestBackupName,
+	}
 	for _, e := range tc.expectEngines {
 		e.Spec.NodeID = ""
 		e.Spec.DesireState = longhorn.InstanceStateStopped
@@ -767,6 +772,9 @@ func (s *TestSuite) TestVolumeLifeCycle(c *C) {
 	tc.copyCurrentToExpect()
 	tc.expectVolume.Status.State = longhorn.VolumeStateAttached
 	tc.expectVolume.Status.Robustness = longhorn.VolumeRobustnessHealthy
+	tc.expectVolume.Status.RecoveryPoint = &longhorn.VolumeRecoveryPointStatus{
+		LastRestoredBackup: TestBackupName,
+	}
 	testCases["standby volume is not automatically detached"] = tc
 
 	// volume detaching - stop engine
@@ -1521,6 +1529,9 @@ func (s *TestSuite) runTestCases(c *C, testCases map[string]*VolumeTestCase) {
 			condition.LastTransitionTime = ""
 			retV.Status.Conditions[ctype] = condition
 		}
+		if retV.Status.Activity != nil {
+			retV.Status.Activity.LastAttachedAt = ""
+		}
 		c.Assert(retV.Status, DeepEquals, tc.expectVolume.Status)
 
 		retEs, err := lhClient.LonghornV1beta2().Engines(TestNamespace).List(context.TODO(), metav1.ListOptions{LabelSelector: getVolumeLabelSelector(v.Name)})
@@ -1571,3 +1582,94 @@ func (s *TestSuite) runTestCases(c *C, testCases map[string]*VolumeTestCase) {
 		}
 	}
 }
+
+func (s *TestSuite) TestIsRecoveryPointObjectiveExceeded(c *C) {
+	c.Assert(getLagSeconds("2024-01-01T01:00:00Z", "2024-01-01T00:00:00Z"), Equals, int64(3600))
+	c.Assert(getLagSeconds("2024-01-01T00:00:00Z", "2024-01-01T01:00:00Z"), Equals, int64(0))
+	c.Assert(getLagSeconds("", "2024-01-01T00:00:00Z"), Equals, int64(0))
+
+	testCases := map[string]struct {
+		standby       bool
+		objective     int
+		recoveryPoint *longhorn.VolumeRecoveryPointStatus
+		exceeded      bool
+		reason        string
+	}{
+		"objective disabled": {
+			recoveryPoint: &longhorn.VolumeRecoveryPointStatus{BackupLag: 7200},
+		},
+		"restore lag within objective": {
+			standby:       true,
+			objective:     60,
+			recoveryPoint: &longhorn.VolumeRecoveryPointStatus{RestoreLag: 1800},
+		},
+		"restore lag exceeded": {
+			standby:       true,
+			objective:     60,
+			recoveryPoint: &longhorn.VolumeRecoveryPointStatus{RestoreLag: 7200},
+			exceeded:      true,
+			reason:        longhorn.VolumeConditionReasonRestoreLagExceeded,
+		},
+		"backup lag exceeded": {
+			objective: 60,
+			recoveryPoint: &longhorn.VolumeRecoveryPointStatus{
+				LastWriteAt:          "2024-01-01T02:00:00Z",
+				LastBackupSnapshotAt: "2024-01-01T00:00:00Z",
+				BackupLag:            7200,
+			},
+			exceeded: true,
+			reason:   longhorn.VolumeConditionReasonBackupLagExceeded,
+		},
+		"written without backup": {
+			objective:     60,
+			recoveryPoint: &longhorn.VolumeRecoveryPointStatus{LastWriteAt: "2024-01-01T02:00:00Z"},
+			exceeded:      true,
+			reason:        longhorn.VolumeConditionReasonBackupLagExceeded,
+		},
+		"no write": {
+			objective: 60,
+		},
+	}
+
+	for name, tc := range testCases {
+		v := newVolume(TestVolumeName, 2)
+		v.Spec.RecoveryPointObjective = tc.objective
+		v.Status.IsStandby = tc.standby
+		v.Status.RecoveryPoint = tc.recoveryPoint
+
+		exceeded, reason, _ := isRecoveryPointObjectiveExceeded(v)
+		c.Assert(exceeded, Equals, tc.exceeded, Commentf("test case %v", name))
+		c.Assert(reason, Equals, tc.reason, Commentf("test case %v", name))
+	}
+}
+
+func (s *TestSuite) TestAddEngineIOStats(c *C) {
+	activity := &longhorn.VolumeActivityStatus{}
+
+	addEngineIOStats(activity, "engine-1", &longhorn.VolumeIOStats{ReadBytes: 100, WriteBytes: 200, ReadOps: 1, WriteOps: 2, LastIOAt: "2024-01-01T00:00:00Z"})
+	c.Assert(activity.IO, DeepEquals, longhorn.VolumeIOStats{ReadBytes: 100, WriteBytes: 200, ReadOps: 1, WriteOps: 2, LastIOAt: "2024-01-01T00:00:00Z"})
+
+	// Only the growth of the counters of the observed engine is added
+	addEngineIOStats(activity, "engine-1", &longhorn.VolumeIOStats{ReadBytes: 150, WriteBytes: 200, ReadOps: 2, WriteOps: 2, LastIOAt: "2024-01-01T01:00:00Z"})
+	c.Assert(activity.IO, DeepEquals, longhorn.VolumeIOStats{ReadBytes: 150, WriteBytes: 200, ReadOps: 2, WriteOps: 2, LastIOAt: "2024-01-01T01:00:00Z"})
+
+	// The counters of a new engine are added as a whole
+	addEngineIOStats(activity, "engine-2", &longhorn.VolumeIOStats{ReadBytes: 10, WriteBytes: 20, ReadOps: 1, WriteOps: 1, LastIOAt: "2024-01-01T02:00:00Z"})
+	c.Assert(activity.IO, DeepEquals, longhorn.VolumeIOStats{ReadBytes: 160, WriteBytes: 220, ReadOps: 3, WriteOps: 3, LastIOAt: "2024-01-01T02:00:00Z"})
+	c.Assert(activity.ObservedEngine, Equals, "engine-2")
+
+	// The counters of the engine started over
+	addEngineIOStats(activity, "engine-2", &longhorn.VolumeIOStats{ReadBytes: 5, LastIOAt: "2024-01-01T00:30:00Z"})
+	c.Assert(activity.IO, DeepEquals, longhorn.VolumeIOStats{ReadBytes: 165, WriteBytes: 220, ReadOps: 3, WriteOps: 3, LastIOAt: "2024-01-01T02:00:00Z"})
+}
+
+func (s *TestSuite) TestGetVolumeWorkload(c *C) {
+	v := newVolume(TestVolumeName, 2)
+	c.Assert(getVolumeWorkload(v), Equals, "")
+
+	v.Status.KubernetesStatus.WorkloadsStatus = []longhorn.WorkloadStatus{{PodName: "pod-1"}}
+	c.Assert(getVolumeWorkload(v), Equals, "Pod/pod-1")
+
+	v.Status.KubernetesStatus.WorkloadsStatus = []longhorn.WorkloadStatus{{PodName: "web-0", WorkloadName: "web", WorkloadType: "StatefulSet"}}
+	c.Assert(getVolumeWorkload(v), Equals, "StatefulSet/web")
+}
@@ -3,11 +3,13 @@ package controller
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
@@ -18,9 +20,13 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientset "k8s.io/client-go/kubernetes"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	"github.com/longhorn/longhorn-manager/constant"
 	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/scheduler"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -37,6 +43,7 @@ type VolumeExpansionController struct {
 	eventRecorder record.EventRecorder
 
 	ds         *datastore.DataStore
+	scheduler  *scheduler.ReplicaScheduler
 	cacheSyncs []cache.InformerSynced
 }
 
@@ -50,6 +57,9 @@ func NewVolumeExpansionController(
 ) (*VolumeExpansionController, error) {
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(logrus.Infof)
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{
+		Interface: v1core.New(kubeClient.CoreV1().RESTClient()).Events(""),
+	})
 
 	vec := &VolumeExpansionController{
 		baseController: newBaseController("longhorn-volume-expansion", logger),
@@ -63,6 +73,8 @@ func NewVolumeExpansionController(
 		eventRecorder: eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "longhorn-volume-expansion-controller"}),
 	}
 
+	vec.scheduler = scheduler.NewReplicaScheduler(ds)
+
 	var err error
 	if _, err = ds.VolumeInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
 		AddFunc:    vec.enqueueVolume,
@@ -199,7 +211,149 @@ func (vec *VolumeExpansionController) reconcile(volName string) (err error) {
 		delete(va.Spec.AttachmentTickets, expandingAttachmentTicketID)
 	}
 
-	return nil
+	return vec.reconcileAutoExpansion(vol)
+}
+
+// reconcileAutoExpansion expands the volume by the step size of the auto expansion policy once the filesystem usage
+// reported by the CSI plugin crosses the threshold. The PVC is expanded instead if the volume is used by a PVC, so that
+// the expansion goes through the CSI resizer like a manual one.
+func (vec *VolumeExpansionController) reconcileAutoExpansion(vol *longhorn.Volume) error {
+	usage := vol.Status.FilesystemUsage
+	// Wait for a new report if the usage was reported before the last expansion.
+	if usage == nil || usage.VolumeSize != vol.Spec.Size {
+		return nil
+	}
+	if vol.Status.ExpansionRequired || vol.Status.IsStandby || vol.Status.State != longhorn.VolumeStateAttached {
+		return nil
+	}
+
+	policy, err := vec.getAutoExpansionPolicy(vol)
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		return nil
+	}
+
+	size := getAutoExpansionSize(vol.Spec.Size, policy, usage)
+	if size == 0 {
+		return nil
+	}
+
+	log := getLoggerForVolume(vec.logger, vol)
+	usagePercentage := types.GetFilesystemUsagePercentage(usage.UsedBytes, usage.TotalBytes)
+
+	if _, err := vec.scheduler.CheckReplicasSizeExpansion(vol, vol.Spec.Size, size); err != nil {
+		log.WithError(err).Warnf("Failed to automatically expand volume from %v to %v", vol.Spec.Size, size)
+		vec.eventRecorder.Eventf(vol, corev1.EventTypeWarning, constant.EventReasonFailedAutoExpansion,
+			"Failed to automatically expand volume from %v to %v with filesystem usage %v%%: %v", vol.Spec.Size, size, usagePercentage, err)
+		return nil
+	}
+
+	kubernetesStatus := &vol.Status.KubernetesStatus
+	if kubernetesStatus.PVCName != "" && kubernetesStatus.LastPVCRefAt == "" {
+		requested, err := vec.expandPVC(kubernetesStatus.Namespace, kubernetesStatus.PVCName, size)
+		if err != nil {
+			return err
+		}
+		// The PVC already requests the size, and the CSI resizer is expanding the volume.
+		if !requested {
+			return nil
+		}
+	} else {
+		vol.Spec.Size = size
+		if vol, err = vec.ds.UpdateVolume(vol); err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Automatically expanding volume from %v to %v since filesystem usage %v%% crosses threshold %v%%",
+		usage.VolumeSize, size, usagePercentage, policy.UsageThreshold)
+	vec.eventRecorder.Eventf(vol, corev1.EventTypeNormal, constant.EventReasonAutoExpansion,
+		"Automatically expanding volume from %v to %v since filesystem usage %v%% crosses threshold %v%%",
+		usage.VolumeSize, size, usagePercentage, policy.UsageThreshold)
+
+	vol.Status.FilesystemUsage.LastAutoExpandedAt = util.Now()
+	_, err = vec.ds.UpdateVolumeStatus(vol)
+	return err
+}
+
+// expandPVC increases the storage request of the PVC to the size. It returns false if the PVC already requests it.
+func (vec *VolumeExpansionController) expandPVC(namespace, pvcName string, size int64) (bool, error) {
+	pvc, err := vec.ds.GetPersistentVolumeClaim(namespace, pvcName)
+	if err != nil {
+		return false, err
+	}
+
+	requestedSize := resource.MustParse(strconv.FormatInt(size, 10))
+	if currentSize, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; ok && currentSize.Cmp(requestedSize) >= 0 {
+		return false, nil
+	}
+
+	if pvc.Spec.Resources.Requests == nil {
+		pvc.Spec.Resources.Requests = corev1.ResourceList{}
+	}
+	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = requestedSize
+	if _, err := vec.ds.UpdatePersistentVolumeClaim(namespace, pvc); err != nil {
+		return false, errors.Wrapf(err, "failed to expand PVC %v/%v to %v", namespace, pvcName, size)
+	}
+	return true, nil
+}
+
+// getAutoExpansionPolicy returns the auto expansion policy of the volume, or the one in the parameters of the
+// StorageClass of the volume.
+func (vec *VolumeExpansionController) getAutoExpansionPolicy(vol *longhorn.Volume) (*longhorn.VolumeAutoExpansionPolicy, error) {
+	if vol.Spec.AutoExpansionPolicy != nil {
+		return vol.Spec.AutoExpansionPolicy, nil
+	}
+
+	pvName := vol.Status.KubernetesStatus.PVName
+	if pvName == "" {
+		return nil, nil
+	}
+	pv, err := vec.ds.GetPersistentVolumeRO(pvName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if pv.Spec.StorageClassName == "" {
+		return nil, nil
+	}
+	sc, err := vec.ds.GetStorageClassRO(pv.Spec.StorageClassName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	policy, err := types.GetVolumeAutoExpansionPolicyFromStorageClassParameters(sc.Parameters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid auto expansion parameters of StorageClass %v", sc.Name)
+	}
+	if err := types.ValidateVolumeAutoExpansionPolicy(policy); err != nil {
+		return nil, errors.Wrapf(err, "invalid auto expansion parameters of StorageClass %v", sc.Name)
+	}
+	return policy, nil
+}
+
+// getAutoExpansionSize returns the size the volume is expanded to, or 0 if the usage is below the threshold or the
+// volume already reaches the max size.
+func getAutoExpansionSize(size int64, policy *longhorn.VolumeAutoExpansionPolicy, usage *longhorn.VolumeFilesystemUsage) int64 {
+	if usage.TotalBytes <= 0 || usage.UsedBytes*100 < usage.TotalBytes*int64(policy.UsageThreshold) {
+		return 0
+	}
+
+	newSize := util.RoundUpSize(min(size+policy.StepSize, policy.MaxSize))
+	if newSize > policy.MaxSize {
+		newSize -= util.SizeAlignment
+	}
+	if newSize <= size {
+		return 0
+	}
+	return newSize
 }
 
 func (vec *VolumeExpansionController) isResponsibleFor(vol *longhorn.Volume) bool {
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func TestGetAutoExpansionSize(t *testing.T) {
+	policy := &longhorn.VolumeAutoExpansionPolicy{
+		UsageThreshold: 80,
+		StepSize:       2 * util.GiB,
+		MaxSize:        15 * util.GiB,
+	}
+
+	testCases := map[string]struct {
+		size         int64
+		usage        longhorn.VolumeFilesystemUsage
+		expectedSize int64
+	}{
+		"below threshold": {
+			size:  10 * util.GiB,
+			usage: longhorn.VolumeFilesystemUsage{UsedBytes: 79, TotalBytes: 100},
+		},
+		"crosses threshold": {
+			size:         10 * util.GiB,
+			usage:        longhorn.VolumeFilesystemUsage{UsedBytes: 80, TotalBytes: 100},
+			expectedSize: 12 * util.GiB,
+		},
+		"capped by max size": {
+			size:         14 * util.GiB,
+			usage:        longhorn.VolumeFilesystemUsage{UsedBytes: 95, TotalBytes: 100},
+			expectedSize: 15 * util.GiB,
+		},
+		"max size reached": {
+			size:  15 * util.GiB,
+			usage: longhorn.VolumeFilesystemUsage{UsedBytes: 95, TotalBytes: 100},
+		},
+		"unknown filesystem size": {
+			size:  10 * util.GiB,
+			usage: longhorn.VolumeFilesystemUsage{UsedBytes: 95},
+		},
+	}
+
+	for name, tc := range testCases {
+		if size := getAutoExpansionSize(tc.size, policy, &tc.usage); size != tc.expectedSize {
+			t.Errorf("%v: expected size %v, got %v", name, tc.expectedSize, size)
+		}
+	}
+}
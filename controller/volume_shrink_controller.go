@@ -0,0 +1,970 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientset "k8s.io/client-go/kubernetes"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/longhorn/longhorn-manager/constant"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	VolumeShrinkMoverContainerName = "volume-shrink-mover"
+
+	volumeShrinkProgressCheckInterval = 5 * time.Second
+	volumeShrinkProgressTimeout       = 3 * time.Second
+)
+
+// VolumeShrinkController shrinks a volume by copying its files into a new smaller volume. The files are copied while
+// the workload keeps using the volume, then the changes since the copy are copied and verified once the workload
+// detaches the volume, and the PVC of the volume is rebound to the new volume. The source volume is retained after
+// the swap.
+type VolumeShrinkController struct {
+	*baseController
+
+	// which namespace controller is running with
+	namespace string
+	// use as the OwnerID of the controller
+	controllerID string
+
+	managerImage string
+
+	kubeClient    clientset.Interface
+	eventRecorder record.EventRecorder
+
+	ds *datastore.DataStore
+
+	httpClient *http.Client
+
+	cacheSyncs []cache.InformerSynced
+}
+
+func NewVolumeShrinkController(
+	logger logrus.FieldLogger,
+	ds *datastore.DataStore,
+	scheme *runtime.Scheme,
+	kubeClient clientset.Interface,
+	controllerID string,
+	namespace string,
+	managerImage string) (*VolumeShrinkController, error) {
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(logrus.Infof)
+	// TODO: remove the wrapper when every clients have moved to use the clientset.
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{
+		Interface: v1core.New(kubeClient.CoreV1().RESTClient()).Events(""),
+	})
+
+	vsc := &VolumeShrinkController{
+		baseController: newBaseController("longhorn-volume-shrink", logger),
+
+		namespace:    namespace,
+		controllerID: controllerID,
+
+		managerImage: managerImage,
+
+		ds: ds,
+
+		httpClient: &http.Client{Timeout: volumeShrinkProgressTimeout},
+
+		kubeClient:    kubeClient,
+		eventRecorder: eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "longhorn-volume-shrink-controller"}),
+	}
+
+	var err error
+	if _, err = ds.VolumeShrinkInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    vsc.enqueueVolumeShrink,
+		UpdateFunc: func(old, cur interface{}) { vsc.enqueueVolumeShrink(cur) },
+		DeleteFunc: vsc.enqueueVolumeShrink,
+	}); err != nil {
+		return nil, err
+	}
+	vsc.cacheSyncs = append(vsc.cacheSyncs, ds.VolumeShrinkInformer.HasSynced)
+
+	if _, err = ds.VolumeInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		AddFunc:    vsc.enqueueVolumeShrinkForVolume,
+		UpdateFunc: func(old, cur interface{}) { vsc.enqueueVolumeShrinkForVolume(cur) },
+		DeleteFunc: vsc.enqueueVolumeShrinkForVolume,
+	}, 0); err != nil {
+		return nil, err
+	}
+	vsc.cacheSyncs = append(vsc.cacheSyncs, ds.VolumeInformer.HasSynced)
+
+	if _, err = ds.PodInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		AddFunc:    vsc.enqueueVolumeShrinkForPod,
+		UpdateFunc: func(old, cur interface{}) { vsc.enqueueVolumeShrinkForPod(cur) },
+		DeleteFunc: vsc.enqueueVolumeShrinkForPod,
+	}, 0); err != nil {
+		return nil, err
+	}
+	vsc.cacheSyncs = append(vsc.cacheSyncs, ds.PodInformer.HasSynced)
+
+	return vsc, nil
+}
+
+func (vsc *VolumeShrinkController) enqueueVolumeShrink(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to get key for object %#v: %v", obj, err))
+		return
+	}
+
+	vsc.queue.Add(key)
+}
+
+// enqueueVolumeShrinkForVolume enqueues the active shrinks of which the volume is the source or the target.
+func (vsc *VolumeShrinkController) enqueueVolumeShrinkForVolume(obj interface{}) {
+	v, isVolume := obj.(*longhorn.Volume)
+	if !isVolume {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("received unexpected obj: %#v", obj))
+			return
+		}
+
+		// use the last known state, to enqueue, dependent objects
+		v, ok = deletedState.Obj.(*longhorn.Volume)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("DeletedFinalStateUnknown contained invalid object: %#v", deletedState.Obj))
+			return
+		}
+	}
+
+	shrinks, err := vsc.ds.ListVolumeShrinksRO()
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to list volume shrinks since %v", err))
+		return
+	}
+	for _, shrink := range shrinks {
+		if shrink.Status.State == longhorn.VolumeShrinkStateCompleted ||
+			shrink.Status.State == longhorn.VolumeShrinkStateError {
+			continue
+		}
+		if shrink.Spec.VolumeName == v.Name || shrink.Spec.TargetVolumeName == v.Name {
+			vsc.enqueueVolumeShrink(shrink)
+		}
+	}
+}
+
+// enqueueVolumeShrinkForPod enqueues the shrink owning the mover pod. The shrink name is stored in the label of the
+// pod.
+func (vsc *VolumeShrinkController) enqueueVolumeShrinkForPod(obj interface{}) {
+	if deletedState, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = deletedState.Obj
+	}
+
+	object, err := meta.Accessor(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("received unexpected obj: %#v", obj))
+		return
+	}
+
+	shrinkName, ok := object.GetLabels()[types.GetLonghornLabelKey(types.LonghornLabelVolumeShrink)]
+	if !ok {
+		return
+	}
+	vsc.queue.Add(vsc.namespace + "/" + shrinkName)
+}
+
+func (vsc *VolumeShrinkController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer vsc.queue.ShutDown()
+
+	vsc.logger.Info("Starting Longhorn VolumeShrink controller")
+	defer vsc.logger.Info("Shut down Longhorn VolumeShrink controller")
+
+	if !cache.WaitForNamedCacheSync(vsc.name, stopCh, vsc.cacheSyncs...) {
+		return
+	}
+	for i := 0; i < workers; i++ {
+		go wait.Until(vsc.worker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+func (vsc *VolumeShrinkController) worker() {
+	for vsc.processNextWorkItem() {
+	}
+}
+
+func (vsc *VolumeShrinkController) processNextWorkItem() bool {
+	key, quit := vsc.queue.Get()
+	if quit {
+		return false
+	}
+	defer vsc.queue.Done(key)
+	err := vsc.syncVolumeShrink(key.(string))
+	vsc.handleErr(err, key)
+	return true
+}
+
+func (vsc *VolumeShrinkController) handleErr(err error, key interface{}) {
+	if err == nil {
+		vsc.queue.Forget(key)
+		return
+	}
+
+	log := vsc.logger.WithField("volumeShrink", key)
+	if vsc.queue.NumRequeues(key) < maxRetries {
+		handleReconcileErrorLogging(log, err, "Failed to sync Longhorn volume shrink")
+		vsc.queue.AddRateLimited(key)
+		return
+	}
+
+	utilruntime.HandleError(err)
+	handleReconcileErrorLogging(log, err, "Dropping Longhorn volume shrink out of the queue")
+	vsc.queue.Forget(key)
+}
+
+func (vsc *VolumeShrinkController) syncVolumeShrink(key string) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to sync volume shrink %v", key)
+	}()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	if namespace != vsc.namespace {
+		return nil
+	}
+	return vsc.reconcile(name)
+}
+
+func getLoggerForVolumeShrink(logger logrus.FieldLogger, shrink *longhorn.VolumeShrink) *logrus.Entry {
+	return logger.WithFields(
+		logrus.Fields{
+			"volumeShrink": shrink.Name,
+			"volume":       shrink.Spec.VolumeName,
+			"size":         shrink.Spec.Size,
+		},
+	)
+}
+
+func (vsc *VolumeShrinkController) isResponsibleFor(shrink *longhorn.VolumeShrink) bool {
+	return isControllerResponsibleFor(vsc.controllerID, vsc.ds, shrink.Name, "", shrink.Status.OwnerID)
+}
+
+func (vsc *VolumeShrinkController) reconcile(name string) (err error) {
+	shrink, err := vsc.ds.GetVolumeShrink(name)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	if !vsc.isResponsibleFor(shrink) {
+		return nil
+	}
+
+	log := getLoggerForVolumeShrink(vsc.logger, shrink)
+
+	if shrink.Status.OwnerID != vsc.controllerID {
+		shrink.Status.OwnerID = vsc.controllerID
+		shrink, err = vsc.ds.UpdateVolumeShrinkStatus(shrink)
+		if err != nil {
+			// we don't mind others coming first
+			if datastore.ErrorIsConflict(errors.Cause(err)) {
+				return nil
+			}
+			return err
+		}
+		log.Infof("Volume shrink got new owner %v", vsc.controllerID)
+	}
+
+	if !shrink.DeletionTimestamp.IsZero() {
+		cleaned, err := vsc.cleanup(shrink)
+		if err != nil || !cleaned {
+			return err
+		}
+		return vsc.ds.RemoveFinalizerForVolumeShrink(shrink)
+	}
+
+	existingShrink := shrink.DeepCopy()
+	defer func() {
+		if err != nil {
+			return
+		}
+		if reflect.DeepEqual(existingShrink.Status, shrink.Status) {
+			return
+		}
+		if _, err = vsc.ds.UpdateVolumeShrinkStatus(shrink); err != nil && datastore.ErrorIsConflict(errors.Cause(err)) {
+			log.WithError(err).Debugf("Requeue %v due to conflict", name)
+			vsc.enqueueVolumeShrink(shrink)
+			err = nil
+		}
+	}()
+
+	switch shrink.Status.State {
+	case longhorn.VolumeShrinkStateCompleted,
+		longhorn.VolumeShrinkStateError:
+		_, err := vsc.releaseVolumes(shrink)
+		return err
+	case "":
+		shrink.Status.State = longhorn.VolumeShrinkStatePending
+		shrink.Status.StartedAt = util.Now()
+	}
+
+	source, err := vsc.ds.GetVolumeRO(shrink.Spec.VolumeName)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		vsc.failVolumeShrink(shrink, fmt.Sprintf("volume %v is not found", shrink.Spec.VolumeName))
+		return nil
+	}
+
+	switch shrink.Status.State {
+	case longhorn.VolumeShrinkStatePending:
+		return vsc.createTargetVolume(shrink, source)
+	case longhorn.VolumeShrinkStateCopying:
+		return vsc.copy(shrink, source)
+	case longhorn.VolumeShrinkStateWaitingForDetach:
+		return vsc.waitForDetach(shrink, source)
+	case longhorn.VolumeShrinkStateVerifying:
+		return vsc.verify(shrink, source)
+	case longhorn.VolumeShrinkStateSwapping:
+		return vsc.swap(shrink, source)
+	}
+	return nil
+}
+
+func (vsc *VolumeShrinkController) failVolumeShrink(shrink *longhorn.VolumeShrink, message string) {
+	shrink.Status.State = longhorn.VolumeShrinkStateError
+	shrink.Status.Message = message
+	vsc.eventRecorder.Event(shrink, corev1.EventTypeWarning, constant.EventReasonVolumeShrinkFailed, message)
+}
+
+// getVolumeShrinkSizeIssue returns the reason the files of the source volume may not fit into the target volume.
+func getVolumeShrinkSizeIssue(shrink *longhorn.VolumeShrink, source *longhorn.Volume) string {
+	if shrink.Spec.Size >= source.Spec.Size {
+		return fmt.Sprintf("size %v is not smaller than size %v of volume %v", shrink.Spec.Size, source.Spec.Size, source.Name)
+	}
+	usage := source.Status.FilesystemUsage
+	if usage == nil {
+		return fmt.Sprintf("filesystem usage of volume %v is not reported, the volume needs to be used by a workload first", source.Name)
+	}
+	requiredSize := types.GetVolumeShrinkRequiredSize(usage.UsedBytes, shrink.Spec.MarginPercentage)
+	if requiredSize > shrink.Spec.Size {
+		return fmt.Sprintf("used space %v of volume %v plus %v%% margin exceeds size %v",
+			usage.UsedBytes, source.Name, shrink.Spec.MarginPercentage, shrink.Spec.Size)
+	}
+	return ""
+}
+
+// createTargetVolume checks the files fit into the target volume, records the PV and the PVC of the source volume,
+// and creates the target volume.
+func (vsc *VolumeShrinkController) createTargetVolume(shrink *longhorn.VolumeShrink, source *longhorn.Volume) error {
+	if issue := getVolumeShrinkSizeIssue(shrink, source); issue != "" {
+		vsc.failVolumeShrink(shrink, issue)
+		return nil
+	}
+
+	ks := source.Status.KubernetesStatus
+	if ks.PVName == "" {
+		vsc.failVolumeShrink(shrink, fmt.Sprintf("volume %v is not bound to a PV", source.Name))
+		return nil
+	}
+	pv, err := vsc.ds.GetPersistentVolumeRO(ks.PVName)
+	if err != nil {
+		return err
+	}
+	if pv.Spec.CSI == nil {
+		vsc.failVolumeShrink(shrink, fmt.Sprintf("PV %v of volume %v is not a CSI volume", pv.Name, source.Name))
+		return nil
+	}
+	if pv.Spec.VolumeMode != nil && *pv.Spec.VolumeMode == corev1.PersistentVolumeBlock {
+		vsc.failVolumeShrink(shrink, fmt.Sprintf("PV %v of volume %v has no filesystem", pv.Name, source.Name))
+		return nil
+	}
+	fsType := pv.Spec.CSI.FSType
+	if fsType == "" {
+		fsType = "ext4"
+	}
+	if fsType != "ext4" && fsType != "xfs" {
+		vsc.failVolumeShrink(shrink, fmt.Sprintf("filesystem %v of volume %v is not supported", fsType, source.Name))
+		return nil
+	}
+	if fsType == "xfs" && shrink.Spec.Size < util.MinimalVolumeSizeXFS {
+		vsc.failVolumeShrink(shrink, fmt.Sprintf("size %v is smaller than the minimal size %v of XFS filesystems", shrink.Spec.Size, util.MinimalVolumeSizeXFS))
+		return nil
+	}
+
+	shrink.Status.SourcePVName = pv.Name
+	shrink.Status.SourcePVReclaimPolicy = string(pv.Spec.PersistentVolumeReclaimPolicy)
+	shrink.Status.StorageClassName = pv.Spec.StorageClassName
+	shrink.Status.FSType = fsType
+	if pv.Spec.ClaimRef != nil {
+		shrink.Status.PVCName = pv.Spec.ClaimRef.Name
+		shrink.Status.PVCNamespace = pv.Spec.ClaimRef.Namespace
+		pvc, err := vsc.ds.GetPersistentVolumeClaimRO(shrink.Status.PVCNamespace, shrink.Status.PVCName)
+		if err != nil && !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		if pvc != nil {
+			shrink.Status.PVCLabels = pvc.Labels
+		}
+	}
+
+	target, err := vsc.ds.GetVolumeRO(shrink.Spec.TargetVolumeName)
+	if err != nil && !datastore.ErrorIsNotFound(err) {
+		return err
+	}
+	if target != nil {
+		if target.Labels[types.GetLonghornLabelKey(types.LonghornLabelVolumeShrink)] != shrink.Name {
+			vsc.failVolumeShrink(shrink, fmt.Sprintf("target volume %v already exists", target.Name))
+			return nil
+		}
+	} else {
+		if _, err := vsc.ds.CreateVolume(newVolumeShrinkTargetVolume(shrink, source)); err != nil {
+			return errors.Wrapf(err, "failed to create target volume %v", shrink.Spec.TargetVolumeName)
+		}
+		vsc.logger.Infof("Created volume %v with size %v to shrink volume %v", shrink.Spec.TargetVolumeName, shrink.Spec.Size, source.Name)
+	}
+
+	shrink.Status.State = longhorn.VolumeShrinkStateCopying
+	shrink.Status.Message = ""
+	return nil
+}
+
+// newVolumeShrinkTargetVolume returns the blank volume with the target size. The other settings are inherited from
+// the source volume or defaulted by the volume mutator.
+func newVolumeShrinkTargetVolume(shrink *longhorn.VolumeShrink, source *longhorn.Volume) *longhorn.Volume {
+	return &longhorn.Volume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   shrink.Spec.TargetVolumeName,
+			Labels: types.GetVolumeShrinkLabels(shrink.Name),
+		},
+		Spec: longhorn.VolumeSpec{
+			Size:                shrink.Spec.Size,
+			AccessMode:          source.Spec.AccessMode,
+			Migratable:          source.Spec.Migratable,
+			Frontend:            longhorn.VolumeFrontendBlockDev,
+			DataEngine:          source.Spec.DataEngine,
+			NumberOfReplicas:    source.Spec.NumberOfReplicas,
+			DataLocality:        source.Spec.DataLocality,
+			StaleReplicaTimeout: source.Spec.StaleReplicaTimeout,
+			DiskSelector:        source.Spec.DiskSelector,
+			NodeSelector:        source.Spec.NodeSelector,
+			BackupTargetName:    source.Spec.BackupTargetName,
+		},
+	}
+}
+
+// copy copies the files into the target volume on the node the workload uses the source volume on, or on this node
+// if the source volume is detached.
+func (vsc *VolumeShrinkController) copy(shrink *longhorn.VolumeShrink, source *longhorn.Volume) error {
+	if shrink.Status.NodeID == "" {
+		shrink.Status.NodeID = vsc.controllerID
+		if source.Status.State == longhorn.VolumeStateAttached && source.Status.CurrentNodeID != "" {
+			shrink.Status.NodeID = source.Status.CurrentNodeID
+		}
+	}
+
+	done, err := vsc.runMover(shrink, source, types.VolumeShrinkMoverModeCopy)
+	if err != nil || !done {
+		return err
+	}
+
+	if _, err := vsc.releaseVolumes(shrink); err != nil {
+		return err
+	}
+	vsc.eventRecorder.Eventf(shrink, corev1.EventTypeNormal, constant.EventReasonVolumeShrinkCopied,
+		"Copied files of volume %v into volume %v", source.Name, shrink.Spec.TargetVolumeName)
+	shrink.Status.State = longhorn.VolumeShrinkStateWaitingForDetach
+	return vsc.waitForDetach(shrink, source)
+}
+
+// waitForDetach waits for the workload to stop using the source volume, so no file changes during the final copy.
+func (vsc *VolumeShrinkController) waitForDetach(shrink *longhorn.VolumeShrink, source *longhorn.Volume) error {
+	inUse, err := vsc.isSourceVolumeInUse(source)
+	if err != nil {
+		return err
+	}
+	if inUse || source.Status.State != longhorn.VolumeStateDetached {
+		shrink.Status.Message = fmt.Sprintf("waiting for the workload to detach volume %v", source.Name)
+		return nil
+	}
+
+	shrink.Status.State = longhorn.VolumeShrinkStateVerifying
+	shrink.Status.NodeID = vsc.controllerID
+	shrink.Status.Progress = 0
+	shrink.Status.Message = ""
+	return nil
+}
+
+// verify copies the changes since the first copy and verifies the files of both volumes are identical. The shrink
+// goes back to wait for the detachment if the workload comes back.
+func (vsc *VolumeShrinkController) verify(shrink *longhorn.VolumeShrink, source *longhorn.Volume) error {
+	inUse, err := vsc.isSourceVolumeInUse(source)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		if err := vsc.deleteMoverPod(shrink, types.VolumeShrinkMoverModeFinal); err != nil {
+			return err
+		}
+		if _, err := vsc.releaseVolumes(shrink); err != nil {
+			return err
+		}
+		shrink.Status.State = longhorn.VolumeShrinkStateWaitingForDetach
+		shrink.Status.Message = fmt.Sprintf("waiting for the workload to detach volume %v", source.Name)
+		return nil
+	}
+
+	done, err := vsc.runMover(shrink, source, types.VolumeShrinkMoverModeFinal)
+	if err != nil || !done {
+		return err
+	}
+
+	shrink.Status.State = longhorn.VolumeShrinkStateSwapping
+	return vsc.swap(shrink, source)
+}
+
+// isSourceVolumeInUse checks if a workload or a user requests the source volume.
+func (vsc *VolumeShrinkController) isSourceVolumeInUse(source *longhorn.Volume) (bool, error) {
+	va, err := vsc.ds.GetLHVolumeAttachmentByVolumeName(source.Name)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return isVolumeTransferSourceInUse(va), nil
+}
+
+// runMover attaches both volumes to the node of the shrink and runs the mover pod in the mode. It returns whether the
+// mover pod succeeded.
+func (vsc *VolumeShrinkController) runMover(shrink *longhorn.VolumeShrink, source *longhorn.Volume, mode string) (bool, error) {
+	target, err := vsc.ds.GetVolumeRO(shrink.Spec.TargetVolumeName)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return false, err
+		}
+		vsc.failVolumeShrink(shrink, fmt.Sprintf("target volume %v is deleted during the shrink", shrink.Spec.TargetVolumeName))
+		return false, nil
+	}
+
+	attached := true
+	for _, v := range []*longhorn.Volume{source, target} {
+		volumeAttached, err := vsc.syncVolumeAttachment(shrink, v)
+		if err != nil {
+			return false, err
+		}
+		attached = attached && volumeAttached
+	}
+	if !attached {
+		shrink.Status.Message = fmt.Sprintf("waiting for volumes %v and %v to be attached to node %v", source.Name, target.Name, shrink.Status.NodeID)
+		return false, nil
+	}
+
+	podName := types.GetVolumeShrinkPodName(shrink.Name, mode)
+	pod, err := vsc.ds.GetPodRO(vsc.namespace, podName)
+	if err != nil {
+		return false, err
+	}
+	if pod == nil {
+		pod, err := vsc.generateVolumeShrinkMoverPod(shrink, source, target, mode)
+		if err != nil {
+			return false, err
+		}
+		if _, err := vsc.ds.CreatePod(pod); err != nil {
+			return false, errors.Wrapf(err, "failed to create mover pod %v", podName)
+		}
+		shrink.Status.Progress = 0
+		shrink.Status.Message = ""
+		return false, nil
+	}
+
+	switch pod.Status.Phase {
+	case corev1.PodRunning:
+		vsc.queue.AddAfter(vsc.namespace+"/"+shrink.Name, volumeShrinkProgressCheckInterval)
+		if pod.Status.PodIP == "" {
+			return false, nil
+		}
+		progress, err := vsc.getMoverProgress(pod.Status.PodIP)
+		if err != nil {
+			vsc.logger.WithError(err).Debugf("Failed to get the progress of mover pod %v", podName)
+			return false, nil
+		}
+		shrink.Status.Progress = progress.Progress
+		return false, nil
+	case corev1.PodSucceeded:
+		if err := vsc.deleteMoverPod(shrink, mode); err != nil {
+			return false, err
+		}
+		shrink.Status.Progress = 100
+		return true, nil
+	case corev1.PodFailed:
+		vsc.failVolumeShrink(shrink, fmt.Sprintf("mover pod %v failed: %v", podName, getVolumeShrinkMoverFailure(pod)))
+		return false, nil
+	}
+	return false, nil
+}
+
+func (vsc *VolumeShrinkController) getMoverProgress(podIP string) (*types.VolumeShrinkMoverProgress, error) {
+	resp, err := vsc.httpClient.Get(types.GetVolumeShrinkMoverProgressURL(podIP))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if errClose := resp.Body.Close(); errClose != nil {
+			vsc.logger.WithError(errClose).Warn("Failed to close the response body of the mover progress")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %v", resp.Status)
+	}
+
+	progress := &types.VolumeShrinkMoverProgress{}
+	if err := json.NewDecoder(resp.Body).Decode(progress); err != nil {
+		return nil, err
+	}
+	return progress, nil
+}
+
+// getVolumeShrinkMoverFailure returns the termination message written by the mover, or the exit code if there is
+// none.
+func getVolumeShrinkMoverFailure(pod *corev1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		terminated := status.State.Terminated
+		if terminated == nil {
+			continue
+		}
+		if terminated.Message != "" {
+			return terminated.Message
+		}
+		return fmt.Sprintf("exited with code %v", terminated.ExitCode)
+	}
+	return "unknown failure"
+}
+
+// syncVolumeAttachment attaches the volume to the node of the shrink with the frontend enabled, so the mover pod can
+// mount the block device. It returns whether the volume is attached to the node.
+func (vsc *VolumeShrinkController) syncVolumeAttachment(shrink *longhorn.VolumeShrink, v *longhorn.Volume) (bool, error) {
+	va, err := vsc.ds.GetLHVolumeAttachmentByVolumeName(v.Name)
+	if err != nil {
+		return false, err
+	}
+	existingVA := va.DeepCopy()
+
+	ticketID := longhorn.GetAttachmentTicketID(longhorn.AttacherTypeVolumeShrinkController, shrink.Name)
+	createOrUpdateAttachmentTicket(va, ticketID, shrink.Status.NodeID, longhorn.FalseValue, longhorn.AttacherTypeVolumeShrinkController)
+	if !reflect.DeepEqual(existingVA.Spec, va.Spec) {
+		if _, err := vsc.ds.UpdateLHVolumeAttachment(va); err != nil {
+			return false, err
+		}
+	}
+
+	return v.Status.State == longhorn.VolumeStateAttached && v.Status.CurrentNodeID == shrink.Status.NodeID, nil
+}
+
+// releaseVolumes removes the attachment tickets of the shrink from both volumes. It returns whether both volumes are
+// detached or attached by others only.
+func (vsc *VolumeShrinkController) releaseVolumes(shrink *longhorn.VolumeShrink) (bool, error) {
+	ticketID := longhorn.GetAttachmentTicketID(longhorn.AttacherTypeVolumeShrinkController, shrink.Name)
+	released := true
+	for _, volumeName := range []string{shrink.Spec.VolumeName, shrink.Spec.TargetVolumeName} {
+		va, err := vsc.ds.GetLHVolumeAttachmentByVolumeName(volumeName)
+		if err != nil {
+			if datastore.ErrorIsNotFound(err) {
+				continue
+			}
+			return false, err
+		}
+		if _, ok := va.Spec.AttachmentTickets[ticketID]; !ok {
+			continue
+		}
+		va = va.DeepCopy()
+		delete(va.Spec.AttachmentTickets, ticketID)
+		if _, err := vsc.ds.UpdateLHVolumeAttachment(va); err != nil {
+			return false, err
+		}
+		released = false
+	}
+	return released, nil
+}
+
+func (vsc *VolumeShrinkController) deleteMoverPod(shrink *longhorn.VolumeShrink, mode string) error {
+	podName := types.GetVolumeShrinkPodName(shrink.Name, mode)
+	pod, err := vsc.ds.GetPodRO(vsc.namespace, podName)
+	if err != nil {
+		return err
+	}
+	if pod == nil || pod.DeletionTimestamp != nil {
+		return nil
+	}
+	if err := vsc.ds.DeletePod(podName); err != nil && !datastore.ErrorIsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete mover pod %v", podName)
+	}
+	return nil
+}
+
+func (vsc *VolumeShrinkController) generateVolumeShrinkMoverPod(shrink *longhorn.VolumeShrink, source, target *longhorn.Volume, mode string) (*corev1.Pod, error) {
+	tolerations, err := vsc.ds.GetSettingTaintToleration()
+	if err != nil {
+		return nil, err
+	}
+
+	priorityClass, err := vsc.ds.GetSettingWithAutoFillingRO(types.SettingNamePriorityClass)
+	if err != nil {
+		return nil, err
+	}
+
+	imagePullPolicy, err := vsc.ds.GetSettingImagePullPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{
+		"longhorn-manager", "volume-shrink-mover",
+		"--source-device", types.GetVolumeShrinkDevicePath(source.Name),
+		"--target-device", types.GetVolumeShrinkDevicePath(target.Name),
+		"--fs-type", shrink.Status.FSType,
+		"--mode", mode,
+		"--port", strconv.Itoa(types.VolumeShrinkMoverPort),
+	}
+
+	privileged := true
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            types.GetVolumeShrinkPodName(shrink.Name, mode),
+			Namespace:       vsc.namespace,
+			OwnerReferences: datastore.GetOwnerReferencesForVolumeShrink(shrink),
+			Labels:          types.GetVolumeShrinkPodLabels(shrink.Name),
+		},
+		Spec: corev1.PodSpec{
+			Tolerations:       util.GetDistinctTolerations(tolerations),
+			PriorityClassName: priorityClass.Value,
+			Containers: []corev1.Container{
+				{
+					Name:            VolumeShrinkMoverContainerName,
+					Image:           vsc.managerImage,
+					ImagePullPolicy: imagePullPolicy,
+					Command:         cmd,
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      "host-dev",
+							MountPath: types.VolumeShrinkMoverHostDevDirectory,
+						},
+					},
+					SecurityContext: &corev1.SecurityContext{
+						Privileged: &privileged,
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: "host-dev",
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{
+							Path: "/dev",
+						},
+					},
+				},
+			},
+			NodeName:      shrink.Status.NodeID,
+			RestartPolicy: corev1.RestartPolicyNever,
+		},
+	}
+
+	registrySecretSetting, err := vsc.ds.GetSettingWithAutoFillingRO(types.SettingNameRegistrySecret)
+	if err != nil {
+		return nil, err
+	}
+	if registrySecretSetting.Value != "" {
+		pod.Spec.ImagePullSecrets = []corev1.LocalObjectReference{
+			{
+				Name: registrySecretSetting.Value,
+			},
+		}
+	}
+
+	return pod, nil
+}
+
+// swap rebinds the PVC from the source volume to the target volume once both volumes are detached. The source PV is
+// retained so that the source volume is kept, then the PVC is recreated with the same name and bound to the PV of the
+// target volume.
+func (vsc *VolumeShrinkController) swap(shrink *longhorn.VolumeShrink, source *longhorn.Volume) error {
+	vsc.queue.AddAfter(vsc.namespace+"/"+shrink.Name, volumeShrinkProgressCheckInterval)
+
+	released, err := vsc.releaseVolumes(shrink)
+	if err != nil || !released {
+		return err
+	}
+
+	if shrink.Status.PVCName != "" {
+		pvc, err := vsc.ds.GetPersistentVolumeClaimRO(shrink.Status.PVCNamespace, shrink.Status.PVCName)
+		if err != nil && !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		if pvc != nil && pvc.Spec.VolumeName == shrink.Status.SourcePVName {
+			inUse, err := vsc.isSourceVolumeInUse(source)
+			if err != nil {
+				return err
+			}
+			if inUse {
+				// The workload came back before the swap started, so the files need to be copied again
+				shrink.Status.State = longhorn.VolumeShrinkStateWaitingForDetach
+				return nil
+			}
+			if source.Status.State != longhorn.VolumeStateDetached {
+				shrink.Status.Message = fmt.Sprintf("waiting for volume %v to be detached", source.Name)
+				return nil
+			}
+			if err := vsc.retainSourcePV(shrink); err != nil {
+				return err
+			}
+			if pvc.DeletionTimestamp == nil {
+				if err := vsc.ds.DeletePersistentVolumeClaim(pvc.Namespace, pvc.Name); err != nil && !datastore.ErrorIsNotFound(err) {
+					return errors.Wrapf(err, "failed to delete PVC %v/%v", pvc.Namespace, pvc.Name)
+				}
+			}
+			shrink.Status.Message = fmt.Sprintf("waiting for PVC %v/%v to be deleted", pvc.Namespace, pvc.Name)
+			return nil
+		}
+	}
+
+	if err := vsc.retainSourcePV(shrink); err != nil {
+		return err
+	}
+	if err := vsc.ds.DeletePersistentVolume(shrink.Status.SourcePVName); err != nil && !datastore.ErrorIsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete PV %v", shrink.Status.SourcePVName)
+	}
+
+	target, err := vsc.ds.GetVolumeRO(shrink.Spec.TargetVolumeName)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		vsc.failVolumeShrink(shrink, fmt.Sprintf("target volume %v is deleted during the swap", shrink.Spec.TargetVolumeName))
+		return nil
+	}
+
+	targetPVName := target.Name
+	if _, err := vsc.ds.GetPersistentVolumeRO(targetPVName); err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		pv := datastore.NewPVManifestForVolume(target, targetPVName, shrink.Status.StorageClassName, shrink.Status.FSType)
+		if shrink.Status.SourcePVReclaimPolicy != "" {
+			pv.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimPolicy(shrink.Status.SourcePVReclaimPolicy)
+		}
+		if _, err := vsc.ds.CreatePersistentVolume(pv); err != nil {
+			return errors.Wrapf(err, "failed to create PV %v", targetPVName)
+		}
+	}
+	shrink.Status.TargetPVName = targetPVName
+
+	if shrink.Status.PVCName == "" {
+		vsc.completeVolumeShrink(shrink, fmt.Sprintf("created PV %v for volume %v", targetPVName, target.Name))
+		return nil
+	}
+
+	pvc, err := vsc.ds.GetPersistentVolumeClaimRO(shrink.Status.PVCNamespace, shrink.Status.PVCName)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		pvc = datastore.NewPVCManifestForVolume(target, targetPVName, shrink.Status.PVCNamespace, shrink.Status.PVCName, shrink.Status.StorageClassName)
+		pvc.Labels = shrink.Status.PVCLabels
+		if _, err := vsc.ds.CreatePersistentVolumeClaim(pvc.Namespace, pvc); err != nil {
+			return errors.Wrapf(err, "failed to create PVC %v/%v", pvc.Namespace, pvc.Name)
+		}
+		shrink.Status.Message = fmt.Sprintf("waiting for PVC %v/%v to be bound to PV %v", pvc.Namespace, pvc.Name, targetPVName)
+		return nil
+	}
+	if pvc.Spec.VolumeName != targetPVName {
+		vsc.failVolumeShrink(shrink, fmt.Sprintf("PVC %v/%v is recreated with PV %v instead of PV %v", pvc.Namespace, pvc.Name, pvc.Spec.VolumeName, targetPVName))
+		return nil
+	}
+	if pvc.Status.Phase != corev1.ClaimBound {
+		return nil
+	}
+
+	vsc.completeVolumeShrink(shrink, fmt.Sprintf("volume %v is retained and can be deleted once the workload is verified with volume %v", source.Name, target.Name))
+	vsc.eventRecorder.Eventf(shrink, corev1.EventTypeNormal, constant.EventReasonVolumeShrinkSwapped,
+		"Rebound PVC %v/%v from volume %v to volume %v with size %v", pvc.Namespace, pvc.Name, source.Name, target.Name, target.Spec.Size)
+	return nil
+}
+
+// retainSourcePV prevents the source volume from being deleted along with the source PV.
+func (vsc *VolumeShrinkController) retainSourcePV(shrink *longhorn.VolumeShrink) error {
+	pv, err := vsc.ds.GetPersistentVolume(shrink.Status.SourcePVName)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if pv.Spec.PersistentVolumeReclaimPolicy == corev1.PersistentVolumeReclaimRetain {
+		return nil
+	}
+	pv.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimRetain
+	if _, err := vsc.ds.UpdatePersistentVolume(pv); err != nil {
+		return errors.Wrapf(err, "failed to retain PV %v", pv.Name)
+	}
+	return nil
+}
+
+func (vsc *VolumeShrinkController) completeVolumeShrink(shrink *longhorn.VolumeShrink, message string) {
+	shrink.Status.State = longhorn.VolumeShrinkStateCompleted
+	shrink.Status.CompletedAt = util.Now()
+	shrink.Status.Message = message
+}
+
+// cleanup deletes the mover pods, releases both volumes, and deletes the target volume if the swap has not started.
+// It returns whether the cleanup is done.
+func (vsc *VolumeShrinkController) cleanup(shrink *longhorn.VolumeShrink) (bool, error) {
+	for _, mode := range []string{types.VolumeShrinkMoverModeCopy, types.VolumeShrinkMoverModeFinal} {
+		if err := vsc.deleteMoverPod(shrink, mode); err != nil {
+			return false, err
+		}
+	}
+	if _, err := vsc.releaseVolumes(shrink); err != nil {
+		return false, err
+	}
+	if shrink.Status.State == longhorn.VolumeShrinkStateSwapping || shrink.Status.State == longhorn.VolumeShrinkStateCompleted {
+		return true, nil
+	}
+
+	target, err := vsc.ds.GetVolumeRO(shrink.Spec.TargetVolumeName)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	if target.DeletionTimestamp == nil && target.Labels[types.GetLonghornLabelKey(types.LonghornLabelVolumeShrink)] == shrink.Name {
+		if err := vsc.ds.DeleteVolume(target.Name); err != nil && !datastore.ErrorIsNotFound(err) {
+			return false, errors.Wrapf(err, "failed to delete target volume %v", target.Name)
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func TestGetVolumeShrinkSizeIssue(t *testing.T) {
+	source := &longhorn.Volume{
+		Spec: longhorn.VolumeSpec{
+			Size: 10 * util.GiB,
+		},
+	}
+	source.Name = TestVolumeName
+	shrink := &longhorn.VolumeShrink{
+		Spec: longhorn.VolumeShrinkSpec{
+			VolumeName:       TestVolumeName,
+			Size:             2 * util.GiB,
+			MarginPercentage: 10,
+		},
+	}
+
+	if issue := getVolumeShrinkSizeIssue(shrink, source); !strings.Contains(issue, "not reported") {
+		t.Fatalf("expected the shrink to wait for the filesystem usage, got %q", issue)
+	}
+
+	source.Status.FilesystemUsage = &longhorn.VolumeFilesystemUsage{UsedBytes: util.GiB}
+	if issue := getVolumeShrinkSizeIssue(shrink, source); issue != "" {
+		t.Fatalf("expected no issue, got %q", issue)
+	}
+
+	source.Status.FilesystemUsage.UsedBytes = 1900 * util.MiB
+	if issue := getVolumeShrinkSizeIssue(shrink, source); !strings.Contains(issue, "exceeds") {
+		t.Fatalf("expected the used space plus the margin to exceed the size, got %q", issue)
+	}
+
+	shrink.Spec.Size = source.Spec.Size
+	if issue := getVolumeShrinkSizeIssue(shrink, source); !strings.Contains(issue, "not smaller") {
+		t.Fatalf("expected the size to be rejected, got %q", issue)
+	}
+}
+
+func TestNewVolumeShrinkTargetVolume(t *testing.T) {
+	source := &longhorn.Volume{
+		Spec: longhorn.VolumeSpec{
+			Size:             10 * util.GiB,
+			DataEngine:       longhorn.DataEngineTypeV1,
+			NumberOfReplicas: 3,
+			Frontend:         longhorn.VolumeFrontendBlockDev,
+		},
+	}
+	shrink := &longhorn.VolumeShrink{
+		Spec: longhorn.VolumeShrinkSpec{
+			VolumeName:       TestVolumeName,
+			Size:             2 * util.GiB,
+			TargetVolumeName: types.GetVolumeShrinkTargetVolumeName(TestVolumeName),
+		},
+	}
+	shrink.Name = "shrink"
+
+	v := newVolumeShrinkTargetVolume(shrink, source)
+	if v.Name != TestVolumeName+"-shrunk" || v.Spec.Size != 2*util.GiB || v.Spec.NumberOfReplicas != 3 {
+		t.Fatalf("unexpected target volume %+v", v)
+	}
+	if v.Spec.DataSource != "" {
+		t.Fatalf("target volume should be blank, got data source %v", v.Spec.DataSource)
+	}
+	if v.Labels[types.GetLonghornLabelKey(types.LonghornLabelVolumeShrink)] != shrink.Name {
+		t.Fatalf("target volume should be labeled with the shrink, got %v", v.Labels)
+	}
+}
+
+func TestGetVolumeShrinkMoverFailure(t *testing.T) {
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{ExitCode: 1},
+					},
+				},
+			},
+		},
+	}
+	if failure := getVolumeShrinkMoverFailure(pod); failure != "exited with code 1" {
+		t.Fatalf("unexpected failure %q", failure)
+	}
+
+	pod.Status.ContainerStatuses[0].State.Terminated.Message = "2 files differ after the copy"
+	if failure := getVolumeShrinkMoverFailure(pod); failure != "2 files differ after the copy" {
+		t.Fatalf("unexpected failure %q", failure)
+	}
+}
@@ -0,0 +1,578 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientset "k8s.io/client-go/kubernetes"
+
+	"github.com/longhorn/longhorn-manager/constant"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// VolumeTieringController moves the replicas of the volumes to the disks of another tier according to the tiering
+// policies. The replicas are moved one by one by requesting the eviction of the replicas, so the rebuilding limits
+// of the volumes and the nodes apply.
+type VolumeTieringController struct {
+	*baseController
+
+	// which namespace controller is running with
+	namespace string
+	// use as the OwnerID of the controller
+	controllerID string
+
+	kubeClient    clientset.Interface
+	eventRecorder record.EventRecorder
+
+	ds         *datastore.DataStore
+	cacheSyncs []cache.InformerSynced
+}
+
+func NewVolumeTieringController(
+	logger logrus.FieldLogger,
+	ds *datastore.DataStore,
+	scheme *runtime.Scheme,
+	kubeClient clientset.Interface,
+	controllerID string,
+	namespace string,
+) (*VolumeTieringController, error) {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(logrus.Infof)
+
+	vtc := &VolumeTieringController{
+		baseController: newBaseController("longhorn-volume-tiering", logger),
+
+		namespace:    namespace,
+		controllerID: controllerID,
+
+		ds: ds,
+
+		kubeClient:    kubeClient,
+		eventRecorder: eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "longhorn-volume-tiering-controller"}),
+	}
+
+	var err error
+	if _, err = ds.VolumeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    vtc.enqueueVolume,
+		UpdateFunc: func(old, cur interface{}) { vtc.enqueueVolume(cur) },
+	}); err != nil {
+		return nil, err
+	}
+	vtc.cacheSyncs = append(vtc.cacheSyncs, ds.VolumeInformer.HasSynced)
+
+	if _, err = ds.ReplicaInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, cur interface{}) { vtc.enqueueReplica(cur) },
+		DeleteFunc: vtc.enqueueReplica,
+	}); err != nil {
+		return nil, err
+	}
+	vtc.cacheSyncs = append(vtc.cacheSyncs, ds.ReplicaInformer.HasSynced)
+
+	return vtc, nil
+}
+
+func (vtc *VolumeTieringController) enqueueVolume(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %#v: %v", obj, err))
+		return
+	}
+
+	vtc.queue.Add(key)
+}
+
+func (vtc *VolumeTieringController) enqueueVolumeAfter(obj interface{}, duration time.Duration) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("enqueueVolumeAfter: failed to get key for object %#v: %v", obj, err))
+		return
+	}
+
+	vtc.queue.AddAfter(key, duration)
+}
+
+func (vtc *VolumeTieringController) enqueueReplica(obj interface{}) {
+	replica, ok := obj.(*longhorn.Replica)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("received unexpected obj: %#v", obj))
+			return
+		}
+
+		// use the last known state, to enqueue, dependent objects
+		replica, ok = deletedState.Obj.(*longhorn.Replica)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("DeletedFinalStateUnknown contained invalid object: %#v", deletedState.Obj))
+			return
+		}
+	}
+
+	vtc.queue.Add(vtc.namespace + "/" + replica.Spec.VolumeName)
+}
+
+func (vtc *VolumeTieringController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer vtc.queue.ShutDown()
+
+	vtc.logger.Info("Starting Longhorn volume tiering controller")
+	defer vtc.logger.Info("Shut down Longhorn volume tiering controller")
+
+	if !cache.WaitForNamedCacheSync(vtc.name, stopCh, vtc.cacheSyncs...) {
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(vtc.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+func (vtc *VolumeTieringController) worker() {
+	for vtc.processNextWorkItem() {
+	}
+}
+
+func (vtc *VolumeTieringController) processNextWorkItem() bool {
+	key, quit := vtc.queue.Get()
+	if quit {
+		return false
+	}
+	defer vtc.queue.Done(key)
+	err := vtc.syncHandler(key.(string))
+	vtc.handleErr(err, key)
+	return true
+}
+
+func (vtc *VolumeTieringController) handleErr(err error, key interface{}) {
+	if err == nil {
+		vtc.queue.Forget(key)
+		return
+	}
+
+	log := vtc.logger.WithField("Volume", key)
+	handleReconcileErrorLogging(log, err, "Failed to sync Longhorn volume")
+	vtc.queue.AddRateLimited(key)
+}
+
+func (vtc *VolumeTieringController) syncHandler(key string) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "%v: failed to sync volume %v", vtc.name, key)
+	}()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	if namespace != vtc.namespace {
+		return nil
+	}
+	return vtc.reconcile(name)
+}
+
+func (vtc *VolumeTieringController) reconcile(volName string) (err error) {
+	vol, err := vtc.ds.GetVolume(volName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	if !vtc.isResponsibleFor(vol) || vol.DeletionTimestamp != nil {
+		return nil
+	}
+
+	log := getLoggerForVolume(vtc.logger, vol)
+
+	existingVolume := vol.DeepCopy()
+	defer func() {
+		if err == nil && !reflect.DeepEqual(existingVolume.Status, vol.Status) {
+			_, err = vtc.ds.UpdateVolumeStatus(vol)
+		}
+		if apierrors.IsConflict(errors.Cause(err)) {
+			log.WithError(err).Debugf("Requeue %v due to conflict", volName)
+			vtc.enqueueVolume(vol)
+			err = nil
+		}
+	}()
+
+	policy, err := vtc.getTieringPolicy(vol)
+	if err != nil {
+		log.WithError(err).Warn("Failed to get the tiering policy, will skip tiering the volume")
+		policy = nil
+	}
+
+	tiering := vol.Status.Tiering
+	if policy == nil {
+		if tiering != nil && tiering.State == longhorn.VolumeTieringStateMigrating {
+			return vtc.cancelMigration(vol, tiering)
+		}
+		return nil
+	}
+	if tiering == nil {
+		tiering = &longhorn.VolumeTieringStatus{}
+		vol.Status.Tiering = tiering
+	}
+
+	if isVolumeFullyDetached(vol) {
+		if tiering.DetachedSince == "" {
+			tiering.DetachedSince = util.Now()
+		}
+	} else {
+		tiering.DetachedSince = ""
+	}
+
+	if tiering.State == longhorn.VolumeTieringStateMigrating {
+		if !isSameDiskSelector(tiering.DiskSelector, policy.DiskSelector) {
+			log.Infof("Tiering disk selector changed from %v to %v during the migration", tiering.DiskSelector, policy.DiskSelector)
+			tiering.DiskSelector = copyDiskSelector(policy.DiskSelector)
+			tiering.MigratingReplica = ""
+		}
+		// The spec is not updated yet if the update failed after the migration was started
+		if err := vtc.updateVolumeSpec(vol, tiering.DiskSelector, getTieringBandwidthLimit(policy, tiering)); err != nil {
+			return err
+		}
+		return vtc.migrateReplicas(vol, tiering)
+	}
+
+	now := time.Now().UTC()
+	triggered, requeueAfter, err := isTieringTriggered(vol, policy, tiering, now)
+	if err != nil {
+		return err
+	}
+	if requeueAfter > 0 {
+		vtc.enqueueVolumeAfter(vol, requeueAfter)
+	}
+	if !triggered {
+		return nil
+	}
+	if policy.Trigger == longhorn.VolumeTieringTriggerSchedule {
+		tiering.LastTriggeredAt = now.Format(time.RFC3339)
+	}
+
+	inTier, err := vtc.isVolumeInTier(vol, policy.DiskSelector)
+	if err != nil {
+		return err
+	}
+	if inTier {
+		return nil
+	}
+
+	return vtc.startMigration(vol, policy, tiering)
+}
+
+// getTieringPolicy returns the tiering policy of the volume, or the one in the parameters of the StorageClass of the volume.
+func (vtc *VolumeTieringController) getTieringPolicy(vol *longhorn.Volume) (*longhorn.VolumeTieringPolicy, error) {
+	if vol.Spec.TieringPolicy != nil {
+		return vol.Spec.TieringPolicy, nil
+	}
+
+	pvName := vol.Status.KubernetesStatus.PVName
+	if pvName == "" {
+		return nil, nil
+	}
+	pv, err := vtc.ds.GetPersistentVolumeRO(pvName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if pv.Spec.StorageClassName == "" {
+		return nil, nil
+	}
+	sc, err := vtc.ds.GetStorageClassRO(pv.Spec.StorageClassName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	policy, err := types.GetVolumeTieringPolicyFromStorageClassParameters(sc.Parameters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid tiering parameters of StorageClass %v", sc.Name)
+	}
+	if err := types.ValidateVolumeTieringPolicy(vol.Spec.DataEngine, policy); err != nil {
+		return nil, errors.Wrapf(err, "invalid tiering parameters of StorageClass %v", sc.Name)
+	}
+	return policy, nil
+}
+
+// isTieringTriggered checks the trigger of the policy. If the trigger is not due yet, the time until the trigger is returned.
+func isTieringTriggered(vol *longhorn.Volume, policy *longhorn.VolumeTieringPolicy, tiering *longhorn.VolumeTieringStatus, now time.Time) (bool, time.Duration, error) {
+	threshold := time.Duration(policy.ThresholdHours) * time.Hour
+
+	switch policy.Trigger {
+	case longhorn.VolumeTieringTriggerOnDemand:
+		return true, 0, nil
+	case longhorn.VolumeTieringTriggerAge:
+		due := vol.CreationTimestamp.Add(threshold)
+		if now.Before(due) {
+			return false, due.Sub(now), nil
+		}
+		return true, 0, nil
+	case longhorn.VolumeTieringTriggerIdle:
+		if tiering.DetachedSince == "" {
+			return false, 0, nil
+		}
+		detachedSince, err := util.ParseTime(tiering.DetachedSince)
+		if err != nil {
+			return false, 0, errors.Wrapf(err, "failed to parse the detached time %v", tiering.DetachedSince)
+		}
+		due := detachedSince.Add(threshold)
+		if now.Before(due) {
+			return false, due.Sub(now), nil
+		}
+		return true, 0, nil
+	case longhorn.VolumeTieringTriggerSchedule:
+		schedule, err := cron.ParseStandard(policy.Schedule)
+		if err != nil {
+			return false, 0, errors.Wrapf(err, "invalid tiering schedule %v", policy.Schedule)
+		}
+		last := vol.CreationTimestamp.Time
+		if tiering.LastTriggeredAt != "" {
+			if last, err = util.ParseTime(tiering.LastTriggeredAt); err != nil {
+				return false, 0, errors.Wrapf(err, "failed to parse the last triggered time %v", tiering.LastTriggeredAt)
+			}
+		}
+		next := schedule.Next(last)
+		if now.Before(next) {
+			return false, next.Sub(now), nil
+		}
+		return true, schedule.Next(now).Sub(now), nil
+	}
+	return false, 0, fmt.Errorf("invalid tiering trigger %v", policy.Trigger)
+}
+
+func (vtc *VolumeTieringController) startMigration(vol *longhorn.Volume, policy *longhorn.VolumeTieringPolicy, tiering *longhorn.VolumeTieringStatus) error {
+	tiering.State = longhorn.VolumeTieringStateMigrating
+	tiering.DiskSelector = copyDiskSelector(policy.DiskSelector)
+	tiering.OriginalDiskSelector = copyDiskSelector(vol.Spec.DiskSelector)
+	tiering.OriginalReplicaRebuildingBandwidthLimit = vol.Spec.ReplicaRebuildingBandwidthLimit
+	tiering.MigratingReplica = ""
+	tiering.StartedAt = util.Now()
+	tiering.CompletedAt = ""
+	tiering.Message = ""
+
+	// Save the original disk selector before changing the spec, otherwise the changed disk selector would be taken as
+	// the original one by the next sync and the migration could not be canceled.
+	updatedVolume, err := vtc.ds.UpdateVolumeStatus(vol)
+	if err != nil {
+		return err
+	}
+	vol.ResourceVersion = updatedVolume.ResourceVersion
+
+	vtc.eventRecorder.Eventf(vol, corev1.EventTypeNormal, constant.EventReasonTieringStarted,
+		"Started moving the replicas of volume %v to the disks with tags %v", vol.Name, tiering.DiskSelector)
+
+	return vtc.updateVolumeSpec(vol, tiering.DiskSelector, getTieringBandwidthLimit(policy, tiering))
+}
+
+// migrateReplicas requests the eviction of the replicas outside the tier one at a time.
+func (vtc *VolumeTieringController) migrateReplicas(vol *longhorn.Volume, tiering *longhorn.VolumeTieringStatus) error {
+	replicas, err := vtc.ds.ListVolumeReplicasRO(vol.Name)
+	if err != nil {
+		return err
+	}
+
+	if tiering.MigratingReplica != "" {
+		if replica, ok := replicas[tiering.MigratingReplica]; ok && replica.DeletionTimestamp == nil {
+			// The replica is removed by the volume controller once its replacement is rebuilt
+			return vtc.requestReplicaEviction(replica)
+		}
+		tiering.MigratingReplica = ""
+	}
+
+	if vol.Status.Robustness == longhorn.VolumeRobustnessDegraded || vol.Status.Robustness == longhorn.VolumeRobustnessFaulted {
+		tiering.Message = fmt.Sprintf("Waiting for volume %v to be healthy before moving the next replica", vol.Name)
+		return nil
+	}
+
+	replicaNames := []string{}
+	for name := range replicas {
+		replicaNames = append(replicaNames, name)
+	}
+	sort.Strings(replicaNames)
+
+	for _, name := range replicaNames {
+		replica := replicas[name]
+		if replica.DeletionTimestamp != nil || replica.Spec.FailedAt != "" {
+			continue
+		}
+		inTier, err := vtc.isReplicaInTier(replica, tiering.DiskSelector)
+		if err != nil {
+			return err
+		}
+		if inTier {
+			continue
+		}
+
+		if err := vtc.requestReplicaEviction(replica); err != nil {
+			return err
+		}
+		tiering.MigratingReplica = replica.Name
+		tiering.Message = fmt.Sprintf("Moving replica %v to the disks with tags %v", replica.Name, tiering.DiskSelector)
+		return nil
+	}
+
+	if err := vtc.updateVolumeSpec(vol, tiering.DiskSelector, tiering.OriginalReplicaRebuildingBandwidthLimit); err != nil {
+		return err
+	}
+	tiering.State = longhorn.VolumeTieringStateCompleted
+	tiering.CompletedAt = util.Now()
+	tiering.Message = ""
+	vtc.eventRecorder.Eventf(vol, corev1.EventTypeNormal, constant.EventReasonTieringCompleted,
+		"Moved the replicas of volume %v to the disks with tags %v", vol.Name, tiering.DiskSelector)
+	return nil
+}
+
+func (vtc *VolumeTieringController) cancelMigration(vol *longhorn.Volume, tiering *longhorn.VolumeTieringStatus) error {
+	if err := vtc.updateVolumeSpec(vol, tiering.OriginalDiskSelector, tiering.OriginalReplicaRebuildingBandwidthLimit); err != nil {
+		return err
+	}
+	// The node controller cancels the eviction of the replica since it is no longer being migrated
+	tiering.State = longhorn.VolumeTieringStateCanceled
+	tiering.MigratingReplica = ""
+	tiering.Message = "The tiering policy was removed during the migration"
+	vtc.eventRecorder.Eventf(vol, corev1.EventTypeNormal, constant.EventReasonTieringCanceled,
+		"Canceled moving the replicas of volume %v to the disks with tags %v", vol.Name, tiering.DiskSelector)
+	return nil
+}
+
+func (vtc *VolumeTieringController) requestReplicaEviction(replica *longhorn.Replica) error {
+	if replica.Spec.EvictionRequested {
+		return nil
+	}
+	replica = replica.DeepCopy()
+	replica.Spec.EvictionRequested = true
+	if _, err := vtc.ds.UpdateReplica(replica); err != nil {
+		return errors.Wrapf(err, "failed to request the eviction of replica %v", replica.Name)
+	}
+	vtc.eventRecorder.Eventf(replica, corev1.EventTypeNormal, constant.EventReasonEvictionTiering,
+		"Requesting replica %v eviction from node %v and disk %v for tiering", replica.Name, replica.Spec.NodeID, replica.Spec.DiskID)
+	return nil
+}
+
+// updateVolumeSpec updates the disk selector and the replica rebuilding bandwidth limit of the volume while keeping the
+// status in memory, which is updated later. The status is not copied, so the callers can keep modifying the tiering
+// status they hold.
+func (vtc *VolumeTieringController) updateVolumeSpec(vol *longhorn.Volume, diskSelector []string, bandwidthLimit int64) error {
+	if isSameDiskSelector(vol.Spec.DiskSelector, diskSelector) && vol.Spec.ReplicaRebuildingBandwidthLimit == bandwidthLimit {
+		return nil
+	}
+
+	status := vol.Status
+	vol.Spec.DiskSelector = copyDiskSelector(diskSelector)
+	vol.Spec.ReplicaRebuildingBandwidthLimit = bandwidthLimit
+	updatedVolume, err := vtc.ds.UpdateVolume(vol)
+	if err != nil {
+		return err
+	}
+	updatedVolume.Status = status
+	*vol = *updatedVolume
+	return nil
+}
+
+func (vtc *VolumeTieringController) isVolumeInTier(vol *longhorn.Volume, diskSelector []string) (bool, error) {
+	if !isSameDiskSelector(vol.Spec.DiskSelector, diskSelector) {
+		return false, nil
+	}
+
+	replicas, err := vtc.ds.ListVolumeReplicasRO(vol.Name)
+	if err != nil {
+		return false, err
+	}
+	for _, replica := range replicas {
+		if replica.DeletionTimestamp != nil || replica.Spec.FailedAt != "" {
+			continue
+		}
+		inTier, err := vtc.isReplicaInTier(replica, diskSelector)
+		if err != nil || !inTier {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// isReplicaInTier checks if the disk of the replica has the tags of the disk selector.
+// The replicas not scheduled yet or on the missing disks are considered in the tier since they cannot be moved.
+func (vtc *VolumeTieringController) isReplicaInTier(replica *longhorn.Replica, diskSelector []string) (bool, error) {
+	if replica.Spec.NodeID == "" || replica.Spec.DiskID == "" {
+		return true, nil
+	}
+
+	node, err := vtc.ds.GetNodeRO(replica.Spec.NodeID)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	for diskName, diskStatus := range node.Status.DiskStatus {
+		if diskStatus.DiskUUID != replica.Spec.DiskID {
+			continue
+		}
+		diskSpec, ok := node.Spec.Disks[diskName]
+		if !ok {
+			return true, nil
+		}
+		tags := copyDiskSelector(diskSpec.Tags)
+		sort.Strings(tags)
+		return types.IsSelectorsInTags(tags, diskSelector, true), nil
+	}
+	return true, nil
+}
+
+func (vtc *VolumeTieringController) isResponsibleFor(vol *longhorn.Volume) bool {
+	return vtc.controllerID == vol.Status.OwnerID
+}
+
+func getTieringBandwidthLimit(policy *longhorn.VolumeTieringPolicy, tiering *longhorn.VolumeTieringStatus) int64 {
+	if policy.BandwidthLimit > 0 {
+		return policy.BandwidthLimit
+	}
+	return tiering.OriginalReplicaRebuildingBandwidthLimit
+}
+
+// isReplicaTieringMigrating checks if the replica is being moved to another tier by the tiering policy of the volume.
+func isReplicaTieringMigrating(vol *longhorn.Volume, replica *longhorn.Replica) bool {
+	tiering := vol.Status.Tiering
+	return tiering != nil && tiering.State == longhorn.VolumeTieringStateMigrating && tiering.MigratingReplica == replica.Name
+}
+
+func isSameDiskSelector(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sortedA := copyDiskSelector(a)
+	sortedB := copyDiskSelector(b)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+	return reflect.DeepEqual(sortedA, sortedB)
+}
+
+func copyDiskSelector(diskSelector []string) []string {
+	return append([]string{}, diskSelector...)
+}
@@ -0,0 +1,176 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+)
+
+func TestIsTieringTriggered(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	vol := &longhorn.Volume{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+	}
+
+	testCases := map[string]struct {
+		policy       longhorn.VolumeTieringPolicy
+		tiering      longhorn.VolumeTieringStatus
+		now          time.Time
+		triggered    bool
+		requeueAfter time.Duration
+	}{
+		"on demand": {
+			policy:    longhorn.VolumeTieringPolicy{Trigger: longhorn.VolumeTieringTriggerOnDemand},
+			now:       created,
+			triggered: true,
+		},
+		"age not reached": {
+			policy:       longhorn.VolumeTieringPolicy{Trigger: longhorn.VolumeTieringTriggerAge, ThresholdHours: 24},
+			now:          created.Add(20 * time.Hour),
+			requeueAfter: 4 * time.Hour,
+		},
+		"age reached": {
+			policy:    longhorn.VolumeTieringPolicy{Trigger: longhorn.VolumeTieringTriggerAge, ThresholdHours: 24},
+			now:       created.Add(25 * time.Hour),
+			triggered: true,
+		},
+		"idle while attached": {
+			policy: longhorn.VolumeTieringPolicy{Trigger: longhorn.VolumeTieringTriggerIdle, ThresholdHours: 1},
+			now:    created.Add(25 * time.Hour),
+		},
+		"idle not reached": {
+			policy:       longhorn.VolumeTieringPolicy{Trigger: longhorn.VolumeTieringTriggerIdle, ThresholdHours: 2},
+			tiering:      longhorn.VolumeTieringStatus{DetachedSince: "2024-01-02T00:00:00Z"},
+			now:          created.Add(25 * time.Hour),
+			requeueAfter: time.Hour,
+		},
+		"idle reached": {
+			policy:    longhorn.VolumeTieringPolicy{Trigger: longhorn.VolumeTieringTriggerIdle, ThresholdHours: 2},
+			tiering:   longhorn.VolumeTieringStatus{DetachedSince: "2024-01-02T00:00:00Z"},
+			now:       created.Add(27 * time.Hour),
+			triggered: true,
+		},
+		"schedule not reached": {
+			policy:       longhorn.VolumeTieringPolicy{Trigger: longhorn.VolumeTieringTriggerSchedule, Schedule: "0 2 * * *"},
+			tiering:      longhorn.VolumeTieringStatus{LastTriggeredAt: "2024-01-01T02:00:00Z"},
+			now:          created.Add(12 * time.Hour),
+			requeueAfter: 14 * time.Hour,
+		},
+		"schedule reached": {
+			policy:       longhorn.VolumeTieringPolicy{Trigger: longhorn.VolumeTieringTriggerSchedule, Schedule: "0 2 * * *"},
+			now:          created.Add(3 * time.Hour),
+			triggered:    true,
+			requeueAfter: 23 * time.Hour,
+		},
+	}
+
+	for name, tc := range testCases {
+		triggered, requeueAfter, err := isTieringTriggered(vol, &tc.policy, &tc.tiering, tc.now)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", name, err)
+		}
+		if triggered != tc.triggered {
+			t.Errorf("%v: expected triggered %v, got %v", name, tc.triggered, triggered)
+		}
+		if requeueAfter != tc.requeueAfter {
+			t.Errorf("%v: expected requeue after %v, got %v", name, tc.requeueAfter, requeueAfter)
+		}
+	}
+}
+
+func TestStartTieringMigrationSavesOriginalDiskSelector(t *testing.T) {
+	assert := require.New(t)
+	datastore.SkipListerCheck = true
+
+	kubeClient := fake.NewSimpleClientset()
+	lhClient := lhfake.NewSimpleClientset()
+	informerFactories := util.NewInformerFactories(TestNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+	volumeIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().Volumes().Informer().GetIndexer()
+	ds := datastore.NewDataStore(TestNamespace, lhClient, kubeClient, apiextensionsfake.NewSimpleClientset(), informerFactories)
+
+	vtc, err := NewVolumeTieringController(logrus.StandardLogger(), ds, scheme.Scheme, kubeClient, TestNode1, TestNamespace)
+	assert.NoError(err)
+	vtc.eventRecorder = record.NewFakeRecorder(eventRecorderBufferSize)
+
+	vol := &longhorn.Volume{
+		ObjectMeta: metav1.ObjectMeta{Name: TestVolumeName, Namespace: TestNamespace},
+		Spec: longhorn.VolumeSpec{
+			DiskSelector:  []string{"hdd"},
+			TieringPolicy: &longhorn.VolumeTieringPolicy{DiskSelector: []string{"ssd"}, Trigger: longhorn.VolumeTieringTriggerOnDemand},
+		},
+		Status: longhorn.VolumeStatus{OwnerID: TestNode1},
+	}
+	vol, err = lhClient.LonghornV1beta2().Volumes(TestNamespace).Create(context.TODO(), vol, metav1.CreateOptions{})
+	assert.NoError(err)
+	assert.NoError(volumeIndexer.Add(vol))
+
+	failedSubresources := map[string]bool{}
+	lhClient.PrependReactor("update", "volumes", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if failedSubresources[action.GetSubresource()] {
+			return true, nil, fmt.Errorf("failed to update volume")
+		}
+		return false, nil, nil
+	})
+	reconcile := func() (*longhorn.Volume, error) {
+		err := vtc.reconcile(TestVolumeName)
+		vol, getErr := lhClient.LonghornV1beta2().Volumes(TestNamespace).Get(context.TODO(), TestVolumeName, metav1.GetOptions{})
+		assert.NoError(getErr)
+		assert.NoError(volumeIndexer.Update(vol))
+		return vol, err
+	}
+
+	// The spec is not changed if the status cannot be saved
+	failedSubresources["status"] = true
+	vol, err = reconcile()
+	assert.Error(err)
+	assert.Equal([]string{"hdd"}, vol.Spec.DiskSelector)
+	assert.Nil(vol.Status.Tiering)
+
+	// The original disk selector is saved before the spec is changed
+	failedSubresources["status"] = false
+	failedSubresources[""] = true
+	vol, err = reconcile()
+	assert.Error(err)
+	assert.Equal([]string{"hdd"}, vol.Spec.DiskSelector)
+	assert.Equal(longhorn.VolumeTieringStateMigrating, vol.Status.Tiering.State)
+	assert.Equal([]string{"hdd"}, vol.Status.Tiering.OriginalDiskSelector)
+
+	// The next sync changes the spec and keeps the original disk selector
+	failedSubresources[""] = false
+	vol, err = reconcile()
+	assert.NoError(err)
+	assert.Equal([]string{"ssd"}, vol.Spec.DiskSelector)
+	assert.Equal([]string{"hdd"}, vol.Status.Tiering.OriginalDiskSelector)
+	assert.Equal(longhorn.VolumeTieringStateCompleted, vol.Status.Tiering.State)
+
+	// The original disk selector is restored once the migration is canceled
+	vol.Spec.TieringPolicy = nil
+	vol.Status.Tiering.State = longhorn.VolumeTieringStateMigrating
+	vol, err = lhClient.LonghornV1beta2().Volumes(TestNamespace).Update(context.TODO(), vol, metav1.UpdateOptions{})
+	assert.NoError(err)
+	assert.NoError(volumeIndexer.Update(vol))
+	vol, err = reconcile()
+	assert.NoError(err)
+	assert.Equal([]string{"hdd"}, vol.Spec.DiskSelector)
+	assert.Equal(longhorn.VolumeTieringStateCanceled, vol.Status.Tiering.State)
+}
@@ -0,0 +1,857 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientset "k8s.io/client-go/kubernetes"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	lhns "github.com/longhorn/go-common-libs/ns"
+
+	"github.com/longhorn/longhorn-manager/constant"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/util/volumetransfer"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	volumeTransferProgressCheckInterval = 5 * time.Second
+	volumeTransferSyncRetryInterval     = 30 * time.Second
+)
+
+// VolumeTransferController streams a volume to another Longhorn cluster. On the source cluster it repeatedly takes a
+// snapshot of the volume and sends the chunks written since the snapshot of the last sync to the destination cluster,
+// reading them from a replica on this node. A final sync follows once the cutover is requested and the workload
+// detaches the volume. On the destination cluster it creates the volume and attaches it to the owner node, where the
+// API receives the chunks.
+type VolumeTransferController struct {
+	*baseController
+
+	// which namespace controller is running with
+	namespace string
+	// use as the OwnerID of the controller
+	controllerID string
+
+	kubeClient    clientset.Interface
+	eventRecorder record.EventRecorder
+
+	ds *datastore.DataStore
+
+	cacheSyncs []cache.InformerSynced
+
+	syncersLock sync.Mutex
+	syncers     map[string]*volumeTransferSyncer
+}
+
+// volumeTransferSyncer runs a sync of a source transfer in the background, since a sync takes much longer than a
+// reconcile.
+type volumeTransferSyncer struct {
+	lock sync.RWMutex
+
+	cancel           context.CancelFunc
+	snapshotName     string
+	startedAt        time.Time
+	progress         int
+	transferredBytes int64
+
+	done       bool
+	finishedAt time.Time
+	result     *volumetransfer.SyncResult
+	err        error
+}
+
+func (s *volumeTransferSyncer) update(progress int, transferredBytes int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.progress = progress
+	s.transferredBytes = transferredBytes
+}
+
+func (s *volumeTransferSyncer) finish(result *volumetransfer.SyncResult, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.done = true
+	s.finishedAt = time.Now()
+	s.result = result
+	s.err = err
+}
+
+func NewVolumeTransferController(
+	logger logrus.FieldLogger,
+	ds *datastore.DataStore,
+	scheme *runtime.Scheme,
+	kubeClient clientset.Interface,
+	controllerID string,
+	namespace string) (*VolumeTransferController, error) {
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(logrus.Infof)
+	// TODO: remove the wrapper when every clients have moved to use the clientset.
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{
+		Interface: v1core.New(kubeClient.CoreV1().RESTClient()).Events(""),
+	})
+
+	vtc := &VolumeTransferController{
+		baseController: newBaseController("longhorn-volume-transfer", logger),
+
+		namespace:    namespace,
+		controllerID: controllerID,
+
+		ds: ds,
+
+		kubeClient:    kubeClient,
+		eventRecorder: eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "longhorn-volume-transfer-controller"}),
+
+		syncers: map[string]*volumeTransferSyncer{},
+	}
+
+	var err error
+	if _, err = ds.VolumeTransferInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    vtc.enqueueVolumeTransfer,
+		UpdateFunc: func(old, cur interface{}) { vtc.enqueueVolumeTransfer(cur) },
+		DeleteFunc: vtc.enqueueVolumeTransfer,
+	}); err != nil {
+		return nil, err
+	}
+	vtc.cacheSyncs = append(vtc.cacheSyncs, ds.VolumeTransferInformer.HasSynced)
+
+	if _, err = ds.VolumeInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		AddFunc:    vtc.enqueueVolumeTransferForVolume,
+		UpdateFunc: func(old, cur interface{}) { vtc.enqueueVolumeTransferForVolume(cur) },
+		DeleteFunc: vtc.enqueueVolumeTransferForVolume,
+	}, 0); err != nil {
+		return nil, err
+	}
+	vtc.cacheSyncs = append(vtc.cacheSyncs, ds.VolumeInformer.HasSynced)
+
+	if _, err = ds.SnapshotInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		AddFunc:    vtc.enqueueVolumeTransferForSnapshot,
+		UpdateFunc: func(old, cur interface{}) { vtc.enqueueVolumeTransferForSnapshot(cur) },
+		DeleteFunc: vtc.enqueueVolumeTransferForSnapshot,
+	}, 0); err != nil {
+		return nil, err
+	}
+	vtc.cacheSyncs = append(vtc.cacheSyncs, ds.SnapshotInformer.HasSynced)
+
+	return vtc, nil
+}
+
+func (vtc *VolumeTransferController) enqueueVolumeTransfer(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to get key for object %#v: %v", obj, err))
+		return
+	}
+
+	vtc.queue.Add(key)
+}
+
+func (vtc *VolumeTransferController) enqueueVolumeTransferForVolume(obj interface{}) {
+	v, isVolume := obj.(*longhorn.Volume)
+	if !isVolume {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("received unexpected obj: %#v", obj))
+			return
+		}
+
+		// use the last known state, to enqueue, dependent objects
+		v, ok = deletedState.Obj.(*longhorn.Volume)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("DeletedFinalStateUnknown contained invalid object: %#v", deletedState.Obj))
+			return
+		}
+	}
+
+	transfers, err := vtc.ds.ListVolumeTransfersRO()
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to list volume transfers since %v", err))
+		return
+	}
+	for _, transfer := range transfers {
+		if transfer.Spec.VolumeName == v.Name {
+			vtc.enqueueVolumeTransfer(transfer)
+		}
+	}
+}
+
+func (vtc *VolumeTransferController) enqueueVolumeTransferForSnapshot(obj interface{}) {
+	snapshot, isSnapshot := obj.(*longhorn.Snapshot)
+	if !isSnapshot {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("received unexpected obj: %#v", obj))
+			return
+		}
+
+		// use the last known state, to enqueue, dependent objects
+		snapshot, ok = deletedState.Obj.(*longhorn.Snapshot)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("DeletedFinalStateUnknown contained invalid object: %#v", deletedState.Obj))
+			return
+		}
+	}
+
+	transferName, ok := snapshot.Labels[types.GetLonghornLabelKey(types.LonghornLabelVolumeTransfer)]
+	if !ok {
+		return
+	}
+	vtc.queue.Add(vtc.namespace + "/" + transferName)
+}
+
+func (vtc *VolumeTransferController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer vtc.queue.ShutDown()
+
+	vtc.logger.Info("Starting Longhorn VolumeTransfer controller")
+	defer vtc.logger.Info("Shut down Longhorn VolumeTransfer controller")
+
+	if !cache.WaitForNamedCacheSync(vtc.name, stopCh, vtc.cacheSyncs...) {
+		return
+	}
+	for i := 0; i < workers; i++ {
+		go wait.Until(vtc.worker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+func (vtc *VolumeTransferController) worker() {
+	for vtc.processNextWorkItem() {
+	}
+}
+
+func (vtc *VolumeTransferController) processNextWorkItem() bool {
+	key, quit := vtc.queue.Get()
+	if quit {
+		return false
+	}
+	defer vtc.queue.Done(key)
+	err := vtc.syncVolumeTransfer(key.(string))
+	vtc.handleErr(err, key)
+	return true
+}
+
+func (vtc *VolumeTransferController) handleErr(err error, key interface{}) {
+	if err == nil {
+		vtc.queue.Forget(key)
+		return
+	}
+
+	log := vtc.logger.WithField("volumeTransfer", key)
+	if vtc.queue.NumRequeues(key) < maxRetries {
+		handleReconcileErrorLogging(log, err, "Failed to sync Longhorn volume transfer")
+		vtc.queue.AddRateLimited(key)
+		return
+	}
+
+	utilruntime.HandleError(err)
+	handleReconcileErrorLogging(log, err, "Dropping Longhorn volume transfer out of the queue")
+	vtc.queue.Forget(key)
+}
+
+func (vtc *VolumeTransferController) syncVolumeTransfer(key string) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to sync volume transfer %v", key)
+	}()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	if namespace != vtc.namespace {
+		return nil
+	}
+	return vtc.reconcile(name)
+}
+
+func getLoggerForVolumeTransfer(logger logrus.FieldLogger, transfer *longhorn.VolumeTransfer) *logrus.Entry {
+	return logger.WithFields(
+		logrus.Fields{
+			"volumeTransfer": transfer.Name,
+			"role":           transfer.Spec.Role,
+			"volume":         transfer.Spec.VolumeName,
+		},
+	)
+}
+
+// isResponsibleFor prefers a node holding a healthy replica of the source volume, since the source transfer reads the
+// snapshots from the replica files.
+func (vtc *VolumeTransferController) isResponsibleFor(transfer *longhorn.VolumeTransfer) (bool, error) {
+	preferredOwnerID := ""
+	if transfer.Spec.Role == longhorn.VolumeTransferRoleSource {
+		v, err := vtc.ds.GetVolumeRO(transfer.Spec.VolumeName)
+		if err != nil && !datastore.ErrorIsNotFound(err) {
+			return false, err
+		}
+		if v != nil {
+			replicas, err := vtc.ds.ListVolumeReplicasRO(v.Name)
+			if err != nil {
+				return false, err
+			}
+			preferredOwnerID = getVolumeTransferSourceNodeID(v, replicas)
+		}
+	}
+	return isControllerResponsibleFor(vtc.controllerID, vtc.ds, transfer.Name, preferredOwnerID, transfer.Status.OwnerID), nil
+}
+
+// getVolumeTransferSourceNodeID returns the owner node of the volume if it holds a healthy replica, or else any node
+// holding one.
+func getVolumeTransferSourceNodeID(v *longhorn.Volume, replicas map[string]*longhorn.Replica) string {
+	nodeID := ""
+	for _, r := range replicas {
+		if !isVolumeTransferSourceReplica(r) {
+			continue
+		}
+		if r.Spec.NodeID == v.Status.OwnerID {
+			return r.Spec.NodeID
+		}
+		if nodeID == "" || r.Spec.NodeID < nodeID {
+			nodeID = r.Spec.NodeID
+		}
+	}
+	return nodeID
+}
+
+func isVolumeTransferSourceReplica(r *longhorn.Replica) bool {
+	return r.Spec.NodeID != "" && r.Spec.HealthyAt != "" && r.Spec.FailedAt == "" && r.DeletionTimestamp == nil
+}
+
+func (vtc *VolumeTransferController) reconcile(name string) (err error) {
+	transfer, err := vtc.ds.GetVolumeTransfer(name)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		vtc.stopSyncer(name)
+		return nil
+	}
+
+	isResponsible, err := vtc.isResponsibleFor(transfer)
+	if err != nil {
+		return err
+	}
+	if !isResponsible {
+		vtc.stopSyncer(name)
+		return nil
+	}
+
+	log := getLoggerForVolumeTransfer(vtc.logger, transfer)
+
+	if transfer.Status.OwnerID != vtc.controllerID {
+		transfer.Status.OwnerID = vtc.controllerID
+		transfer, err = vtc.ds.UpdateVolumeTransferStatus(transfer)
+		if err != nil {
+			// we don't mind others coming first
+			if datastore.ErrorIsConflict(errors.Cause(err)) {
+				return nil
+			}
+			return err
+		}
+		log.Infof("Volume transfer got new owner %v", vtc.controllerID)
+	}
+
+	if !transfer.DeletionTimestamp.IsZero() {
+		vtc.stopSyncer(name)
+		if err := vtc.deleteAttachmentTicket(transfer); err != nil {
+			return err
+		}
+		if err := vtc.deleteSnapshots(transfer, ""); err != nil {
+			return err
+		}
+		return vtc.ds.RemoveFinalizerForVolumeTransfer(transfer)
+	}
+
+	existingTransfer := transfer.DeepCopy()
+	defer func() {
+		if err != nil {
+			return
+		}
+		if reflect.DeepEqual(existingTransfer.Status, transfer.Status) {
+			return
+		}
+		if _, err = vtc.ds.UpdateVolumeTransferStatus(transfer); err != nil && datastore.ErrorIsConflict(errors.Cause(err)) {
+			log.WithError(err).Debugf("Requeue %v due to conflict", name)
+			vtc.enqueueVolumeTransfer(transfer)
+			err = nil
+		}
+	}()
+
+	switch transfer.Status.State {
+	case longhorn.VolumeTransferStateCompleted,
+		longhorn.VolumeTransferStateError:
+		vtc.stopSyncer(name)
+		if err := vtc.deleteAttachmentTicket(transfer); err != nil {
+			return err
+		}
+		return vtc.deleteSnapshots(transfer, "")
+	case "":
+		transfer.Status.State = longhorn.VolumeTransferStatePending
+	}
+
+	if transfer.Spec.Role == longhorn.VolumeTransferRoleDestination {
+		return vtc.reconcileDestination(transfer)
+	}
+	return vtc.reconcileSource(transfer, log)
+}
+
+func (vtc *VolumeTransferController) failVolumeTransfer(transfer *longhorn.VolumeTransfer, message string) {
+	vtc.stopSyncer(transfer.Name)
+	transfer.Status.State = longhorn.VolumeTransferStateError
+	transfer.Status.Message = message
+	vtc.eventRecorder.Event(transfer, corev1.EventTypeWarning, constant.EventReasonVolumeTransferFailed, message)
+}
+
+// reconcileDestination creates the volume receiving the data and attaches it to this node with the frontend enabled,
+// so the API can write the chunks to the block device. The API completes the transfer once the source finalizes it.
+func (vtc *VolumeTransferController) reconcileDestination(transfer *longhorn.VolumeTransfer) error {
+	v, err := vtc.ds.GetVolumeRO(transfer.Spec.VolumeName)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		if transfer.Status.State != longhorn.VolumeTransferStatePending {
+			vtc.failVolumeTransfer(transfer, fmt.Sprintf("volume %v is not found", transfer.Spec.VolumeName))
+			return nil
+		}
+		if _, err := vtc.ds.CreateVolume(newVolumeTransferDestinationVolume(transfer)); err != nil {
+			return errors.Wrapf(err, "failed to create volume %v", transfer.Spec.VolumeName)
+		}
+		transfer.Status.State = longhorn.VolumeTransferStateAttaching
+		vtc.eventRecorder.Eventf(transfer, corev1.EventTypeNormal, constant.EventReasonVolumeTransferCreatedVolume,
+			"Created volume %v to receive the transfer", transfer.Spec.VolumeName)
+		return nil
+	}
+	if v.Labels[types.GetLonghornLabelKey(types.LonghornLabelVolumeTransfer)] != transfer.Name {
+		vtc.failVolumeTransfer(transfer, fmt.Sprintf("volume %v already exists and is not created by the transfer", v.Name))
+		return nil
+	}
+
+	attached, err := vtc.syncVolumeAttachment(transfer, v, true)
+	if err != nil || !attached {
+		return err
+	}
+	if transfer.Status.State != longhorn.VolumeTransferStateSyncing {
+		transfer.Status.State = longhorn.VolumeTransferStateSyncing
+		transfer.Status.Message = ""
+	}
+	return nil
+}
+
+func newVolumeTransferDestinationVolume(transfer *longhorn.VolumeTransfer) *longhorn.Volume {
+	return &longhorn.Volume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   transfer.Spec.VolumeName,
+			Labels: types.GetVolumeTransferLabels(transfer.Name),
+		},
+		Spec: longhorn.VolumeSpec{
+			Size:             transfer.Spec.Size,
+			NumberOfReplicas: transfer.Spec.NumberOfReplicas,
+			Frontend:         longhorn.VolumeFrontendBlockDev,
+		},
+	}
+}
+
+// reconcileSource attaches the source volume and runs the syncs. A sync is started when the sync interval passes
+// after the last sync, or right away once the cutover is requested and the workload detaches the volume. Each sync
+// sends a snapshot, so the destination volume is always crash-consistent.
+func (vtc *VolumeTransferController) reconcileSource(transfer *longhorn.VolumeTransfer, log logrus.FieldLogger) error {
+	v, err := vtc.ds.GetVolumeRO(transfer.Spec.VolumeName)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		vtc.failVolumeTransfer(transfer, fmt.Sprintf("volume %v is not found", transfer.Spec.VolumeName))
+		return nil
+	}
+	if v.Spec.DataEngine != longhorn.DataEngineTypeV1 {
+		vtc.failVolumeTransfer(transfer, fmt.Sprintf("volume %v does not use the v1 data engine", v.Name))
+		return nil
+	}
+	if v.Spec.BackingImage != "" {
+		vtc.failVolumeTransfer(transfer, fmt.Sprintf("volume %v uses backing image %v", v.Name, v.Spec.BackingImage))
+		return nil
+	}
+
+	attached, err := vtc.syncVolumeAttachment(transfer, v, false)
+	if err != nil || !attached {
+		return err
+	}
+
+	if syncer := vtc.getSyncer(transfer.Name); syncer != nil {
+		return vtc.syncSyncResult(transfer, syncer, log)
+	}
+
+	switch transfer.Status.State {
+	case longhorn.VolumeTransferStatePending, longhorn.VolumeTransferStateAttaching, longhorn.VolumeTransferStateSyncing:
+		// A sync in the Syncing state is waiting for its snapshot, or is resumed after a failure or a restart
+		if transfer.Spec.Cutover {
+			transfer.Status.State = longhorn.VolumeTransferStateCuttingOver
+			return vtc.cutOver(transfer, v, log)
+		}
+		return vtc.startSync(transfer, v, longhorn.VolumeTransferStateSyncing, log)
+	case longhorn.VolumeTransferStateSynced:
+		if transfer.Spec.Cutover {
+			transfer.Status.State = longhorn.VolumeTransferStateCuttingOver
+			return vtc.cutOver(transfer, v, log)
+		}
+		interval := time.Duration(transfer.Spec.SyncIntervalSeconds) * time.Second
+		if interval <= 0 {
+			interval = types.VolumeTransferDefaultSyncIntervalSeconds * time.Second
+		}
+		lastSyncedAt, err := util.ParseTime(transfer.Status.LastSyncedAt)
+		if err == nil && time.Since(lastSyncedAt) < interval {
+			vtc.queue.AddAfter(vtc.namespace+"/"+transfer.Name, interval-time.Since(lastSyncedAt))
+			return nil
+		}
+		return vtc.startSync(transfer, v, longhorn.VolumeTransferStateSyncing, log)
+	case longhorn.VolumeTransferStateCuttingOver:
+		return vtc.cutOver(transfer, v, log)
+	}
+	return nil
+}
+
+// cutOver starts the final sync once no workload uses the source volume, so the data cannot change anymore.
+func (vtc *VolumeTransferController) cutOver(transfer *longhorn.VolumeTransfer, v *longhorn.Volume, log logrus.FieldLogger) error {
+	va, err := vtc.ds.GetLHVolumeAttachmentByVolumeName(v.Name)
+	if err != nil {
+		return err
+	}
+	if isVolumeTransferSourceInUse(va) {
+		transfer.Status.Message = fmt.Sprintf("waiting for the workload to detach volume %v", v.Name)
+		return nil
+	}
+	return vtc.startSync(transfer, v, longhorn.VolumeTransferStateCuttingOver, log)
+}
+
+// startSync takes the snapshot the sync sends, and starts the syncer once the snapshot is ready.
+func (vtc *VolumeTransferController) startSync(transfer *longhorn.VolumeTransfer, v *longhorn.Volume, state longhorn.VolumeTransferState, log logrus.FieldLogger) error {
+	transfer.Status.State = state
+
+	snapshotName := types.GetVolumeTransferSnapshotName(transfer.Name, transfer.Status.SyncCount+1,
+		state == longhorn.VolumeTransferStateCuttingOver)
+	snapshot, err := vtc.ds.GetSnapshotRO(snapshotName)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		if _, err := vtc.ds.CreateSnapshot(&longhorn.Snapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   snapshotName,
+				Labels: types.GetVolumeTransferLabels(transfer.Name),
+			},
+			Spec: longhorn.SnapshotSpec{
+				Volume:         v.Name,
+				CreateSnapshot: true,
+			},
+		}); err != nil {
+			return errors.Wrapf(err, "failed to create snapshot %v", snapshotName)
+		}
+		transfer.Status.Message = fmt.Sprintf("taking snapshot %v of volume %v", snapshotName, v.Name)
+		return nil
+	}
+	if snapshot.Status.Error != "" {
+		transfer.Status.Message = fmt.Sprintf("failed to take snapshot %v of volume %v: %v", snapshotName, v.Name, snapshot.Status.Error)
+		// The snapshot is taken again after the deletion
+		return vtc.ds.DeleteSnapshot(snapshotName)
+	}
+	if !snapshot.Status.ReadyToUse {
+		return nil
+	}
+
+	return vtc.startSyncer(transfer, v, snapshotName, log)
+}
+
+// isVolumeTransferSourceInUse checks if a workload or a user still attaches the volume.
+func isVolumeTransferSourceInUse(va *longhorn.VolumeAttachment) bool {
+	for _, ticket := range va.Spec.AttachmentTickets {
+		switch ticket.Type {
+		case longhorn.AttacherTypeCSIAttacher, longhorn.AttacherTypeLonghornAPI, longhorn.AttacherTypeShareManagerController:
+			return true
+		}
+	}
+	return false
+}
+
+// syncVolumeAttachment attaches the volume to this node. The destination requires the frontend on this node, so the
+// API can write the received data to the block device. The source only requires the volume to be attached anywhere,
+// so its snapshots can be taken while the workload still uses it. It returns whether the volume is attached.
+func (vtc *VolumeTransferController) syncVolumeAttachment(transfer *longhorn.VolumeTransfer, v *longhorn.Volume, requireFrontend bool) (bool, error) {
+	va, err := vtc.ds.GetLHVolumeAttachmentByVolumeName(v.Name)
+	if err != nil {
+		return false, err
+	}
+	existingVA := va.DeepCopy()
+
+	disableFrontend := longhorn.AnyValue
+	if requireFrontend {
+		disableFrontend = longhorn.FalseValue
+	}
+	ticketID := longhorn.GetAttachmentTicketID(longhorn.AttacherTypeVolumeTransferController, transfer.Name)
+	createOrUpdateAttachmentTicket(va, ticketID, vtc.controllerID, disableFrontend, longhorn.AttacherTypeVolumeTransferController)
+	if !reflect.DeepEqual(existingVA.Spec, va.Spec) {
+		if _, err := vtc.ds.UpdateLHVolumeAttachment(va); err != nil {
+			return false, err
+		}
+	}
+
+	if v.Status.State != longhorn.VolumeStateAttached || (requireFrontend && v.Status.CurrentNodeID != vtc.controllerID) {
+		if transfer.Status.State == longhorn.VolumeTransferStatePending {
+			transfer.Status.State = longhorn.VolumeTransferStateAttaching
+		}
+		return false, nil
+	}
+	transfer.Status.NodeID = vtc.controllerID
+	return true, nil
+}
+
+func (vtc *VolumeTransferController) deleteAttachmentTicket(transfer *longhorn.VolumeTransfer) error {
+	va, err := vtc.ds.GetLHVolumeAttachmentByVolumeName(transfer.Spec.VolumeName)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	ticketID := longhorn.GetAttachmentTicketID(longhorn.AttacherTypeVolumeTransferController, transfer.Name)
+	if _, ok := va.Spec.AttachmentTickets[ticketID]; !ok {
+		return nil
+	}
+	delete(va.Spec.AttachmentTickets, ticketID)
+	_, err = vtc.ds.UpdateLHVolumeAttachment(va)
+	return err
+}
+
+// deleteSnapshots deletes the snapshots the transfer took, except the kept one.
+func (vtc *VolumeTransferController) deleteSnapshots(transfer *longhorn.VolumeTransfer, keptSnapshotName string) error {
+	snapshots, err := vtc.ds.ListSnapshotsRO(labels.SelectorFromSet(types.GetVolumeTransferLabels(transfer.Name)))
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range snapshots {
+		if snapshot.Name == keptSnapshotName || !snapshot.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := vtc.ds.DeleteSnapshot(snapshot.Name); err != nil && !datastore.ErrorIsNotFound(err) {
+			return errors.Wrapf(err, "failed to delete snapshot %v", snapshot.Name)
+		}
+	}
+	return nil
+}
+
+func (vtc *VolumeTransferController) getVolumeTransferClient(transfer *longhorn.VolumeTransfer) (*volumetransfer.Client, error) {
+	secret, err := vtc.ds.GetSecretRO(vtc.namespace, transfer.Spec.CredentialSecret)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get credential secret %v", transfer.Spec.CredentialSecret)
+	}
+	token := string(secret.Data[volumetransfer.CredentialKeyToken])
+	if token == "" {
+		return nil, fmt.Errorf("credential secret %v has no %v", secret.Name, volumetransfer.CredentialKeyToken)
+	}
+
+	remoteTransferName := transfer.Spec.RemoteTransferName
+	if remoteTransferName == "" {
+		remoteTransferName = transfer.Name
+	}
+	return volumetransfer.NewClient(transfer.Spec.RemoteURL, remoteTransferName, token, secret.Data[volumetransfer.CredentialKeyCACert])
+}
+
+func (vtc *VolumeTransferController) getSyncer(name string) *volumeTransferSyncer {
+	vtc.syncersLock.Lock()
+	defer vtc.syncersLock.Unlock()
+	return vtc.syncers[name]
+}
+
+func (vtc *VolumeTransferController) stopSyncer(name string) {
+	vtc.syncersLock.Lock()
+	defer vtc.syncersLock.Unlock()
+	if syncer, ok := vtc.syncers[name]; ok {
+		syncer.cancel()
+		delete(vtc.syncers, name)
+	}
+}
+
+// startSyncer sends the snapshot in the background, reading it from the files of a replica on this node.
+func (vtc *VolumeTransferController) startSyncer(transfer *longhorn.VolumeTransfer, v *longhorn.Volume, snapshotName string, log logrus.FieldLogger) error {
+	key := vtc.namespace + "/" + transfer.Name
+
+	replicas, err := vtc.ds.ListVolumeReplicasRO(v.Name)
+	if err != nil {
+		return err
+	}
+	replicaDirectory := ""
+	for _, r := range replicas {
+		if r.Spec.NodeID == vtc.controllerID && isVolumeTransferSourceReplica(r) {
+			replicaDirectory = types.GetReplicaDataPath(r.Spec.DiskPath, r.Spec.DataDirectoryName)
+			break
+		}
+	}
+	if replicaDirectory == "" {
+		transfer.Status.Message = fmt.Sprintf("waiting for a healthy replica of volume %v on node %v", v.Name, vtc.controllerID)
+		vtc.queue.AddAfter(key, volumeTransferSyncRetryInterval)
+		return nil
+	}
+
+	client, err := vtc.getVolumeTransferClient(transfer)
+	if err != nil {
+		vtc.failVolumeTransfer(transfer, err.Error())
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	syncer := &volumeTransferSyncer{
+		cancel:       cancel,
+		snapshotName: snapshotName,
+		startedAt:    time.Now(),
+	}
+	vtc.syncersLock.Lock()
+	vtc.syncers[transfer.Name] = syncer
+	vtc.syncersLock.Unlock()
+
+	size := v.Spec.Size
+	baseSnapshotName := transfer.Status.LastSyncedSnapshot
+	syncCount := transfer.Status.SyncCount
+	transferredBytes := transfer.Status.TransferredBytes
+	go func() {
+		result, err := vtc.sync(ctx, client, replicaDirectory, snapshotName, baseSnapshotName, size, syncer, syncCount, transferredBytes, log)
+		syncer.finish(result, err)
+		vtc.queue.Add(key)
+	}()
+
+	transfer.Status.Progress = 0
+	transfer.Status.Message = ""
+	vtc.queue.AddAfter(key, volumeTransferProgressCheckInterval)
+	return nil
+}
+
+// sync sends the chunks of the snapshot written after the base snapshot and reports the progress to the destination.
+func (vtc *VolumeTransferController) sync(ctx context.Context, client *volumetransfer.Client, replicaDirectory, snapshotName, baseSnapshotName string,
+	size int64, syncer *volumeTransferSyncer, syncCount int, transferredBytes int64, log logrus.FieldLogger) (*volumetransfer.SyncResult, error) {
+	// The replica files are only visible in the host mount namespace. The opened files stay readable afterwards.
+	obj, err := lhns.RunFunc(func() (interface{}, error) {
+		return volumetransfer.OpenSnapshotChain(replicaDirectory, snapshotName, size)
+	}, 0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open snapshot %v in %v", snapshotName, replicaDirectory)
+	}
+	chain, ok := obj.(*volumetransfer.SnapshotChain)
+	if !ok {
+		return nil, fmt.Errorf("BUG: invalid snapshot chain %#v", obj)
+	}
+	defer chain.Close()
+
+	reporter := volumetransfer.NewProgressReporter(client)
+	result, err := volumetransfer.Sync(ctx, client, chain, baseSnapshotName, func(progress int, bytes int64) {
+		syncer.update(progress, bytes)
+		if err := reporter.Report(ctx, &volumetransfer.Progress{
+			Progress:         progress,
+			TransferredBytes: transferredBytes + bytes,
+			Throughput:       volumetransfer.FormatThroughput(bytes, time.Since(syncer.startedAt)),
+			SyncCount:        syncCount,
+		}, false); err != nil {
+			log.WithError(err).Warn("Failed to report the volume transfer progress")
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := reporter.Report(ctx, &volumetransfer.Progress{
+		Progress:         100,
+		TransferredBytes: transferredBytes + result.TransferredBytes,
+		Throughput:       volumetransfer.FormatThroughput(result.TransferredBytes, result.Duration),
+		SyncCount:        syncCount + 1,
+	}, true); err != nil {
+		log.WithError(err).Warn("Failed to report the volume transfer progress")
+	}
+	return result, nil
+}
+
+// syncSyncResult updates the progress of the running sync, or records the result of the finished sync. A failed sync
+// is retried after an interval.
+func (vtc *VolumeTransferController) syncSyncResult(transfer *longhorn.VolumeTransfer, syncer *volumeTransferSyncer, log logrus.FieldLogger) error {
+	key := vtc.namespace + "/" + transfer.Name
+
+	syncer.lock.RLock()
+	defer syncer.lock.RUnlock()
+
+	if !syncer.done {
+		transfer.Status.Progress = syncer.progress
+		transfer.Status.Throughput = volumetransfer.FormatThroughput(syncer.transferredBytes, time.Since(syncer.startedAt))
+		vtc.queue.AddAfter(key, volumeTransferProgressCheckInterval)
+		return nil
+	}
+
+	if syncer.err != nil {
+		if transfer.Status.Message != syncer.err.Error() {
+			transfer.Status.Message = syncer.err.Error()
+			vtc.eventRecorder.Eventf(transfer, corev1.EventTypeWarning, constant.EventReasonVolumeTransferSyncFailed,
+				"Failed to sync volume %v: %v", transfer.Spec.VolumeName, syncer.err)
+		}
+		if retryAfter := volumeTransferSyncRetryInterval - time.Since(syncer.finishedAt); retryAfter > 0 {
+			vtc.queue.AddAfter(key, retryAfter)
+			return nil
+		}
+		vtc.stopSyncer(transfer.Name)
+		vtc.queue.Add(key)
+		return nil
+	}
+
+	vtc.stopSyncer(transfer.Name)
+	transfer.Status.Progress = 100
+	transfer.Status.SyncCount++
+	transfer.Status.TransferredBytes += syncer.result.TransferredBytes
+	transfer.Status.Throughput = volumetransfer.FormatThroughput(syncer.result.TransferredBytes, syncer.result.Duration)
+	transfer.Status.LastSyncedAt = util.Now()
+	transfer.Status.LastSyncedSnapshot = syncer.snapshotName
+	transfer.Status.Message = ""
+	log.Infof("Synced %v changed chunks of snapshot %v of volume %v in %v", syncer.result.ChangedChunks, syncer.snapshotName,
+		transfer.Spec.VolumeName, syncer.result.Duration)
+
+	// The destination holds the synced snapshot now, so the older snapshots are not needed for the next sync
+	if err := vtc.deleteSnapshots(transfer, syncer.snapshotName); err != nil {
+		log.WithError(err).Warn("Failed to delete the snapshots of the previous syncs")
+	}
+
+	if transfer.Status.State != longhorn.VolumeTransferStateCuttingOver {
+		transfer.Status.State = longhorn.VolumeTransferStateSynced
+		vtc.queue.Add(key)
+		return nil
+	}
+
+	client, err := vtc.getVolumeTransferClient(transfer)
+	if err != nil {
+		vtc.failVolumeTransfer(transfer, err.Error())
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if err := client.Finalize(ctx); err != nil {
+		return err
+	}
+	transfer.Status.State = longhorn.VolumeTransferStateCompleted
+	transfer.Status.CompletedAt = util.Now()
+	vtc.eventRecorder.Eventf(transfer, corev1.EventTypeNormal, constant.EventReasonVolumeTransferCompleted,
+		"Transferred volume %v to %v", transfer.Spec.VolumeName, transfer.Spec.RemoteURL)
+	vtc.queue.Add(key)
+	return nil
+}
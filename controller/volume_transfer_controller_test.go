@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func TestIsVolumeTransferSourceInUse(t *testing.T) {
+	va := &longhorn.VolumeAttachment{
+		Spec: longhorn.VolumeAttachmentSpec{
+			AttachmentTickets: map[string]*longhorn.AttachmentTicket{},
+		},
+	}
+	addTicket := func(attacherType longhorn.AttacherType) {
+		ticketID := longhorn.GetAttachmentTicketID(attacherType, TestVolumeName)
+		createOrUpdateAttachmentTicket(va, ticketID, TestNode1, longhorn.AnyValue, attacherType)
+	}
+
+	addTicket(longhorn.AttacherTypeVolumeTransferController)
+	addTicket(longhorn.AttacherTypeSnapshotController)
+	if isVolumeTransferSourceInUse(va) {
+		t.Fatal("volume attached by the system only should not be in use")
+	}
+
+	addTicket(longhorn.AttacherTypeCSIAttacher)
+	if !isVolumeTransferSourceInUse(va) {
+		t.Fatal("volume attached by the CSI attacher should be in use")
+	}
+}
+
+func TestNewVolumeTransferDestinationVolume(t *testing.T) {
+	transfer := &longhorn.VolumeTransfer{
+		Spec: longhorn.VolumeTransferSpec{
+			Role:             longhorn.VolumeTransferRoleDestination,
+			VolumeName:       TestVolumeName,
+			Size:             TestVolumeSize,
+			NumberOfReplicas: 2,
+		},
+	}
+	transfer.Name = "transfer"
+
+	v := newVolumeTransferDestinationVolume(transfer)
+	if v.Name != TestVolumeName || v.Spec.Size != TestVolumeSize || v.Spec.NumberOfReplicas != 2 {
+		t.Fatalf("unexpected destination volume %+v", v)
+	}
+	if v.Spec.Frontend != longhorn.VolumeFrontendBlockDev {
+		t.Fatalf("destination volume should expose a block device, got %v", v.Spec.Frontend)
+	}
+	if v.Labels[types.GetLonghornLabelKey(types.LonghornLabelVolumeTransfer)] != transfer.Name {
+		t.Fatalf("destination volume should be labeled with the transfer, got %v", v.Labels)
+	}
+}
+
+func TestGetVolumeTransferSourceNodeID(t *testing.T) {
+	v := &longhorn.Volume{
+		Status: longhorn.VolumeStatus{OwnerID: TestNode2},
+	}
+	newReplica := func(name, nodeID string, healthy bool) *longhorn.Replica {
+		r := &longhorn.Replica{Spec: longhorn.ReplicaSpec{InstanceSpec: longhorn.InstanceSpec{NodeID: nodeID}}}
+		r.Name = name
+		if healthy {
+			r.Spec.HealthyAt = "2024-01-01T00:00:00Z"
+		}
+		return r
+	}
+
+	replicas := map[string]*longhorn.Replica{
+		"r1": newReplica("r1", TestNode1, true),
+		"r2": newReplica("r2", TestNode2, true),
+	}
+	if nodeID := getVolumeTransferSourceNodeID(v, replicas); nodeID != TestNode2 {
+		t.Fatalf("owner node holding a healthy replica should be preferred, got %v", nodeID)
+	}
+
+	replicas["r2"] = newReplica("r2", TestNode2, false)
+	if nodeID := getVolumeTransferSourceNodeID(v, replicas); nodeID != TestNode1 {
+		t.Fatalf("node holding a healthy replica should be preferred, got %v", nodeID)
+	}
+
+	replicas["r1"].Spec.FailedAt = "2024-01-02T00:00:00Z"
+	if nodeID := getVolumeTransferSourceNodeID(v, replicas); nodeID != "" {
+		t.Fatalf("no node should be preferred without a healthy replica, got %v", nodeID)
+	}
+}
@@ -712,6 +712,14 @@ func (ns *NodeServer) NodeGetVolumeStats(ctx context.Context, req *csi.NodeGetVo
 		return nil, status.Errorf(codes.Internal, "failed to retrieve capacity statistics for volume path %v for volume %v: %v", volumePath, volumeID, err)
 	}
 
+	// The usage drives the auto expansion of the volume, so failing to report it does not fail the request.
+	if _, err := ns.apiClient.Volume.ActionUpdateFilesystemUsage(existVol, &longhornclient.UpdateFilesystemUsageInput{
+		UsedBytes:  stats.usedBytes,
+		TotalBytes: stats.totalBytes,
+	}); err != nil {
+		ns.log.WithError(err).Warnf("Failed to report filesystem usage of volume %v", volumeID)
+	}
+
 	return &csi.NodeGetVolumeStatsResponse{
 		Usage: []*csi.VolumeUsage{
 			&csi.VolumeUsage{
@@ -58,8 +58,28 @@ type DataStore struct {
 	EngineImageInformer            cache.SharedInformer
 	nodeLister                     lhlisters.NodeLister
 	NodeInformer                   cache.SharedInformer
+	nodeMaintenanceLister          lhlisters.NodeMaintenanceLister
+	NodeMaintenanceInformer        cache.SharedInformer
+	notificationSinkLister         lhlisters.NotificationSinkLister
+	NotificationSinkInformer       cache.SharedInformer
+	preflightCheckLister           lhlisters.PreflightCheckLister
+	PreflightCheckInformer         cache.SharedInformer
+	disasterRecoveryPlanLister     lhlisters.DisasterRecoveryPlanLister
+	DisasterRecoveryPlanInformer   cache.SharedInformer
+	fileRestoreSessionLister       lhlisters.FileRestoreSessionLister
+	FileRestoreSessionInformer     cache.SharedInformer
+	dataEngineConversionLister     lhlisters.DataEngineConversionLister
+	DataEngineConversionInformer   cache.SharedInformer
+	volumeTransferLister           lhlisters.VolumeTransferLister
+	VolumeTransferInformer         cache.SharedInformer
+	volumeShrinkLister             lhlisters.VolumeShrinkLister
+	VolumeShrinkInformer           cache.SharedInformer
 	settingLister                  lhlisters.SettingLister
 	SettingInformer                cache.SharedInformer
+	settingHistoryLister           lhlisters.SettingHistoryLister
+	SettingHistoryInformer         cache.SharedInformer
+	scrubReportLister              lhlisters.ScrubReportLister
+	ScrubReportInformer            cache.SharedInformer
 	instanceManagerLister          lhlisters.InstanceManagerLister
 	InstanceManagerInformer        cache.SharedInformer
 	shareManagerLister             lhlisters.ShareManagerLister
@@ -147,8 +167,28 @@ func NewDataStore(namespace string, lhClient lhclientset.Interface, kubeClient c
 	cacheSyncs = append(cacheSyncs, engineImageInformer.Informer().HasSynced)
 	nodeInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().Nodes()
 	cacheSyncs = append(cacheSyncs, nodeInformer.Informer().HasSynced)
+	nodeMaintenanceInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().NodeMaintenances()
+	cacheSyncs = append(cacheSyncs, nodeMaintenanceInformer.Informer().HasSynced)
+	notificationSinkInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().NotificationSinks()
+	cacheSyncs = append(cacheSyncs, notificationSinkInformer.Informer().HasSynced)
+	preflightCheckInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().PreflightChecks()
+	cacheSyncs = append(cacheSyncs, preflightCheckInformer.Informer().HasSynced)
+	disasterRecoveryPlanInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().DisasterRecoveryPlans()
+	cacheSyncs = append(cacheSyncs, disasterRecoveryPlanInformer.Informer().HasSynced)
+	fileRestoreSessionInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().FileRestoreSessions()
+	cacheSyncs = append(cacheSyncs, fileRestoreSessionInformer.Informer().HasSynced)
+	dataEngineConversionInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().DataEngineConversions()
+	cacheSyncs = append(cacheSyncs, dataEngineConversionInformer.Informer().HasSynced)
+	volumeTransferInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().VolumeTransfers()
+	cacheSyncs = append(cacheSyncs, volumeTransferInformer.Informer().HasSynced)
+	volumeShrinkInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().VolumeShrinks()
+	cacheSyncs = append(cacheSyncs, volumeShrinkInformer.Informer().HasSynced)
 	settingInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().Settings()
 	cacheSyncs = append(cacheSyncs, settingInformer.Informer().HasSynced)
+	settingHistoryInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().SettingHistories()
+	cacheSyncs = append(cacheSyncs, settingHistoryInformer.Informer().HasSynced)
+	scrubReportInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().ScrubReports()
+	cacheSyncs = append(cacheSyncs, scrubReportInformer.Informer().HasSynced)
 	instanceManagerInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().InstanceManagers()
 	cacheSyncs = append(cacheSyncs, instanceManagerInformer.Informer().HasSynced)
 	shareManagerInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().ShareManagers()
@@ -236,8 +276,28 @@ func NewDataStore(namespace string, lhClient lhclientset.Interface, kubeClient c
 		EngineImageInformer:            engineImageInformer.Informer(),
 		nodeLister:                     nodeInformer.Lister(),
 		NodeInformer:                   nodeInformer.Informer(),
+		nodeMaintenanceLister:          nodeMaintenanceInformer.Lister(),
+		NodeMaintenanceInformer:        nodeMaintenanceInformer.Informer(),
+		notificationSinkLister:         notificationSinkInformer.Lister(),
+		NotificationSinkInformer:       notificationSinkInformer.Informer(),
+		preflightCheckLister:           preflightCheckInformer.Lister(),
+		PreflightCheckInformer:         preflightCheckInformer.Informer(),
+		disasterRecoveryPlanLister:     disasterRecoveryPlanInformer.Lister(),
+		DisasterRecoveryPlanInformer:   disasterRecoveryPlanInformer.Informer(),
+		fileRestoreSessionLister:       fileRestoreSessionInformer.Lister(),
+		FileRestoreSessionInformer:     fileRestoreSessionInformer.Informer(),
+		dataEngineConversionLister:     dataEngineConversionInformer.Lister(),
+		DataEngineConversionInformer:   dataEngineConversionInformer.Informer(),
+		volumeTransferLister:           volumeTransferInformer.Lister(),
+		VolumeTransferInformer:         volumeTransferInformer.Informer(),
+		volumeShrinkLister:             volumeShrinkInformer.Lister(),
+		VolumeShrinkInformer:           volumeShrinkInformer.Informer(),
 		settingLister:                  settingInformer.Lister(),
 		SettingInformer:                settingInformer.Informer(),
+		settingHistoryLister:           settingHistoryInformer.Lister(),
+		SettingHistoryInformer:         settingHistoryInformer.Informer(),
+		scrubReportLister:              scrubReportInformer.Lister(),
+		ScrubReportInformer:            scrubReportInformer.Informer(),
 		instanceManagerLister:          instanceManagerInformer.Lister(),
 		InstanceManagerInformer:        instanceManagerInformer.Informer(),
 		shareManagerLister:             shareManagerInformer.Lister(),
@@ -917,6 +917,11 @@ func (s *DataStore) GetSecret(namespace, name string) (resultRO *corev1.Secret,
 	return resultRO.DeepCopy(), nil
 }
 
+// CreateSecret creates the Secret resource with the given object and namespace
+func (s *DataStore) CreateSecret(namespace string, secret *corev1.Secret) (*corev1.Secret, error) {
+	return s.kubeClient.CoreV1().Secrets(namespace).Create(context.TODO(), secret, metav1.CreateOptions{})
+}
+
 // UpdateSecret updates the Secret resource with the given object and namespace
 func (s *DataStore) UpdateSecret(namespace string, secret *corev1.Secret) (*corev1.Secret, error) {
 	return s.kubeClient.CoreV1().Secrets(namespace).Update(context.TODO(), secret, metav1.UpdateOptions{})
@@ -1020,6 +1020,76 @@ func (s *DataStore) ListSettings() (map[types.SettingName]*longhorn.Setting, err
 	return itemMap, nil
 }
 
+// GetOwnerReferencesForSetting returns a list contains single OwnerReference for the
+// given Setting object
+func GetOwnerReferencesForSetting(setting *longhorn.Setting) []metav1.OwnerReference {
+	return []metav1.OwnerReference{
+		{
+			APIVersion: longhorn.SchemeGroupVersion.String(),
+			Kind:       types.LonghornKindSetting,
+			Name:       setting.Name,
+			UID:        setting.UID,
+		},
+	}
+}
+
+// CreateSettingHistory creates a Longhorn SettingHistory resource and verifies creation
+func (s *DataStore) CreateSettingHistory(settingHistory *longhorn.SettingHistory) (*longhorn.SettingHistory, error) {
+	ret, err := s.lhClient.LonghornV1beta2().SettingHistories(s.namespace).Create(context.TODO(), settingHistory, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if SkipListerCheck {
+		return ret, nil
+	}
+
+	obj, err := verifyCreation(ret.Name, "setting history", func(name string) (k8sruntime.Object, error) {
+		return s.GetSettingHistoryRO(name)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret, ok := obj.(*longhorn.SettingHistory)
+	if !ok {
+		return nil, fmt.Errorf("BUG: datastore: verifyCreation returned wrong type for SettingHistory")
+	}
+
+	return ret.DeepCopy(), nil
+}
+
+// UpdateSettingHistoryStatus updates the given Longhorn SettingHistory status and verifies update
+func (s *DataStore) UpdateSettingHistoryStatus(settingHistory *longhorn.SettingHistory) (*longhorn.SettingHistory, error) {
+	obj, err := s.lhClient.LonghornV1beta2().SettingHistories(s.namespace).UpdateStatus(context.TODO(), settingHistory, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	verifyUpdate(settingHistory.Name, obj, func(name string) (k8sruntime.Object, error) {
+		return s.GetSettingHistoryRO(name)
+	})
+	return obj, nil
+}
+
+// GetSettingHistoryRO returns the SettingHistory with the given setting name
+func (s *DataStore) GetSettingHistoryRO(name string) (*longhorn.SettingHistory, error) {
+	return s.settingHistoryLister.SettingHistories(s.namespace).Get(name)
+}
+
+// GetSettingHistory returns a copy of SettingHistory with the given setting name
+func (s *DataStore) GetSettingHistory(name string) (*longhorn.SettingHistory, error) {
+	resultRO, err := s.GetSettingHistoryRO(name)
+	if err != nil {
+		return nil, err
+	}
+	// Cannot use cached object from lister
+	return resultRO.DeepCopy(), nil
+}
+
+// ListSettingHistoriesRO returns a list of all SettingHistories for the given namespace,
+// the list contains direct references to the internal cache objects and should not be mutated.
+func (s *DataStore) ListSettingHistoriesRO() ([]*longhorn.SettingHistory, error) {
+	return s.settingHistoryLister.SettingHistories(s.namespace).List(labels.Everything())
+}
+
 // GetAutoBalancedReplicasSetting retrieves the replica auto-balance setting for
 // a Longhorn Volume.
 //
@@ -3925,6 +3995,121 @@ func (s *DataStore) GetSettingAsIntByDataEngine(settingName types.SettingName, d
 	return intValue, nil
 }
 
+// GetNodeSettingValue gets the effective value of the setting for the given node.
+// For the node overridable settings, the override in the Longhorn node spec takes precedence
+// over the node setting overrides setting, which takes precedence over the global setting.
+func (s *DataStore) GetNodeSettingValue(settingName types.SettingName, nodeName string) (string, error) {
+	if nodeName != "" && types.IsSettingNodeOverridable(settingName) {
+		value, overridden, err := s.getNodeSettingOverride(settingName, nodeName)
+		if err != nil {
+			return "", err
+		}
+		if overridden {
+			return value, nil
+		}
+	}
+
+	setting, err := s.GetSettingWithAutoFillingRO(settingName)
+	if err != nil {
+		return "", err
+	}
+	return setting.Value, nil
+}
+
+func (s *DataStore) getNodeSettingOverride(settingName types.SettingName, nodeName string) (string, bool, error) {
+	node, err := s.GetNodeRO(nodeName)
+	if err != nil && !ErrorIsNotFound(err) {
+		return "", false, err
+	}
+	if node != nil {
+		if value, ok := node.Spec.SettingOverrides[string(settingName)]; ok {
+			return value, true, nil
+		}
+	}
+
+	rulesSetting, err := s.GetSettingWithAutoFillingRO(types.SettingNameNodeSettingOverrides)
+	if err != nil {
+		return "", false, err
+	}
+	rules, err := types.UnmarshalNodeSettingOverrideRules(rulesSetting.Value)
+	if err != nil {
+		return "", false, err
+	}
+	if len(rules) == 0 {
+		return "", false, nil
+	}
+
+	kubeNode, err := s.GetKubernetesNodeRO(nodeName)
+	if err != nil {
+		if ErrorIsNotFound(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	value, ok := types.GetNodeSettingOverrideFromRules(rules, kubeNode.Labels, settingName)
+	return value, ok, nil
+}
+
+// GetNodeSettingAsInt gets the effective setting for the given name and node, returns as integer
+// Returns error if the definition type is not integer
+func (s *DataStore) GetNodeSettingAsInt(settingName types.SettingName, nodeName string) (int64, error) {
+	definition, ok := types.GetSettingDefinition(settingName)
+	if !ok {
+		return -1, fmt.Errorf("setting %v is not supported", settingName)
+	}
+	if definition.Type != types.SettingTypeInt || definition.DataEngineSpecific {
+		return -1, fmt.Errorf("the %v setting is not a non-data-engine-specific integer setting", settingName)
+	}
+
+	value, err := s.GetNodeSettingValue(settingName, nodeName)
+	if err != nil {
+		return -1, err
+	}
+
+	result, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return -1, errors.Wrapf(err, "failed to parse the %v setting value %v for node %v", settingName, value, nodeName)
+	}
+	return result, nil
+}
+
+// GetNodeSettingAsIntByDataEngine gets the effective setting for the given name, node and data engine, returns as integer.
+// Unlike the global setting, the overridden value can be either a single value applied to all data engines
+// or a JSON-formatted data-engine-specific value.
+func (s *DataStore) GetNodeSettingAsIntByDataEngine(settingName types.SettingName, nodeName string, dataEngine longhorn.DataEngineType) (int64, error) {
+	definition, ok := types.GetSettingDefinition(settingName)
+	if !ok {
+		return -1, fmt.Errorf("setting %v is not supported", settingName)
+	}
+	if !definition.DataEngineSpecific {
+		return s.GetNodeSettingAsInt(settingName, nodeName)
+	}
+	if definition.Type != types.SettingTypeInt {
+		return -1, fmt.Errorf("the %v setting is not an integer setting", settingName)
+	}
+
+	value, err := s.GetNodeSettingValue(settingName, nodeName)
+	if err != nil {
+		return -1, err
+	}
+
+	var values map[longhorn.DataEngineType]any
+	if types.IsJSONFormat(strings.TrimSpace(value)) {
+		values, err = types.ParseDataEngineSpecificSetting(definition, value)
+	} else {
+		values, err = types.ParseSettingSingleValue(definition, value)
+	}
+	if err != nil {
+		return -1, err
+	}
+
+	intValue, ok := values[dataEngine].(int64)
+	if !ok {
+		return -1, fmt.Errorf("the %v setting value for data engine %v on node %v is not a defined integer, value is %v", settingName, dataEngine, nodeName, value)
+	}
+	return intValue, nil
+}
+
 // GetSettingAsBool gets the setting for the given name, returns as boolean
 // Returns error if the definition type is not boolean
 func (s *DataStore) GetSettingAsBool(settingName types.SettingName) (bool, error) {
@@ -5982,6 +6167,637 @@ func (s *DataStore) DeleteSupportBundle(name string) error {
 	return s.lhClient.LonghornV1beta2().SupportBundles(s.namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
 }
 
+// CreateScrubReport creates a Longhorn ScrubReport resource and verifies creation
+func (s *DataStore) CreateScrubReport(scrubReport *longhorn.ScrubReport) (*longhorn.ScrubReport, error) {
+	ret, err := s.lhClient.LonghornV1beta2().ScrubReports(s.namespace).Create(context.TODO(), scrubReport, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if SkipListerCheck {
+		return ret, nil
+	}
+
+	obj, err := verifyCreation(ret.Name, "scrub report", func(name string) (k8sruntime.Object, error) {
+		return s.GetScrubReportRO(name)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret, ok := obj.(*longhorn.ScrubReport)
+	if !ok {
+		return nil, fmt.Errorf("BUG: datastore: verifyCreation returned wrong type for ScrubReport")
+	}
+
+	return ret.DeepCopy(), nil
+}
+
+// UpdateScrubReportStatus updates the given Longhorn ScrubReport status and verifies update
+func (s *DataStore) UpdateScrubReportStatus(scrubReport *longhorn.ScrubReport) (*longhorn.ScrubReport, error) {
+	obj, err := s.lhClient.LonghornV1beta2().ScrubReports(s.namespace).UpdateStatus(context.TODO(), scrubReport, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	verifyUpdate(scrubReport.Name, obj, func(name string) (k8sruntime.Object, error) {
+		return s.GetScrubReportRO(name)
+	})
+	return obj, nil
+}
+
+// DeleteScrubReport deletes the ScrubReport with the given name
+func (s *DataStore) DeleteScrubReport(name string) error {
+	return s.lhClient.LonghornV1beta2().ScrubReports(s.namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
+// GetScrubReportRO returns the ScrubReport with the given name
+func (s *DataStore) GetScrubReportRO(name string) (*longhorn.ScrubReport, error) {
+	return s.scrubReportLister.ScrubReports(s.namespace).Get(name)
+}
+
+// GetScrubReport returns a copy of ScrubReport with the given name
+func (s *DataStore) GetScrubReport(name string) (*longhorn.ScrubReport, error) {
+	resultRO, err := s.GetScrubReportRO(name)
+	if err != nil {
+		return nil, err
+	}
+	// Cannot use cached object from lister
+	return resultRO.DeepCopy(), nil
+}
+
+// ListScrubReportsRO returns a list of all ScrubReports for the given namespace,
+// the list contains direct references to the internal cache objects and should not be mutated.
+func (s *DataStore) ListScrubReportsRO() ([]*longhorn.ScrubReport, error) {
+	return s.scrubReportLister.ScrubReports(s.namespace).List(labels.Everything())
+}
+
+// ListVolumeScrubReportsRO returns a list of ScrubReports of the given volume,
+// the list contains direct references to the internal cache objects and should not be mutated.
+func (s *DataStore) ListVolumeScrubReportsRO(volumeName string) ([]*longhorn.ScrubReport, error) {
+	selector, err := getVolumeSelector(volumeName)
+	if err != nil {
+		return nil, err
+	}
+	return s.scrubReportLister.ScrubReports(s.namespace).List(selector)
+}
+
+// CreateNodeMaintenance creates a Longhorn NodeMaintenance resource and verifies creation
+func (s *DataStore) CreateNodeMaintenance(nodeMaintenance *longhorn.NodeMaintenance) (*longhorn.NodeMaintenance, error) {
+	ret, err := s.lhClient.LonghornV1beta2().NodeMaintenances(s.namespace).Create(context.TODO(), nodeMaintenance, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if SkipListerCheck {
+		return ret, nil
+	}
+
+	obj, err := verifyCreation(ret.Name, "node maintenance", func(name string) (k8sruntime.Object, error) {
+		return s.GetNodeMaintenanceRO(name)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret, ok := obj.(*longhorn.NodeMaintenance)
+	if !ok {
+		return nil, fmt.Errorf("BUG: datastore: verifyCreation returned wrong type for NodeMaintenance")
+	}
+
+	return ret.DeepCopy(), nil
+}
+
+// GetNodeMaintenanceRO returns the NodeMaintenance with the given name
+func (s *DataStore) GetNodeMaintenanceRO(name string) (*longhorn.NodeMaintenance, error) {
+	return s.nodeMaintenanceLister.NodeMaintenances(s.namespace).Get(name)
+}
+
+// GetNodeMaintenance returns a copy of NodeMaintenance with the given name
+func (s *DataStore) GetNodeMaintenance(name string) (*longhorn.NodeMaintenance, error) {
+	resultRO, err := s.GetNodeMaintenanceRO(name)
+	if err != nil {
+		return nil, err
+	}
+	// Cannot use cached object from lister
+	return resultRO.DeepCopy(), nil
+}
+
+// UpdateNodeMaintenanceStatus updates the given Longhorn NodeMaintenance status and verifies update
+func (s *DataStore) UpdateNodeMaintenanceStatus(nodeMaintenance *longhorn.NodeMaintenance) (*longhorn.NodeMaintenance, error) {
+	obj, err := s.lhClient.LonghornV1beta2().NodeMaintenances(s.namespace).UpdateStatus(context.TODO(), nodeMaintenance, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	verifyUpdate(nodeMaintenance.Name, obj, func(name string) (k8sruntime.Object, error) {
+		return s.GetNodeMaintenanceRO(name)
+	})
+	return obj, nil
+}
+
+// RemoveFinalizerForNodeMaintenance results in deletion if DeletionTimestamp was set
+func (s *DataStore) RemoveFinalizerForNodeMaintenance(nodeMaintenance *longhorn.NodeMaintenance) error {
+	if !util.FinalizerExists(longhornFinalizerKey, nodeMaintenance) {
+		// finalizer already removed
+		return nil
+	}
+	if err := util.RemoveFinalizer(longhornFinalizerKey, nodeMaintenance); err != nil {
+		return err
+	}
+	_, err := s.lhClient.LonghornV1beta2().NodeMaintenances(s.namespace).Update(context.TODO(), nodeMaintenance, metav1.UpdateOptions{})
+	if err != nil {
+		// workaround `StorageError: invalid object, Code: 4` due to empty object
+		if nodeMaintenance.DeletionTimestamp != nil {
+			return nil
+		}
+		return errors.Wrapf(err, "unable to remove finalizer for NodeMaintenance %v", nodeMaintenance.Name)
+	}
+	return nil
+}
+
+// DeleteNodeMaintenance won't result in immediately deletion since finalizer was set by default
+func (s *DataStore) DeleteNodeMaintenance(name string) error {
+	return s.lhClient.LonghornV1beta2().NodeMaintenances(s.namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
+// ListNodeMaintenancesRO returns a list of all NodeMaintenances for the given namespace,
+// the list contains direct references to the internal cache objects and should not be mutated.
+func (s *DataStore) ListNodeMaintenancesRO() ([]*longhorn.NodeMaintenance, error) {
+	return s.nodeMaintenanceLister.NodeMaintenances(s.namespace).List(labels.Everything())
+}
+
+// ListNodeMaintenancesByNodeRO returns a list of the NodeMaintenances of the given node,
+// the list contains direct references to the internal cache objects and should not be mutated.
+func (s *DataStore) ListNodeMaintenancesByNodeRO(nodeName string) ([]*longhorn.NodeMaintenance, error) {
+	list, err := s.ListNodeMaintenancesRO()
+	if err != nil {
+		return nil, err
+	}
+	nodeMaintenances := []*longhorn.NodeMaintenance{}
+	for _, nodeMaintenance := range list {
+		if nodeMaintenance.Spec.NodeID == nodeName {
+			nodeMaintenances = append(nodeMaintenances, nodeMaintenance)
+		}
+	}
+	return nodeMaintenances, nil
+}
+
+// GetNotificationSinkRO returns the NotificationSink with the given name
+func (s *DataStore) GetNotificationSinkRO(name string) (*longhorn.NotificationSink, error) {
+	return s.notificationSinkLister.NotificationSinks(s.namespace).Get(name)
+}
+
+// GetNotificationSink returns a copy of NotificationSink with the given name
+func (s *DataStore) GetNotificationSink(name string) (*longhorn.NotificationSink, error) {
+	resultRO, err := s.GetNotificationSinkRO(name)
+	if err != nil {
+		return nil, err
+	}
+	// Cannot use cached object from lister
+	return resultRO.DeepCopy(), nil
+}
+
+// UpdateNotificationSinkStatus updates the given Longhorn NotificationSink status and verifies update
+func (s *DataStore) UpdateNotificationSinkStatus(notificationSink *longhorn.NotificationSink) (*longhorn.NotificationSink, error) {
+	obj, err := s.lhClient.LonghornV1beta2().NotificationSinks(s.namespace).UpdateStatus(context.TODO(), notificationSink, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	verifyUpdate(notificationSink.Name, obj, func(name string) (k8sruntime.Object, error) {
+		return s.GetNotificationSinkRO(name)
+	})
+	return obj, nil
+}
+
+// RemoveFinalizerForNotificationSink results in deletion if DeletionTimestamp was set
+func (s *DataStore) RemoveFinalizerForNotificationSink(notificationSink *longhorn.NotificationSink) error {
+	if !util.FinalizerExists(longhornFinalizerKey, notificationSink) {
+		// finalizer already removed
+		return nil
+	}
+	if err := util.RemoveFinalizer(longhornFinalizerKey, notificationSink); err != nil {
+		return err
+	}
+	_, err := s.lhClient.LonghornV1beta2().NotificationSinks(s.namespace).Update(context.TODO(), notificationSink, metav1.UpdateOptions{})
+	if err != nil {
+		// workaround `StorageError: invalid object, Code: 4` due to empty object
+		if notificationSink.DeletionTimestamp != nil {
+			return nil
+		}
+		return errors.Wrapf(err, "unable to remove finalizer for NotificationSink %v", notificationSink.Name)
+	}
+	return nil
+}
+
+// DeleteNotificationSink won't result in immediately deletion since finalizer was set by default
+func (s *DataStore) DeleteNotificationSink(name string) error {
+	return s.lhClient.LonghornV1beta2().NotificationSinks(s.namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
+// ListNotificationSinksRO returns a list of all NotificationSinks for the given namespace,
+// the list contains direct references to the internal cache objects and should not be mutated.
+func (s *DataStore) ListNotificationSinksRO() ([]*longhorn.NotificationSink, error) {
+	return s.notificationSinkLister.NotificationSinks(s.namespace).List(labels.Everything())
+}
+
+// GetPreflightCheckRO returns the PreflightCheck with the given name
+func (s *DataStore) GetPreflightCheckRO(name string) (*longhorn.PreflightCheck, error) {
+	return s.preflightCheckLister.PreflightChecks(s.namespace).Get(name)
+}
+
+// GetPreflightCheck returns a copy of PreflightCheck with the given name
+func (s *DataStore) GetPreflightCheck(name string) (*longhorn.PreflightCheck, error) {
+	resultRO, err := s.GetPreflightCheckRO(name)
+	if err != nil {
+		return nil, err
+	}
+	// Cannot use cached object from lister
+	return resultRO.DeepCopy(), nil
+}
+
+// UpdatePreflightCheckStatus updates the given Longhorn PreflightCheck status and verifies update
+func (s *DataStore) UpdatePreflightCheckStatus(preflightCheck *longhorn.PreflightCheck) (*longhorn.PreflightCheck, error) {
+	obj, err := s.lhClient.LonghornV1beta2().PreflightChecks(s.namespace).UpdateStatus(context.TODO(), preflightCheck, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	verifyUpdate(preflightCheck.Name, obj, func(name string) (k8sruntime.Object, error) {
+		return s.GetPreflightCheckRO(name)
+	})
+	return obj, nil
+}
+
+// RemoveFinalizerForPreflightCheck results in deletion if DeletionTimestamp was set
+func (s *DataStore) RemoveFinalizerForPreflightCheck(preflightCheck *longhorn.PreflightCheck) error {
+	if !util.FinalizerExists(longhornFinalizerKey, preflightCheck) {
+		// finalizer already removed
+		return nil
+	}
+	if err := util.RemoveFinalizer(longhornFinalizerKey, preflightCheck); err != nil {
+		return err
+	}
+	_, err := s.lhClient.LonghornV1beta2().PreflightChecks(s.namespace).Update(context.TODO(), preflightCheck, metav1.UpdateOptions{})
+	if err != nil {
+		// workaround `StorageError: invalid object, Code: 4` due to empty object
+		if preflightCheck.DeletionTimestamp != nil {
+			return nil
+		}
+		return errors.Wrapf(err, "unable to remove finalizer for PreflightCheck %v", preflightCheck.Name)
+	}
+	return nil
+}
+
+// DeletePreflightCheck won't result in immediately deletion since finalizer was set by default
+func (s *DataStore) DeletePreflightCheck(name string) error {
+	return s.lhClient.LonghornV1beta2().PreflightChecks(s.namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
+// ListPreflightChecksRO returns a list of all PreflightChecks for the given namespace,
+// the list contains direct references to the internal cache objects and should not be mutated.
+func (s *DataStore) ListPreflightChecksRO() ([]*longhorn.PreflightCheck, error) {
+	return s.preflightCheckLister.PreflightChecks(s.namespace).List(labels.Everything())
+}
+
+// GetDisasterRecoveryPlanRO returns the DisasterRecoveryPlan with the given name
+func (s *DataStore) GetDisasterRecoveryPlanRO(name string) (*longhorn.DisasterRecoveryPlan, error) {
+	return s.disasterRecoveryPlanLister.DisasterRecoveryPlans(s.namespace).Get(name)
+}
+
+// GetDisasterRecoveryPlan returns a copy of DisasterRecoveryPlan with the given name
+func (s *DataStore) GetDisasterRecoveryPlan(name string) (*longhorn.DisasterRecoveryPlan, error) {
+	resultRO, err := s.GetDisasterRecoveryPlanRO(name)
+	if err != nil {
+		return nil, err
+	}
+	// Cannot use cached object from lister
+	return resultRO.DeepCopy(), nil
+}
+
+// UpdateDisasterRecoveryPlanStatus updates the given Longhorn DisasterRecoveryPlan status and verifies update
+func (s *DataStore) UpdateDisasterRecoveryPlanStatus(disasterRecoveryPlan *longhorn.DisasterRecoveryPlan) (*longhorn.DisasterRecoveryPlan, error) {
+	obj, err := s.lhClient.LonghornV1beta2().DisasterRecoveryPlans(s.namespace).UpdateStatus(context.TODO(), disasterRecoveryPlan, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	verifyUpdate(disasterRecoveryPlan.Name, obj, func(name string) (k8sruntime.Object, error) {
+		return s.GetDisasterRecoveryPlanRO(name)
+	})
+	return obj, nil
+}
+
+// RemoveFinalizerForDisasterRecoveryPlan results in deletion if DeletionTimestamp was set
+func (s *DataStore) RemoveFinalizerForDisasterRecoveryPlan(disasterRecoveryPlan *longhorn.DisasterRecoveryPlan) error {
+	if !util.FinalizerExists(longhornFinalizerKey, disasterRecoveryPlan) {
+		// finalizer already removed
+		return nil
+	}
+	if err := util.RemoveFinalizer(longhornFinalizerKey, disasterRecoveryPlan); err != nil {
+		return err
+	}
+	_, err := s.lhClient.LonghornV1beta2().DisasterRecoveryPlans(s.namespace).Update(context.TODO(), disasterRecoveryPlan, metav1.UpdateOptions{})
+	if err != nil {
+		// workaround `StorageError: invalid object, Code: 4` due to empty object
+		if disasterRecoveryPlan.DeletionTimestamp != nil {
+			return nil
+		}
+		return errors.Wrapf(err, "unable to remove finalizer for DisasterRecoveryPlan %v", disasterRecoveryPlan.Name)
+	}
+	return nil
+}
+
+// DeleteDisasterRecoveryPlan won't result in immediately deletion since finalizer was set by default
+func (s *DataStore) DeleteDisasterRecoveryPlan(name string) error {
+	return s.lhClient.LonghornV1beta2().DisasterRecoveryPlans(s.namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
+// ListDisasterRecoveryPlansRO returns a list of all DisasterRecoveryPlans for the given namespace,
+// the list contains direct references to the internal cache objects and should not be mutated.
+func (s *DataStore) ListDisasterRecoveryPlansRO() ([]*longhorn.DisasterRecoveryPlan, error) {
+	return s.disasterRecoveryPlanLister.DisasterRecoveryPlans(s.namespace).List(labels.Everything())
+}
+
+func GetOwnerReferencesForFileRestoreSession(fileRestoreSession *longhorn.FileRestoreSession) []metav1.OwnerReference {
+	controller := true
+	blockOwnerDeletion := true
+	return []metav1.OwnerReference{
+		{
+			APIVersion:         longhorn.SchemeGroupVersion.String(),
+			Kind:               types.LonghornKindFileRestoreSession,
+			Name:               fileRestoreSession.Name,
+			UID:                fileRestoreSession.UID,
+			Controller:         &controller,
+			BlockOwnerDeletion: &blockOwnerDeletion,
+		},
+	}
+}
+
+// CreateFileRestoreSession creates a Longhorn FileRestoreSession and verifies creation
+func (s *DataStore) CreateFileRestoreSession(fileRestoreSession *longhorn.FileRestoreSession) (*longhorn.FileRestoreSession, error) {
+	ret, err := s.lhClient.LonghornV1beta2().FileRestoreSessions(s.namespace).Create(context.TODO(), fileRestoreSession, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if SkipListerCheck {
+		return ret, nil
+	}
+
+	obj, err := verifyCreation(ret.Name, "file restore session", func(name string) (k8sruntime.Object, error) {
+		return s.GetFileRestoreSessionRO(name)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret, ok := obj.(*longhorn.FileRestoreSession)
+	if !ok {
+		return nil, errors.Errorf("BUG: datastore: verifyCreation returned wrong type for FileRestoreSession")
+	}
+	return ret.DeepCopy(), nil
+}
+
+// GetFileRestoreSessionRO returns the FileRestoreSession with the given name
+func (s *DataStore) GetFileRestoreSessionRO(name string) (*longhorn.FileRestoreSession, error) {
+	return s.fileRestoreSessionLister.FileRestoreSessions(s.namespace).Get(name)
+}
+
+// GetFileRestoreSession returns a copy of FileRestoreSession with the given name
+func (s *DataStore) GetFileRestoreSession(name string) (*longhorn.FileRestoreSession, error) {
+	resultRO, err := s.GetFileRestoreSessionRO(name)
+	if err != nil {
+		return nil, err
+	}
+	// Cannot use cached object from lister
+	return resultRO.DeepCopy(), nil
+}
+
+// UpdateFileRestoreSessionStatus updates the given Longhorn FileRestoreSession status and verifies update
+func (s *DataStore) UpdateFileRestoreSessionStatus(fileRestoreSession *longhorn.FileRestoreSession) (*longhorn.FileRestoreSession, error) {
+	obj, err := s.lhClient.LonghornV1beta2().FileRestoreSessions(s.namespace).UpdateStatus(context.TODO(), fileRestoreSession, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	verifyUpdate(fileRestoreSession.Name, obj, func(name string) (k8sruntime.Object, error) {
+		return s.GetFileRestoreSessionRO(name)
+	})
+	return obj, nil
+}
+
+// RemoveFinalizerForFileRestoreSession results in deletion if DeletionTimestamp was set
+func (s *DataStore) RemoveFinalizerForFileRestoreSession(fileRestoreSession *longhorn.FileRestoreSession) error {
+	if !util.FinalizerExists(longhornFinalizerKey, fileRestoreSession) {
+		// finalizer already removed
+		return nil
+	}
+	if err := util.RemoveFinalizer(longhornFinalizerKey, fileRestoreSession); err != nil {
+		return err
+	}
+	_, err := s.lhClient.LonghornV1beta2().FileRestoreSessions(s.namespace).Update(context.TODO(), fileRestoreSession, metav1.UpdateOptions{})
+	if err != nil {
+		// workaround `StorageError: invalid object, Code: 4` due to empty object
+		if fileRestoreSession.DeletionTimestamp != nil {
+			return nil
+		}
+		return errors.Wrapf(err, "unable to remove finalizer for FileRestoreSession %v", fileRestoreSession.Name)
+	}
+	return nil
+}
+
+// DeleteFileRestoreSession won't result in immediately deletion since finalizer was set by default
+func (s *DataStore) DeleteFileRestoreSession(name string) error {
+	return s.lhClient.LonghornV1beta2().FileRestoreSessions(s.namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
+// ListFileRestoreSessionsRO returns a list of all FileRestoreSessions for the given namespace,
+// the list contains direct references to the internal cache objects and should not be mutated.
+func (s *DataStore) ListFileRestoreSessionsRO() ([]*longhorn.FileRestoreSession, error) {
+	return s.fileRestoreSessionLister.FileRestoreSessions(s.namespace).List(labels.Everything())
+}
+
+// GetDataEngineConversionRO returns the DataEngineConversion with the given name
+func (s *DataStore) GetDataEngineConversionRO(name string) (*longhorn.DataEngineConversion, error) {
+	return s.dataEngineConversionLister.DataEngineConversions(s.namespace).Get(name)
+}
+
+// GetDataEngineConversion returns a copy of DataEngineConversion with the given name
+func (s *DataStore) GetDataEngineConversion(name string) (*longhorn.DataEngineConversion, error) {
+	resultRO, err := s.GetDataEngineConversionRO(name)
+	if err != nil {
+		return nil, err
+	}
+	// Cannot use cached object from lister
+	return resultRO.DeepCopy(), nil
+}
+
+// UpdateDataEngineConversionStatus updates the given Longhorn DataEngineConversion status and verifies update
+func (s *DataStore) UpdateDataEngineConversionStatus(dataEngineConversion *longhorn.DataEngineConversion) (*longhorn.DataEngineConversion, error) {
+	obj, err := s.lhClient.LonghornV1beta2().DataEngineConversions(s.namespace).UpdateStatus(context.TODO(), dataEngineConversion, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	verifyUpdate(dataEngineConversion.Name, obj, func(name string) (k8sruntime.Object, error) {
+		return s.GetDataEngineConversionRO(name)
+	})
+	return obj, nil
+}
+
+// RemoveFinalizerForDataEngineConversion results in deletion if DeletionTimestamp was set
+func (s *DataStore) RemoveFinalizerForDataEngineConversion(dataEngineConversion *longhorn.DataEngineConversion) error {
+	if !util.FinalizerExists(longhornFinalizerKey, dataEngineConversion) {
+		// finalizer already removed
+		return nil
+	}
+	if err := util.RemoveFinalizer(longhornFinalizerKey, dataEngineConversion); err != nil {
+		return err
+	}
+	_, err := s.lhClient.LonghornV1beta2().DataEngineConversions(s.namespace).Update(context.TODO(), dataEngineConversion, metav1.UpdateOptions{})
+	if err != nil {
+		// workaround `StorageError: invalid object, Code: 4` due to empty object
+		if dataEngineConversion.DeletionTimestamp != nil {
+			return nil
+		}
+		return errors.Wrapf(err, "unable to remove finalizer for DataEngineConversion %v", dataEngineConversion.Name)
+	}
+	return nil
+}
+
+// DeleteDataEngineConversion won't result in immediately deletion since finalizer was set by default
+func (s *DataStore) DeleteDataEngineConversion(name string) error {
+	return s.lhClient.LonghornV1beta2().DataEngineConversions(s.namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
+// ListDataEngineConversionsRO returns a list of all DataEngineConversions for the given namespace,
+// the list contains direct references to the internal cache objects and should not be mutated.
+func (s *DataStore) ListDataEngineConversionsRO() ([]*longhorn.DataEngineConversion, error) {
+	return s.dataEngineConversionLister.DataEngineConversions(s.namespace).List(labels.Everything())
+}
+
+// GetVolumeTransferRO returns the VolumeTransfer with the given name
+func (s *DataStore) GetVolumeTransferRO(name string) (*longhorn.VolumeTransfer, error) {
+	return s.volumeTransferLister.VolumeTransfers(s.namespace).Get(name)
+}
+
+// GetVolumeTransfer returns a copy of VolumeTransfer with the given name
+func (s *DataStore) GetVolumeTransfer(name string) (*longhorn.VolumeTransfer, error) {
+	resultRO, err := s.GetVolumeTransferRO(name)
+	if err != nil {
+		return nil, err
+	}
+	// Cannot use cached object from lister
+	return resultRO.DeepCopy(), nil
+}
+
+// UpdateVolumeTransferStatus updates the given Longhorn VolumeTransfer status and verifies update
+func (s *DataStore) UpdateVolumeTransferStatus(volumeTransfer *longhorn.VolumeTransfer) (*longhorn.VolumeTransfer, error) {
+	obj, err := s.lhClient.LonghornV1beta2().VolumeTransfers(s.namespace).UpdateStatus(context.TODO(), volumeTransfer, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	verifyUpdate(volumeTransfer.Name, obj, func(name string) (k8sruntime.Object, error) {
+		return s.GetVolumeTransferRO(name)
+	})
+	return obj, nil
+}
+
+// RemoveFinalizerForVolumeTransfer results in deletion if DeletionTimestamp was set
+func (s *DataStore) RemoveFinalizerForVolumeTransfer(volumeTransfer *longhorn.VolumeTransfer) error {
+	if !util.FinalizerExists(longhornFinalizerKey, volumeTransfer) {
+		// finalizer already removed
+		return nil
+	}
+	if err := util.RemoveFinalizer(longhornFinalizerKey, volumeTransfer); err != nil {
+		return err
+	}
+	_, err := s.lhClient.LonghornV1beta2().VolumeTransfers(s.namespace).Update(context.TODO(), volumeTransfer, metav1.UpdateOptions{})
+	if err != nil {
+		// workaround `StorageError: invalid object, Code: 4` due to empty object
+		if volumeTransfer.DeletionTimestamp != nil {
+			return nil
+		}
+		return errors.Wrapf(err, "unable to remove finalizer for VolumeTransfer %v", volumeTransfer.Name)
+	}
+	return nil
+}
+
+// DeleteVolumeTransfer won't result in immediately deletion since finalizer was set by default
+func (s *DataStore) DeleteVolumeTransfer(name string) error {
+	return s.lhClient.LonghornV1beta2().VolumeTransfers(s.namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
+// ListVolumeTransfersRO returns a list of all VolumeTransfers for the given namespace,
+// the list contains direct references to the internal cache objects and should not be mutated.
+func (s *DataStore) ListVolumeTransfersRO() ([]*longhorn.VolumeTransfer, error) {
+	return s.volumeTransferLister.VolumeTransfers(s.namespace).List(labels.Everything())
+}
+
+func GetOwnerReferencesForVolumeShrink(volumeShrink *longhorn.VolumeShrink) []metav1.OwnerReference {
+	controller := true
+	blockOwnerDeletion := true
+	return []metav1.OwnerReference{
+		{
+			APIVersion:         longhorn.SchemeGroupVersion.String(),
+			Kind:               types.LonghornKindVolumeShrink,
+			Name:               volumeShrink.Name,
+			UID:                volumeShrink.UID,
+			Controller:         &controller,
+			BlockOwnerDeletion: &blockOwnerDeletion,
+		},
+	}
+}
+
+// GetVolumeShrinkRO returns the VolumeShrink with the given name
+func (s *DataStore) GetVolumeShrinkRO(name string) (*longhorn.VolumeShrink, error) {
+	return s.volumeShrinkLister.VolumeShrinks(s.namespace).Get(name)
+}
+
+// GetVolumeShrink returns a copy of VolumeShrink with the given name
+func (s *DataStore) GetVolumeShrink(name string) (*longhorn.VolumeShrink, error) {
+	resultRO, err := s.GetVolumeShrinkRO(name)
+	if err != nil {
+		return nil, err
+	}
+	// Cannot use cached object from lister
+	return resultRO.DeepCopy(), nil
+}
+
+// UpdateVolumeShrinkStatus updates the given Longhorn VolumeShrink status and verifies update
+func (s *DataStore) UpdateVolumeShrinkStatus(volumeShrink *longhorn.VolumeShrink) (*longhorn.VolumeShrink, error) {
+	obj, err := s.lhClient.LonghornV1beta2().VolumeShrinks(s.namespace).UpdateStatus(context.TODO(), volumeShrink, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	verifyUpdate(volumeShrink.Name, obj, func(name string) (k8sruntime.Object, error) {
+		return s.GetVolumeShrinkRO(name)
+	})
+	return obj, nil
+}
+
+// RemoveFinalizerForVolumeShrink results in deletion if DeletionTimestamp was set
+func (s *DataStore) RemoveFinalizerForVolumeShrink(volumeShrink *longhorn.VolumeShrink) error {
+	if !util.FinalizerExists(longhornFinalizerKey, volumeShrink) {
+		// finalizer already removed
+		return nil
+	}
+	if err := util.RemoveFinalizer(longhornFinalizerKey, volumeShrink); err != nil {
+		return err
+	}
+	_, err := s.lhClient.LonghornV1beta2().VolumeShrinks(s.namespace).Update(context.TODO(), volumeShrink, metav1.UpdateOptions{})
+	if err != nil {
+		// workaround `StorageError: invalid object, Code: 4` due to empty object
+		if volumeShrink.DeletionTimestamp != nil {
+			return nil
+		}
+		return errors.Wrapf(err, "unable to remove finalizer for VolumeShrink %v", volumeShrink.Name)
+	}
+	return nil
+}
+
+// DeleteVolumeShrink won't result in immediately deletion since finalizer was set by default
+func (s *DataStore) DeleteVolumeShrink(name string) error {
+	return s.lhClient.LonghornV1beta2().VolumeShrinks(s.namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
+// ListVolumeShrinksRO returns a list of all VolumeShrinks for the given namespace,
+// the list contains direct references to the internal cache objects and should not be mutated.
+func (s *DataStore) ListVolumeShrinksRO() ([]*longhorn.VolumeShrink, error) {
+	return s.volumeShrinkLister.VolumeShrinks(s.namespace).List(labels.Everything())
+}
+
 // CreateSystemBackup creates a Longhorn SystemBackup and verifies creation
 func (s *DataStore) CreateSystemBackup(systemBackup *longhorn.SystemBackup) (*longhorn.SystemBackup, error) {
 	ret, err := s.lhClient.LonghornV1beta2().SystemBackups(s.namespace).Create(context.TODO(), systemBackup, metav1.CreateOptions{})
@@ -360,6 +360,11 @@ func (s *DataStore) ListVolumeEnginesUncached(volumeName string) ([]longhorn.Eng
 	return engineList.Items, nil
 }
 
+// GetScrubReportUncached returns the ScrubReport with the given name directly from the API server.
+func (s *DataStore) GetScrubReportUncached(name string) (*longhorn.ScrubReport, error) {
+	return s.lhClient.LonghornV1beta2().ScrubReports(s.namespace).Get(context.TODO(), name, metav1.GetOptions{})
+}
+
 // GetAllLonghornRecurringJobs returns an uncached list of RecurringJobs in
 // Longhorn namespace directly from the API server.
 // Using cached informers should be preferred but current lister doesn't have a
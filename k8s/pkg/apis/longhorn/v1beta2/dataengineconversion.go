@@ -0,0 +1,111 @@
+package v1beta2
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type DataEngineConversionState string
+
+const (
+	// DataEngineConversionStatePending means the target volume is not created yet.
+	DataEngineConversionStatePending = DataEngineConversionState("pending")
+	// DataEngineConversionStateCloning means the block device of the source volume is being copied into the target
+	// volume while the workload keeps running.
+	DataEngineConversionStateCloning = DataEngineConversionState("cloning")
+	// DataEngineConversionStateVerifying means the target volume is being verified before the swap.
+	DataEngineConversionStateVerifying = DataEngineConversionState("verifying")
+	// DataEngineConversionStateWaitingForDetach means the conversion waits for the workload to detach the source volume.
+	DataEngineConversionStateWaitingForDetach = DataEngineConversionState("waitingForDetach")
+	// DataEngineConversionStateFinalizing means the changes written to the source volume during the copy are copied
+	// into the target volume, and the content of both volumes is compared.
+	DataEngineConversionStateFinalizing = DataEngineConversionState("finalizing")
+	// DataEngineConversionStateSwapping means the PVC is being rebound from the source volume to the target volume.
+	DataEngineConversionStateSwapping = DataEngineConversionState("swapping")
+	// DataEngineConversionStateCompleted means the workload can use the target volume through the PVC.
+	DataEngineConversionStateCompleted = DataEngineConversionState("completed")
+	// DataEngineConversionStateRolledBack means the target volume failed the verification and is deleted. The source
+	// volume is untouched.
+	DataEngineConversionStateRolledBack = DataEngineConversionState("rolledBack")
+	// DataEngineConversionStateError means the conversion cannot proceed.
+	DataEngineConversionStateError = DataEngineConversionState("error")
+)
+
+// DataEngineConversionSpec defines the desired state of the Longhorn data engine conversion
+type DataEngineConversionSpec struct {
+	// The volume to convert.
+	// +optional
+	VolumeName string `json:"volumeName"`
+	// The data engine of the target volume.
+	// +kubebuilder:validation:Enum=v1;v2
+	// +optional
+	TargetDataEngine DataEngineType `json:"targetDataEngine"`
+	// The volume cloned from the source volume with the target data engine. It is "<volume>-<data engine>" if empty.
+	// +optional
+	TargetVolumeName string `json:"targetVolumeName"`
+}
+
+// DataEngineConversionStatus defines the observed state of the Longhorn data engine conversion
+type DataEngineConversionStatus struct {
+	// +optional
+	OwnerID string `json:"ownerID"`
+	// +optional
+	State DataEngineConversionState `json:"state"`
+	// The progress of the clone, or of the final sync once the workload detached the source volume, in percentage.
+	// +optional
+	Progress int `json:"progress"`
+	// The PV bound to the PVC of the source volume before the swap.
+	// +optional
+	SourcePVName string `json:"sourcePVName"`
+	// The reclaim policy of the source PV, which is applied to the PV of the target volume.
+	// +optional
+	SourcePVReclaimPolicy string `json:"sourcePVReclaimPolicy"`
+	// +optional
+	StorageClassName string `json:"storageClassName"`
+	// +optional
+	FSType string `json:"fsType"`
+	// +optional
+	PVCName string `json:"pvcName"`
+	// +optional
+	PVCNamespace string `json:"pvcNamespace"`
+	// The labels of the source PVC, which are applied to the recreated PVC.
+	// +optional
+	// +nullable
+	PVCLabels map[string]string `json:"pvcLabels"`
+	// The PV of the target volume bound to the PVC after the swap.
+	// +optional
+	TargetPVName string `json:"targetPVName"`
+	// +optional
+	StartedAt string `json:"startedAt"`
+	// +optional
+	CompletedAt string `json:"completedAt"`
+	// +optional
+	Message string `json:"message"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=lhdec
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Volume",type=string,JSONPath=`.spec.volumeName`,description="The volume to convert"
+// +kubebuilder:printcolumn:name="TargetDataEngine",type=string,JSONPath=`.spec.targetDataEngine`,description="The data engine of the target volume"
+// +kubebuilder:printcolumn:name="TargetVolume",type=string,JSONPath=`.spec.targetVolumeName`,description="The target volume"
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`,description="The state of the conversion"
+// +kubebuilder:printcolumn:name="Progress",type=integer,JSONPath=`.status.progress`,description="The progress of the clone or the final sync"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// DataEngineConversion is where Longhorn stores data engine conversion object.
+type DataEngineConversion struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataEngineConversionSpec   `json:"spec,omitempty"`
+	Status DataEngineConversionStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DataEngineConversionList is a list of data engine conversions.
+type DataEngineConversionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataEngineConversion `json:"items"`
+}
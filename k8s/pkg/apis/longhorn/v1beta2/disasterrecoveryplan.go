@@ -0,0 +1,156 @@
+package v1beta2
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type DisasterRecoveryPlanState string
+
+const (
+	// DisasterRecoveryPlanStatePending means some member volumes are not ready for the failover yet.
+	DisasterRecoveryPlanStatePending = DisasterRecoveryPlanState("pending")
+	// DisasterRecoveryPlanStateReady means all member volumes restored the latest backups at a consistent point.
+	DisasterRecoveryPlanStateReady = DisasterRecoveryPlanState("ready")
+	// DisasterRecoveryPlanStateActivating means the member volumes are being activated.
+	DisasterRecoveryPlanStateActivating = DisasterRecoveryPlanState("activating")
+	// DisasterRecoveryPlanStateCreatingPVC means the PVs and PVCs of the activated member volumes are being created.
+	DisasterRecoveryPlanStateCreatingPVC = DisasterRecoveryPlanState("creatingPVC")
+	// DisasterRecoveryPlanStateFailedOver means all member volumes are activated and bound to the PVCs.
+	DisasterRecoveryPlanStateFailedOver = DisasterRecoveryPlanState("failedOver")
+	// DisasterRecoveryPlanStateError means the failover cannot proceed.
+	DisasterRecoveryPlanStateError = DisasterRecoveryPlanState("error")
+)
+
+// DisasterRecoveryPlanMemberStatus is the observed state of a member volume of the plan
+type DisasterRecoveryPlanMemberStatus struct {
+	// The latest backup in the backup volume.
+	// +optional
+	LastBackup string `json:"lastBackup"`
+	// +optional
+	LastBackupAt string `json:"lastBackupAt"`
+	// The last backup restored by the standby volume.
+	// +optional
+	LastRestoredBackup string `json:"lastRestoredBackup"`
+	// The snapshot creation time of the last restored backup, which is the point in time the volume can be recovered to.
+	// +optional
+	LastRestoredBackupAt string `json:"lastRestoredBackupAt"`
+	// The time difference between the latest backup and the last restored backup.
+	// +optional
+	RestoreLag string `json:"restoreLag"`
+	// +optional
+	Ready bool `json:"ready"`
+	// +optional
+	Activated bool `json:"activated"`
+	// +optional
+	PVName string `json:"pvName"`
+	// +optional
+	PVCName string `json:"pvcName"`
+	// +optional
+	PVCNamespace string `json:"pvcNamespace"`
+	// +optional
+	Message string `json:"message"`
+}
+
+// DisasterRecoveryPlanRehearsal is the result of the last dry-run failover
+type DisasterRecoveryPlanRehearsal struct {
+	// +optional
+	Passed bool `json:"passed"`
+	// The problems which would block the failover.
+	// +optional
+	// +nullable
+	Issues []string `json:"issues"`
+	// +optional
+	RehearsedAt string `json:"rehearsedAt"`
+}
+
+// DisasterRecoveryPlanSpec defines the desired state of the Longhorn disaster recovery plan
+type DisasterRecoveryPlanSpec struct {
+	// The standby volumes in the plan.
+	// +optional
+	// +nullable
+	Volumes []string `json:"volumes"`
+	// The labels of the standby volumes in the plan, in addition to the listed volumes.
+	// +optional
+	// +nullable
+	VolumeSelector map[string]string `json:"volumeSelector"`
+	// The namespace of the PVCs created for the member volumes on failover. It can be overridden per volume by PVCNamespaces.
+	// +optional
+	TargetNamespace string `json:"targetNamespace"`
+	// The namespaces of the PVCs created for the member volumes, keyed by the volume names.
+	// +optional
+	// +nullable
+	PVCNamespaces map[string]string `json:"pvcNamespaces"`
+	// The storage class of the created PVs and PVCs. The storage class of the backup volume or the default static storage class is used if empty.
+	// +optional
+	StorageClassName string `json:"storageClassName"`
+	// The filesystem type of the created PVs.
+	// +optional
+	FSType string `json:"fsType"`
+	// The frontend of the activated volumes.
+	// +optional
+	// +kubebuilder:validation:Enum=blockdev;iscsi
+	Frontend VolumeFrontend `json:"frontend"`
+	// In minutes. The maximum difference between the recovery points of the member volumes to fail over.
+	// 0 means the recovery points are not checked.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxRecoveryPointSkew int `json:"maxRecoveryPointSkew"`
+	// Fail over the plan by activating all member volumes and creating the PVs and PVCs.
+	// +optional
+	Failover bool `json:"failover"`
+	// Rehearse the failover without activating the volumes. The result is reported in the status.
+	// +optional
+	DryRun bool `json:"dryRun"`
+}
+
+// DisasterRecoveryPlanStatus defines the observed state of the Longhorn disaster recovery plan
+type DisasterRecoveryPlanStatus struct {
+	// +optional
+	OwnerID string `json:"ownerID"`
+	// +optional
+	State DisasterRecoveryPlanState `json:"state"`
+	// +optional
+	Message string `json:"message"`
+	// The earliest recovery point of the member volumes. The whole group can be recovered to this point.
+	// +optional
+	RecoveryPoint string `json:"recoveryPoint"`
+	// The difference between the latest and the earliest recovery points of the member volumes.
+	// +optional
+	RecoveryPointSkew string `json:"recoveryPointSkew"`
+	// +optional
+	FailoverStartedAt string `json:"failoverStartedAt"`
+	// +optional
+	FailoverCompletedAt string `json:"failoverCompletedAt"`
+	// +optional
+	// +nullable
+	Rehearsal *DisasterRecoveryPlanRehearsal `json:"rehearsal"`
+	// The observed states of the member volumes, keyed by the volume names.
+	// +optional
+	// +nullable
+	Members map[string]*DisasterRecoveryPlanMemberStatus `json:"members"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=lhdrp
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`,description="The state of the disaster recovery plan"
+// +kubebuilder:printcolumn:name="RecoveryPoint",type=string,JSONPath=`.status.recoveryPoint`,description="The point in time all member volumes can be recovered to"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// DisasterRecoveryPlan is where Longhorn stores disaster recovery plan object.
+type DisasterRecoveryPlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DisasterRecoveryPlanSpec   `json:"spec,omitempty"`
+	Status DisasterRecoveryPlanStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DisasterRecoveryPlanList is a list of disaster recovery plans.
+type DisasterRecoveryPlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DisasterRecoveryPlan `json:"items"`
+}
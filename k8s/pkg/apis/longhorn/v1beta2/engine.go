@@ -183,6 +183,10 @@ type EngineStatus struct {
 	// +optional
 	// +nullable
 	CloneStatus map[string]*SnapshotCloneStatus `json:"cloneStatus"`
+	// The clone bandwidth limit in MB/s applied to the engine for the ongoing clone. The replica rebuilding bandwidth
+	// limit is restored once the clone finishes.
+	// +optional
+	AppliedCloneBandwidthLimit int64 `json:"appliedCloneBandwidthLimit"`
 	// +optional
 	// +nullable
 	Snapshots map[string]*SnapshotInfo `json:"snapshots"`
@@ -201,6 +205,13 @@ type EngineStatus struct {
 	// +kubebuilder:validation:Type=string
 	// +optional
 	SnapshotMaxSize int64 `json:"snapshotMaxSize,string"`
+	// The last time a write to the volume was observed by the engine monitor. It is updated at most once a minute.
+	// +optional
+	LastWriteAt string `json:"lastWriteAt"`
+	// The cumulative IO counters of the engine. They are updated at most once a minute.
+	// +optional
+	// +nullable
+	IOStats *VolumeIOStats `json:"ioStats"`
 }
 
 // +genclient
@@ -16,6 +16,42 @@ const (
 	EngineImageConditionTypeReadyReasonBinary    = "binary"
 )
 
+type EngineImageAutoUpgradeRolloutPhase string
+
+const (
+	EngineImageAutoUpgradeRolloutPhaseCanary      = EngineImageAutoUpgradeRolloutPhase("canary")
+	EngineImageAutoUpgradeRolloutPhaseHealthGate  = EngineImageAutoUpgradeRolloutPhase("healthGate")
+	EngineImageAutoUpgradeRolloutPhaseProgressing = EngineImageAutoUpgradeRolloutPhase("progressing")
+	EngineImageAutoUpgradeRolloutPhasePaused      = EngineImageAutoUpgradeRolloutPhase("paused")
+	EngineImageAutoUpgradeRolloutPhaseRollingBack = EngineImageAutoUpgradeRolloutPhase("rollingBack")
+	EngineImageAutoUpgradeRolloutPhaseRolledBack  = EngineImageAutoUpgradeRolloutPhase("rolledBack")
+	EngineImageAutoUpgradeRolloutPhaseCompleted   = EngineImageAutoUpgradeRolloutPhase("completed")
+)
+
+// EngineImageAutoUpgradeRollout records the progress of the staged automatic upgrade of volumes to the default engine image.
+type EngineImageAutoUpgradeRollout struct {
+	// +optional
+	Phase EngineImageAutoUpgradeRolloutPhase `json:"phase"`
+	// The canary volume selector used by the rollout.
+	// +optional
+	CanaryVolumeSelector string `json:"canaryVolumeSelector"`
+	// The number of the progressive waves started after the canary stage.
+	// +optional
+	Wave int `json:"wave"`
+	// The volumes upgraded in the current stage and their previous engine images. The volumes upgraded in the previous
+	// stages passed the health gate and are not tracked anymore.
+	// +optional
+	// +nullable
+	UpgradedVolumes map[string]string `json:"upgradedVolumes"`
+	// The number of the volumes upgraded by the rollout.
+	// +optional
+	UpgradedVolumeCount int `json:"upgradedVolumeCount"`
+	// +optional
+	HealthGateStartedAt string `json:"healthGateStartedAt"`
+	// +optional
+	Message string `json:"message"`
+}
+
 type EngineVersionDetails struct {
 	// +optional
 	Version string `json:"version"`
@@ -60,7 +96,10 @@ type EngineImageStatus struct {
 	Conditions []Condition `json:"conditions"`
 	// +optional
 	// +nullable
-	NodeDeploymentMap    map[string]bool `json:"nodeDeploymentMap"`
+	NodeDeploymentMap map[string]bool `json:"nodeDeploymentMap"`
+	// +optional
+	// +nullable
+	AutoUpgradeRollout   *EngineImageAutoUpgradeRollout `json:"autoUpgradeRollout"`
 	EngineVersionDetails `json:""`
 }
 
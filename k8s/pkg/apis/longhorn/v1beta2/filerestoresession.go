@@ -0,0 +1,94 @@
+package v1beta2
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type FileRestoreSessionState string
+
+const (
+	// FileRestoreSessionStatePending means the temporary volume is not created yet.
+	FileRestoreSessionStatePending = FileRestoreSessionState("pending")
+	// FileRestoreSessionStateRestoring means the backup or the snapshot is being restored into the temporary volume.
+	FileRestoreSessionStateRestoring = FileRestoreSessionState("restoring")
+	// FileRestoreSessionStateAttaching means the temporary volume is being attached to the node of the session.
+	FileRestoreSessionStateAttaching = FileRestoreSessionState("attaching")
+	// FileRestoreSessionStateStarting means the file server pod is starting.
+	FileRestoreSessionStateStarting = FileRestoreSessionState("starting")
+	// FileRestoreSessionStateReady means the files can be browsed and downloaded.
+	FileRestoreSessionStateReady = FileRestoreSessionState("ready")
+	// FileRestoreSessionStateError means the session failed. It is cleaned up after the TTL.
+	FileRestoreSessionStateError = FileRestoreSessionState("error")
+)
+
+// FileRestoreSessionSpec defines the desired state of the Longhorn file restore session
+type FileRestoreSessionSpec struct {
+	// The backup to browse. Either the backup or the snapshot is required.
+	// +optional
+	BackupName string `json:"backupName"`
+	// The volume of the snapshot to browse.
+	// +optional
+	SourceVolume string `json:"sourceVolume"`
+	// The snapshot to browse.
+	// +optional
+	SnapshotName string `json:"snapshotName"`
+	// The node the temporary volume is attached to and the file server runs on.
+	// The node owning the session is used if empty.
+	// +optional
+	NodeID string `json:"nodeID"`
+	// In minutes. The session and the temporary volume are deleted once the session is older than the TTL.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TTLMinutes int `json:"ttlMinutes"`
+}
+
+// FileRestoreSessionStatus defines the observed state of the Longhorn file restore session
+type FileRestoreSessionStatus struct {
+	// +optional
+	OwnerID string `json:"ownerID"`
+	// +optional
+	State FileRestoreSessionState `json:"state"`
+	// The temporary volume the backup or the snapshot is restored into.
+	// +optional
+	VolumeName string `json:"volumeName"`
+	// The node the temporary volume is attached to.
+	// +optional
+	NodeID string `json:"nodeID"`
+	// The pod serving the files of the temporary volume.
+	// +optional
+	PodName string `json:"podName"`
+	// The address of the file server.
+	// +optional
+	Endpoint string `json:"endpoint"`
+	// +optional
+	ExpiresAt string `json:"expiresAt"`
+	// +optional
+	Message string `json:"message"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=lhfrs
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`,description="The state of the file restore session"
+// +kubebuilder:printcolumn:name="Backup",type=string,JSONPath=`.spec.backupName`,description="The backup to browse"
+// +kubebuilder:printcolumn:name="Snapshot",type=string,JSONPath=`.spec.snapshotName`,description="The snapshot to browse"
+// +kubebuilder:printcolumn:name="ExpiresAt",type=string,JSONPath=`.status.expiresAt`,description="The time the session is cleaned up"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// FileRestoreSession is where Longhorn stores file restore session object.
+type FileRestoreSession struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FileRestoreSessionSpec   `json:"spec,omitempty"`
+	Status FileRestoreSessionStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FileRestoreSessionList is a list of file restore sessions.
+type FileRestoreSessionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FileRestoreSession `json:"items"`
+}
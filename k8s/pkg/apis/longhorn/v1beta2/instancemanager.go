@@ -209,6 +209,10 @@ type InstanceManagerStatus struct {
 	ProxyAPIVersion int `json:"proxyApiVersion"`
 	// +optional
 	DataEngineStatus DataEngineStatus `json:"dataEngineStatus"`
+	// The resource requests recommended from the usage history of the instance manager.
+	// +optional
+	// +nullable
+	ResourceRecommendation *InstanceManagerResourceRecommendation `json:"resourceRecommendation"`
 
 	// Deprecated: Replaced by InstanceEngines and InstanceReplicas
 	// +optional
@@ -216,6 +220,36 @@ type InstanceManagerStatus struct {
 	Instances map[string]InstanceProcess `json:"instances,omitempty"`
 }
 
+// InstanceManagerResourceRecommendation is the resource requests recommended for the instance manager pod
+type InstanceManagerResourceRecommendation struct {
+	// The recommended CPU request in millicpu.
+	// +optional
+	CPURequest int64 `json:"cpuRequest"`
+	// The recommended memory request in bytes.
+	// +optional
+	MemoryRequest int64 `json:"memoryRequest"`
+	// Whether the recommended requests are capped to the allocatable resources of the node.
+	// +optional
+	Capped bool `json:"capped"`
+	// The peak CPU usage in millicpu observed in the history.
+	// +optional
+	PeakCPUUsage int64 `json:"peakCPUUsage"`
+	// The peak memory usage in bytes observed in the history.
+	// +optional
+	PeakMemoryUsage int64 `json:"peakMemoryUsage"`
+	// The number of the engines in the instance manager when the recommendation is made.
+	// +optional
+	EngineCount int `json:"engineCount"`
+	// The number of the replicas in the instance manager when the recommendation is made.
+	// +optional
+	ReplicaCount int `json:"replicaCount"`
+	// The number of the usage samples used by the recommendation.
+	// +optional
+	SampleCount int `json:"sampleCount"`
+	// +optional
+	LastUpdatedAt string `json:"lastUpdatedAt"`
+}
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:resource:shortName=lhim
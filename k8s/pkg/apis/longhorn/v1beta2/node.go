@@ -11,33 +11,37 @@ const (
 	NodeConditionTypeNFSClientInstalled  = "NFSClientInstalled"
 	NodeConditionTypeSchedulable         = "Schedulable"
 	NodeConditionTypeHugePagesAvailable  = "HugePagesAvailable"
+	NodeConditionTypeCapacitySufficient  = "CapacitySufficient"
 )
 
 const (
-	NodeConditionReasonManagerPodDown            = "ManagerPodDown"
-	NodeConditionReasonManagerPodMissing         = "ManagerPodMissing"
-	NodeConditionReasonKubernetesNodeGone        = "KubernetesNodeGone"
-	NodeConditionReasonKubernetesNodeNotReady    = "KubernetesNodeNotReady"
-	NodeConditionReasonKubernetesNodePressure    = "KubernetesNodePressure"
-	NodeConditionReasonUnknownNodeConditionTrue  = "UnknownNodeConditionTrue"
-	NodeConditionReasonNoMountPropagationSupport = "NoMountPropagationSupport"
-	NodeConditionReasonMultipathdIsRunning       = "MultipathdIsRunning"
-	NodeConditionReasonUnknownOS                 = "UnknownOS"
-	NodeConditionReasonNamespaceExecutorErr      = "NamespaceExecutorErr"
-	NodeConditionReasonKernelModulesNotLoaded    = "KernelModulesNotLoaded"
-	NodeConditionReasonPackagesNotInstalled      = "PackagesNotInstalled"
-	NodeConditionReasonCheckKernelConfigFailed   = "CheckKernelConfigFailed"
-	NodeConditionReasonNFSClientIsNotFound       = "NFSClientIsNotFound"
-	NodeConditionReasonNFSClientIsMisconfigured  = "NFSClientIsMisconfigured"
-	NodeConditionReasonKubernetesNodeCordoned    = "KubernetesNodeCordoned"
-	NodeConditionReasonHugePagesNotConfigured    = "HugePagesNotConfigured"
-	NodeConditionReasonInsufficientHugePages     = "InsufficientHugePages"
+	NodeConditionReasonManagerPodDown                = "ManagerPodDown"
+	NodeConditionReasonManagerPodMissing             = "ManagerPodMissing"
+	NodeConditionReasonKubernetesNodeGone            = "KubernetesNodeGone"
+	NodeConditionReasonKubernetesNodeNotReady        = "KubernetesNodeNotReady"
+	NodeConditionReasonKubernetesNodePressure        = "KubernetesNodePressure"
+	NodeConditionReasonUnknownNodeConditionTrue      = "UnknownNodeConditionTrue"
+	NodeConditionReasonNoMountPropagationSupport     = "NoMountPropagationSupport"
+	NodeConditionReasonMultipathdIsRunning           = "MultipathdIsRunning"
+	NodeConditionReasonUnknownOS                     = "UnknownOS"
+	NodeConditionReasonNamespaceExecutorErr          = "NamespaceExecutorErr"
+	NodeConditionReasonKernelModulesNotLoaded        = "KernelModulesNotLoaded"
+	NodeConditionReasonPackagesNotInstalled          = "PackagesNotInstalled"
+	NodeConditionReasonCheckKernelConfigFailed       = "CheckKernelConfigFailed"
+	NodeConditionReasonNFSClientIsNotFound           = "NFSClientIsNotFound"
+	NodeConditionReasonNFSClientIsMisconfigured      = "NFSClientIsMisconfigured"
+	NodeConditionReasonKubernetesNodeCordoned        = "KubernetesNodeCordoned"
+	NodeConditionReasonHugePagesNotConfigured        = "HugePagesNotConfigured"
+	NodeConditionReasonInsufficientHugePages         = "InsufficientHugePages"
+	NodeConditionReasonDiskFullForecast              = "DiskFullForecast"
+	NodeConditionReasonOverProvisioningLimitForecast = "OverProvisioningLimitForecast"
 )
 
 const (
 	DiskConditionTypeSchedulable = "Schedulable"
 	DiskConditionTypeReady       = "Ready"
 	DiskConditionTypeError       = "Error"
+	DiskConditionTypeHealthy     = "Healthy"
 )
 
 const (
@@ -46,6 +50,10 @@ const (
 	DiskConditionReasonNoDiskInfo             = "NoDiskInfo"
 	DiskConditionReasonDiskNotReady           = "DiskNotReady"
 	DiskConditionReasonDiskServiceUnreachable = "DiskServiceUnreachable"
+	DiskConditionReasonSMARTFailed            = "SMARTFailed"
+	DiskConditionReasonIOErrors               = "IOErrors"
+	DiskConditionReasonIOLatencyOutlier       = "IOLatencyOutlier"
+	DiskConditionReasonNoDiskHealthInfo       = "NoDiskHealthInfo"
 )
 
 const (
@@ -62,6 +70,10 @@ const (
 	ErrorReplicaScheduleReplicaAlreadyScheduled           = "replica already scheduled"
 	ErrorReplicaScheduleLonghornClientOperationFailed     = "longhorn client operation failed"
 	ErrorReplicaScheduleIncompatibleVolumeSize            = "incompatible volume size"
+	ErrorReplicaScheduleDiskUnhealthy                     = "disk is unhealthy"
+	ErrorReplicaScheduleDiskReplicaCountLimitReached      = "disk replica count limit reached"
+	ErrorReplicaScheduleNodeReplicaCountLimitReached      = "node replica count limit reached"
+	ErrorReplicaScheduleNodeRebuildingReplicaLimitReached = "node rebuilding replica count limit reached"
 )
 
 type DiskType string
@@ -104,6 +116,10 @@ type DiskSpec struct {
 	StorageReserved int64 `json:"storageReserved"`
 	// +optional
 	Tags []string `json:"tags"`
+	// The maximum number of replicas on the disk. 0 means the setting replica-count-limit-per-disk is used.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxReplicaCount int `json:"maxReplicaCount"`
 }
 
 type DiskStatus struct {
@@ -122,6 +138,12 @@ type DiskStatus struct {
 	// +optional
 	// +nullable
 	ScheduledBackingImage map[string]int64 `json:"scheduledBackingImage"`
+	// The number of the replicas scheduled to the disk.
+	// +optional
+	ReplicaCount int `json:"replicaCount"`
+	// The effective maximum number of replicas on the disk. 0 means unlimited.
+	// +optional
+	ReplicaCountLimit int `json:"replicaCountLimit"`
 	// +optional
 	DiskUUID string `json:"diskUUID"`
 	// +optional
@@ -136,6 +158,16 @@ type DiskStatus struct {
 	FSType string `json:"filesystemType"`
 	// +optional
 	InstanceManagerName string `json:"instanceManagerName"`
+	// The estimated time until the disk runs out of storage, fitted from the usage history of the disk.
+	// It is unavailable until the usage history has enough samples.
+	// +optional
+	// +nullable
+	CapacityForecast *CapacityForecast `json:"capacityForecast"`
+	// The hourly samples of the usage history of the disk. The usage history is restored from the samples when the
+	// Longhorn manager restarts or another Longhorn manager takes over the node.
+	// +optional
+	// +nullable
+	CapacityHistory *CapacityHistory `json:"capacityHistory"`
 }
 
 // NodeSpec defines the desired state of the Longhorn node
@@ -152,6 +184,11 @@ type NodeSpec struct {
 	Tags []string `json:"tags"`
 	// +optional
 	InstanceManagerCPURequest int `json:"instanceManagerCPURequest"`
+	// The overrides of the global settings for this node. Only some settings can be overridden per node.
+	// The overrides take precedence over the node setting overrides setting.
+	// +optional
+	// +nullable
+	SettingOverrides map[string]string `json:"settingOverrides"`
 }
 
 // NodeStatus defines the observed state of the Longhorn node
@@ -170,6 +207,94 @@ type NodeStatus struct {
 	SnapshotCheckStatus SnapshotCheckStatus `json:"snapshotCheckStatus"`
 	// +optional
 	AutoEvicting bool `json:"autoEvicting"`
+	// The block devices discovered by the disk provisioning policy.
+	// +optional
+	// +nullable
+	DiscoveredDevices []DiscoveredDevice `json:"discoveredDevices"`
+	// The estimated time until the disks of the node run out of storage in total.
+	// +optional
+	// +nullable
+	CapacityForecast *CapacityForecast `json:"capacityForecast"`
+}
+
+// DiscoveredDevice is a block device discovered on the node by the disk provisioning policy
+type DiscoveredDevice struct {
+	// +optional
+	Path string `json:"path"`
+	// +optional
+	Model string `json:"model"`
+	// +optional
+	Size int64 `json:"size"`
+	// +optional
+	Rotational bool `json:"rotational"`
+	// The name of the disk provisioning rule matching the device.
+	// +optional
+	Rule string `json:"rule"`
+	// The name of the disk provisioned from the device.
+	// +optional
+	DiskName string `json:"diskName"`
+	// Indicate whether the disk has been added to the node by the disk provisioning policy.
+	// +optional
+	Provisioned bool `json:"provisioned"`
+	// The reason why the device is not provisioned.
+	// +optional
+	RejectedReason string `json:"rejectedReason"`
+}
+
+// CapacityHistory is the usage history of a disk saved for the capacity forecast
+type CapacityHistory struct {
+	// The UUID of the disk the samples are taken from.
+	// +optional
+	DiskUUID string `json:"diskUUID"`
+	// The usage samples from the oldest to the newest.
+	// +optional
+	// +nullable
+	Samples []CapacitySample `json:"samples"`
+}
+
+// CapacitySample is the storage usage of a disk at a point in time
+type CapacitySample struct {
+	// +optional
+	Time string `json:"time"`
+	// The storage used on the disk, including the data not managed by Longhorn.
+	// +optional
+	Used int64 `json:"used"`
+	// The sum of the actual size of the volumes having replicas on the disk.
+	// +optional
+	ActualSize int64 `json:"actualSize"`
+	// The storage scheduled to the replicas and the backing images on the disk.
+	// +optional
+	Scheduled int64 `json:"scheduled"`
+}
+
+// CapacityForecast is the estimated time until the storage runs out, fitted by the linear growth trend of the usage history
+type CapacityForecast struct {
+	// The growth of the used storage in bytes per day.
+	// +optional
+	UsageGrowthPerDay int64 `json:"usageGrowthPerDay"`
+	// The growth of the actual size of the volumes having replicas on the disk in bytes per day.
+	// +optional
+	ActualSizeGrowthPerDay int64 `json:"actualSizeGrowthPerDay"`
+	// The growth of the scheduled storage in bytes per day.
+	// +optional
+	ScheduledGrowthPerDay int64 `json:"scheduledGrowthPerDay"`
+	// The storage remaining before the disk is full.
+	// +optional
+	StorageRemaining int64 `json:"storageRemaining"`
+	// The storage remaining before the scheduled storage reaches the over-provisioning limit.
+	// +optional
+	SchedulableRemaining int64 `json:"schedulableRemaining"`
+	// The estimated days until the disk is full. -1 means the usage is not growing.
+	// +optional
+	DaysUntilFull int64 `json:"daysUntilFull"`
+	// The estimated days until the scheduled storage reaches the over-provisioning limit. -1 means the scheduled storage is not growing.
+	// +optional
+	DaysUntilOverProvisioningLimit int64 `json:"daysUntilOverProvisioningLimit"`
+	// The number of the usage samples used by the forecast.
+	// +optional
+	SampleCount int `json:"sampleCount"`
+	// +optional
+	LastUpdatedAt string `json:"lastUpdatedAt"`
 }
 
 // +genclient
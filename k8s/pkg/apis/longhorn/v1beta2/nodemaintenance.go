@@ -0,0 +1,129 @@
+package v1beta2
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type NodeMaintenanceReplicaPolicy string
+
+const (
+	// NodeMaintenanceReplicaPolicyEvict copies the replicas to other nodes before removing them from the node.
+	NodeMaintenanceReplicaPolicyEvict = NodeMaintenanceReplicaPolicy("evict")
+	// NodeMaintenanceReplicaPolicyRebuild removes the replicas from the node and rebuilds them on other nodes from the remaining healthy replicas.
+	NodeMaintenanceReplicaPolicyRebuild = NodeMaintenanceReplicaPolicy("rebuild")
+)
+
+type NodeMaintenanceState string
+
+const (
+	NodeMaintenanceStateDraining = NodeMaintenanceState("draining")
+	NodeMaintenanceStateDrained  = NodeMaintenanceState("drained")
+	NodeMaintenanceStateAborted  = NodeMaintenanceState("aborted")
+	NodeMaintenanceStateTimedOut = NodeMaintenanceState("timedOut")
+	NodeMaintenanceStateError    = NodeMaintenanceState("error")
+)
+
+type NodeMaintenanceVolumeState string
+
+const (
+	NodeMaintenanceVolumeStateEvicting   = NodeMaintenanceVolumeState("evicting")
+	NodeMaintenanceVolumeStateRebuilding = NodeMaintenanceVolumeState("rebuilding")
+	NodeMaintenanceVolumeStateDetaching  = NodeMaintenanceVolumeState("detaching")
+	NodeMaintenanceVolumeStateBlocked    = NodeMaintenanceVolumeState("blocked")
+	NodeMaintenanceVolumeStateDrained    = NodeMaintenanceVolumeState("drained")
+)
+
+// NodeMaintenanceSpec defines the desired state of the Longhorn node maintenance
+type NodeMaintenanceSpec struct {
+	// The node to be drained.
+	// +optional
+	NodeID string `json:"nodeID"`
+	// How the replicas on the node are moved to other nodes.
+	// Can be "evict" or "rebuild".
+	// +optional
+	// +kubebuilder:validation:Enum=evict;rebuild
+	ReplicaPolicy NodeMaintenanceReplicaPolicy `json:"replicaPolicy"`
+	// Detach the volumes attached to the node manually by the Longhorn UI or API.
+	// The volumes are attached again when the maintenance ends.
+	// +optional
+	DetachManuallyAttachedVolumes bool `json:"detachManuallyAttachedVolumes"`
+	// In minutes. The maintenance is reverted if the node is not drained in time. 0 means no timeout.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Timeout int `json:"timeout"`
+	// Abort the maintenance and revert the changes made to the node and the volumes.
+	// +optional
+	Abort bool `json:"abort"`
+}
+
+// NodeMaintenanceVolumeStatus is the drain progress of a volume
+type NodeMaintenanceVolumeStatus struct {
+	// +optional
+	State NodeMaintenanceVolumeState `json:"state"`
+	// The number of the replicas of the volume remaining on the node.
+	// +optional
+	RemainingReplicas int `json:"remainingReplicas"`
+	// +optional
+	Message string `json:"message"`
+}
+
+// NodeMaintenanceDetachedTicket is a manual attachment ticket removed by the maintenance
+type NodeMaintenanceDetachedTicket struct {
+	// +optional
+	Volume string `json:"volume"`
+	// +optional
+	TicketID string `json:"ticketID"`
+	// +optional
+	// +nullable
+	Parameters map[string]string `json:"parameters"`
+}
+
+// NodeMaintenanceStatus defines the observed state of the Longhorn node maintenance
+type NodeMaintenanceStatus struct {
+	// +optional
+	OwnerID string `json:"ownerID"`
+	// +optional
+	State NodeMaintenanceState `json:"state"`
+	// +optional
+	StartedAt string `json:"startedAt"`
+	// +optional
+	Message string `json:"message"`
+	// The scheduling and eviction settings of the node before the maintenance, which are restored when the maintenance ends.
+	// +optional
+	OriginalAllowScheduling bool `json:"originalAllowScheduling"`
+	// +optional
+	OriginalEvictionRequested bool `json:"originalEvictionRequested"`
+	// +optional
+	// +nullable
+	DetachedTickets []NodeMaintenanceDetachedTicket `json:"detachedTickets"`
+	// The drain progress of the volumes having replicas on the node or manually attached to the node.
+	// +optional
+	// +nullable
+	Volumes map[string]*NodeMaintenanceVolumeStatus `json:"volumes"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=lhnm
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Node",type=string,JSONPath=`.spec.nodeID`,description="The node under maintenance"
+// +kubebuilder:printcolumn:name="ReplicaPolicy",type=string,JSONPath=`.spec.replicaPolicy`,description="How the replicas are moved off the node"
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`,description="The state of the node maintenance"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// NodeMaintenance is where Longhorn stores node maintenance object.
+type NodeMaintenance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeMaintenanceSpec   `json:"spec,omitempty"`
+	Status NodeMaintenanceStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NodeMaintenanceList is a list of node maintenances.
+type NodeMaintenanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeMaintenance `json:"items"`
+}
@@ -0,0 +1,140 @@
+package v1beta2
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type NotificationSinkType string
+
+const (
+	// NotificationSinkTypeWebhook posts the notifications as JSON to a generic HTTP endpoint.
+	NotificationSinkTypeWebhook = NotificationSinkType("webhook")
+	// NotificationSinkTypeAlertmanager posts the notifications as alerts to the Alertmanager API v2.
+	NotificationSinkTypeAlertmanager = NotificationSinkType("alertmanager")
+	// NotificationSinkTypeSMTP mails the notifications through an SMTP server, for example a local relay.
+	NotificationSinkTypeSMTP = NotificationSinkType("smtp")
+)
+
+type NotificationEventClass string
+
+const (
+	NotificationEventClassVolumeDegraded     = NotificationEventClass("volumeDegraded")
+	NotificationEventClassVolumeFaulted      = NotificationEventClass("volumeFaulted")
+	NotificationEventClassBackupFailed       = NotificationEventClass("backupFailed")
+	NotificationEventClassDiskUnschedulable  = NotificationEventClass("diskUnschedulable")
+	NotificationEventClassReplicaCorruption  = NotificationEventClass("replicaCorruption")
+	NotificationEventClassSystemBackupFailed = NotificationEventClass("systemBackupFailed")
+)
+
+type NotificationSinkState string
+
+const (
+	NotificationSinkStateReady = NotificationSinkState("ready")
+	NotificationSinkStateError = NotificationSinkState("error")
+)
+
+// NotificationSinkSMTP is the SMTP server and the mail addresses used by the smtp sink
+type NotificationSinkSMTP struct {
+	// The address of the SMTP server in the form of host:port.
+	// +optional
+	Server string `json:"server"`
+	// +optional
+	From string `json:"from"`
+	// +optional
+	// +nullable
+	To []string `json:"to"`
+}
+
+// NotificationSinkFilter limits the notifications sent to the sink
+type NotificationSinkFilter struct {
+	// Only notify the events of the resources whose names match the regular expression.
+	// +optional
+	NamePattern string `json:"namePattern"`
+	// Only notify the volume, backup and replica corruption events of the volumes used by the workloads in the namespaces.
+	// +optional
+	// +nullable
+	KubernetesNamespaces []string `json:"kubernetesNamespaces"`
+}
+
+// NotificationSinkSpec defines the desired state of the Longhorn notification sink
+type NotificationSinkSpec struct {
+	// Can be "webhook", "alertmanager" or "smtp".
+	// +optional
+	// +kubebuilder:validation:Enum=webhook;alertmanager;smtp
+	Type NotificationSinkType `json:"type"`
+	// The URL of the webhook or the Alertmanager.
+	// +optional
+	URL string `json:"url"`
+	// The secret in the Longhorn namespace holding the credentials of the sink.
+	// The key "token" is used as the bearer token, and the keys "username" and "password" are used for the basic or SMTP authentication.
+	// +optional
+	CredentialSecret string `json:"credentialSecret"`
+	// +optional
+	// +nullable
+	SMTP *NotificationSinkSMTP `json:"smtp"`
+	// The event classes subscribed by the sink. All classes are subscribed if empty.
+	// +optional
+	// +nullable
+	EventClasses []NotificationEventClass `json:"eventClasses"`
+	// +optional
+	Filter NotificationSinkFilter `json:"filter"`
+	// In minutes. The same event of the same resource is sent once in the window. 0 disables the deduplication.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	DeduplicationWindow int `json:"deduplicationWindow"`
+	// The maximum number of the notifications sent per hour. 0 means unlimited.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RateLimit int `json:"rateLimit"`
+}
+
+// NotificationSinkStatus defines the observed state of the Longhorn notification sink
+type NotificationSinkStatus struct {
+	// +optional
+	OwnerID string `json:"ownerID"`
+	// +optional
+	State NotificationSinkState `json:"state"`
+	// +optional
+	Message string `json:"message"`
+	// +optional
+	LastSentAt string `json:"lastSentAt"`
+	// +optional
+	LastError string `json:"lastError"`
+	// +optional
+	SentCount int64 `json:"sentCount"`
+	// The number of the notifications dropped since they keep failing to be sent and the retry queue is full.
+	// +optional
+	FailedCount int64 `json:"failedCount"`
+	// The number of the notifications dropped by the deduplication.
+	// +optional
+	DeduplicatedCount int64 `json:"deduplicatedCount"`
+	// The number of the notifications dropped by the rate limit.
+	// +optional
+	RateLimitedCount int64 `json:"rateLimitedCount"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=lhns
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`,description="The type of the notification sink"
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`,description="The state of the notification sink"
+// +kubebuilder:printcolumn:name="Sent",type=integer,JSONPath=`.status.sentCount`,description="The number of the notifications sent"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// NotificationSink is where Longhorn stores notification sink object.
+type NotificationSink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NotificationSinkSpec   `json:"spec,omitempty"`
+	Status NotificationSinkStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NotificationSinkList is a list of notification sinks.
+type NotificationSinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NotificationSink `json:"items"`
+}
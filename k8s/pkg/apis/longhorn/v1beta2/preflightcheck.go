@@ -0,0 +1,104 @@
+package v1beta2
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type PreflightCheckResult string
+
+const (
+	PreflightCheckResultPass = PreflightCheckResult("pass")
+	PreflightCheckResultWarn = PreflightCheckResult("warn")
+	PreflightCheckResultFail = PreflightCheckResult("fail")
+)
+
+type PreflightCheckState string
+
+const (
+	PreflightCheckStateRunning   = PreflightCheckState("running")
+	PreflightCheckStateCompleted = PreflightCheckState("completed")
+)
+
+// PreflightCheckItem is the result of a check on a node
+type PreflightCheckItem struct {
+	// +optional
+	Name string `json:"name"`
+	// +optional
+	Result PreflightCheckResult `json:"result"`
+	// +optional
+	Message string `json:"message"`
+}
+
+// PreflightNodeReport is the results of the checks on a node
+type PreflightNodeReport struct {
+	// The worst result of the checks. Empty if the node is not checked yet.
+	// +optional
+	Result PreflightCheckResult `json:"result"`
+	// +optional
+	// +nullable
+	Checks []PreflightCheckItem `json:"checks"`
+	// +optional
+	CheckedAt string `json:"checkedAt"`
+}
+
+// PreflightCheckSpec defines the desired state of the Longhorn preflight check
+type PreflightCheckSpec struct {
+	// The nodes to be checked. All Longhorn nodes are checked if empty.
+	// +optional
+	// +nullable
+	Nodes []string `json:"nodes"`
+	// Check the requirements of the v2 data engine even if the v2 data engine is not enabled.
+	// +optional
+	V2DataEngine bool `json:"v2DataEngine"`
+	// The paths checked for writability in addition to the filesystem disks of the nodes, for example the disks to be added.
+	// +optional
+	// +nullable
+	DiskPaths []string `json:"diskPaths"`
+	// The root directory of kubelet, where the CSI sockets are located.
+	// +optional
+	KubeletRootDir string `json:"kubeletRootDir"`
+}
+
+// PreflightCheckStatus defines the observed state of the Longhorn preflight check
+type PreflightCheckStatus struct {
+	// +optional
+	OwnerID string `json:"ownerID"`
+	// +optional
+	State PreflightCheckState `json:"state"`
+	// The worst result of the nodes.
+	// +optional
+	Result PreflightCheckResult `json:"result"`
+	// +optional
+	StartedAt string `json:"startedAt"`
+	// +optional
+	CompletedAt string `json:"completedAt"`
+	// The reports of the checked nodes.
+	// +optional
+	// +nullable
+	Nodes map[string]*PreflightNodeReport `json:"nodes"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=lhpc
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`,description="The state of the preflight check"
+// +kubebuilder:printcolumn:name="Result",type=string,JSONPath=`.status.result`,description="The worst result of the checked nodes"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// PreflightCheck is where Longhorn stores preflight check object.
+type PreflightCheck struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PreflightCheckSpec   `json:"spec,omitempty"`
+	Status PreflightCheckStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PreflightCheckList is a list of preflight checks.
+type PreflightCheckList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PreflightCheck `json:"items"`
+}
@@ -35,22 +35,38 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&BackupTargetList{},
 		&BackupVolume{},
 		&BackupVolumeList{},
+		&DataEngineConversion{},
+		&DataEngineConversionList{},
+		&DisasterRecoveryPlan{},
+		&DisasterRecoveryPlanList{},
 		&Engine{},
 		&EngineList{},
 		&EngineImage{},
 		&EngineImageList{},
+		&FileRestoreSession{},
+		&FileRestoreSessionList{},
 		&InstanceManager{},
 		&InstanceManagerList{},
 		&Node{},
 		&NodeList{},
+		&NodeMaintenance{},
+		&NodeMaintenanceList{},
+		&NotificationSink{},
+		&NotificationSinkList{},
 		&Orphan{},
 		&OrphanList{},
+		&PreflightCheck{},
+		&PreflightCheckList{},
 		&RecurringJob{},
 		&RecurringJobList{},
 		&Replica{},
 		&ReplicaList{},
 		&Setting{},
 		&SettingList{},
+		&SettingHistory{},
+		&SettingHistoryList{},
+		&ScrubReport{},
+		&ScrubReportList{},
 		&ShareManager{},
 		&ShareManagerList{},
 		&Snapshot{},
@@ -65,6 +81,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&VolumeList{},
 		&VolumeAttachment{},
 		&VolumeAttachmentList{},
+		&VolumeShrink{},
+		&VolumeShrinkList{},
+		&VolumeTransfer{},
+		&VolumeTransferList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
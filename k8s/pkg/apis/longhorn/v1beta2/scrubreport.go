@@ -0,0 +1,136 @@
+package v1beta2
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type ScrubReportState string
+
+const (
+	ScrubReportStateInProgress = ScrubReportState("InProgress")
+	ScrubReportStateCompleted  = ScrubReportState("Completed")
+	ScrubReportStateAborted    = ScrubReportState("Aborted")
+)
+
+type ScrubVerdict string
+
+const (
+	// ScrubVerdictHealthy indicates the checksum matches the determined checksum of the snapshot.
+	ScrubVerdictHealthy = ScrubVerdict("Healthy")
+	// ScrubVerdictCorrupted indicates the checksum disagrees with the determined checksum of the snapshot.
+	ScrubVerdictCorrupted = ScrubVerdict("Corrupted")
+	// ScrubVerdictUndetermined indicates the checksum of the snapshot cannot be determined by the replicas.
+	ScrubVerdictUndetermined = ScrubVerdict("Undetermined")
+	// ScrubVerdictSkipped indicates the snapshot is not checked, for example, the volume has less than 2 healthy replicas.
+	ScrubVerdictSkipped = ScrubVerdict("Skipped")
+	// ScrubVerdictError indicates the snapshot check failed.
+	ScrubVerdictError = ScrubVerdict("Error")
+)
+
+type ScrubRepairAction string
+
+const (
+	ScrubRepairActionNone = ScrubRepairAction("")
+	// ScrubRepairActionRebuilding indicates the corrupted replica is marked as failed and will be rebuilt.
+	ScrubRepairActionRebuilding = ScrubRepairAction("Rebuilding")
+	// ScrubRepairActionDeferred indicates the repair of the corrupted replica is postponed by the repair policy.
+	ScrubRepairActionDeferred = ScrubRepairAction("Deferred")
+	// ScrubRepairActionFailed indicates the corrupted replica cannot be marked as failed.
+	ScrubRepairActionFailed = ScrubRepairAction("Failed")
+)
+
+// ScrubReplicaResult is the check result of a snapshot on a replica
+type ScrubReplicaResult struct {
+	// +optional
+	ReplicaName string `json:"replicaName"`
+	// +optional
+	Address string `json:"address"`
+	// +optional
+	Checksum string `json:"checksum"`
+	// +optional
+	SilentlyCorrupted bool `json:"silentlyCorrupted"`
+	// +optional
+	Verdict ScrubVerdict `json:"verdict"`
+	// +optional
+	RepairAction ScrubRepairAction `json:"repairAction"`
+	// +optional
+	Message string `json:"message"`
+}
+
+// ScrubSnapshotResult is the check result of a snapshot
+type ScrubSnapshotResult struct {
+	// +optional
+	SnapshotName string `json:"snapshotName"`
+	// The checksum determined by the votes of the replicas.
+	// +optional
+	Checksum string `json:"checksum"`
+	// +optional
+	Verdict ScrubVerdict `json:"verdict"`
+	// +optional
+	Message string `json:"message"`
+	// +optional
+	CheckedAt string `json:"checkedAt"`
+	// +optional
+	// +nullable
+	Replicas []ScrubReplicaResult `json:"replicas"`
+}
+
+// ScrubReportSpec defines the desired state of the Longhorn scrub report
+type ScrubReportSpec struct {
+	// The volume being scrubbed.
+	// +optional
+	VolumeName string `json:"volumeName"`
+	// The snapshots to be checked in this run.
+	// +optional
+	// +nullable
+	Snapshots []string `json:"snapshots"`
+}
+
+// ScrubReportStatus defines the observed state of the Longhorn scrub report
+type ScrubReportStatus struct {
+	// The node which runs the scrub.
+	// +optional
+	OwnerID string `json:"ownerID"`
+	// +optional
+	State ScrubReportState `json:"state"`
+	// +optional
+	StartedAt string `json:"startedAt"`
+	// +optional
+	CompletedAt string `json:"completedAt"`
+	// +optional
+	// +nullable
+	Snapshots []ScrubSnapshotResult `json:"snapshots"`
+	// +optional
+	CorruptedSnapshotCount int `json:"corruptedSnapshotCount"`
+	// +optional
+	CorruptedReplicaCount int `json:"corruptedReplicaCount"`
+	// +optional
+	RepairedReplicaCount int `json:"repairedReplicaCount"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=lhsr
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Volume",type=string,JSONPath=`.spec.volumeName`,description="The volume being scrubbed"
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`,description="The state of the scrub"
+// +kubebuilder:printcolumn:name="Corrupted Snapshots",type=integer,JSONPath=`.status.corruptedSnapshotCount`,description="The number of corrupted snapshots"
+// +kubebuilder:printcolumn:name="Corrupted Replicas",type=integer,JSONPath=`.status.corruptedReplicaCount`,description="The number of corrupted replicas"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ScrubReport is where Longhorn stores the result of a snapshot data integrity check run of a volume.
+type ScrubReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScrubReportSpec   `json:"spec,omitempty"`
+	Status ScrubReportStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ScrubReportList is a list of ScrubReports.
+type ScrubReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScrubReport `json:"items"`
+}
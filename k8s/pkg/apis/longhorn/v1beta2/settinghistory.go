@@ -0,0 +1,81 @@
+package v1beta2
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type SettingUpdateSource string
+
+const (
+	// SettingUpdateSourceAPI indicates the setting is updated through the Longhorn REST API.
+	SettingUpdateSourceAPI = SettingUpdateSource("api")
+	// SettingUpdateSourceKubernetes indicates the setting CR is updated directly through the Kubernetes API.
+	SettingUpdateSourceKubernetes = SettingUpdateSource("kubernetes")
+	// SettingUpdateSourceLonghorn indicates the setting is updated by Longhorn itself, for example, during upgrade.
+	SettingUpdateSourceLonghorn = SettingUpdateSource("longhorn")
+)
+
+// SettingRevision records a single value change of a Longhorn setting
+type SettingRevision struct {
+	// The revision number. It increases monotonically for each change of the setting.
+	Revision int64 `json:"revision"`
+	// The setting value before the change.
+	// +optional
+	OldValue string `json:"oldValue"`
+	// The setting value after the change.
+	// +optional
+	NewValue string `json:"newValue"`
+	// The user who requested the change.
+	// +optional
+	Requester string `json:"requester"`
+	// The way the change was made.
+	// Can be "api", "kubernetes" or "longhorn".
+	// +optional
+	Source SettingUpdateSource `json:"source"`
+	// The time the change was made.
+	// +optional
+	UpdatedAt string `json:"updatedAt"`
+}
+
+// SettingHistorySpec defines the desired state of the Longhorn setting history
+type SettingHistorySpec struct {
+	// The name of the setting the history belongs to.
+	// +optional
+	SettingName string `json:"settingName"`
+}
+
+// SettingHistoryStatus defines the observed state of the Longhorn setting history
+type SettingHistoryStatus struct {
+	// The latest revision number of the setting.
+	// +optional
+	CurrentRevision int64 `json:"currentRevision"`
+	// The retained revisions of the setting, from the oldest to the newest.
+	// +optional
+	// +nullable
+	Revisions []SettingRevision `json:"revisions"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=lhsh
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Setting",type=string,JSONPath=`.spec.settingName`,description="The setting of the history"
+// +kubebuilder:printcolumn:name="Revision",type=integer,JSONPath=`.status.currentRevision`,description="The latest revision of the setting"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// SettingHistory is where Longhorn stores the change history of a setting.
+type SettingHistory struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SettingHistorySpec   `json:"spec,omitempty"`
+	Status SettingHistoryStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SettingHistoryList is a list of SettingHistories.
+type SettingHistoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SettingHistory `json:"items"`
+}
@@ -36,6 +36,47 @@ type SupportBundleSpec struct {
 	IssueURL string `json:"issueURL"`
 	// A brief description of the issue
 	Description string `json:"description"`
+	// The scope limits the content of the support bundle.
+	// +optional
+	Scope SupportBundleScope `json:"scope"`
+	// The redaction policy is applied to the support bundle before it is downloaded.
+	// +optional
+	RedactionPolicy SupportBundleRedactionPolicy `json:"redactionPolicy"`
+}
+
+// SupportBundleScope limits the content of the support bundle
+type SupportBundleScope struct {
+	// The volumes to be included. All volumes are included if empty.
+	// +optional
+	// +nullable
+	VolumeNames []string `json:"volumeNames"`
+	// The nodes to be included. All nodes are included if empty.
+	// +optional
+	// +nullable
+	NodeNames []string `json:"nodeNames"`
+	// The start of the time window of the collected logs, in RFC3339 format.
+	// +optional
+	Since string `json:"since"`
+	// The end of the time window of the collected logs, in RFC3339 format.
+	// +optional
+	Until string `json:"until"`
+}
+
+// SupportBundleRedactionPolicy specifies the sensitive data scrubbed from the support bundle. If any data is scrubbed,
+// the binary files, including the compressed files and the nested archives, are dropped from the support bundle.
+type SupportBundleRedactionPolicy struct {
+	// Scrub the data of the Kubernetes secrets.
+	// +optional
+	Secrets bool `json:"secrets"`
+	// Scrub the credentials of the backup targets.
+	// +optional
+	BackupTargetCredentials bool `json:"backupTargetCredentials"`
+	// Replace the IPv4 and IPv6 addresses with placeholders.
+	// +optional
+	IPAddresses bool `json:"ipAddresses"`
+	// Replace the node hostnames with placeholders.
+	// +optional
+	Hostnames bool `json:"hostnames"`
 }
 
 // SupportBundleStatus defines the observed state of the Longhorn SupportBundle
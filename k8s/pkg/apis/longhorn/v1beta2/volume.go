@@ -89,6 +89,168 @@ const (
 	VolumeOfflineRebuildingIgnored  = VolumeOfflineRebuilding("ignored")
 )
 
+type VolumeTieringTrigger string
+
+const (
+	VolumeTieringTriggerOnDemand = VolumeTieringTrigger("onDemand")
+	VolumeTieringTriggerSchedule = VolumeTieringTrigger("schedule")
+	VolumeTieringTriggerAge      = VolumeTieringTrigger("age")
+	VolumeTieringTriggerIdle     = VolumeTieringTrigger("idle")
+)
+
+type VolumeTieringState string
+
+const (
+	VolumeTieringStateMigrating = VolumeTieringState("migrating")
+	VolumeTieringStateCompleted = VolumeTieringState("completed")
+	VolumeTieringStateCanceled  = VolumeTieringState("canceled")
+)
+
+// VolumeTieringPolicy moves the replicas of the volume to the disks matching another disk selector
+type VolumeTieringPolicy struct {
+	// The disk tags of the tier the replicas are moved to.
+	// +optional
+	DiskSelector []string `json:"diskSelector"`
+	// When the replicas are moved. Can be "onDemand", "schedule", "age" or "idle".
+	// - onDemand: Move the replicas once the policy is applied.
+	// - schedule: Move the replicas at the time of the cron schedule.
+	// - age: Move the replicas once the volume is older than the threshold.
+	// - idle: Move the replicas once the volume has been detached longer than the threshold.
+	// +kubebuilder:validation:Enum=onDemand;schedule;age;idle
+	// +optional
+	Trigger VolumeTieringTrigger `json:"trigger"`
+	// The cron schedule of the trigger "schedule".
+	// +optional
+	Schedule string `json:"schedule"`
+	// In hours. The threshold of the trigger "age" and "idle".
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ThresholdHours int `json:"thresholdHours"`
+	// In megabytes per second. The replica rebuilding bandwidth limit applied to the volume while moving the replicas.
+	// 0 means the limit of the volume is kept. Only the data engine v2 supports the limit.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BandwidthLimit int64 `json:"bandwidthLimit"`
+}
+
+type VolumeTieringStatus struct {
+	// +optional
+	State VolumeTieringState `json:"state"`
+	// The disk selector of the tier the replicas are moved to.
+	// +optional
+	DiskSelector []string `json:"diskSelector"`
+	// The disk selector of the volume before the replicas are moved, which is restored if the migration is cancelled.
+	// +optional
+	OriginalDiskSelector []string `json:"originalDiskSelector"`
+	// The replica rebuilding bandwidth limit of the volume before the replicas are moved.
+	// +optional
+	OriginalReplicaRebuildingBandwidthLimit int64 `json:"originalReplicaRebuildingBandwidthLimit"`
+	// The replica being moved to the tier.
+	// +optional
+	MigratingReplica string `json:"migratingReplica"`
+	// +optional
+	StartedAt string `json:"startedAt"`
+	// +optional
+	CompletedAt string `json:"completedAt"`
+	// The last time the schedule of the policy was triggered.
+	// +optional
+	LastTriggeredAt string `json:"lastTriggeredAt"`
+	// The time the volume was detached. It is empty if the volume is attached.
+	// +optional
+	DetachedSince string `json:"detachedSince"`
+	// +optional
+	Message string `json:"message"`
+}
+
+// VolumeRecoveryPointStatus is the recovery point of the volume. For a standby volume, it is how far the restored data
+// is behind the latest backup. For other volumes, it is how far the latest backup is behind the latest write.
+type VolumeRecoveryPointStatus struct {
+	// The last backup restored by the standby volume.
+	// +optional
+	LastRestoredBackup string `json:"lastRestoredBackup"`
+	// The snapshot creation time of the last restored backup.
+	// +optional
+	LastRestoredBackupAt string `json:"lastRestoredBackupAt"`
+	// In seconds. The time between the latest backup in the backup volume and the last restored backup.
+	// +optional
+	RestoreLag int64 `json:"restoreLag"`
+	// The last time a write to the volume was observed.
+	// +optional
+	LastWriteAt string `json:"lastWriteAt"`
+	// The snapshot creation time of the last backup of the volume.
+	// +optional
+	LastBackupSnapshotAt string `json:"lastBackupSnapshotAt"`
+	// In seconds. The time between the last write and the last backup of the volume.
+	// +optional
+	BackupLag int64 `json:"backupLag"`
+}
+
+// VolumeIOStats are the cumulative IO counters of a volume. The engine monitor accumulates them from the throughput
+// and the IOPS reported by the engine.
+type VolumeIOStats struct {
+	// +optional
+	ReadBytes int64 `json:"readBytes"`
+	// +optional
+	WriteBytes int64 `json:"writeBytes"`
+	// +optional
+	ReadOps int64 `json:"readOps"`
+	// +optional
+	WriteOps int64 `json:"writeOps"`
+	// The last time a read or a write to the volume was observed.
+	// +optional
+	LastIOAt string `json:"lastIOAt"`
+}
+
+// VolumeActivityStatus records how the volume is used over its lifetime
+type VolumeActivityStatus struct {
+	// The IO of the volume over all of its engines.
+	// +optional
+	IO VolumeIOStats `json:"io"`
+	// The last time the volume became attached.
+	// +optional
+	LastAttachedAt string `json:"lastAttachedAt"`
+	// The last Kubernetes workload using the volume, in the format <kind>/<name>.
+	// +optional
+	LastAttachedBy string `json:"lastAttachedBy"`
+	// The engine whose IO counters are added to the IO of the volume.
+	// +optional
+	ObservedEngine string `json:"observedEngine"`
+	// The IO counters of the observed engine already added to the IO of the volume.
+	// +optional
+	ObservedEngineIO VolumeIOStats `json:"observedEngineIO"`
+}
+
+// VolumeAutoExpansionPolicy expands the volume automatically once the usage of its filesystem crosses the threshold
+type VolumeAutoExpansionPolicy struct {
+	// In percentage. The filesystem usage which triggers the expansion.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	// +optional
+	UsageThreshold int `json:"usageThreshold"`
+	// The size added to the volume on each expansion.
+	// +optional
+	StepSize int64 `json:"stepSize,string"`
+	// The size the volume is never expanded beyond.
+	// +optional
+	MaxSize int64 `json:"maxSize,string"`
+}
+
+// VolumeFilesystemUsage is the usage of the filesystem on the volume reported by the CSI plugin
+type VolumeFilesystemUsage struct {
+	// +optional
+	UsedBytes int64 `json:"usedBytes"`
+	// +optional
+	TotalBytes int64 `json:"totalBytes"`
+	// The volume size when the usage was reported.
+	// +optional
+	VolumeSize int64 `json:"volumeSize,string"`
+	// +optional
+	ReportedAt string `json:"reportedAt"`
+	// The last time the volume was expanded by the auto expansion policy.
+	// +optional
+	LastAutoExpandedAt string `json:"lastAutoExpandedAt"`
+}
+
 type VolumeCloneState string
 
 const (
@@ -109,13 +271,26 @@ type VolumeCloneStatus struct {
 	AttemptCount int `json:"attemptCount"`
 	// +optional
 	NextAllowedAttemptAt string `json:"nextAllowedAttemptAt"`
+	// The position of the clone in the clone queue of the source node, starting from 1. It is 0 if the clone is not
+	// queued.
+	// +optional
+	QueuePosition int `json:"queuePosition"`
+	// The estimated time the queued clone starts. It is empty if the clone is not queued or no clone duration is known
+	// yet.
+	// +optional
+	EstimatedStartAt string `json:"estimatedStartAt"`
+	// +optional
+	StartedAt string `json:"startedAt"`
+	// +optional
+	CompletedAt string `json:"completedAt"`
 }
 
 const (
-	VolumeConditionTypeScheduled           = "Scheduled"
-	VolumeConditionTypeRestore             = "Restore"
-	VolumeConditionTypeTooManySnapshots    = "TooManySnapshots"
-	VolumeConditionTypeWaitForBackingImage = "WaitForBackingImage"
+	VolumeConditionTypeScheduled                      = "Scheduled"
+	VolumeConditionTypeRestore                        = "Restore"
+	VolumeConditionTypeTooManySnapshots               = "TooManySnapshots"
+	VolumeConditionTypeWaitForBackingImage            = "WaitForBackingImage"
+	VolumeConditionTypeRecoveryPointObjectiveExceeded = "RecoveryPointObjectiveExceeded"
 )
 
 const (
@@ -126,6 +301,8 @@ const (
 	VolumeConditionReasonTooManySnapshots              = "TooManySnapshots"
 	VolumeConditionReasonWaitForBackingImageFailed     = "GetBackingImageFailed"
 	VolumeConditionReasonWaitForBackingImageWaiting    = "Waiting"
+	VolumeConditionReasonRestoreLagExceeded            = "RestoreLagExceeded"
+	VolumeConditionReasonBackupLagExceeded             = "BackupLagExceeded"
 )
 
 type SnapshotDataIntegrity string
@@ -315,6 +492,21 @@ type VolumeSpec struct {
 	// +kubebuilder:validation:Minimum=0
 	// +optional
 	ReplicaRebuildingBandwidthLimit int64 `json:"replicaRebuildingBandwidthLimit"`
+	// The policy moving the replicas to the disks of another tier.
+	// The parameters of the StorageClass are used if the policy is not set.
+	// +optional
+	// +nullable
+	TieringPolicy *VolumeTieringPolicy `json:"tieringPolicy"`
+	// In minutes. The recovery point objective of the volume. The condition RecoveryPointObjectiveExceeded is set if
+	// the restore lag of the standby volume or the backup lag of the volume exceeds the objective. 0 disables the check.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	RecoveryPointObjective int `json:"recoveryPointObjective"`
+	// The policy expanding the volume once the filesystem usage crosses the threshold.
+	// The parameters of the StorageClass are used if the policy is not set.
+	// +optional
+	// +nullable
+	AutoExpansionPolicy *VolumeAutoExpansionPolicy `json:"autoExpansionPolicy"`
 }
 
 // VolumeStatus defines the observed state of the Longhorn volume
@@ -363,6 +555,18 @@ type VolumeStatus struct {
 	ShareEndpoint string `json:"shareEndpoint"`
 	// +optional
 	ShareState ShareManagerState `json:"shareState"`
+	// +optional
+	// +nullable
+	Tiering *VolumeTieringStatus `json:"tiering"`
+	// +optional
+	// +nullable
+	RecoveryPoint *VolumeRecoveryPointStatus `json:"recoveryPoint"`
+	// +optional
+	// +nullable
+	FilesystemUsage *VolumeFilesystemUsage `json:"filesystemUsage"`
+	// +optional
+	// +nullable
+	Activity *VolumeActivityStatus `json:"activity"`
 }
 
 // +genclient
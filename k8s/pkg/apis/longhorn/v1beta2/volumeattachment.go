@@ -1,6 +1,8 @@
 package v1beta2
 
 import (
+	"strconv"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -52,6 +54,11 @@ const (
 	AttacherTypeVolumeExpansionController        = AttacherType("volume-expansion-controller")
 	AttacherTypeBackingImageDataSourceController = AttacherType("bim-ds-controller")
 	AttacherTypeVolumeRebuildingController       = AttacherType("volume-rebuilding-controller")
+	AttacherTypeFileRestoreSessionController     = AttacherType("file-restore-session-controller")
+	AttacherTypeVolumeTransferController         = AttacherType("volume-transfer-controller")
+	AttacherTypeVolumeShrinkController           = AttacherType("volume-shrink-controller")
+	AttacherTypeDataEngineConversionController   = AttacherType("data-engine-conversion-controller")
+	AttacherTypeExternal                         = AttacherType("external")
 )
 
 const (
@@ -67,6 +74,18 @@ const (
 	AttacherPriorityLevelVolumeEvictionController         = 800
 	AttacherPriorityLevelBackingImageDataSourceController = 800
 	AttachedPriorityLevelVolumeRebuildingController       = 800
+	AttacherPriorityLevelFileRestoreSessionController     = 800
+	AttacherPriorityLevelVolumeTransferController         = 800
+	AttacherPriorityLevelVolumeShrinkController           = 800
+	AttacherPriorityLevelDataEngineConversionController   = 800
+	AttacherPriorityLevelExternal                         = 800
+
+	// AttacherPriorityLevelExternalMin is the lowest explicit priority of the external tickets. The external tickets
+	// without an explicit priority use AttacherPriorityLevelExternal.
+	AttacherPriorityLevelExternalMin = 1
+	// AttacherPriorityLevelExternalMax keeps the external tickets from interrupting the workloads attached by the CSI
+	// attacher or by the other Longhorn components with the same or higher priority.
+	AttacherPriorityLevelExternalMax = AttacherPriorityLevelCSIAttacher - 1
 )
 
 const (
@@ -76,6 +95,10 @@ const (
 
 	AttachmentParameterDisableFrontend = "disableFrontend"
 	AttachmentParameterLastAttachedBy  = "lastAttachedBy"
+	AttachmentParameterPriority        = "priority"
+	AttachmentParameterExpiresAt       = "expiresAt"
+	AttachmentParameterReason          = "reason"
+	AttachmentParameterReservation     = "reservation"
 )
 
 const (
@@ -110,11 +133,45 @@ func GetAttacherPriorityLevel(t AttacherType) int {
 		return AttacherPriorityLevelVolumeExpansionController
 	case AttacherTypeBackingImageDataSourceController:
 		return AttacherPriorityLevelBackingImageDataSourceController
+	case AttacherTypeFileRestoreSessionController:
+		return AttacherPriorityLevelFileRestoreSessionController
+	case AttacherTypeVolumeTransferController:
+		return AttacherPriorityLevelVolumeTransferController
+	case AttacherTypeVolumeShrinkController:
+		return AttacherPriorityLevelVolumeShrinkController
+	case AttacherTypeDataEngineConversionController:
+		return AttacherPriorityLevelDataEngineConversionController
+	case AttacherTypeExternal:
+		return AttacherPriorityLevelExternal
 	default:
 		return 0
 	}
 }
 
+// GetAttachmentTicketPriorityLevel returns the priority level of the ticket. External tickets may carry an explicit
+// priority in the parameters, capped at AttacherPriorityLevelExternalMax, other tickets use the priority level of their
+// attacher type.
+func GetAttachmentTicketPriorityLevel(ticket *AttachmentTicket) int {
+	if ticket == nil {
+		return 0
+	}
+	if ticket.Type == AttacherTypeExternal {
+		if priority, err := strconv.Atoi(ticket.Parameters[AttachmentParameterPriority]); err == nil && priority > 0 {
+			return min(priority, AttacherPriorityLevelExternalMax)
+		}
+	}
+	return GetAttacherPriorityLevel(ticket.Type)
+}
+
+// IsReservationAttachmentTicket returns true if the ticket only keeps the volume on its node without requesting an
+// attachment.
+func IsReservationAttachmentTicket(ticket *AttachmentTicket) bool {
+	if ticket == nil || ticket.Type != AttacherTypeExternal {
+		return false
+	}
+	return ticket.Parameters[AttachmentParameterReservation] == TrueValue
+}
+
 func GetAttachmentTicketID(attacherType AttacherType, id string) string {
 	retID := string(attacherType) + "-" + id
 	if len(retID) > 253 {
@@ -0,0 +1,112 @@
+package v1beta2
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type VolumeShrinkState string
+
+const (
+	// VolumeShrinkStatePending means the target volume is not created yet.
+	VolumeShrinkStatePending = VolumeShrinkState("pending")
+	// VolumeShrinkStateCopying means the files of the source volume are being copied into the target volume while the
+	// workload keeps using the source volume.
+	VolumeShrinkStateCopying = VolumeShrinkState("copying")
+	// VolumeShrinkStateWaitingForDetach means the shrink waits for the workload to detach the source volume.
+	VolumeShrinkStateWaitingForDetach = VolumeShrinkState("waitingForDetach")
+	// VolumeShrinkStateVerifying means the changes since the first copy are being copied into the target volume, and
+	// the files of both volumes are being compared.
+	VolumeShrinkStateVerifying = VolumeShrinkState("verifying")
+	// VolumeShrinkStateSwapping means the PVC is being rebound from the source volume to the target volume.
+	VolumeShrinkStateSwapping = VolumeShrinkState("swapping")
+	// VolumeShrinkStateCompleted means the workload can use the target volume through the PVC.
+	VolumeShrinkStateCompleted = VolumeShrinkState("completed")
+	// VolumeShrinkStateError means the shrink cannot proceed.
+	VolumeShrinkStateError = VolumeShrinkState("error")
+)
+
+// VolumeShrinkSpec defines the desired state of the Longhorn volume shrink
+type VolumeShrinkSpec struct {
+	// The volume to shrink.
+	// +optional
+	VolumeName string `json:"volumeName"`
+	// The size of the target volume.
+	// +optional
+	Size int64 `json:"size,string"`
+	// The volume the files of the source volume are copied into. It is "<volume>-shrunk" if empty.
+	// +optional
+	TargetVolumeName string `json:"targetVolumeName"`
+	// The free space in percentage of the used space of the filesystem that the target volume must have left after
+	// the copy. The shrink refuses to start otherwise. It is 10 if unset.
+	// +optional
+	MarginPercentage int `json:"marginPercentage"`
+}
+
+// VolumeShrinkStatus defines the observed state of the Longhorn volume shrink
+type VolumeShrinkStatus struct {
+	// +optional
+	OwnerID string `json:"ownerID"`
+	// +optional
+	State VolumeShrinkState `json:"state"`
+	// The node the files are copied on.
+	// +optional
+	NodeID string `json:"nodeID"`
+	// The progress of the ongoing copy in percentage.
+	// +optional
+	Progress int `json:"progress"`
+	// The PV bound to the PVC of the source volume before the swap.
+	// +optional
+	SourcePVName string `json:"sourcePVName"`
+	// The reclaim policy of the source PV, which is applied to the PV of the target volume.
+	// +optional
+	SourcePVReclaimPolicy string `json:"sourcePVReclaimPolicy"`
+	// +optional
+	StorageClassName string `json:"storageClassName"`
+	// +optional
+	FSType string `json:"fsType"`
+	// +optional
+	PVCName string `json:"pvcName"`
+	// +optional
+	PVCNamespace string `json:"pvcNamespace"`
+	// The labels of the source PVC, which are applied to the recreated PVC.
+	// +optional
+	// +nullable
+	PVCLabels map[string]string `json:"pvcLabels"`
+	// The PV of the target volume bound to the PVC after the swap.
+	// +optional
+	TargetPVName string `json:"targetPVName"`
+	// +optional
+	StartedAt string `json:"startedAt"`
+	// +optional
+	CompletedAt string `json:"completedAt"`
+	// +optional
+	Message string `json:"message"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=lhvs
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Volume",type=string,JSONPath=`.spec.volumeName`,description="The volume to shrink"
+// +kubebuilder:printcolumn:name="Size",type=string,JSONPath=`.spec.size`,description="The size of the target volume"
+// +kubebuilder:printcolumn:name="TargetVolume",type=string,JSONPath=`.spec.targetVolumeName`,description="The target volume"
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`,description="The state of the shrink"
+// +kubebuilder:printcolumn:name="Progress",type=integer,JSONPath=`.status.progress`,description="The copy progress"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// VolumeShrink is where Longhorn stores volume shrink object.
+type VolumeShrink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VolumeShrinkSpec   `json:"spec,omitempty"`
+	Status VolumeShrinkStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VolumeShrinkList is a list of volume shrinks.
+type VolumeShrinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeShrink `json:"items"`
+}
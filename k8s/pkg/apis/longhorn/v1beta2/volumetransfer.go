@@ -0,0 +1,135 @@
+package v1beta2
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type VolumeTransferRole string
+
+const (
+	// VolumeTransferRoleSource means the transfer streams the local volume to the remote cluster.
+	VolumeTransferRoleSource = VolumeTransferRole("source")
+	// VolumeTransferRoleDestination means the transfer builds the local volume from the data streamed by the remote
+	// cluster.
+	VolumeTransferRoleDestination = VolumeTransferRole("destination")
+)
+
+type VolumeTransferState string
+
+const (
+	// VolumeTransferStatePending means the transfer has not prepared the volume yet.
+	VolumeTransferStatePending = VolumeTransferState("pending")
+	// VolumeTransferStateAttaching means the volume is being attached to the node transferring the data.
+	VolumeTransferStateAttaching = VolumeTransferState("attaching")
+	// VolumeTransferStateSyncing means the changed data is being streamed from the source to the destination.
+	VolumeTransferStateSyncing = VolumeTransferState("syncing")
+	// VolumeTransferStateSynced means the source waits for the next incremental sync or for the cutover.
+	VolumeTransferStateSynced = VolumeTransferState("synced")
+	// VolumeTransferStateCuttingOver means the final incremental sync runs after the workload detached the source
+	// volume.
+	VolumeTransferStateCuttingOver = VolumeTransferState("cuttingOver")
+	// VolumeTransferStateCompleted means the destination volume holds the data of the detached source volume.
+	VolumeTransferStateCompleted = VolumeTransferState("completed")
+	// VolumeTransferStateError means the transfer cannot proceed.
+	VolumeTransferStateError = VolumeTransferState("error")
+)
+
+// VolumeTransferSpec defines the desired state of the Longhorn volume transfer
+type VolumeTransferSpec struct {
+	// The role of this cluster in the transfer.
+	// +kubebuilder:validation:Enum=source;destination
+	// +optional
+	Role VolumeTransferRole `json:"role"`
+	// The volume streamed by the source or built by the destination.
+	// +optional
+	VolumeName string `json:"volumeName"`
+	// The size of the volume built by the destination. It must match the size of the source volume.
+	// +kubebuilder:validation:Type=string
+	// +optional
+	Size int64 `json:"size,string"`
+	// The number of replicas of the volume built by the destination. The default replica count is used if it is 0.
+	// +optional
+	NumberOfReplicas int `json:"numberOfReplicas"`
+	// The HTTPS URL of the Longhorn manager API of the destination cluster, for example "https://longhorn.example.com".
+	// Only used by the source.
+	// +optional
+	RemoteURL string `json:"remoteURL"`
+	// The name of the VolumeTransfer of the destination cluster. It is the name of this transfer if empty. Only used by
+	// the source.
+	// +optional
+	RemoteTransferName string `json:"remoteTransferName"`
+	// The secret in the Longhorn namespace whose "token" key authenticates the source to the destination. Both
+	// clusters must hold the same token. On the source, the optional "ca.crt" key verifies the certificate of the
+	// destination endpoint.
+	// +optional
+	CredentialSecret string `json:"credentialSecret"`
+	// The interval in seconds between the incremental syncs before the cutover. Only used by the source.
+	// +optional
+	SyncIntervalSeconds int `json:"syncIntervalSeconds"`
+	// Set to true to run the final incremental sync once the workload detaches the source volume. Only used by the
+	// source.
+	// +optional
+	Cutover bool `json:"cutover"`
+}
+
+// VolumeTransferStatus defines the observed state of the Longhorn volume transfer
+type VolumeTransferStatus struct {
+	// +optional
+	OwnerID string `json:"ownerID"`
+	// +optional
+	State VolumeTransferState `json:"state"`
+	// The node the volume is attached to for the transfer.
+	// +optional
+	NodeID string `json:"nodeID"`
+	// The progress of the current or last sync in percentage.
+	// +optional
+	Progress int `json:"progress"`
+	// The bytes transferred by all syncs.
+	// +optional
+	TransferredBytes int64 `json:"transferredBytes"`
+	// The throughput of the current or last sync, for example "120.5 MiB/s".
+	// +optional
+	Throughput string `json:"throughput"`
+	// The number of completed syncs.
+	// +optional
+	SyncCount int `json:"syncCount"`
+	// The snapshot of the source volume the destination volume holds after the last sync. The next sync only sends
+	// the data written after it. Only used by the source.
+	// +optional
+	LastSyncedSnapshot string `json:"lastSyncedSnapshot"`
+	// +optional
+	LastSyncedAt string `json:"lastSyncedAt"`
+	// +optional
+	CompletedAt string `json:"completedAt"`
+	// +optional
+	Message string `json:"message"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=lhvt
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Role",type=string,JSONPath=`.spec.role`,description="The role of this cluster in the transfer"
+// +kubebuilder:printcolumn:name="Volume",type=string,JSONPath=`.spec.volumeName`,description="The transferred volume"
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`,description="The state of the transfer"
+// +kubebuilder:printcolumn:name="Progress",type=integer,JSONPath=`.status.progress`,description="The progress of the current or last sync"
+// +kubebuilder:printcolumn:name="Throughput",type=string,JSONPath=`.status.throughput`,description="The throughput of the current or last sync"
+// +kubebuilder:printcolumn:name="LastSyncedAt",type=string,JSONPath=`.status.lastSyncedAt`,description="The time the last sync completed"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// VolumeTransfer is where Longhorn stores volume transfer object.
+type VolumeTransfer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VolumeTransferSpec   `json:"spec,omitempty"`
+	Status VolumeTransferStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VolumeTransferList is a list of volume transfers.
+type VolumeTransferList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeTransfer `json:"items"`
+}
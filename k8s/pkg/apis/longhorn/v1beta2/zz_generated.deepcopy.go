@@ -945,133 +945,129 @@ func (in *BackupVolumeStatus) DeepCopy() *BackupVolumeStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Condition) DeepCopyInto(out *Condition) {
+func (in *CapacityForecast) DeepCopyInto(out *CapacityForecast) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
-func (in *Condition) DeepCopy() *Condition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityForecast.
+func (in *CapacityForecast) DeepCopy() *CapacityForecast {
 	if in == nil {
 		return nil
 	}
-	out := new(Condition)
+	out := new(CapacityForecast)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataEngineSpec) DeepCopyInto(out *DataEngineSpec) {
+func (in *CapacityHistory) DeepCopyInto(out *CapacityHistory) {
 	*out = *in
-	out.V2 = in.V2
+	if in.Samples != nil {
+		in, out := &in.Samples, &out.Samples
+		*out = make([]CapacitySample, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataEngineSpec.
-func (in *DataEngineSpec) DeepCopy() *DataEngineSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityHistory.
+func (in *CapacityHistory) DeepCopy() *CapacityHistory {
 	if in == nil {
 		return nil
 	}
-	out := new(DataEngineSpec)
+	out := new(CapacityHistory)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataEngineStatus) DeepCopyInto(out *DataEngineStatus) {
+func (in *CapacitySample) DeepCopyInto(out *CapacitySample) {
 	*out = *in
-	out.V2 = in.V2
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataEngineStatus.
-func (in *DataEngineStatus) DeepCopy() *DataEngineStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacitySample.
+func (in *CapacitySample) DeepCopy() *CapacitySample {
 	if in == nil {
 		return nil
 	}
-	out := new(DataEngineStatus)
+	out := new(CapacitySample)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DiskSpec) DeepCopyInto(out *DiskSpec) {
+func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskSpec.
-func (in *DiskSpec) DeepCopy() *DiskSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
+func (in *Condition) DeepCopy() *Condition {
 	if in == nil {
 		return nil
 	}
-	out := new(DiskSpec)
+	out := new(Condition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DiskStatus) DeepCopyInto(out *DiskStatus) {
+func (in *DataEngineConversion) DeepCopyInto(out *DataEngineConversion) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]Condition, len(*in))
-		copy(*out, *in)
-	}
-	if in.ScheduledReplica != nil {
-		in, out := &in.ScheduledReplica, &out.ScheduledReplica
-		*out = make(map[string]int64, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.ScheduledBackingImage != nil {
-		in, out := &in.ScheduledBackingImage, &out.ScheduledBackingImage
-		*out = make(map[string]int64, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskStatus.
-func (in *DiskStatus) DeepCopy() *DiskStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataEngineConversion.
+func (in *DataEngineConversion) DeepCopy() *DataEngineConversion {
 	if in == nil {
 		return nil
 	}
-	out := new(DiskStatus)
+	out := new(DataEngineConversion)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataEngineConversion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Engine) DeepCopyInto(out *Engine) {
+func (in *DataEngineConversionList) DeepCopyInto(out *DataEngineConversionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataEngineConversion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Engine.
-func (in *Engine) DeepCopy() *Engine {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataEngineConversionList.
+func (in *DataEngineConversionList) DeepCopy() *DataEngineConversionList {
 	if in == nil {
 		return nil
 	}
-	out := new(Engine)
+	out := new(DataEngineConversionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Engine) DeepCopyObject() runtime.Object {
+func (in *DataEngineConversionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1079,43 +1075,100 @@ func (in *Engine) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EngineBackupStatus) DeepCopyInto(out *EngineBackupStatus) {
+func (in *DataEngineConversionSpec) DeepCopyInto(out *DataEngineConversionSpec) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineBackupStatus.
-func (in *EngineBackupStatus) DeepCopy() *EngineBackupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataEngineConversionSpec.
+func (in *DataEngineConversionSpec) DeepCopy() *DataEngineConversionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EngineBackupStatus)
+	out := new(DataEngineConversionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EngineImage) DeepCopyInto(out *EngineImage) {
+func (in *DataEngineConversionStatus) DeepCopyInto(out *DataEngineConversionStatus) {
+	*out = *in
+	if in.PVCLabels != nil {
+		in, out := &in.PVCLabels, &out.PVCLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataEngineConversionStatus.
+func (in *DataEngineConversionStatus) DeepCopy() *DataEngineConversionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataEngineConversionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataEngineSpec) DeepCopyInto(out *DataEngineSpec) {
+	*out = *in
+	out.V2 = in.V2
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataEngineSpec.
+func (in *DataEngineSpec) DeepCopy() *DataEngineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataEngineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataEngineStatus) DeepCopyInto(out *DataEngineStatus) {
+	*out = *in
+	out.V2 = in.V2
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataEngineStatus.
+func (in *DataEngineStatus) DeepCopy() *DataEngineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataEngineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DisasterRecoveryPlan) DeepCopyInto(out *DisasterRecoveryPlan) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineImage.
-func (in *EngineImage) DeepCopy() *EngineImage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisasterRecoveryPlan.
+func (in *DisasterRecoveryPlan) DeepCopy() *DisasterRecoveryPlan {
 	if in == nil {
 		return nil
 	}
-	out := new(EngineImage)
+	out := new(DisasterRecoveryPlan)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EngineImage) DeepCopyObject() runtime.Object {
+func (in *DisasterRecoveryPlan) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1123,13 +1176,13 @@ func (in *EngineImage) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EngineImageList) DeepCopyInto(out *EngineImageList) {
+func (in *DisasterRecoveryPlanList) DeepCopyInto(out *DisasterRecoveryPlanList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]EngineImage, len(*in))
+		*out = make([]DisasterRecoveryPlan, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1137,18 +1190,18 @@ func (in *EngineImageList) DeepCopyInto(out *EngineImageList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineImageList.
-func (in *EngineImageList) DeepCopy() *EngineImageList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisasterRecoveryPlanList.
+func (in *DisasterRecoveryPlanList) DeepCopy() *DisasterRecoveryPlanList {
 	if in == nil {
 		return nil
 	}
-	out := new(EngineImageList)
+	out := new(DisasterRecoveryPlanList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EngineImageList) DeepCopyObject() runtime.Object {
+func (in *DisasterRecoveryPlanList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1156,276 +1209,1017 @@ func (in *EngineImageList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EngineImageSpec) DeepCopyInto(out *EngineImageSpec) {
+func (in *DisasterRecoveryPlanMemberStatus) DeepCopyInto(out *DisasterRecoveryPlanMemberStatus) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineImageSpec.
-func (in *EngineImageSpec) DeepCopy() *EngineImageSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisasterRecoveryPlanMemberStatus.
+func (in *DisasterRecoveryPlanMemberStatus) DeepCopy() *DisasterRecoveryPlanMemberStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(EngineImageSpec)
+	out := new(DisasterRecoveryPlanMemberStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EngineImageStatus) DeepCopyInto(out *EngineImageStatus) {
+func (in *DisasterRecoveryPlanRehearsal) DeepCopyInto(out *DisasterRecoveryPlanRehearsal) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]Condition, len(*in))
+	if in.Issues != nil {
+		in, out := &in.Issues, &out.Issues
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.NodeDeploymentMap != nil {
-		in, out := &in.NodeDeploymentMap, &out.NodeDeploymentMap
-		*out = make(map[string]bool, len(*in))
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisasterRecoveryPlanRehearsal.
+func (in *DisasterRecoveryPlanRehearsal) DeepCopy() *DisasterRecoveryPlanRehearsal {
+	if in == nil {
+		return nil
+	}
+	out := new(DisasterRecoveryPlanRehearsal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DisasterRecoveryPlanSpec) DeepCopyInto(out *DisasterRecoveryPlanSpec) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumeSelector != nil {
+		in, out := &in.VolumeSelector, &out.VolumeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PVCNamespaces != nil {
+		in, out := &in.PVCNamespaces, &out.PVCNamespaces
+		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	out.EngineVersionDetails = in.EngineVersionDetails
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineImageStatus.
-func (in *EngineImageStatus) DeepCopy() *EngineImageStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisasterRecoveryPlanSpec.
+func (in *DisasterRecoveryPlanSpec) DeepCopy() *DisasterRecoveryPlanSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EngineImageStatus)
+	out := new(DisasterRecoveryPlanSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EngineList) DeepCopyInto(out *EngineList) {
+func (in *DisasterRecoveryPlanStatus) DeepCopyInto(out *DisasterRecoveryPlanStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Engine, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Rehearsal != nil {
+		in, out := &in.Rehearsal, &out.Rehearsal
+		*out = new(DisasterRecoveryPlanRehearsal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make(map[string]*DisasterRecoveryPlanMemberStatus, len(*in))
+		for key, val := range *in {
+			var outVal *DisasterRecoveryPlanMemberStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(DisasterRecoveryPlanMemberStatus)
+				**out = **in
+			}
+			(*out)[key] = outVal
 		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineList.
-func (in *EngineList) DeepCopy() *EngineList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisasterRecoveryPlanStatus.
+func (in *DisasterRecoveryPlanStatus) DeepCopy() *DisasterRecoveryPlanStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(EngineList)
+	out := new(DisasterRecoveryPlanStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EngineList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredDevice) DeepCopyInto(out *DiscoveredDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredDevice.
+func (in *DiscoveredDevice) DeepCopy() *DiscoveredDevice {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(DiscoveredDevice)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EngineSpec) DeepCopyInto(out *EngineSpec) {
+func (in *DiskSpec) DeepCopyInto(out *DiskSpec) {
 	*out = *in
-	out.InstanceSpec = in.InstanceSpec
-	if in.ReplicaAddressMap != nil {
-		in, out := &in.ReplicaAddressMap, &out.ReplicaAddressMap
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.UpgradedReplicaAddressMap != nil {
-		in, out := &in.UpgradedReplicaAddressMap, &out.UpgradedReplicaAddressMap
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineSpec.
-func (in *EngineSpec) DeepCopy() *EngineSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskSpec.
+func (in *DiskSpec) DeepCopy() *DiskSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EngineSpec)
+	out := new(DiskSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EngineStatus) DeepCopyInto(out *EngineStatus) {
+func (in *DiskStatus) DeepCopyInto(out *DiskStatus) {
 	*out = *in
-	in.InstanceStatus.DeepCopyInto(&out.InstanceStatus)
-	if in.CurrentReplicaAddressMap != nil {
-		in, out := &in.CurrentReplicaAddressMap, &out.CurrentReplicaAddressMap
-		*out = make(map[string]string, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		copy(*out, *in)
+	}
+	if in.ScheduledReplica != nil {
+		in, out := &in.ScheduledReplica, &out.ScheduledReplica
+		*out = make(map[string]int64, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.ReplicaModeMap != nil {
-		in, out := &in.ReplicaModeMap, &out.ReplicaModeMap
-		*out = make(map[string]ReplicaMode, len(*in))
+	if in.ScheduledBackingImage != nil {
+		in, out := &in.ScheduledBackingImage, &out.ScheduledBackingImage
+		*out = make(map[string]int64, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.ReplicaTransitionTimeMap != nil {
-		in, out := &in.ReplicaTransitionTimeMap, &out.ReplicaTransitionTimeMap
+	if in.CapacityForecast != nil {
+		in, out := &in.CapacityForecast, &out.CapacityForecast
+		*out = new(CapacityForecast)
+		**out = **in
+	}
+	if in.CapacityHistory != nil {
+		in, out := &in.CapacityHistory, &out.CapacityHistory
+		*out = new(CapacityHistory)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskStatus.
+func (in *DiskStatus) DeepCopy() *DiskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Engine) DeepCopyInto(out *Engine) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Engine.
+func (in *Engine) DeepCopy() *Engine {
+	if in == nil {
+		return nil
+	}
+	out := new(Engine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Engine) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineBackupStatus) DeepCopyInto(out *EngineBackupStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineBackupStatus.
+func (in *EngineBackupStatus) DeepCopy() *EngineBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineImage) DeepCopyInto(out *EngineImage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineImage.
+func (in *EngineImage) DeepCopy() *EngineImage {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EngineImage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineImageAutoUpgradeRollout) DeepCopyInto(out *EngineImageAutoUpgradeRollout) {
+	*out = *in
+	if in.UpgradedVolumes != nil {
+		in, out := &in.UpgradedVolumes, &out.UpgradedVolumes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineImageAutoUpgradeRollout.
+func (in *EngineImageAutoUpgradeRollout) DeepCopy() *EngineImageAutoUpgradeRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineImageAutoUpgradeRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineImageList) DeepCopyInto(out *EngineImageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EngineImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineImageList.
+func (in *EngineImageList) DeepCopy() *EngineImageList {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineImageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EngineImageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineImageSpec) DeepCopyInto(out *EngineImageSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineImageSpec.
+func (in *EngineImageSpec) DeepCopy() *EngineImageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineImageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineImageStatus) DeepCopyInto(out *EngineImageStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeDeploymentMap != nil {
+		in, out := &in.NodeDeploymentMap, &out.NodeDeploymentMap
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AutoUpgradeRollout != nil {
+		in, out := &in.AutoUpgradeRollout, &out.AutoUpgradeRollout
+		*out = new(EngineImageAutoUpgradeRollout)
+		(*in).DeepCopyInto(*out)
+	}
+	out.EngineVersionDetails = in.EngineVersionDetails
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineImageStatus.
+func (in *EngineImageStatus) DeepCopy() *EngineImageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineImageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineList) DeepCopyInto(out *EngineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Engine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineList.
+func (in *EngineList) DeepCopy() *EngineList {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EngineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineSpec) DeepCopyInto(out *EngineSpec) {
+	*out = *in
+	out.InstanceSpec = in.InstanceSpec
+	if in.ReplicaAddressMap != nil {
+		in, out := &in.ReplicaAddressMap, &out.ReplicaAddressMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.UpgradedReplicaAddressMap != nil {
+		in, out := &in.UpgradedReplicaAddressMap, &out.UpgradedReplicaAddressMap
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.BackupStatus != nil {
-		in, out := &in.BackupStatus, &out.BackupStatus
-		*out = make(map[string]*EngineBackupStatus, len(*in))
-		for key, val := range *in {
-			var outVal *EngineBackupStatus
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				in, out := &val, &outVal
-				*out = new(EngineBackupStatus)
-				**out = **in
-			}
-			(*out)[key] = outVal
-		}
-	}
-	if in.RestoreStatus != nil {
-		in, out := &in.RestoreStatus, &out.RestoreStatus
-		*out = make(map[string]*RestoreStatus, len(*in))
-		for key, val := range *in {
-			var outVal *RestoreStatus
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				in, out := &val, &outVal
-				*out = new(RestoreStatus)
-				**out = **in
-			}
-			(*out)[key] = outVal
-		}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineSpec.
+func (in *EngineSpec) DeepCopy() *EngineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineStatus) DeepCopyInto(out *EngineStatus) {
+	*out = *in
+	in.InstanceStatus.DeepCopyInto(&out.InstanceStatus)
+	if in.CurrentReplicaAddressMap != nil {
+		in, out := &in.CurrentReplicaAddressMap, &out.CurrentReplicaAddressMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ReplicaModeMap != nil {
+		in, out := &in.ReplicaModeMap, &out.ReplicaModeMap
+		*out = make(map[string]ReplicaMode, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ReplicaTransitionTimeMap != nil {
+		in, out := &in.ReplicaTransitionTimeMap, &out.ReplicaTransitionTimeMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BackupStatus != nil {
+		in, out := &in.BackupStatus, &out.BackupStatus
+		*out = make(map[string]*EngineBackupStatus, len(*in))
+		for key, val := range *in {
+			var outVal *EngineBackupStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(EngineBackupStatus)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.RestoreStatus != nil {
+		in, out := &in.RestoreStatus, &out.RestoreStatus
+		*out = make(map[string]*RestoreStatus, len(*in))
+		for key, val := range *in {
+			var outVal *RestoreStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(RestoreStatus)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.PurgeStatus != nil {
+		in, out := &in.PurgeStatus, &out.PurgeStatus
+		*out = make(map[string]*PurgeStatus, len(*in))
+		for key, val := range *in {
+			var outVal *PurgeStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(PurgeStatus)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.RebuildStatus != nil {
+		in, out := &in.RebuildStatus, &out.RebuildStatus
+		*out = make(map[string]*RebuildStatus, len(*in))
+		for key, val := range *in {
+			var outVal *RebuildStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(RebuildStatus)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.CloneStatus != nil {
+		in, out := &in.CloneStatus, &out.CloneStatus
+		*out = make(map[string]*SnapshotCloneStatus, len(*in))
+		for key, val := range *in {
+			var outVal *SnapshotCloneStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(SnapshotCloneStatus)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Snapshots != nil {
+		in, out := &in.Snapshots, &out.Snapshots
+		*out = make(map[string]*SnapshotInfo, len(*in))
+		for key, val := range *in {
+			var outVal *SnapshotInfo
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(SnapshotInfo)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.IOStats != nil {
+		in, out := &in.IOStats, &out.IOStats
+		*out = new(VolumeIOStats)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineStatus.
+func (in *EngineStatus) DeepCopy() *EngineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineVersionDetails) DeepCopyInto(out *EngineVersionDetails) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineVersionDetails.
+func (in *EngineVersionDetails) DeepCopy() *EngineVersionDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineVersionDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileRestoreSession) DeepCopyInto(out *FileRestoreSession) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileRestoreSession.
+func (in *FileRestoreSession) DeepCopy() *FileRestoreSession {
+	if in == nil {
+		return nil
+	}
+	out := new(FileRestoreSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FileRestoreSession) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileRestoreSessionList) DeepCopyInto(out *FileRestoreSessionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FileRestoreSession, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileRestoreSessionList.
+func (in *FileRestoreSessionList) DeepCopy() *FileRestoreSessionList {
+	if in == nil {
+		return nil
+	}
+	out := new(FileRestoreSessionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FileRestoreSessionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileRestoreSessionSpec) DeepCopyInto(out *FileRestoreSessionSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileRestoreSessionSpec.
+func (in *FileRestoreSessionSpec) DeepCopy() *FileRestoreSessionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FileRestoreSessionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileRestoreSessionStatus) DeepCopyInto(out *FileRestoreSessionStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileRestoreSessionStatus.
+func (in *FileRestoreSessionStatus) DeepCopy() *FileRestoreSessionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FileRestoreSessionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HashStatus) DeepCopyInto(out *HashStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HashStatus.
+func (in *HashStatus) DeepCopy() *HashStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HashStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceManager) DeepCopyInto(out *InstanceManager) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceManager.
+func (in *InstanceManager) DeepCopy() *InstanceManager {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceManager)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceManager) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceManagerList) DeepCopyInto(out *InstanceManagerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstanceManager, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceManagerList.
+func (in *InstanceManagerList) DeepCopy() *InstanceManagerList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceManagerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceManagerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceManagerResourceRecommendation) DeepCopyInto(out *InstanceManagerResourceRecommendation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceManagerResourceRecommendation.
+func (in *InstanceManagerResourceRecommendation) DeepCopy() *InstanceManagerResourceRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceManagerResourceRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceManagerSpec) DeepCopyInto(out *InstanceManagerSpec) {
+	*out = *in
+	out.DataEngineSpec = in.DataEngineSpec
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceManagerSpec.
+func (in *InstanceManagerSpec) DeepCopy() *InstanceManagerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceManagerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceManagerStatus) DeepCopyInto(out *InstanceManagerStatus) {
+	*out = *in
+	if in.InstanceEngines != nil {
+		in, out := &in.InstanceEngines, &out.InstanceEngines
+		*out = make(map[string]InstanceProcess, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.InstanceReplicas != nil {
+		in, out := &in.InstanceReplicas, &out.InstanceReplicas
+		*out = make(map[string]InstanceProcess, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.BackingImages != nil {
+		in, out := &in.BackingImages, &out.BackingImages
+		*out = make(map[string]BackingImageV2CopyInfo, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.DataEngineStatus = in.DataEngineStatus
+	if in.ResourceRecommendation != nil {
+		in, out := &in.ResourceRecommendation, &out.ResourceRecommendation
+		*out = new(InstanceManagerResourceRecommendation)
+		**out = **in
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make(map[string]InstanceProcess, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceManagerStatus.
+func (in *InstanceManagerStatus) DeepCopy() *InstanceManagerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceManagerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceProcess) DeepCopyInto(out *InstanceProcess) {
+	*out = *in
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceProcess.
+func (in *InstanceProcess) DeepCopy() *InstanceProcess {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceProcess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceProcessSpec) DeepCopyInto(out *InstanceProcessSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceProcessSpec.
+func (in *InstanceProcessSpec) DeepCopy() *InstanceProcessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceProcessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceProcessStatus) DeepCopyInto(out *InstanceProcessStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceProcessStatus.
+func (in *InstanceProcessStatus) DeepCopy() *InstanceProcessStatus {
+	if in == nil {
+		return nil
 	}
-	if in.PurgeStatus != nil {
-		in, out := &in.PurgeStatus, &out.PurgeStatus
-		*out = make(map[string]*PurgeStatus, len(*in))
-		for key, val := range *in {
-			var outVal *PurgeStatus
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				in, out := &val, &outVal
-				*out = new(PurgeStatus)
-				**out = **in
-			}
-			(*out)[key] = outVal
-		}
+	out := new(InstanceProcessStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceSpec.
+func (in *InstanceSpec) DeepCopy() *InstanceSpec {
+	if in == nil {
+		return nil
 	}
-	if in.RebuildStatus != nil {
-		in, out := &in.RebuildStatus, &out.RebuildStatus
-		*out = make(map[string]*RebuildStatus, len(*in))
-		for key, val := range *in {
-			var outVal *RebuildStatus
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				in, out := &val, &outVal
-				*out = new(RebuildStatus)
-				**out = **in
-			}
-			(*out)[key] = outVal
-		}
+	out := new(InstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		copy(*out, *in)
 	}
-	if in.CloneStatus != nil {
-		in, out := &in.CloneStatus, &out.CloneStatus
-		*out = make(map[string]*SnapshotCloneStatus, len(*in))
-		for key, val := range *in {
-			var outVal *SnapshotCloneStatus
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				in, out := &val, &outVal
-				*out = new(SnapshotCloneStatus)
-				**out = **in
-			}
-			(*out)[key] = outVal
-		}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceStatus.
+func (in *InstanceStatus) DeepCopy() *InstanceStatus {
+	if in == nil {
+		return nil
 	}
-	if in.Snapshots != nil {
-		in, out := &in.Snapshots, &out.Snapshots
-		*out = make(map[string]*SnapshotInfo, len(*in))
-		for key, val := range *in {
-			var outVal *SnapshotInfo
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				in, out := &val, &outVal
-				*out = new(SnapshotInfo)
-				(*in).DeepCopyInto(*out)
-			}
-			(*out)[key] = outVal
-		}
+	out := new(InstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesStatus) DeepCopyInto(out *KubernetesStatus) {
+	*out = *in
+	if in.WorkloadsStatus != nil {
+		in, out := &in.WorkloadsStatus, &out.WorkloadsStatus
+		*out = make([]WorkloadStatus, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineStatus.
-func (in *EngineStatus) DeepCopy() *EngineStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesStatus.
+func (in *KubernetesStatus) DeepCopy() *KubernetesStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(EngineStatus)
+	out := new(KubernetesStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EngineVersionDetails) DeepCopyInto(out *EngineVersionDetails) {
+func (in *Node) DeepCopyInto(out *Node) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineVersionDetails.
-func (in *EngineVersionDetails) DeepCopy() *EngineVersionDetails {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Node.
+func (in *Node) DeepCopy() *Node {
 	if in == nil {
 		return nil
 	}
-	out := new(EngineVersionDetails)
+	out := new(Node)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Node) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HashStatus) DeepCopyInto(out *HashStatus) {
+func (in *NodeList) DeepCopyInto(out *NodeList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Node, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HashStatus.
-func (in *HashStatus) DeepCopy() *HashStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeList.
+func (in *NodeList) DeepCopy() *NodeList {
 	if in == nil {
 		return nil
 	}
-	out := new(HashStatus)
+	out := new(NodeList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceManager) DeepCopyInto(out *InstanceManager) {
+func (in *NodeMaintenance) DeepCopyInto(out *NodeMaintenance) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1434,18 +2228,18 @@ func (in *InstanceManager) DeepCopyInto(out *InstanceManager) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceManager.
-func (in *InstanceManager) DeepCopy() *InstanceManager {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeMaintenance.
+func (in *NodeMaintenance) DeepCopy() *NodeMaintenance {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceManager)
+	out := new(NodeMaintenance)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InstanceManager) DeepCopyObject() runtime.Object {
+func (in *NodeMaintenance) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1453,13 +2247,36 @@ func (in *InstanceManager) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceManagerList) DeepCopyInto(out *InstanceManagerList) {
+func (in *NodeMaintenanceDetachedTicket) DeepCopyInto(out *NodeMaintenanceDetachedTicket) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeMaintenanceDetachedTicket.
+func (in *NodeMaintenanceDetachedTicket) DeepCopy() *NodeMaintenanceDetachedTicket {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeMaintenanceDetachedTicket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeMaintenanceList) DeepCopyInto(out *NodeMaintenanceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]InstanceManager, len(*in))
+		*out = make([]NodeMaintenance, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1467,203 +2284,324 @@ func (in *InstanceManagerList) DeepCopyInto(out *InstanceManagerList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceManagerList.
-func (in *InstanceManagerList) DeepCopy() *InstanceManagerList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeMaintenanceList.
+func (in *NodeMaintenanceList) DeepCopy() *NodeMaintenanceList {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceManagerList)
+	out := new(NodeMaintenanceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InstanceManagerList) DeepCopyObject() runtime.Object {
+func (in *NodeMaintenanceList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
-	return nil
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeMaintenanceSpec) DeepCopyInto(out *NodeMaintenanceSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeMaintenanceSpec.
+func (in *NodeMaintenanceSpec) DeepCopy() *NodeMaintenanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeMaintenanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeMaintenanceStatus) DeepCopyInto(out *NodeMaintenanceStatus) {
+	*out = *in
+	if in.DetachedTickets != nil {
+		in, out := &in.DetachedTickets, &out.DetachedTickets
+		*out = make([]NodeMaintenanceDetachedTicket, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make(map[string]*NodeMaintenanceVolumeStatus, len(*in))
+		for key, val := range *in {
+			var outVal *NodeMaintenanceVolumeStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(NodeMaintenanceVolumeStatus)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeMaintenanceStatus.
+func (in *NodeMaintenanceStatus) DeepCopy() *NodeMaintenanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeMaintenanceStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceManagerSpec) DeepCopyInto(out *InstanceManagerSpec) {
+func (in *NodeMaintenanceVolumeStatus) DeepCopyInto(out *NodeMaintenanceVolumeStatus) {
 	*out = *in
-	out.DataEngineSpec = in.DataEngineSpec
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceManagerSpec.
-func (in *InstanceManagerSpec) DeepCopy() *InstanceManagerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeMaintenanceVolumeStatus.
+func (in *NodeMaintenanceVolumeStatus) DeepCopy() *NodeMaintenanceVolumeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceManagerSpec)
+	out := new(NodeMaintenanceVolumeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceManagerStatus) DeepCopyInto(out *InstanceManagerStatus) {
+func (in *NodeSpec) DeepCopyInto(out *NodeSpec) {
 	*out = *in
-	if in.InstanceEngines != nil {
-		in, out := &in.InstanceEngines, &out.InstanceEngines
-		*out = make(map[string]InstanceProcess, len(*in))
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make(map[string]DiskSpec, len(*in))
 		for key, val := range *in {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
-	if in.InstanceReplicas != nil {
-		in, out := &in.InstanceReplicas, &out.InstanceReplicas
-		*out = make(map[string]InstanceProcess, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
-		}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.BackingImages != nil {
-		in, out := &in.BackingImages, &out.BackingImages
-		*out = make(map[string]BackingImageV2CopyInfo, len(*in))
+	if in.SettingOverrides != nil {
+		in, out := &in.SettingOverrides, &out.SettingOverrides
+		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	out.DataEngineStatus = in.DataEngineStatus
-	if in.Instances != nil {
-		in, out := &in.Instances, &out.Instances
-		*out = make(map[string]InstanceProcess, len(*in))
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSpec.
+func (in *NodeSpec) DeepCopy() *NodeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		copy(*out, *in)
+	}
+	if in.DiskStatus != nil {
+		in, out := &in.DiskStatus, &out.DiskStatus
+		*out = make(map[string]*DiskStatus, len(*in))
 		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
+			var outVal *DiskStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(DiskStatus)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
 		}
 	}
+	in.SnapshotCheckStatus.DeepCopyInto(&out.SnapshotCheckStatus)
+	if in.DiscoveredDevices != nil {
+		in, out := &in.DiscoveredDevices, &out.DiscoveredDevices
+		*out = make([]DiscoveredDevice, len(*in))
+		copy(*out, *in)
+	}
+	if in.CapacityForecast != nil {
+		in, out := &in.CapacityForecast, &out.CapacityForecast
+		*out = new(CapacityForecast)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceManagerStatus.
-func (in *InstanceManagerStatus) DeepCopy() *InstanceManagerStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeStatus.
+func (in *NodeStatus) DeepCopy() *NodeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceManagerStatus)
+	out := new(NodeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceProcess) DeepCopyInto(out *InstanceProcess) {
+func (in *NotificationSink) DeepCopyInto(out *NotificationSink) {
 	*out = *in
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceProcess.
-func (in *InstanceProcess) DeepCopy() *InstanceProcess {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSink.
+func (in *NotificationSink) DeepCopy() *NotificationSink {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceProcess)
+	out := new(NotificationSink)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationSink) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceProcessSpec) DeepCopyInto(out *InstanceProcessSpec) {
+func (in *NotificationSinkFilter) DeepCopyInto(out *NotificationSinkFilter) {
 	*out = *in
+	if in.KubernetesNamespaces != nil {
+		in, out := &in.KubernetesNamespaces, &out.KubernetesNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceProcessSpec.
-func (in *InstanceProcessSpec) DeepCopy() *InstanceProcessSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkFilter.
+func (in *NotificationSinkFilter) DeepCopy() *NotificationSinkFilter {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceProcessSpec)
+	out := new(NotificationSinkFilter)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceProcessStatus) DeepCopyInto(out *InstanceProcessStatus) {
+func (in *NotificationSinkList) DeepCopyInto(out *NotificationSinkList) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make(map[string]bool, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationSink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceProcessStatus.
-func (in *InstanceProcessStatus) DeepCopy() *InstanceProcessStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkList.
+func (in *NotificationSinkList) DeepCopy() *NotificationSinkList {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceProcessStatus)
+	out := new(NotificationSinkList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationSinkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
+func (in *NotificationSinkSMTP) DeepCopyInto(out *NotificationSinkSMTP) {
 	*out = *in
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceSpec.
-func (in *InstanceSpec) DeepCopy() *InstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkSMTP.
+func (in *NotificationSinkSMTP) DeepCopy() *NotificationSinkSMTP {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceSpec)
+	out := new(NotificationSinkSMTP)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
+func (in *NotificationSinkSpec) DeepCopyInto(out *NotificationSinkSpec) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]Condition, len(*in))
+	if in.SMTP != nil {
+		in, out := &in.SMTP, &out.SMTP
+		*out = new(NotificationSinkSMTP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventClasses != nil {
+		in, out := &in.EventClasses, &out.EventClasses
+		*out = make([]NotificationEventClass, len(*in))
 		copy(*out, *in)
 	}
+	in.Filter.DeepCopyInto(&out.Filter)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceStatus.
-func (in *InstanceStatus) DeepCopy() *InstanceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkSpec.
+func (in *NotificationSinkSpec) DeepCopy() *NotificationSinkSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceStatus)
+	out := new(NotificationSinkSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesStatus) DeepCopyInto(out *KubernetesStatus) {
+func (in *NotificationSinkStatus) DeepCopyInto(out *NotificationSinkStatus) {
 	*out = *in
-	if in.WorkloadsStatus != nil {
-		in, out := &in.WorkloadsStatus, &out.WorkloadsStatus
-		*out = make([]WorkloadStatus, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesStatus.
-func (in *KubernetesStatus) DeepCopy() *KubernetesStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkStatus.
+func (in *NotificationSinkStatus) DeepCopy() *NotificationSinkStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesStatus)
+	out := new(NotificationSinkStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Node) DeepCopyInto(out *Node) {
+func (in *Orphan) DeepCopyInto(out *Orphan) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1672,18 +2610,18 @@ func (in *Node) DeepCopyInto(out *Node) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Node.
-func (in *Node) DeepCopy() *Node {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Orphan.
+func (in *Orphan) DeepCopy() *Orphan {
 	if in == nil {
 		return nil
 	}
-	out := new(Node)
+	out := new(Orphan)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Node) DeepCopyObject() runtime.Object {
+func (in *Orphan) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1691,13 +2629,13 @@ func (in *Node) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeList) DeepCopyInto(out *NodeList) {
+func (in *OrphanList) DeepCopyInto(out *OrphanList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Node, len(*in))
+		*out = make([]Orphan, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1705,18 +2643,18 @@ func (in *NodeList) DeepCopyInto(out *NodeList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeList.
-func (in *NodeList) DeepCopy() *NodeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanList.
+func (in *OrphanList) DeepCopy() *OrphanList {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeList)
+	out := new(OrphanList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NodeList) DeepCopyObject() runtime.Object {
+func (in *OrphanList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1724,72 +2662,51 @@ func (in *NodeList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeSpec) DeepCopyInto(out *NodeSpec) {
+func (in *OrphanSpec) DeepCopyInto(out *OrphanSpec) {
 	*out = *in
-	if in.Disks != nil {
-		in, out := &in.Disks, &out.Disks
-		*out = make(map[string]DiskSpec, len(*in))
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
+			(*out)[key] = val
 		}
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSpec.
-func (in *NodeSpec) DeepCopy() *NodeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanSpec.
+func (in *OrphanSpec) DeepCopy() *OrphanSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeSpec)
+	out := new(OrphanSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
+func (in *OrphanStatus) DeepCopyInto(out *OrphanStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]Condition, len(*in))
 		copy(*out, *in)
 	}
-	if in.DiskStatus != nil {
-		in, out := &in.DiskStatus, &out.DiskStatus
-		*out = make(map[string]*DiskStatus, len(*in))
-		for key, val := range *in {
-			var outVal *DiskStatus
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				in, out := &val, &outVal
-				*out = new(DiskStatus)
-				(*in).DeepCopyInto(*out)
-			}
-			(*out)[key] = outVal
-		}
-	}
-	in.SnapshotCheckStatus.DeepCopyInto(&out.SnapshotCheckStatus)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeStatus.
-func (in *NodeStatus) DeepCopy() *NodeStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanStatus.
+func (in *OrphanStatus) DeepCopy() *OrphanStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeStatus)
+	out := new(OrphanStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Orphan) DeepCopyInto(out *Orphan) {
+func (in *PreflightCheck) DeepCopyInto(out *PreflightCheck) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1798,18 +2715,18 @@ func (in *Orphan) DeepCopyInto(out *Orphan) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Orphan.
-func (in *Orphan) DeepCopy() *Orphan {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreflightCheck.
+func (in *PreflightCheck) DeepCopy() *PreflightCheck {
 	if in == nil {
 		return nil
 	}
-	out := new(Orphan)
+	out := new(PreflightCheck)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Orphan) DeepCopyObject() runtime.Object {
+func (in *PreflightCheck) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1817,13 +2734,29 @@ func (in *Orphan) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrphanList) DeepCopyInto(out *OrphanList) {
+func (in *PreflightCheckItem) DeepCopyInto(out *PreflightCheckItem) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreflightCheckItem.
+func (in *PreflightCheckItem) DeepCopy() *PreflightCheckItem {
+	if in == nil {
+		return nil
+	}
+	out := new(PreflightCheckItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreflightCheckList) DeepCopyInto(out *PreflightCheckList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Orphan, len(*in))
+		*out = make([]PreflightCheck, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1831,64 +2764,98 @@ func (in *OrphanList) DeepCopyInto(out *OrphanList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanList.
-func (in *OrphanList) DeepCopy() *OrphanList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreflightCheckList.
+func (in *PreflightCheckList) DeepCopy() *PreflightCheckList {
 	if in == nil {
 		return nil
 	}
-	out := new(OrphanList)
+	out := new(PreflightCheckList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OrphanList) DeepCopyObject() runtime.Object {
+func (in *PreflightCheckList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
-	return nil
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreflightCheckSpec) DeepCopyInto(out *PreflightCheckSpec) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DiskPaths != nil {
+		in, out := &in.DiskPaths, &out.DiskPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreflightCheckSpec.
+func (in *PreflightCheckSpec) DeepCopy() *PreflightCheckSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PreflightCheckSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrphanSpec) DeepCopyInto(out *OrphanSpec) {
+func (in *PreflightCheckStatus) DeepCopyInto(out *PreflightCheckStatus) {
 	*out = *in
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = make(map[string]string, len(*in))
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make(map[string]*PreflightNodeReport, len(*in))
 		for key, val := range *in {
-			(*out)[key] = val
+			var outVal *PreflightNodeReport
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(PreflightNodeReport)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
 		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanSpec.
-func (in *OrphanSpec) DeepCopy() *OrphanSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreflightCheckStatus.
+func (in *PreflightCheckStatus) DeepCopy() *PreflightCheckStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OrphanSpec)
+	out := new(PreflightCheckStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrphanStatus) DeepCopyInto(out *OrphanStatus) {
+func (in *PreflightNodeReport) DeepCopyInto(out *PreflightNodeReport) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]Condition, len(*in))
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]PreflightCheckItem, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanStatus.
-func (in *OrphanStatus) DeepCopy() *OrphanStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreflightNodeReport.
+func (in *PreflightNodeReport) DeepCopy() *PreflightNodeReport {
 	if in == nil {
 		return nil
 	}
-	out := new(OrphanStatus)
+	out := new(PreflightNodeReport)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2148,6 +3115,148 @@ func (in *RestoreStatus) DeepCopy() *RestoreStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrubReplicaResult) DeepCopyInto(out *ScrubReplicaResult) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrubReplicaResult.
+func (in *ScrubReplicaResult) DeepCopy() *ScrubReplicaResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrubReplicaResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrubReport) DeepCopyInto(out *ScrubReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrubReport.
+func (in *ScrubReport) DeepCopy() *ScrubReport {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrubReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScrubReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrubReportList) DeepCopyInto(out *ScrubReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScrubReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrubReportList.
+func (in *ScrubReportList) DeepCopy() *ScrubReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrubReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScrubReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrubReportSpec) DeepCopyInto(out *ScrubReportSpec) {
+	*out = *in
+	if in.Snapshots != nil {
+		in, out := &in.Snapshots, &out.Snapshots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrubReportSpec.
+func (in *ScrubReportSpec) DeepCopy() *ScrubReportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrubReportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrubReportStatus) DeepCopyInto(out *ScrubReportStatus) {
+	*out = *in
+	if in.Snapshots != nil {
+		in, out := &in.Snapshots, &out.Snapshots
+		*out = make([]ScrubSnapshotResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrubReportStatus.
+func (in *ScrubReportStatus) DeepCopy() *ScrubReportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrubReportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrubSnapshotResult) DeepCopyInto(out *ScrubSnapshotResult) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = make([]ScrubReplicaResult, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrubSnapshotResult.
+func (in *ScrubSnapshotResult) DeepCopy() *ScrubSnapshotResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrubSnapshotResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Setting) DeepCopyInto(out *Setting) {
 	*out = *in
@@ -2175,6 +3284,104 @@ func (in *Setting) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingHistory) DeepCopyInto(out *SettingHistory) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingHistory.
+func (in *SettingHistory) DeepCopy() *SettingHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SettingHistory) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingHistoryList) DeepCopyInto(out *SettingHistoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SettingHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingHistoryList.
+func (in *SettingHistoryList) DeepCopy() *SettingHistoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingHistoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SettingHistoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingHistorySpec) DeepCopyInto(out *SettingHistorySpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingHistorySpec.
+func (in *SettingHistorySpec) DeepCopy() *SettingHistorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingHistorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingHistoryStatus) DeepCopyInto(out *SettingHistoryStatus) {
+	*out = *in
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]SettingRevision, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingHistoryStatus.
+func (in *SettingHistoryStatus) DeepCopy() *SettingHistoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingHistoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SettingList) DeepCopyInto(out *SettingList) {
 	*out = *in
@@ -2208,6 +3415,22 @@ func (in *SettingList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingRevision) DeepCopyInto(out *SettingRevision) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingRevision.
+func (in *SettingRevision) DeepCopy() *SettingRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SettingStatus) DeepCopyInto(out *SettingStatus) {
 	*out = *in
@@ -2499,7 +3722,7 @@ func (in *SupportBundle) DeepCopyInto(out *SupportBundle) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -2552,12 +3775,56 @@ func (in *SupportBundleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
-	return nil
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupportBundleRedactionPolicy) DeepCopyInto(out *SupportBundleRedactionPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundleRedactionPolicy.
+func (in *SupportBundleRedactionPolicy) DeepCopy() *SupportBundleRedactionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SupportBundleRedactionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupportBundleScope) DeepCopyInto(out *SupportBundleScope) {
+	*out = *in
+	if in.VolumeNames != nil {
+		in, out := &in.VolumeNames, &out.VolumeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeNames != nil {
+		in, out := &in.NodeNames, &out.NodeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundleScope.
+func (in *SupportBundleScope) DeepCopy() *SupportBundleScope {
+	if in == nil {
+		return nil
+	}
+	out := new(SupportBundleScope)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SupportBundleSpec) DeepCopyInto(out *SupportBundleSpec) {
 	*out = *in
+	in.Scope.DeepCopyInto(&out.Scope)
+	out.RedactionPolicy = in.RedactionPolicy
 	return
 }
 
@@ -2850,6 +4117,24 @@ func (in *Volume) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeActivityStatus) DeepCopyInto(out *VolumeActivityStatus) {
+	*out = *in
+	out.IO = in.IO
+	out.ObservedEngineIO = in.ObservedEngineIO
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeActivityStatus.
+func (in *VolumeActivityStatus) DeepCopy() *VolumeActivityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeActivityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeAttachment) DeepCopyInto(out *VolumeAttachment) {
 	*out = *in
@@ -2973,6 +4258,22 @@ func (in *VolumeAttachmentStatus) DeepCopy() *VolumeAttachmentStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeAutoExpansionPolicy) DeepCopyInto(out *VolumeAutoExpansionPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeAutoExpansionPolicy.
+func (in *VolumeAutoExpansionPolicy) DeepCopy() *VolumeAutoExpansionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeAutoExpansionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeCloneStatus) DeepCopyInto(out *VolumeCloneStatus) {
 	*out = *in
@@ -2989,6 +4290,38 @@ func (in *VolumeCloneStatus) DeepCopy() *VolumeCloneStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeFilesystemUsage) DeepCopyInto(out *VolumeFilesystemUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeFilesystemUsage.
+func (in *VolumeFilesystemUsage) DeepCopy() *VolumeFilesystemUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeFilesystemUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeIOStats) DeepCopyInto(out *VolumeIOStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeIOStats.
+func (in *VolumeIOStats) DeepCopy() *VolumeIOStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeIOStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeList) DeepCopyInto(out *VolumeList) {
 	*out = *in
@@ -3022,6 +4355,22 @@ func (in *VolumeList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeRecoveryPointStatus) DeepCopyInto(out *VolumeRecoveryPointStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeRecoveryPointStatus.
+func (in *VolumeRecoveryPointStatus) DeepCopy() *VolumeRecoveryPointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeRecoveryPointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeRecurringJob) DeepCopyInto(out *VolumeRecurringJob) {
 	*out = *in
@@ -3060,6 +4409,106 @@ func (in *VolumeRecurringJobInfo) DeepCopy() *VolumeRecurringJobInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeShrink) DeepCopyInto(out *VolumeShrink) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeShrink.
+func (in *VolumeShrink) DeepCopy() *VolumeShrink {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeShrink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeShrink) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeShrinkList) DeepCopyInto(out *VolumeShrinkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VolumeShrink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeShrinkList.
+func (in *VolumeShrinkList) DeepCopy() *VolumeShrinkList {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeShrinkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeShrinkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeShrinkSpec) DeepCopyInto(out *VolumeShrinkSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeShrinkSpec.
+func (in *VolumeShrinkSpec) DeepCopy() *VolumeShrinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeShrinkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeShrinkStatus) DeepCopyInto(out *VolumeShrinkStatus) {
+	*out = *in
+	if in.PVCLabels != nil {
+		in, out := &in.PVCLabels, &out.PVCLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeShrinkStatus.
+func (in *VolumeShrinkStatus) DeepCopy() *VolumeShrinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeShrinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSpec) DeepCopyInto(out *VolumeSpec) {
 	*out = *in
@@ -3073,6 +4522,16 @@ func (in *VolumeSpec) DeepCopyInto(out *VolumeSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.TieringPolicy != nil {
+		in, out := &in.TieringPolicy, &out.TieringPolicy
+		*out = new(VolumeTieringPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoExpansionPolicy != nil {
+		in, out := &in.AutoExpansionPolicy, &out.AutoExpansionPolicy
+		*out = new(VolumeAutoExpansionPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -3096,6 +4555,26 @@ func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
 		copy(*out, *in)
 	}
 	out.CloneStatus = in.CloneStatus
+	if in.Tiering != nil {
+		in, out := &in.Tiering, &out.Tiering
+		*out = new(VolumeTieringStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RecoveryPoint != nil {
+		in, out := &in.RecoveryPoint, &out.RecoveryPoint
+		*out = new(VolumeRecoveryPointStatus)
+		**out = **in
+	}
+	if in.FilesystemUsage != nil {
+		in, out := &in.FilesystemUsage, &out.FilesystemUsage
+		*out = new(VolumeFilesystemUsage)
+		**out = **in
+	}
+	if in.Activity != nil {
+		in, out := &in.Activity, &out.Activity
+		*out = new(VolumeActivityStatus)
+		**out = **in
+	}
 	return
 }
 
@@ -3109,6 +4588,146 @@ func (in *VolumeStatus) DeepCopy() *VolumeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeTieringPolicy) DeepCopyInto(out *VolumeTieringPolicy) {
+	*out = *in
+	if in.DiskSelector != nil {
+		in, out := &in.DiskSelector, &out.DiskSelector
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeTieringPolicy.
+func (in *VolumeTieringPolicy) DeepCopy() *VolumeTieringPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeTieringPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeTieringStatus) DeepCopyInto(out *VolumeTieringStatus) {
+	*out = *in
+	if in.DiskSelector != nil {
+		in, out := &in.DiskSelector, &out.DiskSelector
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OriginalDiskSelector != nil {
+		in, out := &in.OriginalDiskSelector, &out.OriginalDiskSelector
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeTieringStatus.
+func (in *VolumeTieringStatus) DeepCopy() *VolumeTieringStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeTieringStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeTransfer) DeepCopyInto(out *VolumeTransfer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeTransfer.
+func (in *VolumeTransfer) DeepCopy() *VolumeTransfer {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeTransfer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeTransfer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeTransferList) DeepCopyInto(out *VolumeTransferList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VolumeTransfer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeTransferList.
+func (in *VolumeTransferList) DeepCopy() *VolumeTransferList {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeTransferList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeTransferList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeTransferSpec) DeepCopyInto(out *VolumeTransferSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeTransferSpec.
+func (in *VolumeTransferSpec) DeepCopy() *VolumeTransferSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeTransferSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeTransferStatus) DeepCopyInto(out *VolumeTransferStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeTransferStatus.
+func (in *VolumeTransferStatus) DeepCopy() *VolumeTransferStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeTransferStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadStatus) DeepCopyInto(out *WorkloadStatus) {
 	*out = *in
@@ -0,0 +1,111 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// CapacityForecastApplyConfiguration represents a declarative configuration of the CapacityForecast type for use
+// with apply.
+type CapacityForecastApplyConfiguration struct {
+	UsageGrowthPerDay              *int64  `json:"usageGrowthPerDay,omitempty"`
+	ActualSizeGrowthPerDay         *int64  `json:"actualSizeGrowthPerDay,omitempty"`
+	ScheduledGrowthPerDay          *int64  `json:"scheduledGrowthPerDay,omitempty"`
+	StorageRemaining               *int64  `json:"storageRemaining,omitempty"`
+	SchedulableRemaining           *int64  `json:"schedulableRemaining,omitempty"`
+	DaysUntilFull                  *int64  `json:"daysUntilFull,omitempty"`
+	DaysUntilOverProvisioningLimit *int64  `json:"daysUntilOverProvisioningLimit,omitempty"`
+	SampleCount                    *int    `json:"sampleCount,omitempty"`
+	LastUpdatedAt                  *string `json:"lastUpdatedAt,omitempty"`
+}
+
+// CapacityForecastApplyConfiguration constructs a declarative configuration of the CapacityForecast type for use with
+// apply.
+func CapacityForecast() *CapacityForecastApplyConfiguration {
+	return &CapacityForecastApplyConfiguration{}
+}
+
+// WithUsageGrowthPerDay sets the UsageGrowthPerDay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UsageGrowthPerDay field is set to the value of the last call.
+func (b *CapacityForecastApplyConfiguration) WithUsageGrowthPerDay(value int64) *CapacityForecastApplyConfiguration {
+	b.UsageGrowthPerDay = &value
+	return b
+}
+
+// WithActualSizeGrowthPerDay sets the ActualSizeGrowthPerDay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActualSizeGrowthPerDay field is set to the value of the last call.
+func (b *CapacityForecastApplyConfiguration) WithActualSizeGrowthPerDay(value int64) *CapacityForecastApplyConfiguration {
+	b.ActualSizeGrowthPerDay = &value
+	return b
+}
+
+// WithScheduledGrowthPerDay sets the ScheduledGrowthPerDay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScheduledGrowthPerDay field is set to the value of the last call.
+func (b *CapacityForecastApplyConfiguration) WithScheduledGrowthPerDay(value int64) *CapacityForecastApplyConfiguration {
+	b.ScheduledGrowthPerDay = &value
+	return b
+}
+
+// WithStorageRemaining sets the StorageRemaining field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StorageRemaining field is set to the value of the last call.
+func (b *CapacityForecastApplyConfiguration) WithStorageRemaining(value int64) *CapacityForecastApplyConfiguration {
+	b.StorageRemaining = &value
+	return b
+}
+
+// WithSchedulableRemaining sets the SchedulableRemaining field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SchedulableRemaining field is set to the value of the last call.
+func (b *CapacityForecastApplyConfiguration) WithSchedulableRemaining(value int64) *CapacityForecastApplyConfiguration {
+	b.SchedulableRemaining = &value
+	return b
+}
+
+// WithDaysUntilFull sets the DaysUntilFull field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DaysUntilFull field is set to the value of the last call.
+func (b *CapacityForecastApplyConfiguration) WithDaysUntilFull(value int64) *CapacityForecastApplyConfiguration {
+	b.DaysUntilFull = &value
+	return b
+}
+
+// WithDaysUntilOverProvisioningLimit sets the DaysUntilOverProvisioningLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DaysUntilOverProvisioningLimit field is set to the value of the last call.
+func (b *CapacityForecastApplyConfiguration) WithDaysUntilOverProvisioningLimit(value int64) *CapacityForecastApplyConfiguration {
+	b.DaysUntilOverProvisioningLimit = &value
+	return b
+}
+
+// WithSampleCount sets the SampleCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SampleCount field is set to the value of the last call.
+func (b *CapacityForecastApplyConfiguration) WithSampleCount(value int) *CapacityForecastApplyConfiguration {
+	b.SampleCount = &value
+	return b
+}
+
+// WithLastUpdatedAt sets the LastUpdatedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUpdatedAt field is set to the value of the last call.
+func (b *CapacityForecastApplyConfiguration) WithLastUpdatedAt(value string) *CapacityForecastApplyConfiguration {
+	b.LastUpdatedAt = &value
+	return b
+}
@@ -0,0 +1,53 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// CapacityHistoryApplyConfiguration represents a declarative configuration of the CapacityHistory type for use
+// with apply.
+type CapacityHistoryApplyConfiguration struct {
+	DiskUUID *string                            `json:"diskUUID,omitempty"`
+	Samples  []CapacitySampleApplyConfiguration `json:"samples,omitempty"`
+}
+
+// CapacityHistoryApplyConfiguration constructs a declarative configuration of the CapacityHistory type for use with
+// apply.
+func CapacityHistory() *CapacityHistoryApplyConfiguration {
+	return &CapacityHistoryApplyConfiguration{}
+}
+
+// WithDiskUUID sets the DiskUUID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DiskUUID field is set to the value of the last call.
+func (b *CapacityHistoryApplyConfiguration) WithDiskUUID(value string) *CapacityHistoryApplyConfiguration {
+	b.DiskUUID = &value
+	return b
+}
+
+// WithSamples adds the given value to the Samples field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Samples field.
+func (b *CapacityHistoryApplyConfiguration) WithSamples(values ...*CapacitySampleApplyConfiguration) *CapacityHistoryApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSamples")
+		}
+		b.Samples = append(b.Samples, *values[i])
+	}
+	return b
+}
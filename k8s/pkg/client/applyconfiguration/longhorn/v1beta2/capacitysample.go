@@ -0,0 +1,66 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// CapacitySampleApplyConfiguration represents a declarative configuration of the CapacitySample type for use
+// with apply.
+type CapacitySampleApplyConfiguration struct {
+	Time       *string `json:"time,omitempty"`
+	Used       *int64  `json:"used,omitempty"`
+	ActualSize *int64  `json:"actualSize,omitempty"`
+	Scheduled  *int64  `json:"scheduled,omitempty"`
+}
+
+// CapacitySampleApplyConfiguration constructs a declarative configuration of the CapacitySample type for use with
+// apply.
+func CapacitySample() *CapacitySampleApplyConfiguration {
+	return &CapacitySampleApplyConfiguration{}
+}
+
+// WithTime sets the Time field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Time field is set to the value of the last call.
+func (b *CapacitySampleApplyConfiguration) WithTime(value string) *CapacitySampleApplyConfiguration {
+	b.Time = &value
+	return b
+}
+
+// WithUsed sets the Used field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Used field is set to the value of the last call.
+func (b *CapacitySampleApplyConfiguration) WithUsed(value int64) *CapacitySampleApplyConfiguration {
+	b.Used = &value
+	return b
+}
+
+// WithActualSize sets the ActualSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActualSize field is set to the value of the last call.
+func (b *CapacitySampleApplyConfiguration) WithActualSize(value int64) *CapacitySampleApplyConfiguration {
+	b.ActualSize = &value
+	return b
+}
+
+// WithScheduled sets the Scheduled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scheduled field is set to the value of the last call.
+func (b *CapacitySampleApplyConfiguration) WithScheduled(value int64) *CapacitySampleApplyConfiguration {
+	b.Scheduled = &value
+	return b
+}
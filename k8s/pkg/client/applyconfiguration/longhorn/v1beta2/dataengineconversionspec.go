@@ -0,0 +1,61 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// DataEngineConversionSpecApplyConfiguration represents a declarative configuration of the DataEngineConversionSpec type for use
+// with apply.
+type DataEngineConversionSpecApplyConfiguration struct {
+	VolumeName       *string                         `json:"volumeName,omitempty"`
+	TargetDataEngine *longhornv1beta2.DataEngineType `json:"targetDataEngine,omitempty"`
+	TargetVolumeName *string                         `json:"targetVolumeName,omitempty"`
+}
+
+// DataEngineConversionSpecApplyConfiguration constructs a declarative configuration of the DataEngineConversionSpec type for use with
+// apply.
+func DataEngineConversionSpec() *DataEngineConversionSpecApplyConfiguration {
+	return &DataEngineConversionSpecApplyConfiguration{}
+}
+
+// WithVolumeName sets the VolumeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeName field is set to the value of the last call.
+func (b *DataEngineConversionSpecApplyConfiguration) WithVolumeName(value string) *DataEngineConversionSpecApplyConfiguration {
+	b.VolumeName = &value
+	return b
+}
+
+// WithTargetDataEngine sets the TargetDataEngine field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetDataEngine field is set to the value of the last call.
+func (b *DataEngineConversionSpecApplyConfiguration) WithTargetDataEngine(value longhornv1beta2.DataEngineType) *DataEngineConversionSpecApplyConfiguration {
+	b.TargetDataEngine = &value
+	return b
+}
+
+// WithTargetVolumeName sets the TargetVolumeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetVolumeName field is set to the value of the last call.
+func (b *DataEngineConversionSpecApplyConfiguration) WithTargetVolumeName(value string) *DataEngineConversionSpecApplyConfiguration {
+	b.TargetVolumeName = &value
+	return b
+}
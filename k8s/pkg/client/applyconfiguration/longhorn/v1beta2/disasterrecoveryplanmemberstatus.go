@@ -0,0 +1,129 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// DisasterRecoveryPlanMemberStatusApplyConfiguration represents a declarative configuration of the DisasterRecoveryPlanMemberStatus type for use
+// with apply.
+type DisasterRecoveryPlanMemberStatusApplyConfiguration struct {
+	LastBackup           *string `json:"lastBackup,omitempty"`
+	LastBackupAt         *string `json:"lastBackupAt,omitempty"`
+	LastRestoredBackup   *string `json:"lastRestoredBackup,omitempty"`
+	LastRestoredBackupAt *string `json:"lastRestoredBackupAt,omitempty"`
+	RestoreLag           *string `json:"restoreLag,omitempty"`
+	Ready                *bool   `json:"ready,omitempty"`
+	Activated            *bool   `json:"activated,omitempty"`
+	PVName               *string `json:"pvName,omitempty"`
+	PVCName              *string `json:"pvcName,omitempty"`
+	PVCNamespace         *string `json:"pvcNamespace,omitempty"`
+	Message              *string `json:"message,omitempty"`
+}
+
+// DisasterRecoveryPlanMemberStatusApplyConfiguration constructs a declarative configuration of the DisasterRecoveryPlanMemberStatus type for use with
+// apply.
+func DisasterRecoveryPlanMemberStatus() *DisasterRecoveryPlanMemberStatusApplyConfiguration {
+	return &DisasterRecoveryPlanMemberStatusApplyConfiguration{}
+}
+
+// WithLastBackup sets the LastBackup field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastBackup field is set to the value of the last call.
+func (b *DisasterRecoveryPlanMemberStatusApplyConfiguration) WithLastBackup(value string) *DisasterRecoveryPlanMemberStatusApplyConfiguration {
+	b.LastBackup = &value
+	return b
+}
+
+// WithLastBackupAt sets the LastBackupAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastBackupAt field is set to the value of the last call.
+func (b *DisasterRecoveryPlanMemberStatusApplyConfiguration) WithLastBackupAt(value string) *DisasterRecoveryPlanMemberStatusApplyConfiguration {
+	b.LastBackupAt = &value
+	return b
+}
+
+// WithLastRestoredBackup sets the LastRestoredBackup field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastRestoredBackup field is set to the value of the last call.
+func (b *DisasterRecoveryPlanMemberStatusApplyConfiguration) WithLastRestoredBackup(value string) *DisasterRecoveryPlanMemberStatusApplyConfiguration {
+	b.LastRestoredBackup = &value
+	return b
+}
+
+// WithLastRestoredBackupAt sets the LastRestoredBackupAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastRestoredBackupAt field is set to the value of the last call.
+func (b *DisasterRecoveryPlanMemberStatusApplyConfiguration) WithLastRestoredBackupAt(value string) *DisasterRecoveryPlanMemberStatusApplyConfiguration {
+	b.LastRestoredBackupAt = &value
+	return b
+}
+
+// WithRestoreLag sets the RestoreLag field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestoreLag field is set to the value of the last call.
+func (b *DisasterRecoveryPlanMemberStatusApplyConfiguration) WithRestoreLag(value string) *DisasterRecoveryPlanMemberStatusApplyConfiguration {
+	b.RestoreLag = &value
+	return b
+}
+
+// WithReady sets the Ready field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ready field is set to the value of the last call.
+func (b *DisasterRecoveryPlanMemberStatusApplyConfiguration) WithReady(value bool) *DisasterRecoveryPlanMemberStatusApplyConfiguration {
+	b.Ready = &value
+	return b
+}
+
+// WithActivated sets the Activated field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Activated field is set to the value of the last call.
+func (b *DisasterRecoveryPlanMemberStatusApplyConfiguration) WithActivated(value bool) *DisasterRecoveryPlanMemberStatusApplyConfiguration {
+	b.Activated = &value
+	return b
+}
+
+// WithPVName sets the PVName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PVName field is set to the value of the last call.
+func (b *DisasterRecoveryPlanMemberStatusApplyConfiguration) WithPVName(value string) *DisasterRecoveryPlanMemberStatusApplyConfiguration {
+	b.PVName = &value
+	return b
+}
+
+// WithPVCName sets the PVCName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PVCName field is set to the value of the last call.
+func (b *DisasterRecoveryPlanMemberStatusApplyConfiguration) WithPVCName(value string) *DisasterRecoveryPlanMemberStatusApplyConfiguration {
+	b.PVCName = &value
+	return b
+}
+
+// WithPVCNamespace sets the PVCNamespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PVCNamespace field is set to the value of the last call.
+func (b *DisasterRecoveryPlanMemberStatusApplyConfiguration) WithPVCNamespace(value string) *DisasterRecoveryPlanMemberStatusApplyConfiguration {
+	b.PVCNamespace = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *DisasterRecoveryPlanMemberStatusApplyConfiguration) WithMessage(value string) *DisasterRecoveryPlanMemberStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
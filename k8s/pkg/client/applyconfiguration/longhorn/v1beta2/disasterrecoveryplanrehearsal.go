@@ -0,0 +1,59 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// DisasterRecoveryPlanRehearsalApplyConfiguration represents a declarative configuration of the DisasterRecoveryPlanRehearsal type for use
+// with apply.
+type DisasterRecoveryPlanRehearsalApplyConfiguration struct {
+	Passed      *bool    `json:"passed,omitempty"`
+	Issues      []string `json:"issues,omitempty"`
+	RehearsedAt *string  `json:"rehearsedAt,omitempty"`
+}
+
+// DisasterRecoveryPlanRehearsalApplyConfiguration constructs a declarative configuration of the DisasterRecoveryPlanRehearsal type for use with
+// apply.
+func DisasterRecoveryPlanRehearsal() *DisasterRecoveryPlanRehearsalApplyConfiguration {
+	return &DisasterRecoveryPlanRehearsalApplyConfiguration{}
+}
+
+// WithPassed sets the Passed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Passed field is set to the value of the last call.
+func (b *DisasterRecoveryPlanRehearsalApplyConfiguration) WithPassed(value bool) *DisasterRecoveryPlanRehearsalApplyConfiguration {
+	b.Passed = &value
+	return b
+}
+
+// WithIssues adds the given value to the Issues field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Issues field.
+func (b *DisasterRecoveryPlanRehearsalApplyConfiguration) WithIssues(values ...string) *DisasterRecoveryPlanRehearsalApplyConfiguration {
+	for i := range values {
+		b.Issues = append(b.Issues, values[i])
+	}
+	return b
+}
+
+// WithRehearsedAt sets the RehearsedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RehearsedAt field is set to the value of the last call.
+func (b *DisasterRecoveryPlanRehearsalApplyConfiguration) WithRehearsedAt(value string) *DisasterRecoveryPlanRehearsalApplyConfiguration {
+	b.RehearsedAt = &value
+	return b
+}
@@ -0,0 +1,138 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// DisasterRecoveryPlanSpecApplyConfiguration represents a declarative configuration of the DisasterRecoveryPlanSpec type for use
+// with apply.
+type DisasterRecoveryPlanSpecApplyConfiguration struct {
+	Volumes              []string                        `json:"volumes,omitempty"`
+	VolumeSelector       map[string]string               `json:"volumeSelector,omitempty"`
+	TargetNamespace      *string                         `json:"targetNamespace,omitempty"`
+	PVCNamespaces        map[string]string               `json:"pvcNamespaces,omitempty"`
+	StorageClassName     *string                         `json:"storageClassName,omitempty"`
+	FSType               *string                         `json:"fsType,omitempty"`
+	Frontend             *longhornv1beta2.VolumeFrontend `json:"frontend,omitempty"`
+	MaxRecoveryPointSkew *int                            `json:"maxRecoveryPointSkew,omitempty"`
+	Failover             *bool                           `json:"failover,omitempty"`
+	DryRun               *bool                           `json:"dryRun,omitempty"`
+}
+
+// DisasterRecoveryPlanSpecApplyConfiguration constructs a declarative configuration of the DisasterRecoveryPlanSpec type for use with
+// apply.
+func DisasterRecoveryPlanSpec() *DisasterRecoveryPlanSpecApplyConfiguration {
+	return &DisasterRecoveryPlanSpecApplyConfiguration{}
+}
+
+// WithVolumes adds the given value to the Volumes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Volumes field.
+func (b *DisasterRecoveryPlanSpecApplyConfiguration) WithVolumes(values ...string) *DisasterRecoveryPlanSpecApplyConfiguration {
+	for i := range values {
+		b.Volumes = append(b.Volumes, values[i])
+	}
+	return b
+}
+
+// WithVolumeSelector puts the entries into the VolumeSelector field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the VolumeSelector field,
+// overwriting an existing map entries in VolumeSelector field with the same key.
+func (b *DisasterRecoveryPlanSpecApplyConfiguration) WithVolumeSelector(entries map[string]string) *DisasterRecoveryPlanSpecApplyConfiguration {
+	if b.VolumeSelector == nil && len(entries) > 0 {
+		b.VolumeSelector = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.VolumeSelector[k] = v
+	}
+	return b
+}
+
+// WithTargetNamespace sets the TargetNamespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetNamespace field is set to the value of the last call.
+func (b *DisasterRecoveryPlanSpecApplyConfiguration) WithTargetNamespace(value string) *DisasterRecoveryPlanSpecApplyConfiguration {
+	b.TargetNamespace = &value
+	return b
+}
+
+// WithPVCNamespaces puts the entries into the PVCNamespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the PVCNamespaces field,
+// overwriting an existing map entries in PVCNamespaces field with the same key.
+func (b *DisasterRecoveryPlanSpecApplyConfiguration) WithPVCNamespaces(entries map[string]string) *DisasterRecoveryPlanSpecApplyConfiguration {
+	if b.PVCNamespaces == nil && len(entries) > 0 {
+		b.PVCNamespaces = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.PVCNamespaces[k] = v
+	}
+	return b
+}
+
+// WithStorageClassName sets the StorageClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StorageClassName field is set to the value of the last call.
+func (b *DisasterRecoveryPlanSpecApplyConfiguration) WithStorageClassName(value string) *DisasterRecoveryPlanSpecApplyConfiguration {
+	b.StorageClassName = &value
+	return b
+}
+
+// WithFSType sets the FSType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FSType field is set to the value of the last call.
+func (b *DisasterRecoveryPlanSpecApplyConfiguration) WithFSType(value string) *DisasterRecoveryPlanSpecApplyConfiguration {
+	b.FSType = &value
+	return b
+}
+
+// WithFrontend sets the Frontend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Frontend field is set to the value of the last call.
+func (b *DisasterRecoveryPlanSpecApplyConfiguration) WithFrontend(value longhornv1beta2.VolumeFrontend) *DisasterRecoveryPlanSpecApplyConfiguration {
+	b.Frontend = &value
+	return b
+}
+
+// WithMaxRecoveryPointSkew sets the MaxRecoveryPointSkew field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRecoveryPointSkew field is set to the value of the last call.
+func (b *DisasterRecoveryPlanSpecApplyConfiguration) WithMaxRecoveryPointSkew(value int) *DisasterRecoveryPlanSpecApplyConfiguration {
+	b.MaxRecoveryPointSkew = &value
+	return b
+}
+
+// WithFailover sets the Failover field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Failover field is set to the value of the last call.
+func (b *DisasterRecoveryPlanSpecApplyConfiguration) WithFailover(value bool) *DisasterRecoveryPlanSpecApplyConfiguration {
+	b.Failover = &value
+	return b
+}
+
+// WithDryRun sets the DryRun field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DryRun field is set to the value of the last call.
+func (b *DisasterRecoveryPlanSpecApplyConfiguration) WithDryRun(value bool) *DisasterRecoveryPlanSpecApplyConfiguration {
+	b.DryRun = &value
+	return b
+}
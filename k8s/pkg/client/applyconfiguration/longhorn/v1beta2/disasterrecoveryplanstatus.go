@@ -0,0 +1,121 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// DisasterRecoveryPlanStatusApplyConfiguration represents a declarative configuration of the DisasterRecoveryPlanStatus type for use
+// with apply.
+type DisasterRecoveryPlanStatusApplyConfiguration struct {
+	OwnerID             *string                                                      `json:"ownerID,omitempty"`
+	State               *longhornv1beta2.DisasterRecoveryPlanState                   `json:"state,omitempty"`
+	Message             *string                                                      `json:"message,omitempty"`
+	RecoveryPoint       *string                                                      `json:"recoveryPoint,omitempty"`
+	RecoveryPointSkew   *string                                                      `json:"recoveryPointSkew,omitempty"`
+	FailoverStartedAt   *string                                                      `json:"failoverStartedAt,omitempty"`
+	FailoverCompletedAt *string                                                      `json:"failoverCompletedAt,omitempty"`
+	Rehearsal           *DisasterRecoveryPlanRehearsalApplyConfiguration             `json:"rehearsal,omitempty"`
+	Members             map[string]*longhornv1beta2.DisasterRecoveryPlanMemberStatus `json:"members,omitempty"`
+}
+
+// DisasterRecoveryPlanStatusApplyConfiguration constructs a declarative configuration of the DisasterRecoveryPlanStatus type for use with
+// apply.
+func DisasterRecoveryPlanStatus() *DisasterRecoveryPlanStatusApplyConfiguration {
+	return &DisasterRecoveryPlanStatusApplyConfiguration{}
+}
+
+// WithOwnerID sets the OwnerID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OwnerID field is set to the value of the last call.
+func (b *DisasterRecoveryPlanStatusApplyConfiguration) WithOwnerID(value string) *DisasterRecoveryPlanStatusApplyConfiguration {
+	b.OwnerID = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *DisasterRecoveryPlanStatusApplyConfiguration) WithState(value longhornv1beta2.DisasterRecoveryPlanState) *DisasterRecoveryPlanStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *DisasterRecoveryPlanStatusApplyConfiguration) WithMessage(value string) *DisasterRecoveryPlanStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithRecoveryPoint sets the RecoveryPoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RecoveryPoint field is set to the value of the last call.
+func (b *DisasterRecoveryPlanStatusApplyConfiguration) WithRecoveryPoint(value string) *DisasterRecoveryPlanStatusApplyConfiguration {
+	b.RecoveryPoint = &value
+	return b
+}
+
+// WithRecoveryPointSkew sets the RecoveryPointSkew field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RecoveryPointSkew field is set to the value of the last call.
+func (b *DisasterRecoveryPlanStatusApplyConfiguration) WithRecoveryPointSkew(value string) *DisasterRecoveryPlanStatusApplyConfiguration {
+	b.RecoveryPointSkew = &value
+	return b
+}
+
+// WithFailoverStartedAt sets the FailoverStartedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailoverStartedAt field is set to the value of the last call.
+func (b *DisasterRecoveryPlanStatusApplyConfiguration) WithFailoverStartedAt(value string) *DisasterRecoveryPlanStatusApplyConfiguration {
+	b.FailoverStartedAt = &value
+	return b
+}
+
+// WithFailoverCompletedAt sets the FailoverCompletedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailoverCompletedAt field is set to the value of the last call.
+func (b *DisasterRecoveryPlanStatusApplyConfiguration) WithFailoverCompletedAt(value string) *DisasterRecoveryPlanStatusApplyConfiguration {
+	b.FailoverCompletedAt = &value
+	return b
+}
+
+// WithRehearsal sets the Rehearsal field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rehearsal field is set to the value of the last call.
+func (b *DisasterRecoveryPlanStatusApplyConfiguration) WithRehearsal(value *DisasterRecoveryPlanRehearsalApplyConfiguration) *DisasterRecoveryPlanStatusApplyConfiguration {
+	b.Rehearsal = value
+	return b
+}
+
+// WithMembers puts the entries into the Members field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Members field,
+// overwriting an existing map entries in Members field with the same key.
+func (b *DisasterRecoveryPlanStatusApplyConfiguration) WithMembers(entries map[string]*longhornv1beta2.DisasterRecoveryPlanMemberStatus) *DisasterRecoveryPlanStatusApplyConfiguration {
+	if b.Members == nil && len(entries) > 0 {
+		b.Members = make(map[string]*longhornv1beta2.DisasterRecoveryPlanMemberStatus, len(entries))
+	}
+	for k, v := range entries {
+		b.Members[k] = v
+	}
+	return b
+}
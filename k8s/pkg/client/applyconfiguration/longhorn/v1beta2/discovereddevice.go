@@ -0,0 +1,102 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// DiscoveredDeviceApplyConfiguration represents a declarative configuration of the DiscoveredDevice type for use
+// with apply.
+type DiscoveredDeviceApplyConfiguration struct {
+	Path           *string `json:"path,omitempty"`
+	Model          *string `json:"model,omitempty"`
+	Size           *int64  `json:"size,omitempty"`
+	Rotational     *bool   `json:"rotational,omitempty"`
+	Rule           *string `json:"rule,omitempty"`
+	DiskName       *string `json:"diskName,omitempty"`
+	Provisioned    *bool   `json:"provisioned,omitempty"`
+	RejectedReason *string `json:"rejectedReason,omitempty"`
+}
+
+// DiscoveredDeviceApplyConfiguration constructs a declarative configuration of the DiscoveredDevice type for use with
+// apply.
+func DiscoveredDevice() *DiscoveredDeviceApplyConfiguration {
+	return &DiscoveredDeviceApplyConfiguration{}
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *DiscoveredDeviceApplyConfiguration) WithPath(value string) *DiscoveredDeviceApplyConfiguration {
+	b.Path = &value
+	return b
+}
+
+// WithModel sets the Model field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Model field is set to the value of the last call.
+func (b *DiscoveredDeviceApplyConfiguration) WithModel(value string) *DiscoveredDeviceApplyConfiguration {
+	b.Model = &value
+	return b
+}
+
+// WithSize sets the Size field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Size field is set to the value of the last call.
+func (b *DiscoveredDeviceApplyConfiguration) WithSize(value int64) *DiscoveredDeviceApplyConfiguration {
+	b.Size = &value
+	return b
+}
+
+// WithRotational sets the Rotational field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rotational field is set to the value of the last call.
+func (b *DiscoveredDeviceApplyConfiguration) WithRotational(value bool) *DiscoveredDeviceApplyConfiguration {
+	b.Rotational = &value
+	return b
+}
+
+// WithRule sets the Rule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rule field is set to the value of the last call.
+func (b *DiscoveredDeviceApplyConfiguration) WithRule(value string) *DiscoveredDeviceApplyConfiguration {
+	b.Rule = &value
+	return b
+}
+
+// WithDiskName sets the DiskName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DiskName field is set to the value of the last call.
+func (b *DiscoveredDeviceApplyConfiguration) WithDiskName(value string) *DiscoveredDeviceApplyConfiguration {
+	b.DiskName = &value
+	return b
+}
+
+// WithProvisioned sets the Provisioned field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provisioned field is set to the value of the last call.
+func (b *DiscoveredDeviceApplyConfiguration) WithProvisioned(value bool) *DiscoveredDeviceApplyConfiguration {
+	b.Provisioned = &value
+	return b
+}
+
+// WithRejectedReason sets the RejectedReason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RejectedReason field is set to the value of the last call.
+func (b *DiscoveredDeviceApplyConfiguration) WithRejectedReason(value string) *DiscoveredDeviceApplyConfiguration {
+	b.RejectedReason = &value
+	return b
+}
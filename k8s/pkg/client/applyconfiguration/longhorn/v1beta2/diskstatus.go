@@ -25,19 +25,23 @@ import (
 // DiskStatusApplyConfiguration represents a declarative configuration of the DiskStatus type for use
 // with apply.
 type DiskStatusApplyConfiguration struct {
-	Conditions            []ConditionApplyConfiguration `json:"conditions,omitempty"`
-	StorageAvailable      *int64                        `json:"storageAvailable,omitempty"`
-	StorageScheduled      *int64                        `json:"storageScheduled,omitempty"`
-	StorageMaximum        *int64                        `json:"storageMaximum,omitempty"`
-	ScheduledReplica      map[string]int64              `json:"scheduledReplica,omitempty"`
-	ScheduledBackingImage map[string]int64              `json:"scheduledBackingImage,omitempty"`
-	DiskUUID              *string                       `json:"diskUUID,omitempty"`
-	DiskName              *string                       `json:"diskName,omitempty"`
-	DiskPath              *string                       `json:"diskPath,omitempty"`
-	Type                  *longhornv1beta2.DiskType     `json:"diskType,omitempty"`
-	DiskDriver            *longhornv1beta2.DiskDriver   `json:"diskDriver,omitempty"`
-	FSType                *string                       `json:"filesystemType,omitempty"`
-	InstanceManagerName   *string                       `json:"instanceManagerName,omitempty"`
+	Conditions            []ConditionApplyConfiguration       `json:"conditions,omitempty"`
+	StorageAvailable      *int64                              `json:"storageAvailable,omitempty"`
+	StorageScheduled      *int64                              `json:"storageScheduled,omitempty"`
+	StorageMaximum        *int64                              `json:"storageMaximum,omitempty"`
+	ScheduledReplica      map[string]int64                    `json:"scheduledReplica,omitempty"`
+	ScheduledBackingImage map[string]int64                    `json:"scheduledBackingImage,omitempty"`
+	ReplicaCount          *int                                `json:"replicaCount,omitempty"`
+	ReplicaCountLimit     *int                                `json:"replicaCountLimit,omitempty"`
+	DiskUUID              *string                             `json:"diskUUID,omitempty"`
+	DiskName              *string                             `json:"diskName,omitempty"`
+	DiskPath              *string                             `json:"diskPath,omitempty"`
+	Type                  *longhornv1beta2.DiskType           `json:"diskType,omitempty"`
+	DiskDriver            *longhornv1beta2.DiskDriver         `json:"diskDriver,omitempty"`
+	FSType                *string                             `json:"filesystemType,omitempty"`
+	InstanceManagerName   *string                             `json:"instanceManagerName,omitempty"`
+	CapacityForecast      *CapacityForecastApplyConfiguration `json:"capacityForecast,omitempty"`
+	CapacityHistory       *CapacityHistoryApplyConfiguration  `json:"capacityHistory,omitempty"`
 }
 
 // DiskStatusApplyConfiguration constructs a declarative configuration of the DiskStatus type for use with
@@ -111,6 +115,22 @@ func (b *DiskStatusApplyConfiguration) WithScheduledBackingImage(entries map[str
 	return b
 }
 
+// WithReplicaCount sets the ReplicaCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplicaCount field is set to the value of the last call.
+func (b *DiskStatusApplyConfiguration) WithReplicaCount(value int) *DiskStatusApplyConfiguration {
+	b.ReplicaCount = &value
+	return b
+}
+
+// WithReplicaCountLimit sets the ReplicaCountLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplicaCountLimit field is set to the value of the last call.
+func (b *DiskStatusApplyConfiguration) WithReplicaCountLimit(value int) *DiskStatusApplyConfiguration {
+	b.ReplicaCountLimit = &value
+	return b
+}
+
 // WithDiskUUID sets the DiskUUID field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the DiskUUID field is set to the value of the last call.
@@ -166,3 +186,19 @@ func (b *DiskStatusApplyConfiguration) WithInstanceManagerName(value string) *Di
 	b.InstanceManagerName = &value
 	return b
 }
+
+// WithCapacityForecast sets the CapacityForecast field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CapacityForecast field is set to the value of the last call.
+func (b *DiskStatusApplyConfiguration) WithCapacityForecast(value *CapacityForecastApplyConfiguration) *DiskStatusApplyConfiguration {
+	b.CapacityForecast = value
+	return b
+}
+
+// WithCapacityHistory sets the CapacityHistory field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CapacityHistory field is set to the value of the last call.
+func (b *DiskStatusApplyConfiguration) WithCapacityHistory(value *CapacityHistoryApplyConfiguration) *DiskStatusApplyConfiguration {
+	b.CapacityHistory = value
+	return b
+}
@@ -0,0 +1,103 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// EngineImageAutoUpgradeRolloutApplyConfiguration represents a declarative configuration of the EngineImageAutoUpgradeRollout type for use
+// with apply.
+type EngineImageAutoUpgradeRolloutApplyConfiguration struct {
+	Phase                *longhornv1beta2.EngineImageAutoUpgradeRolloutPhase `json:"phase,omitempty"`
+	CanaryVolumeSelector *string                                             `json:"canaryVolumeSelector,omitempty"`
+	Wave                 *int                                                `json:"wave,omitempty"`
+	UpgradedVolumes      map[string]string                                   `json:"upgradedVolumes,omitempty"`
+	UpgradedVolumeCount  *int                                                `json:"upgradedVolumeCount,omitempty"`
+	HealthGateStartedAt  *string                                             `json:"healthGateStartedAt,omitempty"`
+	Message              *string                                             `json:"message,omitempty"`
+}
+
+// EngineImageAutoUpgradeRolloutApplyConfiguration constructs a declarative configuration of the EngineImageAutoUpgradeRollout type for use with
+// apply.
+func EngineImageAutoUpgradeRollout() *EngineImageAutoUpgradeRolloutApplyConfiguration {
+	return &EngineImageAutoUpgradeRolloutApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *EngineImageAutoUpgradeRolloutApplyConfiguration) WithPhase(value longhornv1beta2.EngineImageAutoUpgradeRolloutPhase) *EngineImageAutoUpgradeRolloutApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithCanaryVolumeSelector sets the CanaryVolumeSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CanaryVolumeSelector field is set to the value of the last call.
+func (b *EngineImageAutoUpgradeRolloutApplyConfiguration) WithCanaryVolumeSelector(value string) *EngineImageAutoUpgradeRolloutApplyConfiguration {
+	b.CanaryVolumeSelector = &value
+	return b
+}
+
+// WithWave sets the Wave field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Wave field is set to the value of the last call.
+func (b *EngineImageAutoUpgradeRolloutApplyConfiguration) WithWave(value int) *EngineImageAutoUpgradeRolloutApplyConfiguration {
+	b.Wave = &value
+	return b
+}
+
+// WithUpgradedVolumes puts the entries into the UpgradedVolumes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the UpgradedVolumes field,
+// overwriting an existing map entries in UpgradedVolumes field with the same key.
+func (b *EngineImageAutoUpgradeRolloutApplyConfiguration) WithUpgradedVolumes(entries map[string]string) *EngineImageAutoUpgradeRolloutApplyConfiguration {
+	if b.UpgradedVolumes == nil && len(entries) > 0 {
+		b.UpgradedVolumes = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.UpgradedVolumes[k] = v
+	}
+	return b
+}
+
+// WithUpgradedVolumeCount sets the UpgradedVolumeCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpgradedVolumeCount field is set to the value of the last call.
+func (b *EngineImageAutoUpgradeRolloutApplyConfiguration) WithUpgradedVolumeCount(value int) *EngineImageAutoUpgradeRolloutApplyConfiguration {
+	b.UpgradedVolumeCount = &value
+	return b
+}
+
+// WithHealthGateStartedAt sets the HealthGateStartedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HealthGateStartedAt field is set to the value of the last call.
+func (b *EngineImageAutoUpgradeRolloutApplyConfiguration) WithHealthGateStartedAt(value string) *EngineImageAutoUpgradeRolloutApplyConfiguration {
+	b.HealthGateStartedAt = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *EngineImageAutoUpgradeRolloutApplyConfiguration) WithMessage(value string) *EngineImageAutoUpgradeRolloutApplyConfiguration {
+	b.Message = &value
+	return b
+}
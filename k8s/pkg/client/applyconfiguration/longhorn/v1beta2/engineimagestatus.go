@@ -25,13 +25,14 @@ import (
 // EngineImageStatusApplyConfiguration represents a declarative configuration of the EngineImageStatus type for use
 // with apply.
 type EngineImageStatusApplyConfiguration struct {
-	OwnerID           *string                           `json:"ownerID,omitempty"`
-	State             *longhornv1beta2.EngineImageState `json:"state,omitempty"`
-	RefCount          *int                              `json:"refCount,omitempty"`
-	NoRefSince        *string                           `json:"noRefSince,omitempty"`
-	Incompatible      *bool                             `json:"incompatible,omitempty"`
-	Conditions        []ConditionApplyConfiguration     `json:"conditions,omitempty"`
-	NodeDeploymentMap map[string]bool                   `json:"nodeDeploymentMap,omitempty"`
+	OwnerID            *string                                          `json:"ownerID,omitempty"`
+	State              *longhornv1beta2.EngineImageState                `json:"state,omitempty"`
+	RefCount           *int                                             `json:"refCount,omitempty"`
+	NoRefSince         *string                                          `json:"noRefSince,omitempty"`
+	Incompatible       *bool                                            `json:"incompatible,omitempty"`
+	Conditions         []ConditionApplyConfiguration                    `json:"conditions,omitempty"`
+	NodeDeploymentMap  map[string]bool                                  `json:"nodeDeploymentMap,omitempty"`
+	AutoUpgradeRollout *EngineImageAutoUpgradeRolloutApplyConfiguration `json:"autoUpgradeRollout,omitempty"`
 }
 
 // EngineImageStatusApplyConfiguration constructs a declarative configuration of the EngineImageStatus type for use with
@@ -106,3 +107,11 @@ func (b *EngineImageStatusApplyConfiguration) WithNodeDeploymentMap(entries map[
 	}
 	return b
 }
+
+// WithAutoUpgradeRollout sets the AutoUpgradeRollout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AutoUpgradeRollout field is set to the value of the last call.
+func (b *EngineImageStatusApplyConfiguration) WithAutoUpgradeRollout(value *EngineImageAutoUpgradeRolloutApplyConfiguration) *EngineImageStatusApplyConfiguration {
+	b.AutoUpgradeRollout = value
+	return b
+}
@@ -36,6 +36,7 @@ type EngineStatusApplyConfiguration struct {
 	PurgeStatus                      map[string]*longhornv1beta2.PurgeStatus         `json:"purgeStatus,omitempty"`
 	RebuildStatus                    map[string]*longhornv1beta2.RebuildStatus       `json:"rebuildStatus,omitempty"`
 	CloneStatus                      map[string]*longhornv1beta2.SnapshotCloneStatus `json:"cloneStatus,omitempty"`
+	AppliedCloneBandwidthLimit       *int64                                          `json:"appliedCloneBandwidthLimit,omitempty"`
 	Snapshots                        map[string]*longhornv1beta2.SnapshotInfo        `json:"snapshots,omitempty"`
 	SnapshotsError                   *string                                         `json:"snapshotsError,omitempty"`
 	IsExpanding                      *bool                                           `json:"isExpanding,omitempty"`
@@ -44,6 +45,8 @@ type EngineStatusApplyConfiguration struct {
 	UnmapMarkSnapChainRemovedEnabled *bool                                           `json:"unmapMarkSnapChainRemovedEnabled,omitempty"`
 	SnapshotMaxCount                 *int                                            `json:"snapshotMaxCount,omitempty"`
 	SnapshotMaxSize                  *int64                                          `json:"snapshotMaxSize,omitempty"`
+	LastWriteAt                      *string                                         `json:"lastWriteAt,omitempty"`
+	IOStats                          *VolumeIOStatsApplyConfiguration                `json:"ioStats,omitempty"`
 }
 
 // EngineStatusApplyConfiguration constructs a declarative configuration of the EngineStatus type for use with
@@ -188,6 +191,14 @@ func (b *EngineStatusApplyConfiguration) WithCloneStatus(entries map[string]*lon
 	return b
 }
 
+// WithAppliedCloneBandwidthLimit sets the AppliedCloneBandwidthLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AppliedCloneBandwidthLimit field is set to the value of the last call.
+func (b *EngineStatusApplyConfiguration) WithAppliedCloneBandwidthLimit(value int64) *EngineStatusApplyConfiguration {
+	b.AppliedCloneBandwidthLimit = &value
+	return b
+}
+
 // WithSnapshots puts the entries into the Snapshots field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, the entries provided by each call will be put on the Snapshots field,
@@ -257,3 +268,19 @@ func (b *EngineStatusApplyConfiguration) WithSnapshotMaxSize(value int64) *Engin
 	b.SnapshotMaxSize = &value
 	return b
 }
+
+// WithLastWriteAt sets the LastWriteAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastWriteAt field is set to the value of the last call.
+func (b *EngineStatusApplyConfiguration) WithLastWriteAt(value string) *EngineStatusApplyConfiguration {
+	b.LastWriteAt = &value
+	return b
+}
+
+// WithIOStats sets the IOStats field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IOStats field is set to the value of the last call.
+func (b *EngineStatusApplyConfiguration) WithIOStats(value *VolumeIOStatsApplyConfiguration) *EngineStatusApplyConfiguration {
+	b.IOStats = value
+	return b
+}
@@ -0,0 +1,75 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// FileRestoreSessionSpecApplyConfiguration represents a declarative configuration of the FileRestoreSessionSpec type for use
+// with apply.
+type FileRestoreSessionSpecApplyConfiguration struct {
+	BackupName   *string `json:"backupName,omitempty"`
+	SourceVolume *string `json:"sourceVolume,omitempty"`
+	SnapshotName *string `json:"snapshotName,omitempty"`
+	NodeID       *string `json:"nodeID,omitempty"`
+	TTLMinutes   *int    `json:"ttlMinutes,omitempty"`
+}
+
+// FileRestoreSessionSpecApplyConfiguration constructs a declarative configuration of the FileRestoreSessionSpec type for use with
+// apply.
+func FileRestoreSessionSpec() *FileRestoreSessionSpecApplyConfiguration {
+	return &FileRestoreSessionSpecApplyConfiguration{}
+}
+
+// WithBackupName sets the BackupName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BackupName field is set to the value of the last call.
+func (b *FileRestoreSessionSpecApplyConfiguration) WithBackupName(value string) *FileRestoreSessionSpecApplyConfiguration {
+	b.BackupName = &value
+	return b
+}
+
+// WithSourceVolume sets the SourceVolume field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SourceVolume field is set to the value of the last call.
+func (b *FileRestoreSessionSpecApplyConfiguration) WithSourceVolume(value string) *FileRestoreSessionSpecApplyConfiguration {
+	b.SourceVolume = &value
+	return b
+}
+
+// WithSnapshotName sets the SnapshotName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SnapshotName field is set to the value of the last call.
+func (b *FileRestoreSessionSpecApplyConfiguration) WithSnapshotName(value string) *FileRestoreSessionSpecApplyConfiguration {
+	b.SnapshotName = &value
+	return b
+}
+
+// WithNodeID sets the NodeID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeID field is set to the value of the last call.
+func (b *FileRestoreSessionSpecApplyConfiguration) WithNodeID(value string) *FileRestoreSessionSpecApplyConfiguration {
+	b.NodeID = &value
+	return b
+}
+
+// WithTTLMinutes sets the TTLMinutes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TTLMinutes field is set to the value of the last call.
+func (b *FileRestoreSessionSpecApplyConfiguration) WithTTLMinutes(value int) *FileRestoreSessionSpecApplyConfiguration {
+	b.TTLMinutes = &value
+	return b
+}
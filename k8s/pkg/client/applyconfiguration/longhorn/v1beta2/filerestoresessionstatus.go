@@ -0,0 +1,106 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// FileRestoreSessionStatusApplyConfiguration represents a declarative configuration of the FileRestoreSessionStatus type for use
+// with apply.
+type FileRestoreSessionStatusApplyConfiguration struct {
+	OwnerID    *string                                  `json:"ownerID,omitempty"`
+	State      *longhornv1beta2.FileRestoreSessionState `json:"state,omitempty"`
+	VolumeName *string                                  `json:"volumeName,omitempty"`
+	NodeID     *string                                  `json:"nodeID,omitempty"`
+	PodName    *string                                  `json:"podName,omitempty"`
+	Endpoint   *string                                  `json:"endpoint,omitempty"`
+	ExpiresAt  *string                                  `json:"expiresAt,omitempty"`
+	Message    *string                                  `json:"message,omitempty"`
+}
+
+// FileRestoreSessionStatusApplyConfiguration constructs a declarative configuration of the FileRestoreSessionStatus type for use with
+// apply.
+func FileRestoreSessionStatus() *FileRestoreSessionStatusApplyConfiguration {
+	return &FileRestoreSessionStatusApplyConfiguration{}
+}
+
+// WithOwnerID sets the OwnerID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OwnerID field is set to the value of the last call.
+func (b *FileRestoreSessionStatusApplyConfiguration) WithOwnerID(value string) *FileRestoreSessionStatusApplyConfiguration {
+	b.OwnerID = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *FileRestoreSessionStatusApplyConfiguration) WithState(value longhornv1beta2.FileRestoreSessionState) *FileRestoreSessionStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithVolumeName sets the VolumeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeName field is set to the value of the last call.
+func (b *FileRestoreSessionStatusApplyConfiguration) WithVolumeName(value string) *FileRestoreSessionStatusApplyConfiguration {
+	b.VolumeName = &value
+	return b
+}
+
+// WithNodeID sets the NodeID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeID field is set to the value of the last call.
+func (b *FileRestoreSessionStatusApplyConfiguration) WithNodeID(value string) *FileRestoreSessionStatusApplyConfiguration {
+	b.NodeID = &value
+	return b
+}
+
+// WithPodName sets the PodName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodName field is set to the value of the last call.
+func (b *FileRestoreSessionStatusApplyConfiguration) WithPodName(value string) *FileRestoreSessionStatusApplyConfiguration {
+	b.PodName = &value
+	return b
+}
+
+// WithEndpoint sets the Endpoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Endpoint field is set to the value of the last call.
+func (b *FileRestoreSessionStatusApplyConfiguration) WithEndpoint(value string) *FileRestoreSessionStatusApplyConfiguration {
+	b.Endpoint = &value
+	return b
+}
+
+// WithExpiresAt sets the ExpiresAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExpiresAt field is set to the value of the last call.
+func (b *FileRestoreSessionStatusApplyConfiguration) WithExpiresAt(value string) *FileRestoreSessionStatusApplyConfiguration {
+	b.ExpiresAt = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *FileRestoreSessionStatusApplyConfiguration) WithMessage(value string) *FileRestoreSessionStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
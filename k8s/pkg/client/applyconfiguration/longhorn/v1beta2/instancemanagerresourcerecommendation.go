@@ -0,0 +1,111 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// InstanceManagerResourceRecommendationApplyConfiguration represents a declarative configuration of the InstanceManagerResourceRecommendation type for use
+// with apply.
+type InstanceManagerResourceRecommendationApplyConfiguration struct {
+	CPURequest      *int64  `json:"cpuRequest,omitempty"`
+	MemoryRequest   *int64  `json:"memoryRequest,omitempty"`
+	Capped          *bool   `json:"capped,omitempty"`
+	PeakCPUUsage    *int64  `json:"peakCPUUsage,omitempty"`
+	PeakMemoryUsage *int64  `json:"peakMemoryUsage,omitempty"`
+	EngineCount     *int    `json:"engineCount,omitempty"`
+	ReplicaCount    *int    `json:"replicaCount,omitempty"`
+	SampleCount     *int    `json:"sampleCount,omitempty"`
+	LastUpdatedAt   *string `json:"lastUpdatedAt,omitempty"`
+}
+
+// InstanceManagerResourceRecommendationApplyConfiguration constructs a declarative configuration of the InstanceManagerResourceRecommendation type for use with
+// apply.
+func InstanceManagerResourceRecommendation() *InstanceManagerResourceRecommendationApplyConfiguration {
+	return &InstanceManagerResourceRecommendationApplyConfiguration{}
+}
+
+// WithCPURequest sets the CPURequest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CPURequest field is set to the value of the last call.
+func (b *InstanceManagerResourceRecommendationApplyConfiguration) WithCPURequest(value int64) *InstanceManagerResourceRecommendationApplyConfiguration {
+	b.CPURequest = &value
+	return b
+}
+
+// WithMemoryRequest sets the MemoryRequest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MemoryRequest field is set to the value of the last call.
+func (b *InstanceManagerResourceRecommendationApplyConfiguration) WithMemoryRequest(value int64) *InstanceManagerResourceRecommendationApplyConfiguration {
+	b.MemoryRequest = &value
+	return b
+}
+
+// WithCapped sets the Capped field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Capped field is set to the value of the last call.
+func (b *InstanceManagerResourceRecommendationApplyConfiguration) WithCapped(value bool) *InstanceManagerResourceRecommendationApplyConfiguration {
+	b.Capped = &value
+	return b
+}
+
+// WithPeakCPUUsage sets the PeakCPUUsage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PeakCPUUsage field is set to the value of the last call.
+func (b *InstanceManagerResourceRecommendationApplyConfiguration) WithPeakCPUUsage(value int64) *InstanceManagerResourceRecommendationApplyConfiguration {
+	b.PeakCPUUsage = &value
+	return b
+}
+
+// WithPeakMemoryUsage sets the PeakMemoryUsage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PeakMemoryUsage field is set to the value of the last call.
+func (b *InstanceManagerResourceRecommendationApplyConfiguration) WithPeakMemoryUsage(value int64) *InstanceManagerResourceRecommendationApplyConfiguration {
+	b.PeakMemoryUsage = &value
+	return b
+}
+
+// WithEngineCount sets the EngineCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EngineCount field is set to the value of the last call.
+func (b *InstanceManagerResourceRecommendationApplyConfiguration) WithEngineCount(value int) *InstanceManagerResourceRecommendationApplyConfiguration {
+	b.EngineCount = &value
+	return b
+}
+
+// WithReplicaCount sets the ReplicaCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplicaCount field is set to the value of the last call.
+func (b *InstanceManagerResourceRecommendationApplyConfiguration) WithReplicaCount(value int) *InstanceManagerResourceRecommendationApplyConfiguration {
+	b.ReplicaCount = &value
+	return b
+}
+
+// WithSampleCount sets the SampleCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SampleCount field is set to the value of the last call.
+func (b *InstanceManagerResourceRecommendationApplyConfiguration) WithSampleCount(value int) *InstanceManagerResourceRecommendationApplyConfiguration {
+	b.SampleCount = &value
+	return b
+}
+
+// WithLastUpdatedAt sets the LastUpdatedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUpdatedAt field is set to the value of the last call.
+func (b *InstanceManagerResourceRecommendationApplyConfiguration) WithLastUpdatedAt(value string) *InstanceManagerResourceRecommendationApplyConfiguration {
+	b.LastUpdatedAt = &value
+	return b
+}
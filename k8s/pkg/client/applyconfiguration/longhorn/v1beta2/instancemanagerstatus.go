@@ -25,18 +25,19 @@ import (
 // InstanceManagerStatusApplyConfiguration represents a declarative configuration of the InstanceManagerStatus type for use
 // with apply.
 type InstanceManagerStatusApplyConfiguration struct {
-	OwnerID            *string                                             `json:"ownerID,omitempty"`
-	CurrentState       *longhornv1beta2.InstanceManagerState               `json:"currentState,omitempty"`
-	InstanceEngines    map[string]InstanceProcessApplyConfiguration        `json:"instanceEngines,omitempty"`
-	InstanceReplicas   map[string]InstanceProcessApplyConfiguration        `json:"instanceReplicas,omitempty"`
-	BackingImages      map[string]BackingImageV2CopyInfoApplyConfiguration `json:"backingImages,omitempty"`
-	IP                 *string                                             `json:"ip,omitempty"`
-	APIMinVersion      *int                                                `json:"apiMinVersion,omitempty"`
-	APIVersion         *int                                                `json:"apiVersion,omitempty"`
-	ProxyAPIMinVersion *int                                                `json:"proxyApiMinVersion,omitempty"`
-	ProxyAPIVersion    *int                                                `json:"proxyApiVersion,omitempty"`
-	DataEngineStatus   *DataEngineStatusApplyConfiguration                 `json:"dataEngineStatus,omitempty"`
-	Instances          map[string]InstanceProcessApplyConfiguration        `json:"instances,omitempty"`
+	OwnerID                *string                                                  `json:"ownerID,omitempty"`
+	CurrentState           *longhornv1beta2.InstanceManagerState                    `json:"currentState,omitempty"`
+	InstanceEngines        map[string]InstanceProcessApplyConfiguration             `json:"instanceEngines,omitempty"`
+	InstanceReplicas       map[string]InstanceProcessApplyConfiguration             `json:"instanceReplicas,omitempty"`
+	BackingImages          map[string]BackingImageV2CopyInfoApplyConfiguration      `json:"backingImages,omitempty"`
+	IP                     *string                                                  `json:"ip,omitempty"`
+	APIMinVersion          *int                                                     `json:"apiMinVersion,omitempty"`
+	APIVersion             *int                                                     `json:"apiVersion,omitempty"`
+	ProxyAPIMinVersion     *int                                                     `json:"proxyApiMinVersion,omitempty"`
+	ProxyAPIVersion        *int                                                     `json:"proxyApiVersion,omitempty"`
+	DataEngineStatus       *DataEngineStatusApplyConfiguration                      `json:"dataEngineStatus,omitempty"`
+	ResourceRecommendation *InstanceManagerResourceRecommendationApplyConfiguration `json:"resourceRecommendation,omitempty"`
+	Instances              map[string]InstanceProcessApplyConfiguration             `json:"instances,omitempty"`
 }
 
 // InstanceManagerStatusApplyConfiguration constructs a declarative configuration of the InstanceManagerStatus type for use with
@@ -151,6 +152,14 @@ func (b *InstanceManagerStatusApplyConfiguration) WithDataEngineStatus(value *Da
 	return b
 }
 
+// WithResourceRecommendation sets the ResourceRecommendation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceRecommendation field is set to the value of the last call.
+func (b *InstanceManagerStatusApplyConfiguration) WithResourceRecommendation(value *InstanceManagerResourceRecommendationApplyConfiguration) *InstanceManagerStatusApplyConfiguration {
+	b.ResourceRecommendation = value
+	return b
+}
+
 // WithInstances puts the entries into the Instances field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, the entries provided by each call will be put on the Instances field,
@@ -0,0 +1,63 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// NodeMaintenanceDetachedTicketApplyConfiguration represents a declarative configuration of the NodeMaintenanceDetachedTicket type for use
+// with apply.
+type NodeMaintenanceDetachedTicketApplyConfiguration struct {
+	Volume     *string           `json:"volume,omitempty"`
+	TicketID   *string           `json:"ticketID,omitempty"`
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// NodeMaintenanceDetachedTicketApplyConfiguration constructs a declarative configuration of the NodeMaintenanceDetachedTicket type for use with
+// apply.
+func NodeMaintenanceDetachedTicket() *NodeMaintenanceDetachedTicketApplyConfiguration {
+	return &NodeMaintenanceDetachedTicketApplyConfiguration{}
+}
+
+// WithVolume sets the Volume field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Volume field is set to the value of the last call.
+func (b *NodeMaintenanceDetachedTicketApplyConfiguration) WithVolume(value string) *NodeMaintenanceDetachedTicketApplyConfiguration {
+	b.Volume = &value
+	return b
+}
+
+// WithTicketID sets the TicketID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TicketID field is set to the value of the last call.
+func (b *NodeMaintenanceDetachedTicketApplyConfiguration) WithTicketID(value string) *NodeMaintenanceDetachedTicketApplyConfiguration {
+	b.TicketID = &value
+	return b
+}
+
+// WithParameters puts the entries into the Parameters field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Parameters field,
+// overwriting an existing map entries in Parameters field with the same key.
+func (b *NodeMaintenanceDetachedTicketApplyConfiguration) WithParameters(entries map[string]string) *NodeMaintenanceDetachedTicketApplyConfiguration {
+	if b.Parameters == nil && len(entries) > 0 {
+		b.Parameters = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Parameters[k] = v
+	}
+	return b
+}
@@ -0,0 +1,79 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// NodeMaintenanceSpecApplyConfiguration represents a declarative configuration of the NodeMaintenanceSpec type for use
+// with apply.
+type NodeMaintenanceSpecApplyConfiguration struct {
+	NodeID                        *string                                       `json:"nodeID,omitempty"`
+	ReplicaPolicy                 *longhornv1beta2.NodeMaintenanceReplicaPolicy `json:"replicaPolicy,omitempty"`
+	DetachManuallyAttachedVolumes *bool                                         `json:"detachManuallyAttachedVolumes,omitempty"`
+	Timeout                       *int                                          `json:"timeout,omitempty"`
+	Abort                         *bool                                         `json:"abort,omitempty"`
+}
+
+// NodeMaintenanceSpecApplyConfiguration constructs a declarative configuration of the NodeMaintenanceSpec type for use with
+// apply.
+func NodeMaintenanceSpec() *NodeMaintenanceSpecApplyConfiguration {
+	return &NodeMaintenanceSpecApplyConfiguration{}
+}
+
+// WithNodeID sets the NodeID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeID field is set to the value of the last call.
+func (b *NodeMaintenanceSpecApplyConfiguration) WithNodeID(value string) *NodeMaintenanceSpecApplyConfiguration {
+	b.NodeID = &value
+	return b
+}
+
+// WithReplicaPolicy sets the ReplicaPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplicaPolicy field is set to the value of the last call.
+func (b *NodeMaintenanceSpecApplyConfiguration) WithReplicaPolicy(value longhornv1beta2.NodeMaintenanceReplicaPolicy) *NodeMaintenanceSpecApplyConfiguration {
+	b.ReplicaPolicy = &value
+	return b
+}
+
+// WithDetachManuallyAttachedVolumes sets the DetachManuallyAttachedVolumes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DetachManuallyAttachedVolumes field is set to the value of the last call.
+func (b *NodeMaintenanceSpecApplyConfiguration) WithDetachManuallyAttachedVolumes(value bool) *NodeMaintenanceSpecApplyConfiguration {
+	b.DetachManuallyAttachedVolumes = &value
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *NodeMaintenanceSpecApplyConfiguration) WithTimeout(value int) *NodeMaintenanceSpecApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
+
+// WithAbort sets the Abort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Abort field is set to the value of the last call.
+func (b *NodeMaintenanceSpecApplyConfiguration) WithAbort(value bool) *NodeMaintenanceSpecApplyConfiguration {
+	b.Abort = &value
+	return b
+}
@@ -0,0 +1,117 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// NodeMaintenanceStatusApplyConfiguration represents a declarative configuration of the NodeMaintenanceStatus type for use
+// with apply.
+type NodeMaintenanceStatusApplyConfiguration struct {
+	OwnerID                   *string                                                 `json:"ownerID,omitempty"`
+	State                     *longhornv1beta2.NodeMaintenanceState                   `json:"state,omitempty"`
+	StartedAt                 *string                                                 `json:"startedAt,omitempty"`
+	Message                   *string                                                 `json:"message,omitempty"`
+	OriginalAllowScheduling   *bool                                                   `json:"originalAllowScheduling,omitempty"`
+	OriginalEvictionRequested *bool                                                   `json:"originalEvictionRequested,omitempty"`
+	DetachedTickets           []NodeMaintenanceDetachedTicketApplyConfiguration       `json:"detachedTickets,omitempty"`
+	Volumes                   map[string]*longhornv1beta2.NodeMaintenanceVolumeStatus `json:"volumes,omitempty"`
+}
+
+// NodeMaintenanceStatusApplyConfiguration constructs a declarative configuration of the NodeMaintenanceStatus type for use with
+// apply.
+func NodeMaintenanceStatus() *NodeMaintenanceStatusApplyConfiguration {
+	return &NodeMaintenanceStatusApplyConfiguration{}
+}
+
+// WithOwnerID sets the OwnerID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OwnerID field is set to the value of the last call.
+func (b *NodeMaintenanceStatusApplyConfiguration) WithOwnerID(value string) *NodeMaintenanceStatusApplyConfiguration {
+	b.OwnerID = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *NodeMaintenanceStatusApplyConfiguration) WithState(value longhornv1beta2.NodeMaintenanceState) *NodeMaintenanceStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithStartedAt sets the StartedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartedAt field is set to the value of the last call.
+func (b *NodeMaintenanceStatusApplyConfiguration) WithStartedAt(value string) *NodeMaintenanceStatusApplyConfiguration {
+	b.StartedAt = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *NodeMaintenanceStatusApplyConfiguration) WithMessage(value string) *NodeMaintenanceStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithOriginalAllowScheduling sets the OriginalAllowScheduling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OriginalAllowScheduling field is set to the value of the last call.
+func (b *NodeMaintenanceStatusApplyConfiguration) WithOriginalAllowScheduling(value bool) *NodeMaintenanceStatusApplyConfiguration {
+	b.OriginalAllowScheduling = &value
+	return b
+}
+
+// WithOriginalEvictionRequested sets the OriginalEvictionRequested field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OriginalEvictionRequested field is set to the value of the last call.
+func (b *NodeMaintenanceStatusApplyConfiguration) WithOriginalEvictionRequested(value bool) *NodeMaintenanceStatusApplyConfiguration {
+	b.OriginalEvictionRequested = &value
+	return b
+}
+
+// WithDetachedTickets adds the given value to the DetachedTickets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DetachedTickets field.
+func (b *NodeMaintenanceStatusApplyConfiguration) WithDetachedTickets(values ...*NodeMaintenanceDetachedTicketApplyConfiguration) *NodeMaintenanceStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDetachedTickets")
+		}
+		b.DetachedTickets = append(b.DetachedTickets, *values[i])
+	}
+	return b
+}
+
+// WithVolumes puts the entries into the Volumes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Volumes field,
+// overwriting an existing map entries in Volumes field with the same key.
+func (b *NodeMaintenanceStatusApplyConfiguration) WithVolumes(entries map[string]*longhornv1beta2.NodeMaintenanceVolumeStatus) *NodeMaintenanceStatusApplyConfiguration {
+	if b.Volumes == nil && len(entries) > 0 {
+		b.Volumes = make(map[string]*longhornv1beta2.NodeMaintenanceVolumeStatus, len(entries))
+	}
+	for k, v := range entries {
+		b.Volumes[k] = v
+	}
+	return b
+}
@@ -27,6 +27,7 @@ type NodeSpecApplyConfiguration struct {
 	EvictionRequested         *bool                                 `json:"evictionRequested,omitempty"`
 	Tags                      []string                              `json:"tags,omitempty"`
 	InstanceManagerCPURequest *int                                  `json:"instanceManagerCPURequest,omitempty"`
+	SettingOverrides          map[string]string                     `json:"settingOverrides,omitempty"`
 }
 
 // NodeSpecApplyConfiguration constructs a declarative configuration of the NodeSpec type for use with
@@ -90,3 +91,17 @@ func (b *NodeSpecApplyConfiguration) WithInstanceManagerCPURequest(value int) *N
 	b.InstanceManagerCPURequest = &value
 	return b
 }
+
+// WithSettingOverrides puts the entries into the SettingOverrides field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the SettingOverrides field,
+// overwriting an existing map entries in SettingOverrides field with the same key.
+func (b *NodeSpecApplyConfiguration) WithSettingOverrides(entries map[string]string) *NodeSpecApplyConfiguration {
+	if b.SettingOverrides == nil && len(entries) > 0 {
+		b.SettingOverrides = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.SettingOverrides[k] = v
+	}
+	return b
+}
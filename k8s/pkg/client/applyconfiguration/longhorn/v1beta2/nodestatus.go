@@ -31,6 +31,8 @@ type NodeStatusApplyConfiguration struct {
 	Zone                *string                                `json:"zone,omitempty"`
 	SnapshotCheckStatus *SnapshotCheckStatusApplyConfiguration `json:"snapshotCheckStatus,omitempty"`
 	AutoEvicting        *bool                                  `json:"autoEvicting,omitempty"`
+	DiscoveredDevices   []DiscoveredDeviceApplyConfiguration   `json:"discoveredDevices,omitempty"`
+	CapacityForecast    *CapacityForecastApplyConfiguration    `json:"capacityForecast,omitempty"`
 }
 
 // NodeStatusApplyConfiguration constructs a declarative configuration of the NodeStatus type for use with
@@ -97,3 +99,24 @@ func (b *NodeStatusApplyConfiguration) WithAutoEvicting(value bool) *NodeStatusA
 	b.AutoEvicting = &value
 	return b
 }
+
+// WithDiscoveredDevices adds the given value to the DiscoveredDevices field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DiscoveredDevices field.
+func (b *NodeStatusApplyConfiguration) WithDiscoveredDevices(values ...*DiscoveredDeviceApplyConfiguration) *NodeStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDiscoveredDevices")
+		}
+		b.DiscoveredDevices = append(b.DiscoveredDevices, *values[i])
+	}
+	return b
+}
+
+// WithCapacityForecast sets the CapacityForecast field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CapacityForecast field is set to the value of the last call.
+func (b *NodeStatusApplyConfiguration) WithCapacityForecast(value *CapacityForecastApplyConfiguration) *NodeStatusApplyConfiguration {
+	b.CapacityForecast = value
+	return b
+}
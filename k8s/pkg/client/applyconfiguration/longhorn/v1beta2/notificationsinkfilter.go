@@ -0,0 +1,50 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// NotificationSinkFilterApplyConfiguration represents a declarative configuration of the NotificationSinkFilter type for use
+// with apply.
+type NotificationSinkFilterApplyConfiguration struct {
+	NamePattern          *string  `json:"namePattern,omitempty"`
+	KubernetesNamespaces []string `json:"kubernetesNamespaces,omitempty"`
+}
+
+// NotificationSinkFilterApplyConfiguration constructs a declarative configuration of the NotificationSinkFilter type for use with
+// apply.
+func NotificationSinkFilter() *NotificationSinkFilterApplyConfiguration {
+	return &NotificationSinkFilterApplyConfiguration{}
+}
+
+// WithNamePattern sets the NamePattern field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NamePattern field is set to the value of the last call.
+func (b *NotificationSinkFilterApplyConfiguration) WithNamePattern(value string) *NotificationSinkFilterApplyConfiguration {
+	b.NamePattern = &value
+	return b
+}
+
+// WithKubernetesNamespaces adds the given value to the KubernetesNamespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the KubernetesNamespaces field.
+func (b *NotificationSinkFilterApplyConfiguration) WithKubernetesNamespaces(values ...string) *NotificationSinkFilterApplyConfiguration {
+	for i := range values {
+		b.KubernetesNamespaces = append(b.KubernetesNamespaces, values[i])
+	}
+	return b
+}
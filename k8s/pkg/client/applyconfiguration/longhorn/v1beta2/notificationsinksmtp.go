@@ -0,0 +1,59 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// NotificationSinkSMTPApplyConfiguration represents a declarative configuration of the NotificationSinkSMTP type for use
+// with apply.
+type NotificationSinkSMTPApplyConfiguration struct {
+	Server *string  `json:"server,omitempty"`
+	From   *string  `json:"from,omitempty"`
+	To     []string `json:"to,omitempty"`
+}
+
+// NotificationSinkSMTPApplyConfiguration constructs a declarative configuration of the NotificationSinkSMTP type for use with
+// apply.
+func NotificationSinkSMTP() *NotificationSinkSMTPApplyConfiguration {
+	return &NotificationSinkSMTPApplyConfiguration{}
+}
+
+// WithServer sets the Server field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Server field is set to the value of the last call.
+func (b *NotificationSinkSMTPApplyConfiguration) WithServer(value string) *NotificationSinkSMTPApplyConfiguration {
+	b.Server = &value
+	return b
+}
+
+// WithFrom sets the From field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the From field is set to the value of the last call.
+func (b *NotificationSinkSMTPApplyConfiguration) WithFrom(value string) *NotificationSinkSMTPApplyConfiguration {
+	b.From = &value
+	return b
+}
+
+// WithTo adds the given value to the To field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the To field.
+func (b *NotificationSinkSMTPApplyConfiguration) WithTo(values ...string) *NotificationSinkSMTPApplyConfiguration {
+	for i := range values {
+		b.To = append(b.To, values[i])
+	}
+	return b
+}
@@ -0,0 +1,108 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// NotificationSinkSpecApplyConfiguration represents a declarative configuration of the NotificationSinkSpec type for use
+// with apply.
+type NotificationSinkSpecApplyConfiguration struct {
+	Type                *longhornv1beta2.NotificationSinkType     `json:"type,omitempty"`
+	URL                 *string                                   `json:"url,omitempty"`
+	CredentialSecret    *string                                   `json:"credentialSecret,omitempty"`
+	SMTP                *NotificationSinkSMTPApplyConfiguration   `json:"smtp,omitempty"`
+	EventClasses        []longhornv1beta2.NotificationEventClass  `json:"eventClasses,omitempty"`
+	Filter              *NotificationSinkFilterApplyConfiguration `json:"filter,omitempty"`
+	DeduplicationWindow *int                                      `json:"deduplicationWindow,omitempty"`
+	RateLimit           *int                                      `json:"rateLimit,omitempty"`
+}
+
+// NotificationSinkSpecApplyConfiguration constructs a declarative configuration of the NotificationSinkSpec type for use with
+// apply.
+func NotificationSinkSpec() *NotificationSinkSpecApplyConfiguration {
+	return &NotificationSinkSpecApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *NotificationSinkSpecApplyConfiguration) WithType(value longhornv1beta2.NotificationSinkType) *NotificationSinkSpecApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *NotificationSinkSpecApplyConfiguration) WithURL(value string) *NotificationSinkSpecApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithCredentialSecret sets the CredentialSecret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CredentialSecret field is set to the value of the last call.
+func (b *NotificationSinkSpecApplyConfiguration) WithCredentialSecret(value string) *NotificationSinkSpecApplyConfiguration {
+	b.CredentialSecret = &value
+	return b
+}
+
+// WithSMTP sets the SMTP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SMTP field is set to the value of the last call.
+func (b *NotificationSinkSpecApplyConfiguration) WithSMTP(value *NotificationSinkSMTPApplyConfiguration) *NotificationSinkSpecApplyConfiguration {
+	b.SMTP = value
+	return b
+}
+
+// WithEventClasses adds the given value to the EventClasses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EventClasses field.
+func (b *NotificationSinkSpecApplyConfiguration) WithEventClasses(values ...longhornv1beta2.NotificationEventClass) *NotificationSinkSpecApplyConfiguration {
+	for i := range values {
+		b.EventClasses = append(b.EventClasses, values[i])
+	}
+	return b
+}
+
+// WithFilter sets the Filter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Filter field is set to the value of the last call.
+func (b *NotificationSinkSpecApplyConfiguration) WithFilter(value *NotificationSinkFilterApplyConfiguration) *NotificationSinkSpecApplyConfiguration {
+	b.Filter = value
+	return b
+}
+
+// WithDeduplicationWindow sets the DeduplicationWindow field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeduplicationWindow field is set to the value of the last call.
+func (b *NotificationSinkSpecApplyConfiguration) WithDeduplicationWindow(value int) *NotificationSinkSpecApplyConfiguration {
+	b.DeduplicationWindow = &value
+	return b
+}
+
+// WithRateLimit sets the RateLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RateLimit field is set to the value of the last call.
+func (b *NotificationSinkSpecApplyConfiguration) WithRateLimit(value int) *NotificationSinkSpecApplyConfiguration {
+	b.RateLimit = &value
+	return b
+}
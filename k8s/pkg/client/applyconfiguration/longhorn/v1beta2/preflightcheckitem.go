@@ -0,0 +1,61 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// PreflightCheckItemApplyConfiguration represents a declarative configuration of the PreflightCheckItem type for use
+// with apply.
+type PreflightCheckItemApplyConfiguration struct {
+	Name    *string                               `json:"name,omitempty"`
+	Result  *longhornv1beta2.PreflightCheckResult `json:"result,omitempty"`
+	Message *string                               `json:"message,omitempty"`
+}
+
+// PreflightCheckItemApplyConfiguration constructs a declarative configuration of the PreflightCheckItem type for use with
+// apply.
+func PreflightCheckItem() *PreflightCheckItemApplyConfiguration {
+	return &PreflightCheckItemApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *PreflightCheckItemApplyConfiguration) WithName(value string) *PreflightCheckItemApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithResult sets the Result field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Result field is set to the value of the last call.
+func (b *PreflightCheckItemApplyConfiguration) WithResult(value longhornv1beta2.PreflightCheckResult) *PreflightCheckItemApplyConfiguration {
+	b.Result = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *PreflightCheckItemApplyConfiguration) WithMessage(value string) *PreflightCheckItemApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -0,0 +1,70 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// PreflightCheckSpecApplyConfiguration represents a declarative configuration of the PreflightCheckSpec type for use
+// with apply.
+type PreflightCheckSpecApplyConfiguration struct {
+	Nodes          []string `json:"nodes,omitempty"`
+	V2DataEngine   *bool    `json:"v2DataEngine,omitempty"`
+	DiskPaths      []string `json:"diskPaths,omitempty"`
+	KubeletRootDir *string  `json:"kubeletRootDir,omitempty"`
+}
+
+// PreflightCheckSpecApplyConfiguration constructs a declarative configuration of the PreflightCheckSpec type for use with
+// apply.
+func PreflightCheckSpec() *PreflightCheckSpecApplyConfiguration {
+	return &PreflightCheckSpecApplyConfiguration{}
+}
+
+// WithNodes adds the given value to the Nodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Nodes field.
+func (b *PreflightCheckSpecApplyConfiguration) WithNodes(values ...string) *PreflightCheckSpecApplyConfiguration {
+	for i := range values {
+		b.Nodes = append(b.Nodes, values[i])
+	}
+	return b
+}
+
+// WithV2DataEngine sets the V2DataEngine field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the V2DataEngine field is set to the value of the last call.
+func (b *PreflightCheckSpecApplyConfiguration) WithV2DataEngine(value bool) *PreflightCheckSpecApplyConfiguration {
+	b.V2DataEngine = &value
+	return b
+}
+
+// WithDiskPaths adds the given value to the DiskPaths field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DiskPaths field.
+func (b *PreflightCheckSpecApplyConfiguration) WithDiskPaths(values ...string) *PreflightCheckSpecApplyConfiguration {
+	for i := range values {
+		b.DiskPaths = append(b.DiskPaths, values[i])
+	}
+	return b
+}
+
+// WithKubeletRootDir sets the KubeletRootDir field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KubeletRootDir field is set to the value of the last call.
+func (b *PreflightCheckSpecApplyConfiguration) WithKubeletRootDir(value string) *PreflightCheckSpecApplyConfiguration {
+	b.KubeletRootDir = &value
+	return b
+}
@@ -0,0 +1,94 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// PreflightCheckStatusApplyConfiguration represents a declarative configuration of the PreflightCheckStatus type for use
+// with apply.
+type PreflightCheckStatusApplyConfiguration struct {
+	OwnerID     *string                                         `json:"ownerID,omitempty"`
+	State       *longhornv1beta2.PreflightCheckState            `json:"state,omitempty"`
+	Result      *longhornv1beta2.PreflightCheckResult           `json:"result,omitempty"`
+	StartedAt   *string                                         `json:"startedAt,omitempty"`
+	CompletedAt *string                                         `json:"completedAt,omitempty"`
+	Nodes       map[string]*longhornv1beta2.PreflightNodeReport `json:"nodes,omitempty"`
+}
+
+// PreflightCheckStatusApplyConfiguration constructs a declarative configuration of the PreflightCheckStatus type for use with
+// apply.
+func PreflightCheckStatus() *PreflightCheckStatusApplyConfiguration {
+	return &PreflightCheckStatusApplyConfiguration{}
+}
+
+// WithOwnerID sets the OwnerID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OwnerID field is set to the value of the last call.
+func (b *PreflightCheckStatusApplyConfiguration) WithOwnerID(value string) *PreflightCheckStatusApplyConfiguration {
+	b.OwnerID = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *PreflightCheckStatusApplyConfiguration) WithState(value longhornv1beta2.PreflightCheckState) *PreflightCheckStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithResult sets the Result field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Result field is set to the value of the last call.
+func (b *PreflightCheckStatusApplyConfiguration) WithResult(value longhornv1beta2.PreflightCheckResult) *PreflightCheckStatusApplyConfiguration {
+	b.Result = &value
+	return b
+}
+
+// WithStartedAt sets the StartedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartedAt field is set to the value of the last call.
+func (b *PreflightCheckStatusApplyConfiguration) WithStartedAt(value string) *PreflightCheckStatusApplyConfiguration {
+	b.StartedAt = &value
+	return b
+}
+
+// WithCompletedAt sets the CompletedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletedAt field is set to the value of the last call.
+func (b *PreflightCheckStatusApplyConfiguration) WithCompletedAt(value string) *PreflightCheckStatusApplyConfiguration {
+	b.CompletedAt = &value
+	return b
+}
+
+// WithNodes puts the entries into the Nodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Nodes field,
+// overwriting an existing map entries in Nodes field with the same key.
+func (b *PreflightCheckStatusApplyConfiguration) WithNodes(entries map[string]*longhornv1beta2.PreflightNodeReport) *PreflightCheckStatusApplyConfiguration {
+	if b.Nodes == nil && len(entries) > 0 {
+		b.Nodes = make(map[string]*longhornv1beta2.PreflightNodeReport, len(entries))
+	}
+	for k, v := range entries {
+		b.Nodes[k] = v
+	}
+	return b
+}
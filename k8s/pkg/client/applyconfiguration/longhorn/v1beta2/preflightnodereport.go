@@ -0,0 +1,66 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// PreflightNodeReportApplyConfiguration represents a declarative configuration of the PreflightNodeReport type for use
+// with apply.
+type PreflightNodeReportApplyConfiguration struct {
+	Result    *longhornv1beta2.PreflightCheckResult  `json:"result,omitempty"`
+	Checks    []PreflightCheckItemApplyConfiguration `json:"checks,omitempty"`
+	CheckedAt *string                                `json:"checkedAt,omitempty"`
+}
+
+// PreflightNodeReportApplyConfiguration constructs a declarative configuration of the PreflightNodeReport type for use with
+// apply.
+func PreflightNodeReport() *PreflightNodeReportApplyConfiguration {
+	return &PreflightNodeReportApplyConfiguration{}
+}
+
+// WithResult sets the Result field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Result field is set to the value of the last call.
+func (b *PreflightNodeReportApplyConfiguration) WithResult(value longhornv1beta2.PreflightCheckResult) *PreflightNodeReportApplyConfiguration {
+	b.Result = &value
+	return b
+}
+
+// WithChecks adds the given value to the Checks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Checks field.
+func (b *PreflightNodeReportApplyConfiguration) WithChecks(values ...*PreflightCheckItemApplyConfiguration) *PreflightNodeReportApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithChecks")
+		}
+		b.Checks = append(b.Checks, *values[i])
+	}
+	return b
+}
+
+// WithCheckedAt sets the CheckedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CheckedAt field is set to the value of the last call.
+func (b *PreflightNodeReportApplyConfiguration) WithCheckedAt(value string) *PreflightNodeReportApplyConfiguration {
+	b.CheckedAt = &value
+	return b
+}
@@ -0,0 +1,97 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// ScrubReplicaResultApplyConfiguration represents a declarative configuration of the ScrubReplicaResult type for use
+// with apply.
+type ScrubReplicaResultApplyConfiguration struct {
+	ReplicaName       *string                            `json:"replicaName,omitempty"`
+	Address           *string                            `json:"address,omitempty"`
+	Checksum          *string                            `json:"checksum,omitempty"`
+	SilentlyCorrupted *bool                              `json:"silentlyCorrupted,omitempty"`
+	Verdict           *longhornv1beta2.ScrubVerdict      `json:"verdict,omitempty"`
+	RepairAction      *longhornv1beta2.ScrubRepairAction `json:"repairAction,omitempty"`
+	Message           *string                            `json:"message,omitempty"`
+}
+
+// ScrubReplicaResultApplyConfiguration constructs a declarative configuration of the ScrubReplicaResult type for use with
+// apply.
+func ScrubReplicaResult() *ScrubReplicaResultApplyConfiguration {
+	return &ScrubReplicaResultApplyConfiguration{}
+}
+
+// WithReplicaName sets the ReplicaName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplicaName field is set to the value of the last call.
+func (b *ScrubReplicaResultApplyConfiguration) WithReplicaName(value string) *ScrubReplicaResultApplyConfiguration {
+	b.ReplicaName = &value
+	return b
+}
+
+// WithAddress sets the Address field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Address field is set to the value of the last call.
+func (b *ScrubReplicaResultApplyConfiguration) WithAddress(value string) *ScrubReplicaResultApplyConfiguration {
+	b.Address = &value
+	return b
+}
+
+// WithChecksum sets the Checksum field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Checksum field is set to the value of the last call.
+func (b *ScrubReplicaResultApplyConfiguration) WithChecksum(value string) *ScrubReplicaResultApplyConfiguration {
+	b.Checksum = &value
+	return b
+}
+
+// WithSilentlyCorrupted sets the SilentlyCorrupted field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SilentlyCorrupted field is set to the value of the last call.
+func (b *ScrubReplicaResultApplyConfiguration) WithSilentlyCorrupted(value bool) *ScrubReplicaResultApplyConfiguration {
+	b.SilentlyCorrupted = &value
+	return b
+}
+
+// WithVerdict sets the Verdict field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Verdict field is set to the value of the last call.
+func (b *ScrubReplicaResultApplyConfiguration) WithVerdict(value longhornv1beta2.ScrubVerdict) *ScrubReplicaResultApplyConfiguration {
+	b.Verdict = &value
+	return b
+}
+
+// WithRepairAction sets the RepairAction field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RepairAction field is set to the value of the last call.
+func (b *ScrubReplicaResultApplyConfiguration) WithRepairAction(value longhornv1beta2.ScrubRepairAction) *ScrubReplicaResultApplyConfiguration {
+	b.RepairAction = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *ScrubReplicaResultApplyConfiguration) WithMessage(value string) *ScrubReplicaResultApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -0,0 +1,50 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// ScrubReportSpecApplyConfiguration represents a declarative configuration of the ScrubReportSpec type for use
+// with apply.
+type ScrubReportSpecApplyConfiguration struct {
+	VolumeName *string  `json:"volumeName,omitempty"`
+	Snapshots  []string `json:"snapshots,omitempty"`
+}
+
+// ScrubReportSpecApplyConfiguration constructs a declarative configuration of the ScrubReportSpec type for use with
+// apply.
+func ScrubReportSpec() *ScrubReportSpecApplyConfiguration {
+	return &ScrubReportSpecApplyConfiguration{}
+}
+
+// WithVolumeName sets the VolumeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeName field is set to the value of the last call.
+func (b *ScrubReportSpecApplyConfiguration) WithVolumeName(value string) *ScrubReportSpecApplyConfiguration {
+	b.VolumeName = &value
+	return b
+}
+
+// WithSnapshots adds the given value to the Snapshots field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Snapshots field.
+func (b *ScrubReportSpecApplyConfiguration) WithSnapshots(values ...string) *ScrubReportSpecApplyConfiguration {
+	for i := range values {
+		b.Snapshots = append(b.Snapshots, values[i])
+	}
+	return b
+}
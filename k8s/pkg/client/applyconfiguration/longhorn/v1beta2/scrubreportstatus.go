@@ -0,0 +1,111 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// ScrubReportStatusApplyConfiguration represents a declarative configuration of the ScrubReportStatus type for use
+// with apply.
+type ScrubReportStatusApplyConfiguration struct {
+	OwnerID                *string                                 `json:"ownerID,omitempty"`
+	State                  *longhornv1beta2.ScrubReportState       `json:"state,omitempty"`
+	StartedAt              *string                                 `json:"startedAt,omitempty"`
+	CompletedAt            *string                                 `json:"completedAt,omitempty"`
+	Snapshots              []ScrubSnapshotResultApplyConfiguration `json:"snapshots,omitempty"`
+	CorruptedSnapshotCount *int                                    `json:"corruptedSnapshotCount,omitempty"`
+	CorruptedReplicaCount  *int                                    `json:"corruptedReplicaCount,omitempty"`
+	RepairedReplicaCount   *int                                    `json:"repairedReplicaCount,omitempty"`
+}
+
+// ScrubReportStatusApplyConfiguration constructs a declarative configuration of the ScrubReportStatus type for use with
+// apply.
+func ScrubReportStatus() *ScrubReportStatusApplyConfiguration {
+	return &ScrubReportStatusApplyConfiguration{}
+}
+
+// WithOwnerID sets the OwnerID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OwnerID field is set to the value of the last call.
+func (b *ScrubReportStatusApplyConfiguration) WithOwnerID(value string) *ScrubReportStatusApplyConfiguration {
+	b.OwnerID = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *ScrubReportStatusApplyConfiguration) WithState(value longhornv1beta2.ScrubReportState) *ScrubReportStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithStartedAt sets the StartedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartedAt field is set to the value of the last call.
+func (b *ScrubReportStatusApplyConfiguration) WithStartedAt(value string) *ScrubReportStatusApplyConfiguration {
+	b.StartedAt = &value
+	return b
+}
+
+// WithCompletedAt sets the CompletedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletedAt field is set to the value of the last call.
+func (b *ScrubReportStatusApplyConfiguration) WithCompletedAt(value string) *ScrubReportStatusApplyConfiguration {
+	b.CompletedAt = &value
+	return b
+}
+
+// WithSnapshots adds the given value to the Snapshots field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Snapshots field.
+func (b *ScrubReportStatusApplyConfiguration) WithSnapshots(values ...*ScrubSnapshotResultApplyConfiguration) *ScrubReportStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSnapshots")
+		}
+		b.Snapshots = append(b.Snapshots, *values[i])
+	}
+	return b
+}
+
+// WithCorruptedSnapshotCount sets the CorruptedSnapshotCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CorruptedSnapshotCount field is set to the value of the last call.
+func (b *ScrubReportStatusApplyConfiguration) WithCorruptedSnapshotCount(value int) *ScrubReportStatusApplyConfiguration {
+	b.CorruptedSnapshotCount = &value
+	return b
+}
+
+// WithCorruptedReplicaCount sets the CorruptedReplicaCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CorruptedReplicaCount field is set to the value of the last call.
+func (b *ScrubReportStatusApplyConfiguration) WithCorruptedReplicaCount(value int) *ScrubReportStatusApplyConfiguration {
+	b.CorruptedReplicaCount = &value
+	return b
+}
+
+// WithRepairedReplicaCount sets the RepairedReplicaCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RepairedReplicaCount field is set to the value of the last call.
+func (b *ScrubReportStatusApplyConfiguration) WithRepairedReplicaCount(value int) *ScrubReportStatusApplyConfiguration {
+	b.RepairedReplicaCount = &value
+	return b
+}
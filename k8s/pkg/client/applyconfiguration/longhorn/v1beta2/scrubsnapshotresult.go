@@ -0,0 +1,93 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// ScrubSnapshotResultApplyConfiguration represents a declarative configuration of the ScrubSnapshotResult type for use
+// with apply.
+type ScrubSnapshotResultApplyConfiguration struct {
+	SnapshotName *string                                `json:"snapshotName,omitempty"`
+	Checksum     *string                                `json:"checksum,omitempty"`
+	Verdict      *longhornv1beta2.ScrubVerdict          `json:"verdict,omitempty"`
+	Message      *string                                `json:"message,omitempty"`
+	CheckedAt    *string                                `json:"checkedAt,omitempty"`
+	Replicas     []ScrubReplicaResultApplyConfiguration `json:"replicas,omitempty"`
+}
+
+// ScrubSnapshotResultApplyConfiguration constructs a declarative configuration of the ScrubSnapshotResult type for use with
+// apply.
+func ScrubSnapshotResult() *ScrubSnapshotResultApplyConfiguration {
+	return &ScrubSnapshotResultApplyConfiguration{}
+}
+
+// WithSnapshotName sets the SnapshotName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SnapshotName field is set to the value of the last call.
+func (b *ScrubSnapshotResultApplyConfiguration) WithSnapshotName(value string) *ScrubSnapshotResultApplyConfiguration {
+	b.SnapshotName = &value
+	return b
+}
+
+// WithChecksum sets the Checksum field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Checksum field is set to the value of the last call.
+func (b *ScrubSnapshotResultApplyConfiguration) WithChecksum(value string) *ScrubSnapshotResultApplyConfiguration {
+	b.Checksum = &value
+	return b
+}
+
+// WithVerdict sets the Verdict field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Verdict field is set to the value of the last call.
+func (b *ScrubSnapshotResultApplyConfiguration) WithVerdict(value longhornv1beta2.ScrubVerdict) *ScrubSnapshotResultApplyConfiguration {
+	b.Verdict = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *ScrubSnapshotResultApplyConfiguration) WithMessage(value string) *ScrubSnapshotResultApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithCheckedAt sets the CheckedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CheckedAt field is set to the value of the last call.
+func (b *ScrubSnapshotResultApplyConfiguration) WithCheckedAt(value string) *ScrubSnapshotResultApplyConfiguration {
+	b.CheckedAt = &value
+	return b
+}
+
+// WithReplicas adds the given value to the Replicas field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Replicas field.
+func (b *ScrubSnapshotResultApplyConfiguration) WithReplicas(values ...*ScrubReplicaResultApplyConfiguration) *ScrubSnapshotResultApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithReplicas")
+		}
+		b.Replicas = append(b.Replicas, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,39 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// SettingHistorySpecApplyConfiguration represents a declarative configuration of the SettingHistorySpec type for use
+// with apply.
+type SettingHistorySpecApplyConfiguration struct {
+	SettingName *string `json:"settingName,omitempty"`
+}
+
+// SettingHistorySpecApplyConfiguration constructs a declarative configuration of the SettingHistorySpec type for use with
+// apply.
+func SettingHistorySpec() *SettingHistorySpecApplyConfiguration {
+	return &SettingHistorySpecApplyConfiguration{}
+}
+
+// WithSettingName sets the SettingName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SettingName field is set to the value of the last call.
+func (b *SettingHistorySpecApplyConfiguration) WithSettingName(value string) *SettingHistorySpecApplyConfiguration {
+	b.SettingName = &value
+	return b
+}
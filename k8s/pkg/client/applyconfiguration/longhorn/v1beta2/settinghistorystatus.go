@@ -0,0 +1,53 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// SettingHistoryStatusApplyConfiguration represents a declarative configuration of the SettingHistoryStatus type for use
+// with apply.
+type SettingHistoryStatusApplyConfiguration struct {
+	CurrentRevision *int64                              `json:"currentRevision,omitempty"`
+	Revisions       []SettingRevisionApplyConfiguration `json:"revisions,omitempty"`
+}
+
+// SettingHistoryStatusApplyConfiguration constructs a declarative configuration of the SettingHistoryStatus type for use with
+// apply.
+func SettingHistoryStatus() *SettingHistoryStatusApplyConfiguration {
+	return &SettingHistoryStatusApplyConfiguration{}
+}
+
+// WithCurrentRevision sets the CurrentRevision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CurrentRevision field is set to the value of the last call.
+func (b *SettingHistoryStatusApplyConfiguration) WithCurrentRevision(value int64) *SettingHistoryStatusApplyConfiguration {
+	b.CurrentRevision = &value
+	return b
+}
+
+// WithRevisions adds the given value to the Revisions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Revisions field.
+func (b *SettingHistoryStatusApplyConfiguration) WithRevisions(values ...*SettingRevisionApplyConfiguration) *SettingHistoryStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRevisions")
+		}
+		b.Revisions = append(b.Revisions, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,88 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// SettingRevisionApplyConfiguration represents a declarative configuration of the SettingRevision type for use
+// with apply.
+type SettingRevisionApplyConfiguration struct {
+	Revision  *int64                               `json:"revision,omitempty"`
+	OldValue  *string                              `json:"oldValue,omitempty"`
+	NewValue  *string                              `json:"newValue,omitempty"`
+	Requester *string                              `json:"requester,omitempty"`
+	Source    *longhornv1beta2.SettingUpdateSource `json:"source,omitempty"`
+	UpdatedAt *string                              `json:"updatedAt,omitempty"`
+}
+
+// SettingRevisionApplyConfiguration constructs a declarative configuration of the SettingRevision type for use with
+// apply.
+func SettingRevision() *SettingRevisionApplyConfiguration {
+	return &SettingRevisionApplyConfiguration{}
+}
+
+// WithRevision sets the Revision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Revision field is set to the value of the last call.
+func (b *SettingRevisionApplyConfiguration) WithRevision(value int64) *SettingRevisionApplyConfiguration {
+	b.Revision = &value
+	return b
+}
+
+// WithOldValue sets the OldValue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OldValue field is set to the value of the last call.
+func (b *SettingRevisionApplyConfiguration) WithOldValue(value string) *SettingRevisionApplyConfiguration {
+	b.OldValue = &value
+	return b
+}
+
+// WithNewValue sets the NewValue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NewValue field is set to the value of the last call.
+func (b *SettingRevisionApplyConfiguration) WithNewValue(value string) *SettingRevisionApplyConfiguration {
+	b.NewValue = &value
+	return b
+}
+
+// WithRequester sets the Requester field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Requester field is set to the value of the last call.
+func (b *SettingRevisionApplyConfiguration) WithRequester(value string) *SettingRevisionApplyConfiguration {
+	b.Requester = &value
+	return b
+}
+
+// WithSource sets the Source field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Source field is set to the value of the last call.
+func (b *SettingRevisionApplyConfiguration) WithSource(value longhornv1beta2.SettingUpdateSource) *SettingRevisionApplyConfiguration {
+	b.Source = &value
+	return b
+}
+
+// WithUpdatedAt sets the UpdatedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdatedAt field is set to the value of the last call.
+func (b *SettingRevisionApplyConfiguration) WithUpdatedAt(value string) *SettingRevisionApplyConfiguration {
+	b.UpdatedAt = &value
+	return b
+}
@@ -0,0 +1,66 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// SupportBundleRedactionPolicyApplyConfiguration represents a declarative configuration of the SupportBundleRedactionPolicy type for use
+// with apply.
+type SupportBundleRedactionPolicyApplyConfiguration struct {
+	Secrets                 *bool `json:"secrets,omitempty"`
+	BackupTargetCredentials *bool `json:"backupTargetCredentials,omitempty"`
+	IPAddresses             *bool `json:"ipAddresses,omitempty"`
+	Hostnames               *bool `json:"hostnames,omitempty"`
+}
+
+// SupportBundleRedactionPolicyApplyConfiguration constructs a declarative configuration of the SupportBundleRedactionPolicy type for use with
+// apply.
+func SupportBundleRedactionPolicy() *SupportBundleRedactionPolicyApplyConfiguration {
+	return &SupportBundleRedactionPolicyApplyConfiguration{}
+}
+
+// WithSecrets sets the Secrets field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Secrets field is set to the value of the last call.
+func (b *SupportBundleRedactionPolicyApplyConfiguration) WithSecrets(value bool) *SupportBundleRedactionPolicyApplyConfiguration {
+	b.Secrets = &value
+	return b
+}
+
+// WithBackupTargetCredentials sets the BackupTargetCredentials field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BackupTargetCredentials field is set to the value of the last call.
+func (b *SupportBundleRedactionPolicyApplyConfiguration) WithBackupTargetCredentials(value bool) *SupportBundleRedactionPolicyApplyConfiguration {
+	b.BackupTargetCredentials = &value
+	return b
+}
+
+// WithIPAddresses sets the IPAddresses field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPAddresses field is set to the value of the last call.
+func (b *SupportBundleRedactionPolicyApplyConfiguration) WithIPAddresses(value bool) *SupportBundleRedactionPolicyApplyConfiguration {
+	b.IPAddresses = &value
+	return b
+}
+
+// WithHostnames sets the Hostnames field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Hostnames field is set to the value of the last call.
+func (b *SupportBundleRedactionPolicyApplyConfiguration) WithHostnames(value bool) *SupportBundleRedactionPolicyApplyConfiguration {
+	b.Hostnames = &value
+	return b
+}
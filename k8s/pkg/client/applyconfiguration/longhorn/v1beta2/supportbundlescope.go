@@ -0,0 +1,70 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// SupportBundleScopeApplyConfiguration represents a declarative configuration of the SupportBundleScope type for use
+// with apply.
+type SupportBundleScopeApplyConfiguration struct {
+	VolumeNames []string `json:"volumeNames,omitempty"`
+	NodeNames   []string `json:"nodeNames,omitempty"`
+	Since       *string  `json:"since,omitempty"`
+	Until       *string  `json:"until,omitempty"`
+}
+
+// SupportBundleScopeApplyConfiguration constructs a declarative configuration of the SupportBundleScope type for use with
+// apply.
+func SupportBundleScope() *SupportBundleScopeApplyConfiguration {
+	return &SupportBundleScopeApplyConfiguration{}
+}
+
+// WithVolumeNames adds the given value to the VolumeNames field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeNames field.
+func (b *SupportBundleScopeApplyConfiguration) WithVolumeNames(values ...string) *SupportBundleScopeApplyConfiguration {
+	for i := range values {
+		b.VolumeNames = append(b.VolumeNames, values[i])
+	}
+	return b
+}
+
+// WithNodeNames adds the given value to the NodeNames field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NodeNames field.
+func (b *SupportBundleScopeApplyConfiguration) WithNodeNames(values ...string) *SupportBundleScopeApplyConfiguration {
+	for i := range values {
+		b.NodeNames = append(b.NodeNames, values[i])
+	}
+	return b
+}
+
+// WithSince sets the Since field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Since field is set to the value of the last call.
+func (b *SupportBundleScopeApplyConfiguration) WithSince(value string) *SupportBundleScopeApplyConfiguration {
+	b.Since = &value
+	return b
+}
+
+// WithUntil sets the Until field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Until field is set to the value of the last call.
+func (b *SupportBundleScopeApplyConfiguration) WithUntil(value string) *SupportBundleScopeApplyConfiguration {
+	b.Until = &value
+	return b
+}
@@ -21,9 +21,11 @@ package v1beta2
 // SupportBundleSpecApplyConfiguration represents a declarative configuration of the SupportBundleSpec type for use
 // with apply.
 type SupportBundleSpecApplyConfiguration struct {
-	NodeID      *string `json:"nodeID,omitempty"`
-	IssueURL    *string `json:"issueURL,omitempty"`
-	Description *string `json:"description,omitempty"`
+	NodeID          *string                                         `json:"nodeID,omitempty"`
+	IssueURL        *string                                         `json:"issueURL,omitempty"`
+	Description     *string                                         `json:"description,omitempty"`
+	Scope           *SupportBundleScopeApplyConfiguration           `json:"scope,omitempty"`
+	RedactionPolicy *SupportBundleRedactionPolicyApplyConfiguration `json:"redactionPolicy,omitempty"`
 }
 
 // SupportBundleSpecApplyConfiguration constructs a declarative configuration of the SupportBundleSpec type for use with
@@ -55,3 +57,19 @@ func (b *SupportBundleSpecApplyConfiguration) WithDescription(value string) *Sup
 	b.Description = &value
 	return b
 }
+
+// WithScope sets the Scope field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scope field is set to the value of the last call.
+func (b *SupportBundleSpecApplyConfiguration) WithScope(value *SupportBundleScopeApplyConfiguration) *SupportBundleSpecApplyConfiguration {
+	b.Scope = value
+	return b
+}
+
+// WithRedactionPolicy sets the RedactionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RedactionPolicy field is set to the value of the last call.
+func (b *SupportBundleSpecApplyConfiguration) WithRedactionPolicy(value *SupportBundleRedactionPolicyApplyConfiguration) *SupportBundleSpecApplyConfiguration {
+	b.RedactionPolicy = value
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// VolumeAutoExpansionPolicyApplyConfiguration represents a declarative configuration of the VolumeAutoExpansionPolicy type for use
+// with apply.
+type VolumeAutoExpansionPolicyApplyConfiguration struct {
+	UsageThreshold *int   `json:"usageThreshold,omitempty"`
+	StepSize       *int64 `json:"stepSize,omitempty"`
+	MaxSize        *int64 `json:"maxSize,omitempty"`
+}
+
+// VolumeAutoExpansionPolicyApplyConfiguration constructs a declarative configuration of the VolumeAutoExpansionPolicy type for use with
+// apply.
+func VolumeAutoExpansionPolicy() *VolumeAutoExpansionPolicyApplyConfiguration {
+	return &VolumeAutoExpansionPolicyApplyConfiguration{}
+}
+
+// WithUsageThreshold sets the UsageThreshold field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UsageThreshold field is set to the value of the last call.
+func (b *VolumeAutoExpansionPolicyApplyConfiguration) WithUsageThreshold(value int) *VolumeAutoExpansionPolicyApplyConfiguration {
+	b.UsageThreshold = &value
+	return b
+}
+
+// WithStepSize sets the StepSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StepSize field is set to the value of the last call.
+func (b *VolumeAutoExpansionPolicyApplyConfiguration) WithStepSize(value int64) *VolumeAutoExpansionPolicyApplyConfiguration {
+	b.StepSize = &value
+	return b
+}
+
+// WithMaxSize sets the MaxSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxSize field is set to the value of the last call.
+func (b *VolumeAutoExpansionPolicyApplyConfiguration) WithMaxSize(value int64) *VolumeAutoExpansionPolicyApplyConfiguration {
+	b.MaxSize = &value
+	return b
+}
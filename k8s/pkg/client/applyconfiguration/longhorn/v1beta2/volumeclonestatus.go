@@ -30,6 +30,10 @@ type VolumeCloneStatusApplyConfiguration struct {
 	State                *longhornv1beta2.VolumeCloneState `json:"state,omitempty"`
 	AttemptCount         *int                              `json:"attemptCount,omitempty"`
 	NextAllowedAttemptAt *string                           `json:"nextAllowedAttemptAt,omitempty"`
+	QueuePosition        *int                              `json:"queuePosition,omitempty"`
+	EstimatedStartAt     *string                           `json:"estimatedStartAt,omitempty"`
+	StartedAt            *string                           `json:"startedAt,omitempty"`
+	CompletedAt          *string                           `json:"completedAt,omitempty"`
 }
 
 // VolumeCloneStatusApplyConfiguration constructs a declarative configuration of the VolumeCloneStatus type for use with
@@ -77,3 +81,35 @@ func (b *VolumeCloneStatusApplyConfiguration) WithNextAllowedAttemptAt(value str
 	b.NextAllowedAttemptAt = &value
 	return b
 }
+
+// WithQueuePosition sets the QueuePosition field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QueuePosition field is set to the value of the last call.
+func (b *VolumeCloneStatusApplyConfiguration) WithQueuePosition(value int) *VolumeCloneStatusApplyConfiguration {
+	b.QueuePosition = &value
+	return b
+}
+
+// WithEstimatedStartAt sets the EstimatedStartAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EstimatedStartAt field is set to the value of the last call.
+func (b *VolumeCloneStatusApplyConfiguration) WithEstimatedStartAt(value string) *VolumeCloneStatusApplyConfiguration {
+	b.EstimatedStartAt = &value
+	return b
+}
+
+// WithStartedAt sets the StartedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartedAt field is set to the value of the last call.
+func (b *VolumeCloneStatusApplyConfiguration) WithStartedAt(value string) *VolumeCloneStatusApplyConfiguration {
+	b.StartedAt = &value
+	return b
+}
+
+// WithCompletedAt sets the CompletedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletedAt field is set to the value of the last call.
+func (b *VolumeCloneStatusApplyConfiguration) WithCompletedAt(value string) *VolumeCloneStatusApplyConfiguration {
+	b.CompletedAt = &value
+	return b
+}
@@ -0,0 +1,75 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// VolumeFilesystemUsageApplyConfiguration represents a declarative configuration of the VolumeFilesystemUsage type for use
+// with apply.
+type VolumeFilesystemUsageApplyConfiguration struct {
+	UsedBytes          *int64  `json:"usedBytes,omitempty"`
+	TotalBytes         *int64  `json:"totalBytes,omitempty"`
+	VolumeSize         *int64  `json:"volumeSize,omitempty"`
+	ReportedAt         *string `json:"reportedAt,omitempty"`
+	LastAutoExpandedAt *string `json:"lastAutoExpandedAt,omitempty"`
+}
+
+// VolumeFilesystemUsageApplyConfiguration constructs a declarative configuration of the VolumeFilesystemUsage type for use with
+// apply.
+func VolumeFilesystemUsage() *VolumeFilesystemUsageApplyConfiguration {
+	return &VolumeFilesystemUsageApplyConfiguration{}
+}
+
+// WithUsedBytes sets the UsedBytes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UsedBytes field is set to the value of the last call.
+func (b *VolumeFilesystemUsageApplyConfiguration) WithUsedBytes(value int64) *VolumeFilesystemUsageApplyConfiguration {
+	b.UsedBytes = &value
+	return b
+}
+
+// WithTotalBytes sets the TotalBytes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TotalBytes field is set to the value of the last call.
+func (b *VolumeFilesystemUsageApplyConfiguration) WithTotalBytes(value int64) *VolumeFilesystemUsageApplyConfiguration {
+	b.TotalBytes = &value
+	return b
+}
+
+// WithVolumeSize sets the VolumeSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeSize field is set to the value of the last call.
+func (b *VolumeFilesystemUsageApplyConfiguration) WithVolumeSize(value int64) *VolumeFilesystemUsageApplyConfiguration {
+	b.VolumeSize = &value
+	return b
+}
+
+// WithReportedAt sets the ReportedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReportedAt field is set to the value of the last call.
+func (b *VolumeFilesystemUsageApplyConfiguration) WithReportedAt(value string) *VolumeFilesystemUsageApplyConfiguration {
+	b.ReportedAt = &value
+	return b
+}
+
+// WithLastAutoExpandedAt sets the LastAutoExpandedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastAutoExpandedAt field is set to the value of the last call.
+func (b *VolumeFilesystemUsageApplyConfiguration) WithLastAutoExpandedAt(value string) *VolumeFilesystemUsageApplyConfiguration {
+	b.LastAutoExpandedAt = &value
+	return b
+}
@@ -0,0 +1,66 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// VolumeShrinkSpecApplyConfiguration represents a declarative configuration of the VolumeShrinkSpec type for use
+// with apply.
+type VolumeShrinkSpecApplyConfiguration struct {
+	VolumeName       *string `json:"volumeName,omitempty"`
+	Size             *int64  `json:"size,omitempty"`
+	TargetVolumeName *string `json:"targetVolumeName,omitempty"`
+	MarginPercentage *int    `json:"marginPercentage,omitempty"`
+}
+
+// VolumeShrinkSpecApplyConfiguration constructs a declarative configuration of the VolumeShrinkSpec type for use with
+// apply.
+func VolumeShrinkSpec() *VolumeShrinkSpecApplyConfiguration {
+	return &VolumeShrinkSpecApplyConfiguration{}
+}
+
+// WithVolumeName sets the VolumeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeName field is set to the value of the last call.
+func (b *VolumeShrinkSpecApplyConfiguration) WithVolumeName(value string) *VolumeShrinkSpecApplyConfiguration {
+	b.VolumeName = &value
+	return b
+}
+
+// WithSize sets the Size field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Size field is set to the value of the last call.
+func (b *VolumeShrinkSpecApplyConfiguration) WithSize(value int64) *VolumeShrinkSpecApplyConfiguration {
+	b.Size = &value
+	return b
+}
+
+// WithTargetVolumeName sets the TargetVolumeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetVolumeName field is set to the value of the last call.
+func (b *VolumeShrinkSpecApplyConfiguration) WithTargetVolumeName(value string) *VolumeShrinkSpecApplyConfiguration {
+	b.TargetVolumeName = &value
+	return b
+}
+
+// WithMarginPercentage sets the MarginPercentage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MarginPercentage field is set to the value of the last call.
+func (b *VolumeShrinkSpecApplyConfiguration) WithMarginPercentage(value int) *VolumeShrinkSpecApplyConfiguration {
+	b.MarginPercentage = &value
+	return b
+}
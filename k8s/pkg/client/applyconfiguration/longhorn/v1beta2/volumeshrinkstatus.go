@@ -0,0 +1,175 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// VolumeShrinkStatusApplyConfiguration represents a declarative configuration of the VolumeShrinkStatus type for use
+// with apply.
+type VolumeShrinkStatusApplyConfiguration struct {
+	OwnerID               *string                            `json:"ownerID,omitempty"`
+	State                 *longhornv1beta2.VolumeShrinkState `json:"state,omitempty"`
+	NodeID                *string                            `json:"nodeID,omitempty"`
+	Progress              *int                               `json:"progress,omitempty"`
+	SourcePVName          *string                            `json:"sourcePVName,omitempty"`
+	SourcePVReclaimPolicy *string                            `json:"sourcePVReclaimPolicy,omitempty"`
+	StorageClassName      *string                            `json:"storageClassName,omitempty"`
+	FSType                *string                            `json:"fsType,omitempty"`
+	PVCName               *string                            `json:"pvcName,omitempty"`
+	PVCNamespace          *string                            `json:"pvcNamespace,omitempty"`
+	PVCLabels             map[string]string                  `json:"pvcLabels,omitempty"`
+	TargetPVName          *string                            `json:"targetPVName,omitempty"`
+	StartedAt             *string                            `json:"startedAt,omitempty"`
+	CompletedAt           *string                            `json:"completedAt,omitempty"`
+	Message               *string                            `json:"message,omitempty"`
+}
+
+// VolumeShrinkStatusApplyConfiguration constructs a declarative configuration of the VolumeShrinkStatus type for use with
+// apply.
+func VolumeShrinkStatus() *VolumeShrinkStatusApplyConfiguration {
+	return &VolumeShrinkStatusApplyConfiguration{}
+}
+
+// WithOwnerID sets the OwnerID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OwnerID field is set to the value of the last call.
+func (b *VolumeShrinkStatusApplyConfiguration) WithOwnerID(value string) *VolumeShrinkStatusApplyConfiguration {
+	b.OwnerID = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *VolumeShrinkStatusApplyConfiguration) WithState(value longhornv1beta2.VolumeShrinkState) *VolumeShrinkStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithNodeID sets the NodeID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeID field is set to the value of the last call.
+func (b *VolumeShrinkStatusApplyConfiguration) WithNodeID(value string) *VolumeShrinkStatusApplyConfiguration {
+	b.NodeID = &value
+	return b
+}
+
+// WithProgress sets the Progress field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Progress field is set to the value of the last call.
+func (b *VolumeShrinkStatusApplyConfiguration) WithProgress(value int) *VolumeShrinkStatusApplyConfiguration {
+	b.Progress = &value
+	return b
+}
+
+// WithSourcePVName sets the SourcePVName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SourcePVName field is set to the value of the last call.
+func (b *VolumeShrinkStatusApplyConfiguration) WithSourcePVName(value string) *VolumeShrinkStatusApplyConfiguration {
+	b.SourcePVName = &value
+	return b
+}
+
+// WithSourcePVReclaimPolicy sets the SourcePVReclaimPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SourcePVReclaimPolicy field is set to the value of the last call.
+func (b *VolumeShrinkStatusApplyConfiguration) WithSourcePVReclaimPolicy(value string) *VolumeShrinkStatusApplyConfiguration {
+	b.SourcePVReclaimPolicy = &value
+	return b
+}
+
+// WithStorageClassName sets the StorageClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StorageClassName field is set to the value of the last call.
+func (b *VolumeShrinkStatusApplyConfiguration) WithStorageClassName(value string) *VolumeShrinkStatusApplyConfiguration {
+	b.StorageClassName = &value
+	return b
+}
+
+// WithFSType sets the FSType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FSType field is set to the value of the last call.
+func (b *VolumeShrinkStatusApplyConfiguration) WithFSType(value string) *VolumeShrinkStatusApplyConfiguration {
+	b.FSType = &value
+	return b
+}
+
+// WithPVCName sets the PVCName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PVCName field is set to the value of the last call.
+func (b *VolumeShrinkStatusApplyConfiguration) WithPVCName(value string) *VolumeShrinkStatusApplyConfiguration {
+	b.PVCName = &value
+	return b
+}
+
+// WithPVCNamespace sets the PVCNamespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PVCNamespace field is set to the value of the last call.
+func (b *VolumeShrinkStatusApplyConfiguration) WithPVCNamespace(value string) *VolumeShrinkStatusApplyConfiguration {
+	b.PVCNamespace = &value
+	return b
+}
+
+// WithPVCLabels puts the entries into the PVCLabels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the PVCLabels field,
+// overwriting an existing map entries in PVCLabels field with the same key.
+func (b *VolumeShrinkStatusApplyConfiguration) WithPVCLabels(entries map[string]string) *VolumeShrinkStatusApplyConfiguration {
+	if b.PVCLabels == nil && len(entries) > 0 {
+		b.PVCLabels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.PVCLabels[k] = v
+	}
+	return b
+}
+
+// WithTargetPVName sets the TargetPVName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetPVName field is set to the value of the last call.
+func (b *VolumeShrinkStatusApplyConfiguration) WithTargetPVName(value string) *VolumeShrinkStatusApplyConfiguration {
+	b.TargetPVName = &value
+	return b
+}
+
+// WithStartedAt sets the StartedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartedAt field is set to the value of the last call.
+func (b *VolumeShrinkStatusApplyConfiguration) WithStartedAt(value string) *VolumeShrinkStatusApplyConfiguration {
+	b.StartedAt = &value
+	return b
+}
+
+// WithCompletedAt sets the CompletedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletedAt field is set to the value of the last call.
+func (b *VolumeShrinkStatusApplyConfiguration) WithCompletedAt(value string) *VolumeShrinkStatusApplyConfiguration {
+	b.CompletedAt = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *VolumeShrinkStatusApplyConfiguration) WithMessage(value string) *VolumeShrinkStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -61,6 +61,9 @@ type VolumeSpecApplyConfiguration struct {
 	BackupTargetName                *string                                        `json:"backupTargetName,omitempty"`
 	OfflineRebuilding               *longhornv1beta2.VolumeOfflineRebuilding       `json:"offlineRebuilding,omitempty"`
 	ReplicaRebuildingBandwidthLimit *int64                                         `json:"replicaRebuildingBandwidthLimit,omitempty"`
+	TieringPolicy                   *VolumeTieringPolicyApplyConfiguration         `json:"tieringPolicy,omitempty"`
+	RecoveryPointObjective          *int                                           `json:"recoveryPointObjective,omitempty"`
+	AutoExpansionPolicy             *VolumeAutoExpansionPolicyApplyConfiguration   `json:"autoExpansionPolicy,omitempty"`
 }
 
 // VolumeSpecApplyConfiguration constructs a declarative configuration of the VolumeSpec type for use with
@@ -360,3 +363,27 @@ func (b *VolumeSpecApplyConfiguration) WithReplicaRebuildingBandwidthLimit(value
 	b.ReplicaRebuildingBandwidthLimit = &value
 	return b
 }
+
+// WithTieringPolicy sets the TieringPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TieringPolicy field is set to the value of the last call.
+func (b *VolumeSpecApplyConfiguration) WithTieringPolicy(value *VolumeTieringPolicyApplyConfiguration) *VolumeSpecApplyConfiguration {
+	b.TieringPolicy = value
+	return b
+}
+
+// WithRecoveryPointObjective sets the RecoveryPointObjective field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RecoveryPointObjective field is set to the value of the last call.
+func (b *VolumeSpecApplyConfiguration) WithRecoveryPointObjective(value int) *VolumeSpecApplyConfiguration {
+	b.RecoveryPointObjective = &value
+	return b
+}
+
+// WithAutoExpansionPolicy sets the AutoExpansionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AutoExpansionPolicy field is set to the value of the last call.
+func (b *VolumeSpecApplyConfiguration) WithAutoExpansionPolicy(value *VolumeAutoExpansionPolicyApplyConfiguration) *VolumeSpecApplyConfiguration {
+	b.AutoExpansionPolicy = value
+	return b
+}
@@ -25,27 +25,31 @@ import (
 // VolumeStatusApplyConfiguration represents a declarative configuration of the VolumeStatus type for use
 // with apply.
 type VolumeStatusApplyConfiguration struct {
-	OwnerID                *string                              `json:"ownerID,omitempty"`
-	State                  *longhornv1beta2.VolumeState         `json:"state,omitempty"`
-	Robustness             *longhornv1beta2.VolumeRobustness    `json:"robustness,omitempty"`
-	CurrentNodeID          *string                              `json:"currentNodeID,omitempty"`
-	CurrentImage           *string                              `json:"currentImage,omitempty"`
-	KubernetesStatus       *KubernetesStatusApplyConfiguration  `json:"kubernetesStatus,omitempty"`
-	Conditions             []ConditionApplyConfiguration        `json:"conditions,omitempty"`
-	LastBackup             *string                              `json:"lastBackup,omitempty"`
-	LastBackupAt           *string                              `json:"lastBackupAt,omitempty"`
-	CurrentMigrationNodeID *string                              `json:"currentMigrationNodeID,omitempty"`
-	FrontendDisabled       *bool                                `json:"frontendDisabled,omitempty"`
-	RestoreRequired        *bool                                `json:"restoreRequired,omitempty"`
-	RestoreInitiated       *bool                                `json:"restoreInitiated,omitempty"`
-	CloneStatus            *VolumeCloneStatusApplyConfiguration `json:"cloneStatus,omitempty"`
-	RemountRequestedAt     *string                              `json:"remountRequestedAt,omitempty"`
-	ExpansionRequired      *bool                                `json:"expansionRequired,omitempty"`
-	IsStandby              *bool                                `json:"isStandby,omitempty"`
-	ActualSize             *int64                               `json:"actualSize,omitempty"`
-	LastDegradedAt         *string                              `json:"lastDegradedAt,omitempty"`
-	ShareEndpoint          *string                              `json:"shareEndpoint,omitempty"`
-	ShareState             *longhornv1beta2.ShareManagerState   `json:"shareState,omitempty"`
+	OwnerID                *string                                      `json:"ownerID,omitempty"`
+	State                  *longhornv1beta2.VolumeState                 `json:"state,omitempty"`
+	Robustness             *longhornv1beta2.VolumeRobustness            `json:"robustness,omitempty"`
+	CurrentNodeID          *string                                      `json:"currentNodeID,omitempty"`
+	CurrentImage           *string                                      `json:"currentImage,omitempty"`
+	KubernetesStatus       *KubernetesStatusApplyConfiguration          `json:"kubernetesStatus,omitempty"`
+	Conditions             []ConditionApplyConfiguration                `json:"conditions,omitempty"`
+	LastBackup             *string                                      `json:"lastBackup,omitempty"`
+	LastBackupAt           *string                                      `json:"lastBackupAt,omitempty"`
+	CurrentMigrationNodeID *string                                      `json:"currentMigrationNodeID,omitempty"`
+	FrontendDisabled       *bool                                        `json:"frontendDisabled,omitempty"`
+	RestoreRequired        *bool                                        `json:"restoreRequired,omitempty"`
+	RestoreInitiated       *bool                                        `json:"restoreInitiated,omitempty"`
+	CloneStatus            *VolumeCloneStatusApplyConfiguration         `json:"cloneStatus,omitempty"`
+	RemountRequestedAt     *string                                      `json:"remountRequestedAt,omitempty"`
+	ExpansionRequired      *bool                                        `json:"expansionRequired,omitempty"`
+	IsStandby              *bool                                        `json:"isStandby,omitempty"`
+	ActualSize             *int64                                       `json:"actualSize,omitempty"`
+	LastDegradedAt         *string                                      `json:"lastDegradedAt,omitempty"`
+	ShareEndpoint          *string                                      `json:"shareEndpoint,omitempty"`
+	ShareState             *longhornv1beta2.ShareManagerState           `json:"shareState,omitempty"`
+	Tiering                *VolumeTieringStatusApplyConfiguration       `json:"tiering,omitempty"`
+	RecoveryPoint          *VolumeRecoveryPointStatusApplyConfiguration `json:"recoveryPoint,omitempty"`
+	FilesystemUsage        *VolumeFilesystemUsageApplyConfiguration     `json:"filesystemUsage,omitempty"`
+	Activity               *VolumeActivityStatusApplyConfiguration      `json:"activity,omitempty"`
 }
 
 // VolumeStatusApplyConfiguration constructs a declarative configuration of the VolumeStatus type for use with
@@ -226,3 +230,35 @@ func (b *VolumeStatusApplyConfiguration) WithShareState(value longhornv1beta2.Sh
 	b.ShareState = &value
 	return b
 }
+
+// WithTiering sets the Tiering field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tiering field is set to the value of the last call.
+func (b *VolumeStatusApplyConfiguration) WithTiering(value *VolumeTieringStatusApplyConfiguration) *VolumeStatusApplyConfiguration {
+	b.Tiering = value
+	return b
+}
+
+// WithRecoveryPoint sets the RecoveryPoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RecoveryPoint field is set to the value of the last call.
+func (b *VolumeStatusApplyConfiguration) WithRecoveryPoint(value *VolumeRecoveryPointStatusApplyConfiguration) *VolumeStatusApplyConfiguration {
+	b.RecoveryPoint = value
+	return b
+}
+
+// WithFilesystemUsage sets the FilesystemUsage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FilesystemUsage field is set to the value of the last call.
+func (b *VolumeStatusApplyConfiguration) WithFilesystemUsage(value *VolumeFilesystemUsageApplyConfiguration) *VolumeStatusApplyConfiguration {
+	b.FilesystemUsage = value
+	return b
+}
+
+// WithActivity sets the Activity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Activity field is set to the value of the last call.
+func (b *VolumeStatusApplyConfiguration) WithActivity(value *VolumeActivityStatusApplyConfiguration) *VolumeStatusApplyConfiguration {
+	b.Activity = value
+	return b
+}
@@ -0,0 +1,81 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// VolumeTieringPolicyApplyConfiguration represents a declarative configuration of the VolumeTieringPolicy type for use
+// with apply.
+type VolumeTieringPolicyApplyConfiguration struct {
+	DiskSelector   []string                              `json:"diskSelector,omitempty"`
+	Trigger        *longhornv1beta2.VolumeTieringTrigger `json:"trigger,omitempty"`
+	Schedule       *string                               `json:"schedule,omitempty"`
+	ThresholdHours *int                                  `json:"thresholdHours,omitempty"`
+	BandwidthLimit *int64                                `json:"bandwidthLimit,omitempty"`
+}
+
+// VolumeTieringPolicyApplyConfiguration constructs a declarative configuration of the VolumeTieringPolicy type for use with
+// apply.
+func VolumeTieringPolicy() *VolumeTieringPolicyApplyConfiguration {
+	return &VolumeTieringPolicyApplyConfiguration{}
+}
+
+// WithDiskSelector adds the given value to the DiskSelector field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DiskSelector field.
+func (b *VolumeTieringPolicyApplyConfiguration) WithDiskSelector(values ...string) *VolumeTieringPolicyApplyConfiguration {
+	for i := range values {
+		b.DiskSelector = append(b.DiskSelector, values[i])
+	}
+	return b
+}
+
+// WithTrigger sets the Trigger field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Trigger field is set to the value of the last call.
+func (b *VolumeTieringPolicyApplyConfiguration) WithTrigger(value longhornv1beta2.VolumeTieringTrigger) *VolumeTieringPolicyApplyConfiguration {
+	b.Trigger = &value
+	return b
+}
+
+// WithSchedule sets the Schedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Schedule field is set to the value of the last call.
+func (b *VolumeTieringPolicyApplyConfiguration) WithSchedule(value string) *VolumeTieringPolicyApplyConfiguration {
+	b.Schedule = &value
+	return b
+}
+
+// WithThresholdHours sets the ThresholdHours field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ThresholdHours field is set to the value of the last call.
+func (b *VolumeTieringPolicyApplyConfiguration) WithThresholdHours(value int) *VolumeTieringPolicyApplyConfiguration {
+	b.ThresholdHours = &value
+	return b
+}
+
+// WithBandwidthLimit sets the BandwidthLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BandwidthLimit field is set to the value of the last call.
+func (b *VolumeTieringPolicyApplyConfiguration) WithBandwidthLimit(value int64) *VolumeTieringPolicyApplyConfiguration {
+	b.BandwidthLimit = &value
+	return b
+}
@@ -0,0 +1,128 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// VolumeTieringStatusApplyConfiguration represents a declarative configuration of the VolumeTieringStatus type for use
+// with apply.
+type VolumeTieringStatusApplyConfiguration struct {
+	State                                   *longhornv1beta2.VolumeTieringState `json:"state,omitempty"`
+	DiskSelector                            []string                            `json:"diskSelector,omitempty"`
+	OriginalDiskSelector                    []string                            `json:"originalDiskSelector,omitempty"`
+	OriginalReplicaRebuildingBandwidthLimit *int64                              `json:"originalReplicaRebuildingBandwidthLimit,omitempty"`
+	MigratingReplica                        *string                             `json:"migratingReplica,omitempty"`
+	StartedAt                               *string                             `json:"startedAt,omitempty"`
+	CompletedAt                             *string                             `json:"completedAt,omitempty"`
+	LastTriggeredAt                         *string                             `json:"lastTriggeredAt,omitempty"`
+	DetachedSince                           *string                             `json:"detachedSince,omitempty"`
+	Message                                 *string                             `json:"message,omitempty"`
+}
+
+// VolumeTieringStatusApplyConfiguration constructs a declarative configuration of the VolumeTieringStatus type for use with
+// apply.
+func VolumeTieringStatus() *VolumeTieringStatusApplyConfiguration {
+	return &VolumeTieringStatusApplyConfiguration{}
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *VolumeTieringStatusApplyConfiguration) WithState(value longhornv1beta2.VolumeTieringState) *VolumeTieringStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithDiskSelector adds the given value to the DiskSelector field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DiskSelector field.
+func (b *VolumeTieringStatusApplyConfiguration) WithDiskSelector(values ...string) *VolumeTieringStatusApplyConfiguration {
+	for i := range values {
+		b.DiskSelector = append(b.DiskSelector, values[i])
+	}
+	return b
+}
+
+// WithOriginalDiskSelector adds the given value to the OriginalDiskSelector field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OriginalDiskSelector field.
+func (b *VolumeTieringStatusApplyConfiguration) WithOriginalDiskSelector(values ...string) *VolumeTieringStatusApplyConfiguration {
+	for i := range values {
+		b.OriginalDiskSelector = append(b.OriginalDiskSelector, values[i])
+	}
+	return b
+}
+
+// WithOriginalReplicaRebuildingBandwidthLimit sets the OriginalReplicaRebuildingBandwidthLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OriginalReplicaRebuildingBandwidthLimit field is set to the value of the last call.
+func (b *VolumeTieringStatusApplyConfiguration) WithOriginalReplicaRebuildingBandwidthLimit(value int64) *VolumeTieringStatusApplyConfiguration {
+	b.OriginalReplicaRebuildingBandwidthLimit = &value
+	return b
+}
+
+// WithMigratingReplica sets the MigratingReplica field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MigratingReplica field is set to the value of the last call.
+func (b *VolumeTieringStatusApplyConfiguration) WithMigratingReplica(value string) *VolumeTieringStatusApplyConfiguration {
+	b.MigratingReplica = &value
+	return b
+}
+
+// WithStartedAt sets the StartedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartedAt field is set to the value of the last call.
+func (b *VolumeTieringStatusApplyConfiguration) WithStartedAt(value string) *VolumeTieringStatusApplyConfiguration {
+	b.StartedAt = &value
+	return b
+}
+
+// WithCompletedAt sets the CompletedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletedAt field is set to the value of the last call.
+func (b *VolumeTieringStatusApplyConfiguration) WithCompletedAt(value string) *VolumeTieringStatusApplyConfiguration {
+	b.CompletedAt = &value
+	return b
+}
+
+// WithLastTriggeredAt sets the LastTriggeredAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastTriggeredAt field is set to the value of the last call.
+func (b *VolumeTieringStatusApplyConfiguration) WithLastTriggeredAt(value string) *VolumeTieringStatusApplyConfiguration {
+	b.LastTriggeredAt = &value
+	return b
+}
+
+// WithDetachedSince sets the DetachedSince field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DetachedSince field is set to the value of the last call.
+func (b *VolumeTieringStatusApplyConfiguration) WithDetachedSince(value string) *VolumeTieringStatusApplyConfiguration {
+	b.DetachedSince = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *VolumeTieringStatusApplyConfiguration) WithMessage(value string) *VolumeTieringStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -0,0 +1,115 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// VolumeTransferSpecApplyConfiguration represents a declarative configuration of the VolumeTransferSpec type for use
+// with apply.
+type VolumeTransferSpecApplyConfiguration struct {
+	Role                *longhornv1beta2.VolumeTransferRole `json:"role,omitempty"`
+	VolumeName          *string                             `json:"volumeName,omitempty"`
+	Size                *int64                              `json:"size,omitempty"`
+	NumberOfReplicas    *int                                `json:"numberOfReplicas,omitempty"`
+	RemoteURL           *string                             `json:"remoteURL,omitempty"`
+	RemoteTransferName  *string                             `json:"remoteTransferName,omitempty"`
+	CredentialSecret    *string                             `json:"credentialSecret,omitempty"`
+	SyncIntervalSeconds *int                                `json:"syncIntervalSeconds,omitempty"`
+	Cutover             *bool                               `json:"cutover,omitempty"`
+}
+
+// VolumeTransferSpecApplyConfiguration constructs a declarative configuration of the VolumeTransferSpec type for use with
+// apply.
+func VolumeTransferSpec() *VolumeTransferSpecApplyConfiguration {
+	return &VolumeTransferSpecApplyConfiguration{}
+}
+
+// WithRole sets the Role field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Role field is set to the value of the last call.
+func (b *VolumeTransferSpecApplyConfiguration) WithRole(value longhornv1beta2.VolumeTransferRole) *VolumeTransferSpecApplyConfiguration {
+	b.Role = &value
+	return b
+}
+
+// WithVolumeName sets the VolumeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeName field is set to the value of the last call.
+func (b *VolumeTransferSpecApplyConfiguration) WithVolumeName(value string) *VolumeTransferSpecApplyConfiguration {
+	b.VolumeName = &value
+	return b
+}
+
+// WithSize sets the Size field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Size field is set to the value of the last call.
+func (b *VolumeTransferSpecApplyConfiguration) WithSize(value int64) *VolumeTransferSpecApplyConfiguration {
+	b.Size = &value
+	return b
+}
+
+// WithNumberOfReplicas sets the NumberOfReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NumberOfReplicas field is set to the value of the last call.
+func (b *VolumeTransferSpecApplyConfiguration) WithNumberOfReplicas(value int) *VolumeTransferSpecApplyConfiguration {
+	b.NumberOfReplicas = &value
+	return b
+}
+
+// WithRemoteURL sets the RemoteURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemoteURL field is set to the value of the last call.
+func (b *VolumeTransferSpecApplyConfiguration) WithRemoteURL(value string) *VolumeTransferSpecApplyConfiguration {
+	b.RemoteURL = &value
+	return b
+}
+
+// WithRemoteTransferName sets the RemoteTransferName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemoteTransferName field is set to the value of the last call.
+func (b *VolumeTransferSpecApplyConfiguration) WithRemoteTransferName(value string) *VolumeTransferSpecApplyConfiguration {
+	b.RemoteTransferName = &value
+	return b
+}
+
+// WithCredentialSecret sets the CredentialSecret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CredentialSecret field is set to the value of the last call.
+func (b *VolumeTransferSpecApplyConfiguration) WithCredentialSecret(value string) *VolumeTransferSpecApplyConfiguration {
+	b.CredentialSecret = &value
+	return b
+}
+
+// WithSyncIntervalSeconds sets the SyncIntervalSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SyncIntervalSeconds field is set to the value of the last call.
+func (b *VolumeTransferSpecApplyConfiguration) WithSyncIntervalSeconds(value int) *VolumeTransferSpecApplyConfiguration {
+	b.SyncIntervalSeconds = &value
+	return b
+}
+
+// WithCutover sets the Cutover field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cutover field is set to the value of the last call.
+func (b *VolumeTransferSpecApplyConfiguration) WithCutover(value bool) *VolumeTransferSpecApplyConfiguration {
+	b.Cutover = &value
+	return b
+}
@@ -0,0 +1,133 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// VolumeTransferStatusApplyConfiguration represents a declarative configuration of the VolumeTransferStatus type for use
+// with apply.
+type VolumeTransferStatusApplyConfiguration struct {
+	OwnerID            *string                              `json:"ownerID,omitempty"`
+	State              *longhornv1beta2.VolumeTransferState `json:"state,omitempty"`
+	NodeID             *string                              `json:"nodeID,omitempty"`
+	Progress           *int                                 `json:"progress,omitempty"`
+	TransferredBytes   *int64                               `json:"transferredBytes,omitempty"`
+	Throughput         *string                              `json:"throughput,omitempty"`
+	SyncCount          *int                                 `json:"syncCount,omitempty"`
+	LastSyncedSnapshot *string                              `json:"lastSyncedSnapshot,omitempty"`
+	LastSyncedAt       *string                              `json:"lastSyncedAt,omitempty"`
+	CompletedAt        *string                              `json:"completedAt,omitempty"`
+	Message            *string                              `json:"message,omitempty"`
+}
+
+// VolumeTransferStatusApplyConfiguration constructs a declarative configuration of the VolumeTransferStatus type for use with
+// apply.
+func VolumeTransferStatus() *VolumeTransferStatusApplyConfiguration {
+	return &VolumeTransferStatusApplyConfiguration{}
+}
+
+// WithOwnerID sets the OwnerID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OwnerID field is set to the value of the last call.
+func (b *VolumeTransferStatusApplyConfiguration) WithOwnerID(value string) *VolumeTransferStatusApplyConfiguration {
+	b.OwnerID = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *VolumeTransferStatusApplyConfiguration) WithState(value longhornv1beta2.VolumeTransferState) *VolumeTransferStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithNodeID sets the NodeID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeID field is set to the value of the last call.
+func (b *VolumeTransferStatusApplyConfiguration) WithNodeID(value string) *VolumeTransferStatusApplyConfiguration {
+	b.NodeID = &value
+	return b
+}
+
+// WithProgress sets the Progress field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Progress field is set to the value of the last call.
+func (b *VolumeTransferStatusApplyConfiguration) WithProgress(value int) *VolumeTransferStatusApplyConfiguration {
+	b.Progress = &value
+	return b
+}
+
+// WithTransferredBytes sets the TransferredBytes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TransferredBytes field is set to the value of the last call.
+func (b *VolumeTransferStatusApplyConfiguration) WithTransferredBytes(value int64) *VolumeTransferStatusApplyConfiguration {
+	b.TransferredBytes = &value
+	return b
+}
+
+// WithThroughput sets the Throughput field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Throughput field is set to the value of the last call.
+func (b *VolumeTransferStatusApplyConfiguration) WithThroughput(value string) *VolumeTransferStatusApplyConfiguration {
+	b.Throughput = &value
+	return b
+}
+
+// WithSyncCount sets the SyncCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SyncCount field is set to the value of the last call.
+func (b *VolumeTransferStatusApplyConfiguration) WithSyncCount(value int) *VolumeTransferStatusApplyConfiguration {
+	b.SyncCount = &value
+	return b
+}
+
+// WithLastSyncedSnapshot sets the LastSyncedSnapshot field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastSyncedSnapshot field is set to the value of the last call.
+func (b *VolumeTransferStatusApplyConfiguration) WithLastSyncedSnapshot(value string) *VolumeTransferStatusApplyConfiguration {
+	b.LastSyncedSnapshot = &value
+	return b
+}
+
+// WithLastSyncedAt sets the LastSyncedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastSyncedAt field is set to the value of the last call.
+func (b *VolumeTransferStatusApplyConfiguration) WithLastSyncedAt(value string) *VolumeTransferStatusApplyConfiguration {
+	b.LastSyncedAt = &value
+	return b
+}
+
+// WithCompletedAt sets the CompletedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletedAt field is set to the value of the last call.
+func (b *VolumeTransferStatusApplyConfiguration) WithCompletedAt(value string) *VolumeTransferStatusApplyConfiguration {
+	b.CompletedAt = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *VolumeTransferStatusApplyConfiguration) WithMessage(value string) *VolumeTransferStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -86,12 +86,36 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.BackupVolumeSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("BackupVolumeStatus"):
 		return &longhornv1beta2.BackupVolumeStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("CapacityForecast"):
+		return &longhornv1beta2.CapacityForecastApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("CapacityHistory"):
+		return &longhornv1beta2.CapacityHistoryApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("CapacitySample"):
+		return &longhornv1beta2.CapacitySampleApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Condition"):
 		return &longhornv1beta2.ConditionApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("DataEngineConversion"):
+		return &longhornv1beta2.DataEngineConversionApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("DataEngineConversionSpec"):
+		return &longhornv1beta2.DataEngineConversionSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("DataEngineConversionStatus"):
+		return &longhornv1beta2.DataEngineConversionStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("DataEngineSpec"):
 		return &longhornv1beta2.DataEngineSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("DataEngineStatus"):
 		return &longhornv1beta2.DataEngineStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("DisasterRecoveryPlan"):
+		return &longhornv1beta2.DisasterRecoveryPlanApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("DisasterRecoveryPlanMemberStatus"):
+		return &longhornv1beta2.DisasterRecoveryPlanMemberStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("DisasterRecoveryPlanRehearsal"):
+		return &longhornv1beta2.DisasterRecoveryPlanRehearsalApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("DisasterRecoveryPlanSpec"):
+		return &longhornv1beta2.DisasterRecoveryPlanSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("DisasterRecoveryPlanStatus"):
+		return &longhornv1beta2.DisasterRecoveryPlanStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("DiscoveredDevice"):
+		return &longhornv1beta2.DiscoveredDeviceApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("DiskSpec"):
 		return &longhornv1beta2.DiskSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("DiskStatus"):
@@ -102,6 +126,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.EngineBackupStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("EngineImage"):
 		return &longhornv1beta2.EngineImageApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("EngineImageAutoUpgradeRollout"):
+		return &longhornv1beta2.EngineImageAutoUpgradeRolloutApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("EngineImageSpec"):
 		return &longhornv1beta2.EngineImageSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("EngineImageStatus"):
@@ -112,8 +138,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.EngineStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("EngineVersionDetails"):
 		return &longhornv1beta2.EngineVersionDetailsApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("FileRestoreSession"):
+		return &longhornv1beta2.FileRestoreSessionApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("FileRestoreSessionSpec"):
+		return &longhornv1beta2.FileRestoreSessionSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("FileRestoreSessionStatus"):
+		return &longhornv1beta2.FileRestoreSessionStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("InstanceManager"):
 		return &longhornv1beta2.InstanceManagerApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("InstanceManagerResourceRecommendation"):
+		return &longhornv1beta2.InstanceManagerResourceRecommendationApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("InstanceManagerSpec"):
 		return &longhornv1beta2.InstanceManagerSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("InstanceManagerStatus"):
@@ -132,16 +166,46 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.KubernetesStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Node"):
 		return &longhornv1beta2.NodeApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("NodeMaintenance"):
+		return &longhornv1beta2.NodeMaintenanceApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("NodeMaintenanceDetachedTicket"):
+		return &longhornv1beta2.NodeMaintenanceDetachedTicketApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("NodeMaintenanceSpec"):
+		return &longhornv1beta2.NodeMaintenanceSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("NodeMaintenanceStatus"):
+		return &longhornv1beta2.NodeMaintenanceStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("NodeMaintenanceVolumeStatus"):
+		return &longhornv1beta2.NodeMaintenanceVolumeStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("NodeSpec"):
 		return &longhornv1beta2.NodeSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("NodeStatus"):
 		return &longhornv1beta2.NodeStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("NotificationSink"):
+		return &longhornv1beta2.NotificationSinkApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("NotificationSinkFilter"):
+		return &longhornv1beta2.NotificationSinkFilterApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("NotificationSinkSMTP"):
+		return &longhornv1beta2.NotificationSinkSMTPApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("NotificationSinkSpec"):
+		return &longhornv1beta2.NotificationSinkSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("NotificationSinkStatus"):
+		return &longhornv1beta2.NotificationSinkStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Orphan"):
 		return &longhornv1beta2.OrphanApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("OrphanSpec"):
 		return &longhornv1beta2.OrphanSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("OrphanStatus"):
 		return &longhornv1beta2.OrphanStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("PreflightCheck"):
+		return &longhornv1beta2.PreflightCheckApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("PreflightCheckItem"):
+		return &longhornv1beta2.PreflightCheckItemApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("PreflightCheckSpec"):
+		return &longhornv1beta2.PreflightCheckSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("PreflightCheckStatus"):
+		return &longhornv1beta2.PreflightCheckStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("PreflightNodeReport"):
+		return &longhornv1beta2.PreflightNodeReportApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("PurgeStatus"):
 		return &longhornv1beta2.PurgeStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("RebuildStatus"):
@@ -158,8 +222,26 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.ReplicaSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("RestoreStatus"):
 		return &longhornv1beta2.RestoreStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ScrubReplicaResult"):
+		return &longhornv1beta2.ScrubReplicaResultApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ScrubReport"):
+		return &longhornv1beta2.ScrubReportApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ScrubReportSpec"):
+		return &longhornv1beta2.ScrubReportSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ScrubReportStatus"):
+		return &longhornv1beta2.ScrubReportStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ScrubSnapshotResult"):
+		return &longhornv1beta2.ScrubSnapshotResultApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Setting"):
 		return &longhornv1beta2.SettingApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SettingHistory"):
+		return &longhornv1beta2.SettingHistoryApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SettingHistorySpec"):
+		return &longhornv1beta2.SettingHistorySpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SettingHistoryStatus"):
+		return &longhornv1beta2.SettingHistoryStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SettingRevision"):
+		return &longhornv1beta2.SettingRevisionApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("SettingStatus"):
 		return &longhornv1beta2.SettingStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ShareManager"):
@@ -182,6 +264,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.SnapshotStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("SupportBundle"):
 		return &longhornv1beta2.SupportBundleApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SupportBundleRedactionPolicy"):
+		return &longhornv1beta2.SupportBundleRedactionPolicyApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SupportBundleScope"):
+		return &longhornv1beta2.SupportBundleScopeApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("SupportBundleSpec"):
 		return &longhornv1beta2.SupportBundleSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("SupportBundleStatus"):
@@ -204,18 +290,44 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.V2DataEngineStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Volume"):
 		return &longhornv1beta2.VolumeApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VolumeActivityStatus"):
+		return &longhornv1beta2.VolumeActivityStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("VolumeAttachment"):
 		return &longhornv1beta2.VolumeAttachmentApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("VolumeAttachmentSpec"):
 		return &longhornv1beta2.VolumeAttachmentSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("VolumeAttachmentStatus"):
 		return &longhornv1beta2.VolumeAttachmentStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VolumeAutoExpansionPolicy"):
+		return &longhornv1beta2.VolumeAutoExpansionPolicyApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("VolumeCloneStatus"):
 		return &longhornv1beta2.VolumeCloneStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VolumeFilesystemUsage"):
+		return &longhornv1beta2.VolumeFilesystemUsageApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VolumeIOStats"):
+		return &longhornv1beta2.VolumeIOStatsApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VolumeRecoveryPointStatus"):
+		return &longhornv1beta2.VolumeRecoveryPointStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VolumeShrink"):
+		return &longhornv1beta2.VolumeShrinkApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VolumeShrinkSpec"):
+		return &longhornv1beta2.VolumeShrinkSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VolumeShrinkStatus"):
+		return &longhornv1beta2.VolumeShrinkStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("VolumeSpec"):
 		return &longhornv1beta2.VolumeSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("VolumeStatus"):
 		return &longhornv1beta2.VolumeStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VolumeTieringPolicy"):
+		return &longhornv1beta2.VolumeTieringPolicyApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VolumeTieringStatus"):
+		return &longhornv1beta2.VolumeTieringStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VolumeTransfer"):
+		return &longhornv1beta2.VolumeTransferApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VolumeTransferSpec"):
+		return &longhornv1beta2.VolumeTransferSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VolumeTransferStatus"):
+		return &longhornv1beta2.VolumeTransferStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("WorkloadStatus"):
 		return &longhornv1beta2.WorkloadStatusApplyConfiguration{}
 
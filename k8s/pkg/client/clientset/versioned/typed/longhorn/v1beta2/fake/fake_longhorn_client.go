@@ -56,6 +56,14 @@ func (c *FakeLonghornV1beta2) BackupVolumes(namespace string) v1beta2.BackupVolu
 	return newFakeBackupVolumes(c, namespace)
 }
 
+func (c *FakeLonghornV1beta2) DataEngineConversions(namespace string) v1beta2.DataEngineConversionInterface {
+	return newFakeDataEngineConversions(c, namespace)
+}
+
+func (c *FakeLonghornV1beta2) DisasterRecoveryPlans(namespace string) v1beta2.DisasterRecoveryPlanInterface {
+	return newFakeDisasterRecoveryPlans(c, namespace)
+}
+
 func (c *FakeLonghornV1beta2) Engines(namespace string) v1beta2.EngineInterface {
 	return newFakeEngines(c, namespace)
 }
@@ -64,6 +72,10 @@ func (c *FakeLonghornV1beta2) EngineImages(namespace string) v1beta2.EngineImage
 	return newFakeEngineImages(c, namespace)
 }
 
+func (c *FakeLonghornV1beta2) FileRestoreSessions(namespace string) v1beta2.FileRestoreSessionInterface {
+	return newFakeFileRestoreSessions(c, namespace)
+}
+
 func (c *FakeLonghornV1beta2) InstanceManagers(namespace string) v1beta2.InstanceManagerInterface {
 	return newFakeInstanceManagers(c, namespace)
 }
@@ -72,10 +84,22 @@ func (c *FakeLonghornV1beta2) Nodes(namespace string) v1beta2.NodeInterface {
 	return newFakeNodes(c, namespace)
 }
 
+func (c *FakeLonghornV1beta2) NodeMaintenances(namespace string) v1beta2.NodeMaintenanceInterface {
+	return newFakeNodeMaintenances(c, namespace)
+}
+
+func (c *FakeLonghornV1beta2) NotificationSinks(namespace string) v1beta2.NotificationSinkInterface {
+	return newFakeNotificationSinks(c, namespace)
+}
+
 func (c *FakeLonghornV1beta2) Orphans(namespace string) v1beta2.OrphanInterface {
 	return newFakeOrphans(c, namespace)
 }
 
+func (c *FakeLonghornV1beta2) PreflightChecks(namespace string) v1beta2.PreflightCheckInterface {
+	return newFakePreflightChecks(c, namespace)
+}
+
 func (c *FakeLonghornV1beta2) RecurringJobs(namespace string) v1beta2.RecurringJobInterface {
 	return newFakeRecurringJobs(c, namespace)
 }
@@ -84,10 +108,18 @@ func (c *FakeLonghornV1beta2) Replicas(namespace string) v1beta2.ReplicaInterfac
 	return newFakeReplicas(c, namespace)
 }
 
+func (c *FakeLonghornV1beta2) ScrubReports(namespace string) v1beta2.ScrubReportInterface {
+	return newFakeScrubReports(c, namespace)
+}
+
 func (c *FakeLonghornV1beta2) Settings(namespace string) v1beta2.SettingInterface {
 	return newFakeSettings(c, namespace)
 }
 
+func (c *FakeLonghornV1beta2) SettingHistories(namespace string) v1beta2.SettingHistoryInterface {
+	return newFakeSettingHistories(c, namespace)
+}
+
 func (c *FakeLonghornV1beta2) ShareManagers(namespace string) v1beta2.ShareManagerInterface {
 	return newFakeShareManagers(c, namespace)
 }
@@ -116,6 +148,14 @@ func (c *FakeLonghornV1beta2) VolumeAttachments(namespace string) v1beta2.Volume
 	return newFakeVolumeAttachments(c, namespace)
 }
 
+func (c *FakeLonghornV1beta2) VolumeShrinks(namespace string) v1beta2.VolumeShrinkInterface {
+	return newFakeVolumeShrinks(c, namespace)
+}
+
+func (c *FakeLonghornV1beta2) VolumeTransfers(namespace string) v1beta2.VolumeTransferInterface {
+	return newFakeVolumeTransfers(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeLonghornV1beta2) RESTClient() rest.Interface {
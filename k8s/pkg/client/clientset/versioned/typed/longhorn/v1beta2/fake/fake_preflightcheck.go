@@ -0,0 +1,53 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/client/applyconfiguration/longhorn/v1beta2"
+	typedlonghornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/typed/longhorn/v1beta2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakePreflightChecks implements PreflightCheckInterface
+type fakePreflightChecks struct {
+	*gentype.FakeClientWithListAndApply[*v1beta2.PreflightCheck, *v1beta2.PreflightCheckList, *longhornv1beta2.PreflightCheckApplyConfiguration]
+	Fake *FakeLonghornV1beta2
+}
+
+func newFakePreflightChecks(fake *FakeLonghornV1beta2, namespace string) typedlonghornv1beta2.PreflightCheckInterface {
+	return &fakePreflightChecks{
+		gentype.NewFakeClientWithListAndApply[*v1beta2.PreflightCheck, *v1beta2.PreflightCheckList, *longhornv1beta2.PreflightCheckApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1beta2.SchemeGroupVersion.WithResource("preflightchecks"),
+			v1beta2.SchemeGroupVersion.WithKind("PreflightCheck"),
+			func() *v1beta2.PreflightCheck { return &v1beta2.PreflightCheck{} },
+			func() *v1beta2.PreflightCheckList { return &v1beta2.PreflightCheckList{} },
+			func(dst, src *v1beta2.PreflightCheckList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta2.PreflightCheckList) []*v1beta2.PreflightCheck {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta2.PreflightCheckList, items []*v1beta2.PreflightCheck) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/client/applyconfiguration/longhorn/v1beta2"
+	typedlonghornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/typed/longhorn/v1beta2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeVolumeTransfers implements VolumeTransferInterface
+type fakeVolumeTransfers struct {
+	*gentype.FakeClientWithListAndApply[*v1beta2.VolumeTransfer, *v1beta2.VolumeTransferList, *longhornv1beta2.VolumeTransferApplyConfiguration]
+	Fake *FakeLonghornV1beta2
+}
+
+func newFakeVolumeTransfers(fake *FakeLonghornV1beta2, namespace string) typedlonghornv1beta2.VolumeTransferInterface {
+	return &fakeVolumeTransfers{
+		gentype.NewFakeClientWithListAndApply[*v1beta2.VolumeTransfer, *v1beta2.VolumeTransferList, *longhornv1beta2.VolumeTransferApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1beta2.SchemeGroupVersion.WithResource("volumetransfers"),
+			v1beta2.SchemeGroupVersion.WithKind("VolumeTransfer"),
+			func() *v1beta2.VolumeTransfer { return &v1beta2.VolumeTransfer{} },
+			func() *v1beta2.VolumeTransferList { return &v1beta2.VolumeTransferList{} },
+			func(dst, src *v1beta2.VolumeTransferList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta2.VolumeTransferList) []*v1beta2.VolumeTransfer {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta2.VolumeTransferList, items []*v1beta2.VolumeTransfer) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
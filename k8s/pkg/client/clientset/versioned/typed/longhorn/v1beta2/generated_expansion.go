@@ -32,22 +32,38 @@ type BackupTargetExpansion interface{}
 
 type BackupVolumeExpansion interface{}
 
+type DataEngineConversionExpansion interface{}
+
+type DisasterRecoveryPlanExpansion interface{}
+
 type EngineExpansion interface{}
 
 type EngineImageExpansion interface{}
 
+type FileRestoreSessionExpansion interface{}
+
 type InstanceManagerExpansion interface{}
 
 type NodeExpansion interface{}
 
+type NodeMaintenanceExpansion interface{}
+
+type NotificationSinkExpansion interface{}
+
 type OrphanExpansion interface{}
 
+type PreflightCheckExpansion interface{}
+
 type RecurringJobExpansion interface{}
 
 type ReplicaExpansion interface{}
 
+type ScrubReportExpansion interface{}
+
 type SettingExpansion interface{}
 
+type SettingHistoryExpansion interface{}
+
 type ShareManagerExpansion interface{}
 
 type SnapshotExpansion interface{}
@@ -61,3 +77,7 @@ type SystemRestoreExpansion interface{}
 type VolumeExpansion interface{}
 
 type VolumeAttachmentExpansion interface{}
+
+type VolumeShrinkExpansion interface{}
+
+type VolumeTransferExpansion interface{}
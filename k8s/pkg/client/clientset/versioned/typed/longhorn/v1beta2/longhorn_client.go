@@ -35,14 +35,22 @@ type LonghornV1beta2Interface interface {
 	BackupBackingImagesGetter
 	BackupTargetsGetter
 	BackupVolumesGetter
+	DataEngineConversionsGetter
+	DisasterRecoveryPlansGetter
 	EnginesGetter
 	EngineImagesGetter
+	FileRestoreSessionsGetter
 	InstanceManagersGetter
 	NodesGetter
+	NodeMaintenancesGetter
+	NotificationSinksGetter
 	OrphansGetter
+	PreflightChecksGetter
 	RecurringJobsGetter
 	ReplicasGetter
+	ScrubReportsGetter
 	SettingsGetter
+	SettingHistoriesGetter
 	ShareManagersGetter
 	SnapshotsGetter
 	SupportBundlesGetter
@@ -50,6 +58,8 @@ type LonghornV1beta2Interface interface {
 	SystemRestoresGetter
 	VolumesGetter
 	VolumeAttachmentsGetter
+	VolumeShrinksGetter
+	VolumeTransfersGetter
 }
 
 // LonghornV1beta2Client is used to interact with features provided by the longhorn.io group.
@@ -85,6 +95,14 @@ func (c *LonghornV1beta2Client) BackupVolumes(namespace string) BackupVolumeInte
 	return newBackupVolumes(c, namespace)
 }
 
+func (c *LonghornV1beta2Client) DataEngineConversions(namespace string) DataEngineConversionInterface {
+	return newDataEngineConversions(c, namespace)
+}
+
+func (c *LonghornV1beta2Client) DisasterRecoveryPlans(namespace string) DisasterRecoveryPlanInterface {
+	return newDisasterRecoveryPlans(c, namespace)
+}
+
 func (c *LonghornV1beta2Client) Engines(namespace string) EngineInterface {
 	return newEngines(c, namespace)
 }
@@ -93,6 +111,10 @@ func (c *LonghornV1beta2Client) EngineImages(namespace string) EngineImageInterf
 	return newEngineImages(c, namespace)
 }
 
+func (c *LonghornV1beta2Client) FileRestoreSessions(namespace string) FileRestoreSessionInterface {
+	return newFileRestoreSessions(c, namespace)
+}
+
 func (c *LonghornV1beta2Client) InstanceManagers(namespace string) InstanceManagerInterface {
 	return newInstanceManagers(c, namespace)
 }
@@ -101,10 +123,22 @@ func (c *LonghornV1beta2Client) Nodes(namespace string) NodeInterface {
 	return newNodes(c, namespace)
 }
 
+func (c *LonghornV1beta2Client) NodeMaintenances(namespace string) NodeMaintenanceInterface {
+	return newNodeMaintenances(c, namespace)
+}
+
+func (c *LonghornV1beta2Client) NotificationSinks(namespace string) NotificationSinkInterface {
+	return newNotificationSinks(c, namespace)
+}
+
 func (c *LonghornV1beta2Client) Orphans(namespace string) OrphanInterface {
 	return newOrphans(c, namespace)
 }
 
+func (c *LonghornV1beta2Client) PreflightChecks(namespace string) PreflightCheckInterface {
+	return newPreflightChecks(c, namespace)
+}
+
 func (c *LonghornV1beta2Client) RecurringJobs(namespace string) RecurringJobInterface {
 	return newRecurringJobs(c, namespace)
 }
@@ -113,10 +147,18 @@ func (c *LonghornV1beta2Client) Replicas(namespace string) ReplicaInterface {
 	return newReplicas(c, namespace)
 }
 
+func (c *LonghornV1beta2Client) ScrubReports(namespace string) ScrubReportInterface {
+	return newScrubReports(c, namespace)
+}
+
 func (c *LonghornV1beta2Client) Settings(namespace string) SettingInterface {
 	return newSettings(c, namespace)
 }
 
+func (c *LonghornV1beta2Client) SettingHistories(namespace string) SettingHistoryInterface {
+	return newSettingHistories(c, namespace)
+}
+
 func (c *LonghornV1beta2Client) ShareManagers(namespace string) ShareManagerInterface {
 	return newShareManagers(c, namespace)
 }
@@ -145,6 +187,14 @@ func (c *LonghornV1beta2Client) VolumeAttachments(namespace string) VolumeAttach
 	return newVolumeAttachments(c, namespace)
 }
 
+func (c *LonghornV1beta2Client) VolumeShrinks(namespace string) VolumeShrinkInterface {
+	return newVolumeShrinks(c, namespace)
+}
+
+func (c *LonghornV1beta2Client) VolumeTransfers(namespace string) VolumeTransferInterface {
+	return newVolumeTransfers(c, namespace)
+}
+
 // NewForConfig creates a new LonghornV1beta2Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
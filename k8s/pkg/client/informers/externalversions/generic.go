@@ -67,22 +67,38 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().BackupTargets().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("backupvolumes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().BackupVolumes().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("dataengineconversions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().DataEngineConversions().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("disasterrecoveryplans"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().DisasterRecoveryPlans().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("engines"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().Engines().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("engineimages"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().EngineImages().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("filerestoresessions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().FileRestoreSessions().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("instancemanagers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().InstanceManagers().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("nodes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().Nodes().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("nodemaintenances"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().NodeMaintenances().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("notificationsinks"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().NotificationSinks().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("orphans"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().Orphans().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("preflightchecks"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().PreflightChecks().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("recurringjobs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().RecurringJobs().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("replicas"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().Replicas().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("scrubreports"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().ScrubReports().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("settings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().Settings().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("settinghistories"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().SettingHistories().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("sharemanagers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().ShareManagers().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("snapshots"):
@@ -97,6 +113,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().Volumes().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("volumeattachments"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().VolumeAttachments().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("volumeshrinks"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().VolumeShrinks().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("volumetransfers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Longhorn().V1beta2().VolumeTransfers().Informer()}, nil
 
 	}
 
@@ -38,22 +38,38 @@ type Interface interface {
 	BackupTargets() BackupTargetInformer
 	// BackupVolumes returns a BackupVolumeInformer.
 	BackupVolumes() BackupVolumeInformer
+	// DataEngineConversions returns a DataEngineConversionInformer.
+	DataEngineConversions() DataEngineConversionInformer
+	// DisasterRecoveryPlans returns a DisasterRecoveryPlanInformer.
+	DisasterRecoveryPlans() DisasterRecoveryPlanInformer
 	// Engines returns a EngineInformer.
 	Engines() EngineInformer
 	// EngineImages returns a EngineImageInformer.
 	EngineImages() EngineImageInformer
+	// FileRestoreSessions returns a FileRestoreSessionInformer.
+	FileRestoreSessions() FileRestoreSessionInformer
 	// InstanceManagers returns a InstanceManagerInformer.
 	InstanceManagers() InstanceManagerInformer
 	// Nodes returns a NodeInformer.
 	Nodes() NodeInformer
+	// NodeMaintenances returns a NodeMaintenanceInformer.
+	NodeMaintenances() NodeMaintenanceInformer
+	// NotificationSinks returns a NotificationSinkInformer.
+	NotificationSinks() NotificationSinkInformer
 	// Orphans returns a OrphanInformer.
 	Orphans() OrphanInformer
+	// PreflightChecks returns a PreflightCheckInformer.
+	PreflightChecks() PreflightCheckInformer
 	// RecurringJobs returns a RecurringJobInformer.
 	RecurringJobs() RecurringJobInformer
 	// Replicas returns a ReplicaInformer.
 	Replicas() ReplicaInformer
+	// ScrubReports returns a ScrubReportInformer.
+	ScrubReports() ScrubReportInformer
 	// Settings returns a SettingInformer.
 	Settings() SettingInformer
+	// SettingHistories returns a SettingHistoryInformer.
+	SettingHistories() SettingHistoryInformer
 	// ShareManagers returns a ShareManagerInformer.
 	ShareManagers() ShareManagerInformer
 	// Snapshots returns a SnapshotInformer.
@@ -68,6 +84,10 @@ type Interface interface {
 	Volumes() VolumeInformer
 	// VolumeAttachments returns a VolumeAttachmentInformer.
 	VolumeAttachments() VolumeAttachmentInformer
+	// VolumeShrinks returns a VolumeShrinkInformer.
+	VolumeShrinks() VolumeShrinkInformer
+	// VolumeTransfers returns a VolumeTransferInformer.
+	VolumeTransfers() VolumeTransferInformer
 }
 
 type version struct {
@@ -116,6 +136,16 @@ func (v *version) BackupVolumes() BackupVolumeInformer {
 	return &backupVolumeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// DataEngineConversions returns a DataEngineConversionInformer.
+func (v *version) DataEngineConversions() DataEngineConversionInformer {
+	return &dataEngineConversionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// DisasterRecoveryPlans returns a DisasterRecoveryPlanInformer.
+func (v *version) DisasterRecoveryPlans() DisasterRecoveryPlanInformer {
+	return &disasterRecoveryPlanInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Engines returns a EngineInformer.
 func (v *version) Engines() EngineInformer {
 	return &engineInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -126,6 +156,11 @@ func (v *version) EngineImages() EngineImageInformer {
 	return &engineImageInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// FileRestoreSessions returns a FileRestoreSessionInformer.
+func (v *version) FileRestoreSessions() FileRestoreSessionInformer {
+	return &fileRestoreSessionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // InstanceManagers returns a InstanceManagerInformer.
 func (v *version) InstanceManagers() InstanceManagerInformer {
 	return &instanceManagerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -136,11 +171,26 @@ func (v *version) Nodes() NodeInformer {
 	return &nodeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// NodeMaintenances returns a NodeMaintenanceInformer.
+func (v *version) NodeMaintenances() NodeMaintenanceInformer {
+	return &nodeMaintenanceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// NotificationSinks returns a NotificationSinkInformer.
+func (v *version) NotificationSinks() NotificationSinkInformer {
+	return &notificationSinkInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Orphans returns a OrphanInformer.
 func (v *version) Orphans() OrphanInformer {
 	return &orphanInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// PreflightChecks returns a PreflightCheckInformer.
+func (v *version) PreflightChecks() PreflightCheckInformer {
+	return &preflightCheckInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // RecurringJobs returns a RecurringJobInformer.
 func (v *version) RecurringJobs() RecurringJobInformer {
 	return &recurringJobInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -151,11 +201,21 @@ func (v *version) Replicas() ReplicaInformer {
 	return &replicaInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ScrubReports returns a ScrubReportInformer.
+func (v *version) ScrubReports() ScrubReportInformer {
+	return &scrubReportInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Settings returns a SettingInformer.
 func (v *version) Settings() SettingInformer {
 	return &settingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// SettingHistories returns a SettingHistoryInformer.
+func (v *version) SettingHistories() SettingHistoryInformer {
+	return &settingHistoryInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ShareManagers returns a ShareManagerInformer.
 func (v *version) ShareManagers() ShareManagerInformer {
 	return &shareManagerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -190,3 +250,13 @@ func (v *version) Volumes() VolumeInformer {
 func (v *version) VolumeAttachments() VolumeAttachmentInformer {
 	return &volumeAttachmentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// VolumeShrinks returns a VolumeShrinkInformer.
+func (v *version) VolumeShrinks() VolumeShrinkInformer {
+	return &volumeShrinkInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// VolumeTransfers returns a VolumeTransferInformer.
+func (v *version) VolumeTransfers() VolumeTransferInformer {
+	return &volumeTransferInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// DataEngineConversionLister helps list DataEngineConversions.
+// All objects returned here must be treated as read-only.
+type DataEngineConversionLister interface {
+	// List lists all DataEngineConversions in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.DataEngineConversion, err error)
+	// DataEngineConversions returns an object that can list and get DataEngineConversions.
+	DataEngineConversions(namespace string) DataEngineConversionNamespaceLister
+	DataEngineConversionListerExpansion
+}
+
+// dataEngineConversionLister implements the DataEngineConversionLister interface.
+type dataEngineConversionLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.DataEngineConversion]
+}
+
+// NewDataEngineConversionLister returns a new DataEngineConversionLister.
+func NewDataEngineConversionLister(indexer cache.Indexer) DataEngineConversionLister {
+	return &dataEngineConversionLister{listers.New[*longhornv1beta2.DataEngineConversion](indexer, longhornv1beta2.Resource("dataengineconversion"))}
+}
+
+// DataEngineConversions returns an object that can list and get DataEngineConversions.
+func (s *dataEngineConversionLister) DataEngineConversions(namespace string) DataEngineConversionNamespaceLister {
+	return dataEngineConversionNamespaceLister{listers.NewNamespaced[*longhornv1beta2.DataEngineConversion](s.ResourceIndexer, namespace)}
+}
+
+// DataEngineConversionNamespaceLister helps list and get DataEngineConversions.
+// All objects returned here must be treated as read-only.
+type DataEngineConversionNamespaceLister interface {
+	// List lists all DataEngineConversions in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.DataEngineConversion, err error)
+	// Get retrieves the DataEngineConversion from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*longhornv1beta2.DataEngineConversion, error)
+	DataEngineConversionNamespaceListerExpansion
+}
+
+// dataEngineConversionNamespaceLister implements the DataEngineConversionNamespaceLister
+// interface.
+type dataEngineConversionNamespaceLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.DataEngineConversion]
+}
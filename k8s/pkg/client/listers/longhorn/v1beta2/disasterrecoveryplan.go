@@ -0,0 +1,70 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// DisasterRecoveryPlanLister helps list DisasterRecoveryPlans.
+// All objects returned here must be treated as read-only.
+type DisasterRecoveryPlanLister interface {
+	// List lists all DisasterRecoveryPlans in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.DisasterRecoveryPlan, err error)
+	// DisasterRecoveryPlans returns an object that can list and get DisasterRecoveryPlans.
+	DisasterRecoveryPlans(namespace string) DisasterRecoveryPlanNamespaceLister
+	DisasterRecoveryPlanListerExpansion
+}
+
+// disasterRecoveryPlanLister implements the DisasterRecoveryPlanLister interface.
+type disasterRecoveryPlanLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.DisasterRecoveryPlan]
+}
+
+// NewDisasterRecoveryPlanLister returns a new DisasterRecoveryPlanLister.
+func NewDisasterRecoveryPlanLister(indexer cache.Indexer) DisasterRecoveryPlanLister {
+	return &disasterRecoveryPlanLister{listers.New[*longhornv1beta2.DisasterRecoveryPlan](indexer, longhornv1beta2.Resource("disasterrecoveryplan"))}
+}
+
+// DisasterRecoveryPlans returns an object that can list and get DisasterRecoveryPlans.
+func (s *disasterRecoveryPlanLister) DisasterRecoveryPlans(namespace string) DisasterRecoveryPlanNamespaceLister {
+	return disasterRecoveryPlanNamespaceLister{listers.NewNamespaced[*longhornv1beta2.DisasterRecoveryPlan](s.ResourceIndexer, namespace)}
+}
+
+// DisasterRecoveryPlanNamespaceLister helps list and get DisasterRecoveryPlans.
+// All objects returned here must be treated as read-only.
+type DisasterRecoveryPlanNamespaceLister interface {
+	// List lists all DisasterRecoveryPlans in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.DisasterRecoveryPlan, err error)
+	// Get retrieves the DisasterRecoveryPlan from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*longhornv1beta2.DisasterRecoveryPlan, error)
+	DisasterRecoveryPlanNamespaceListerExpansion
+}
+
+// disasterRecoveryPlanNamespaceLister implements the DisasterRecoveryPlanNamespaceLister
+// interface.
+type disasterRecoveryPlanNamespaceLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.DisasterRecoveryPlan]
+}
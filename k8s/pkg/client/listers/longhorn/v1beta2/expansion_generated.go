@@ -74,6 +74,22 @@ type BackupVolumeListerExpansion interface{}
 // BackupVolumeNamespaceLister.
 type BackupVolumeNamespaceListerExpansion interface{}
 
+// DataEngineConversionListerExpansion allows custom methods to be added to
+// DataEngineConversionLister.
+type DataEngineConversionListerExpansion interface{}
+
+// DataEngineConversionNamespaceListerExpansion allows custom methods to be added to
+// DataEngineConversionNamespaceLister.
+type DataEngineConversionNamespaceListerExpansion interface{}
+
+// DisasterRecoveryPlanListerExpansion allows custom methods to be added to
+// DisasterRecoveryPlanLister.
+type DisasterRecoveryPlanListerExpansion interface{}
+
+// DisasterRecoveryPlanNamespaceListerExpansion allows custom methods to be added to
+// DisasterRecoveryPlanNamespaceLister.
+type DisasterRecoveryPlanNamespaceListerExpansion interface{}
+
 // EngineListerExpansion allows custom methods to be added to
 // EngineLister.
 type EngineListerExpansion interface{}
@@ -90,6 +106,14 @@ type EngineImageListerExpansion interface{}
 // EngineImageNamespaceLister.
 type EngineImageNamespaceListerExpansion interface{}
 
+// FileRestoreSessionListerExpansion allows custom methods to be added to
+// FileRestoreSessionLister.
+type FileRestoreSessionListerExpansion interface{}
+
+// FileRestoreSessionNamespaceListerExpansion allows custom methods to be added to
+// FileRestoreSessionNamespaceLister.
+type FileRestoreSessionNamespaceListerExpansion interface{}
+
 // InstanceManagerListerExpansion allows custom methods to be added to
 // InstanceManagerLister.
 type InstanceManagerListerExpansion interface{}
@@ -106,6 +130,22 @@ type NodeListerExpansion interface{}
 // NodeNamespaceLister.
 type NodeNamespaceListerExpansion interface{}
 
+// NodeMaintenanceListerExpansion allows custom methods to be added to
+// NodeMaintenanceLister.
+type NodeMaintenanceListerExpansion interface{}
+
+// NodeMaintenanceNamespaceListerExpansion allows custom methods to be added to
+// NodeMaintenanceNamespaceLister.
+type NodeMaintenanceNamespaceListerExpansion interface{}
+
+// NotificationSinkListerExpansion allows custom methods to be added to
+// NotificationSinkLister.
+type NotificationSinkListerExpansion interface{}
+
+// NotificationSinkNamespaceListerExpansion allows custom methods to be added to
+// NotificationSinkNamespaceLister.
+type NotificationSinkNamespaceListerExpansion interface{}
+
 // OrphanListerExpansion allows custom methods to be added to
 // OrphanLister.
 type OrphanListerExpansion interface{}
@@ -114,6 +154,14 @@ type OrphanListerExpansion interface{}
 // OrphanNamespaceLister.
 type OrphanNamespaceListerExpansion interface{}
 
+// PreflightCheckListerExpansion allows custom methods to be added to
+// PreflightCheckLister.
+type PreflightCheckListerExpansion interface{}
+
+// PreflightCheckNamespaceListerExpansion allows custom methods to be added to
+// PreflightCheckNamespaceLister.
+type PreflightCheckNamespaceListerExpansion interface{}
+
 // RecurringJobListerExpansion allows custom methods to be added to
 // RecurringJobLister.
 type RecurringJobListerExpansion interface{}
@@ -130,6 +178,14 @@ type ReplicaListerExpansion interface{}
 // ReplicaNamespaceLister.
 type ReplicaNamespaceListerExpansion interface{}
 
+// ScrubReportListerExpansion allows custom methods to be added to
+// ScrubReportLister.
+type ScrubReportListerExpansion interface{}
+
+// ScrubReportNamespaceListerExpansion allows custom methods to be added to
+// ScrubReportNamespaceLister.
+type ScrubReportNamespaceListerExpansion interface{}
+
 // SettingListerExpansion allows custom methods to be added to
 // SettingLister.
 type SettingListerExpansion interface{}
@@ -138,6 +194,14 @@ type SettingListerExpansion interface{}
 // SettingNamespaceLister.
 type SettingNamespaceListerExpansion interface{}
 
+// SettingHistoryListerExpansion allows custom methods to be added to
+// SettingHistoryLister.
+type SettingHistoryListerExpansion interface{}
+
+// SettingHistoryNamespaceListerExpansion allows custom methods to be added to
+// SettingHistoryNamespaceLister.
+type SettingHistoryNamespaceListerExpansion interface{}
+
 // ShareManagerListerExpansion allows custom methods to be added to
 // ShareManagerLister.
 type ShareManagerListerExpansion interface{}
@@ -193,3 +257,19 @@ type VolumeAttachmentListerExpansion interface{}
 // VolumeAttachmentNamespaceListerExpansion allows custom methods to be added to
 // VolumeAttachmentNamespaceLister.
 type VolumeAttachmentNamespaceListerExpansion interface{}
+
+// VolumeShrinkListerExpansion allows custom methods to be added to
+// VolumeShrinkLister.
+type VolumeShrinkListerExpansion interface{}
+
+// VolumeShrinkNamespaceListerExpansion allows custom methods to be added to
+// VolumeShrinkNamespaceLister.
+type VolumeShrinkNamespaceListerExpansion interface{}
+
+// VolumeTransferListerExpansion allows custom methods to be added to
+// VolumeTransferLister.
+type VolumeTransferListerExpansion interface{}
+
+// VolumeTransferNamespaceListerExpansion allows custom methods to be added to
+// VolumeTransferNamespaceLister.
+type VolumeTransferNamespaceListerExpansion interface{}
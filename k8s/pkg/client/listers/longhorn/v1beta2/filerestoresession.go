@@ -0,0 +1,70 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// FileRestoreSessionLister helps list FileRestoreSessions.
+// All objects returned here must be treated as read-only.
+type FileRestoreSessionLister interface {
+	// List lists all FileRestoreSessions in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.FileRestoreSession, err error)
+	// FileRestoreSessions returns an object that can list and get FileRestoreSessions.
+	FileRestoreSessions(namespace string) FileRestoreSessionNamespaceLister
+	FileRestoreSessionListerExpansion
+}
+
+// fileRestoreSessionLister implements the FileRestoreSessionLister interface.
+type fileRestoreSessionLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.FileRestoreSession]
+}
+
+// NewFileRestoreSessionLister returns a new FileRestoreSessionLister.
+func NewFileRestoreSessionLister(indexer cache.Indexer) FileRestoreSessionLister {
+	return &fileRestoreSessionLister{listers.New[*longhornv1beta2.FileRestoreSession](indexer, longhornv1beta2.Resource("filerestoresession"))}
+}
+
+// FileRestoreSessions returns an object that can list and get FileRestoreSessions.
+func (s *fileRestoreSessionLister) FileRestoreSessions(namespace string) FileRestoreSessionNamespaceLister {
+	return fileRestoreSessionNamespaceLister{listers.NewNamespaced[*longhornv1beta2.FileRestoreSession](s.ResourceIndexer, namespace)}
+}
+
+// FileRestoreSessionNamespaceLister helps list and get FileRestoreSessions.
+// All objects returned here must be treated as read-only.
+type FileRestoreSessionNamespaceLister interface {
+	// List lists all FileRestoreSessions in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.FileRestoreSession, err error)
+	// Get retrieves the FileRestoreSession from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*longhornv1beta2.FileRestoreSession, error)
+	FileRestoreSessionNamespaceListerExpansion
+}
+
+// fileRestoreSessionNamespaceLister implements the FileRestoreSessionNamespaceLister
+// interface.
+type fileRestoreSessionNamespaceLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.FileRestoreSession]
+}
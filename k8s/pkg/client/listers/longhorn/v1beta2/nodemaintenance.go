@@ -0,0 +1,70 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// NodeMaintenanceLister helps list NodeMaintenances.
+// All objects returned here must be treated as read-only.
+type NodeMaintenanceLister interface {
+	// List lists all NodeMaintenances in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.NodeMaintenance, err error)
+	// NodeMaintenances returns an object that can list and get NodeMaintenances.
+	NodeMaintenances(namespace string) NodeMaintenanceNamespaceLister
+	NodeMaintenanceListerExpansion
+}
+
+// nodeMaintenanceLister implements the NodeMaintenanceLister interface.
+type nodeMaintenanceLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.NodeMaintenance]
+}
+
+// NewNodeMaintenanceLister returns a new NodeMaintenanceLister.
+func NewNodeMaintenanceLister(indexer cache.Indexer) NodeMaintenanceLister {
+	return &nodeMaintenanceLister{listers.New[*longhornv1beta2.NodeMaintenance](indexer, longhornv1beta2.Resource("nodemaintenance"))}
+}
+
+// NodeMaintenances returns an object that can list and get NodeMaintenances.
+func (s *nodeMaintenanceLister) NodeMaintenances(namespace string) NodeMaintenanceNamespaceLister {
+	return nodeMaintenanceNamespaceLister{listers.NewNamespaced[*longhornv1beta2.NodeMaintenance](s.ResourceIndexer, namespace)}
+}
+
+// NodeMaintenanceNamespaceLister helps list and get NodeMaintenances.
+// All objects returned here must be treated as read-only.
+type NodeMaintenanceNamespaceLister interface {
+	// List lists all NodeMaintenances in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.NodeMaintenance, err error)
+	// Get retrieves the NodeMaintenance from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*longhornv1beta2.NodeMaintenance, error)
+	NodeMaintenanceNamespaceListerExpansion
+}
+
+// nodeMaintenanceNamespaceLister implements the NodeMaintenanceNamespaceLister
+// interface.
+type nodeMaintenanceNamespaceLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.NodeMaintenance]
+}
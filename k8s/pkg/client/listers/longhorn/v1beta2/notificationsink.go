@@ -0,0 +1,70 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// NotificationSinkLister helps list NotificationSinks.
+// All objects returned here must be treated as read-only.
+type NotificationSinkLister interface {
+	// List lists all NotificationSinks in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.NotificationSink, err error)
+	// NotificationSinks returns an object that can list and get NotificationSinks.
+	NotificationSinks(namespace string) NotificationSinkNamespaceLister
+	NotificationSinkListerExpansion
+}
+
+// notificationSinkLister implements the NotificationSinkLister interface.
+type notificationSinkLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.NotificationSink]
+}
+
+// NewNotificationSinkLister returns a new NotificationSinkLister.
+func NewNotificationSinkLister(indexer cache.Indexer) NotificationSinkLister {
+	return &notificationSinkLister{listers.New[*longhornv1beta2.NotificationSink](indexer, longhornv1beta2.Resource("notificationsink"))}
+}
+
+// NotificationSinks returns an object that can list and get NotificationSinks.
+func (s *notificationSinkLister) NotificationSinks(namespace string) NotificationSinkNamespaceLister {
+	return notificationSinkNamespaceLister{listers.NewNamespaced[*longhornv1beta2.NotificationSink](s.ResourceIndexer, namespace)}
+}
+
+// NotificationSinkNamespaceLister helps list and get NotificationSinks.
+// All objects returned here must be treated as read-only.
+type NotificationSinkNamespaceLister interface {
+	// List lists all NotificationSinks in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.NotificationSink, err error)
+	// Get retrieves the NotificationSink from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*longhornv1beta2.NotificationSink, error)
+	NotificationSinkNamespaceListerExpansion
+}
+
+// notificationSinkNamespaceLister implements the NotificationSinkNamespaceLister
+// interface.
+type notificationSinkNamespaceLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.NotificationSink]
+}
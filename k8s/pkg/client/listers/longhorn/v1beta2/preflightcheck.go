@@ -0,0 +1,70 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// PreflightCheckLister helps list PreflightChecks.
+// All objects returned here must be treated as read-only.
+type PreflightCheckLister interface {
+	// List lists all PreflightChecks in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.PreflightCheck, err error)
+	// PreflightChecks returns an object that can list and get PreflightChecks.
+	PreflightChecks(namespace string) PreflightCheckNamespaceLister
+	PreflightCheckListerExpansion
+}
+
+// preflightCheckLister implements the PreflightCheckLister interface.
+type preflightCheckLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.PreflightCheck]
+}
+
+// NewPreflightCheckLister returns a new PreflightCheckLister.
+func NewPreflightCheckLister(indexer cache.Indexer) PreflightCheckLister {
+	return &preflightCheckLister{listers.New[*longhornv1beta2.PreflightCheck](indexer, longhornv1beta2.Resource("preflightcheck"))}
+}
+
+// PreflightChecks returns an object that can list and get PreflightChecks.
+func (s *preflightCheckLister) PreflightChecks(namespace string) PreflightCheckNamespaceLister {
+	return preflightCheckNamespaceLister{listers.NewNamespaced[*longhornv1beta2.PreflightCheck](s.ResourceIndexer, namespace)}
+}
+
+// PreflightCheckNamespaceLister helps list and get PreflightChecks.
+// All objects returned here must be treated as read-only.
+type PreflightCheckNamespaceLister interface {
+	// List lists all PreflightChecks in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.PreflightCheck, err error)
+	// Get retrieves the PreflightCheck from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*longhornv1beta2.PreflightCheck, error)
+	PreflightCheckNamespaceListerExpansion
+}
+
+// preflightCheckNamespaceLister implements the PreflightCheckNamespaceLister
+// interface.
+type preflightCheckNamespaceLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.PreflightCheck]
+}
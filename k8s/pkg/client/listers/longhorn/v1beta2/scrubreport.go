@@ -0,0 +1,70 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ScrubReportLister helps list ScrubReports.
+// All objects returned here must be treated as read-only.
+type ScrubReportLister interface {
+	// List lists all ScrubReports in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.ScrubReport, err error)
+	// ScrubReports returns an object that can list and get ScrubReports.
+	ScrubReports(namespace string) ScrubReportNamespaceLister
+	ScrubReportListerExpansion
+}
+
+// scrubReportLister implements the ScrubReportLister interface.
+type scrubReportLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.ScrubReport]
+}
+
+// NewScrubReportLister returns a new ScrubReportLister.
+func NewScrubReportLister(indexer cache.Indexer) ScrubReportLister {
+	return &scrubReportLister{listers.New[*longhornv1beta2.ScrubReport](indexer, longhornv1beta2.Resource("scrubreport"))}
+}
+
+// ScrubReports returns an object that can list and get ScrubReports.
+func (s *scrubReportLister) ScrubReports(namespace string) ScrubReportNamespaceLister {
+	return scrubReportNamespaceLister{listers.NewNamespaced[*longhornv1beta2.ScrubReport](s.ResourceIndexer, namespace)}
+}
+
+// ScrubReportNamespaceLister helps list and get ScrubReports.
+// All objects returned here must be treated as read-only.
+type ScrubReportNamespaceLister interface {
+	// List lists all ScrubReports in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.ScrubReport, err error)
+	// Get retrieves the ScrubReport from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*longhornv1beta2.ScrubReport, error)
+	ScrubReportNamespaceListerExpansion
+}
+
+// scrubReportNamespaceLister implements the ScrubReportNamespaceLister
+// interface.
+type scrubReportNamespaceLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.ScrubReport]
+}
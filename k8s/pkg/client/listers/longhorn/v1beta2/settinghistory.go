@@ -0,0 +1,70 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// SettingHistoryLister helps list SettingHistories.
+// All objects returned here must be treated as read-only.
+type SettingHistoryLister interface {
+	// List lists all SettingHistories in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.SettingHistory, err error)
+	// SettingHistories returns an object that can list and get SettingHistories.
+	SettingHistories(namespace string) SettingHistoryNamespaceLister
+	SettingHistoryListerExpansion
+}
+
+// settingHistoryLister implements the SettingHistoryLister interface.
+type settingHistoryLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.SettingHistory]
+}
+
+// NewSettingHistoryLister returns a new SettingHistoryLister.
+func NewSettingHistoryLister(indexer cache.Indexer) SettingHistoryLister {
+	return &settingHistoryLister{listers.New[*longhornv1beta2.SettingHistory](indexer, longhornv1beta2.Resource("settinghistory"))}
+}
+
+// SettingHistories returns an object that can list and get SettingHistories.
+func (s *settingHistoryLister) SettingHistories(namespace string) SettingHistoryNamespaceLister {
+	return settingHistoryNamespaceLister{listers.NewNamespaced[*longhornv1beta2.SettingHistory](s.ResourceIndexer, namespace)}
+}
+
+// SettingHistoryNamespaceLister helps list and get SettingHistories.
+// All objects returned here must be treated as read-only.
+type SettingHistoryNamespaceLister interface {
+	// List lists all SettingHistories in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.SettingHistory, err error)
+	// Get retrieves the SettingHistory from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*longhornv1beta2.SettingHistory, error)
+	SettingHistoryNamespaceListerExpansion
+}
+
+// settingHistoryNamespaceLister implements the SettingHistoryNamespaceLister
+// interface.
+type settingHistoryNamespaceLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.SettingHistory]
+}
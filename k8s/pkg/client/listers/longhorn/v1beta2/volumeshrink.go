@@ -0,0 +1,70 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// VolumeShrinkLister helps list VolumeShrinks.
+// All objects returned here must be treated as read-only.
+type VolumeShrinkLister interface {
+	// List lists all VolumeShrinks in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.VolumeShrink, err error)
+	// VolumeShrinks returns an object that can list and get VolumeShrinks.
+	VolumeShrinks(namespace string) VolumeShrinkNamespaceLister
+	VolumeShrinkListerExpansion
+}
+
+// volumeShrinkLister implements the VolumeShrinkLister interface.
+type volumeShrinkLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.VolumeShrink]
+}
+
+// NewVolumeShrinkLister returns a new VolumeShrinkLister.
+func NewVolumeShrinkLister(indexer cache.Indexer) VolumeShrinkLister {
+	return &volumeShrinkLister{listers.New[*longhornv1beta2.VolumeShrink](indexer, longhornv1beta2.Resource("volumeshrink"))}
+}
+
+// VolumeShrinks returns an object that can list and get VolumeShrinks.
+func (s *volumeShrinkLister) VolumeShrinks(namespace string) VolumeShrinkNamespaceLister {
+	return volumeShrinkNamespaceLister{listers.NewNamespaced[*longhornv1beta2.VolumeShrink](s.ResourceIndexer, namespace)}
+}
+
+// VolumeShrinkNamespaceLister helps list and get VolumeShrinks.
+// All objects returned here must be treated as read-only.
+type VolumeShrinkNamespaceLister interface {
+	// List lists all VolumeShrinks in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.VolumeShrink, err error)
+	// Get retrieves the VolumeShrink from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*longhornv1beta2.VolumeShrink, error)
+	VolumeShrinkNamespaceListerExpansion
+}
+
+// volumeShrinkNamespaceLister implements the VolumeShrinkNamespaceLister
+// interface.
+type volumeShrinkNamespaceLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.VolumeShrink]
+}
@@ -0,0 +1,70 @@
+/*
+Copyright The Longhorn Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// VolumeTransferLister helps list VolumeTransfers.
+// All objects returned here must be treated as read-only.
+type VolumeTransferLister interface {
+	// List lists all VolumeTransfers in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.VolumeTransfer, err error)
+	// VolumeTransfers returns an object that can list and get VolumeTransfers.
+	VolumeTransfers(namespace string) VolumeTransferNamespaceLister
+	VolumeTransferListerExpansion
+}
+
+// volumeTransferLister implements the VolumeTransferLister interface.
+type volumeTransferLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.VolumeTransfer]
+}
+
+// NewVolumeTransferLister returns a new VolumeTransferLister.
+func NewVolumeTransferLister(indexer cache.Indexer) VolumeTransferLister {
+	return &volumeTransferLister{listers.New[*longhornv1beta2.VolumeTransfer](indexer, longhornv1beta2.Resource("volumetransfer"))}
+}
+
+// VolumeTransfers returns an object that can list and get VolumeTransfers.
+func (s *volumeTransferLister) VolumeTransfers(namespace string) VolumeTransferNamespaceLister {
+	return volumeTransferNamespaceLister{listers.NewNamespaced[*longhornv1beta2.VolumeTransfer](s.ResourceIndexer, namespace)}
+}
+
+// VolumeTransferNamespaceLister helps list and get VolumeTransfers.
+// All objects returned here must be treated as read-only.
+type VolumeTransferNamespaceLister interface {
+	// List lists all VolumeTransfers in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*longhornv1beta2.VolumeTransfer, err error)
+	// Get retrieves the VolumeTransfer from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*longhornv1beta2.VolumeTransfer, error)
+	VolumeTransferNamespaceListerExpansion
+}
+
+// volumeTransferNamespaceLister implements the VolumeTransferNamespaceLister
+// interface.
+type volumeTransferNamespaceLister struct {
+	listers.ResourceIndexer[*longhornv1beta2.VolumeTransfer]
+}
@@ -67,6 +67,9 @@ func main() {
 		app.PostUpgradeCmd(),
 		app.UninstallCmd(),
 		app.SystemRolloutCmd(),
+		app.PreflightCmd(),
+		app.FileRestoreServerCmd(),
+		app.VolumeShrinkMoverCmd(),
 		// TODO: Remove MigrateForPre070VolumesCmd() after v0.8.1
 		app.MigrateForPre070VolumesCmd(),
 	}
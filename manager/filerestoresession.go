@@ -0,0 +1,66 @@
+package manager
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func (m *VolumeManager) CreateFileRestoreSession(obj *longhorn.FileRestoreSession) (*longhorn.FileRestoreSession, error) {
+	logrus.WithFields(logrus.Fields{
+		"fileRestoreSession": obj.Name,
+		"backup":             obj.Spec.BackupName,
+		"sourceVolume":       obj.Spec.SourceVolume,
+		"snapshot":           obj.Spec.SnapshotName,
+	}).Info("Creating FileRestoreSession")
+
+	return m.ds.CreateFileRestoreSession(obj)
+}
+
+func (m *VolumeManager) DeleteFileRestoreSession(name string) error {
+	logrus.WithField("fileRestoreSession", name).Info("Deleting FileRestoreSession")
+
+	err := m.ds.DeleteFileRestoreSession(name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (m *VolumeManager) GetFileRestoreSession(name string) (*longhorn.FileRestoreSession, error) {
+	return m.ds.GetFileRestoreSessionRO(name)
+}
+
+func (m *VolumeManager) ListFileRestoreSessionsSorted() ([]*longhorn.FileRestoreSession, error) {
+	sessions, err := m.ds.ListFileRestoreSessionsRO()
+	if err != nil {
+		return []*longhorn.FileRestoreSession{}, err
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Name < sessions[j].Name
+	})
+	return sessions, nil
+}
+
+// GetFileRestoreSessionToken returns the token the file server of the session authenticates the requests with.
+func (m *VolumeManager) GetFileRestoreSessionToken(name string) (string, error) {
+	session, err := m.ds.GetFileRestoreSessionRO(name)
+	if err != nil {
+		return "", err
+	}
+	secretName := types.GetFileRestoreSessionSecretName(session.Name)
+	secret, err := m.ds.GetSecretRO(session.Namespace, secretName)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get token secret %v", secretName)
+	}
+	return string(secret.Data[types.FileRestoreServerTokenKey]), nil
+}
@@ -6,6 +6,7 @@ import (
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/util/capacityforecast"
 )
 
 func (m *VolumeManager) GetInstanceManager(name string) (*longhorn.InstanceManager, error) {
@@ -81,6 +82,15 @@ func (m *VolumeManager) ListReadyNodesContainingEngineImageRO(image string) (map
 	return m.ds.ListReadyNodesContainingEngineImageRO(image)
 }
 
+// GetClusterCapacityForecast estimates when the storage of all disks in the cluster runs out in total
+func (m *VolumeManager) GetClusterCapacityForecast() (*longhorn.CapacityForecast, error) {
+	nodeList, err := m.ds.ListNodesRO()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list nodes")
+	}
+	return capacityforecast.SumDisks(nodeList), nil
+}
+
 func (m *VolumeManager) ListNodesSorted() ([]*longhorn.Node, error) {
 	nodeMap, err := m.ListNodes()
 	if err != nil {
@@ -1,6 +1,9 @@
 package manager
 
 import (
+	"fmt"
+
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -55,3 +58,103 @@ func (m *VolumeManager) CreateOrUpdateSetting(s *longhorn.Setting) (*longhorn.Se
 	logrus.Infof("Updated setting %v to %v", s.Name, setting.Value)
 	return setting, nil
 }
+
+func (m *VolumeManager) GetSettingHistory(name string) (*longhorn.SettingHistory, error) {
+	return m.ds.GetSettingHistory(name)
+}
+
+func (m *VolumeManager) ListSettingHistoriesSorted() ([]*longhorn.SettingHistory, error) {
+	historyList, err := m.ds.ListSettingHistoriesRO()
+	if err != nil {
+		return []*longhorn.SettingHistory{}, err
+	}
+
+	historyMap := make(map[string]*longhorn.SettingHistory, len(historyList))
+	for _, history := range historyList {
+		historyMap[history.Name] = history.DeepCopy()
+	}
+	histories := make([]*longhorn.SettingHistory, len(historyMap))
+	historyNames, err := util.SortKeys(historyMap)
+	if err != nil {
+		return []*longhorn.SettingHistory{}, err
+	}
+	for i, historyName := range historyNames {
+		histories[i] = historyMap[historyName]
+	}
+	return histories, nil
+}
+
+// RollbackSettings sets each of the given settings back to the value of the given revision in the setting history.
+// All the rollbacks are validated before any setting is updated.
+func (m *VolumeManager) RollbackSettings(revisions map[types.SettingName]int64, requester string) ([]*longhorn.Setting, error) {
+	settingNames, err := util.SortKeys(revisions)
+	if err != nil {
+		return nil, err
+	}
+
+	rollbackSettings := make([]*longhorn.Setting, 0, len(settingNames))
+	for _, name := range settingNames {
+		sName := types.SettingName(name)
+		setting, err := m.getSettingForRollback(sName, revisions[sName])
+		if err != nil {
+			return nil, err
+		}
+		rollbackSettings = append(rollbackSettings, setting)
+	}
+
+	settings := make([]*longhorn.Setting, 0, len(rollbackSettings))
+	for _, s := range rollbackSettings {
+		if s.Annotations == nil {
+			s.Annotations = map[string]string{}
+		}
+		s.Annotations[types.GetLonghornLabelKey(types.UpdateSettingRequester)] = requester
+
+		setting, err := m.CreateOrUpdateSetting(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to roll back setting %v, settings %v have been rolled back", s.Name, getSettingNames(settings))
+		}
+		logrus.Infof("Rolled back setting %v to revision %v", s.Name, revisions[types.SettingName(s.Name)])
+		settings = append(settings, setting)
+	}
+	return settings, nil
+}
+
+func (m *VolumeManager) getSettingForRollback(sName types.SettingName, revision int64) (*longhorn.Setting, error) {
+	definition, ok := types.GetSettingDefinition(sName)
+	if !ok {
+		return nil, fmt.Errorf("setting %v is not supported", sName)
+	}
+	if definition.ReadOnly {
+		return nil, fmt.Errorf("setting %v is read-only", sName)
+	}
+
+	history, err := m.ds.GetSettingHistoryRO(string(sName))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get history of setting %v", sName)
+	}
+
+	for _, r := range history.Status.Revisions {
+		if r.Revision != revision {
+			continue
+		}
+		if err := m.ds.ValidateSetting(string(sName), r.NewValue); err != nil {
+			return nil, errors.Wrapf(err, "failed to roll back setting %v to revision %v", sName, revision)
+		}
+		setting, err := m.ds.GetSetting(sName)
+		if err != nil {
+			return nil, err
+		}
+		setting.Value = r.NewValue
+		return setting, nil
+	}
+
+	return nil, fmt.Errorf("revision %v of setting %v is not found in the retained history", revision, sName)
+}
+
+func getSettingNames(settings []*longhorn.Setting) []string {
+	names := make([]string, 0, len(settings))
+	for _, s := range settings {
+		names = append(names, s.Name)
+	}
+	return names
+}
@@ -0,0 +1,140 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kubernetes/pkg/controller"
+
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+)
+
+const testNamespace = "longhorn-system"
+
+func newTestSettingManager(t *testing.T, settings map[types.SettingName]string, histories map[types.SettingName][]longhorn.SettingRevision) (*VolumeManager, *lhfake.Clientset) {
+	datastore.SkipListerCheck = true
+
+	kubeClient := fake.NewSimpleClientset()
+	lhClient := lhfake.NewSimpleClientset()
+	extensionsClient := apiextensionsfake.NewSimpleClientset()
+	informerFactories := util.NewInformerFactories(testNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+	ds := datastore.NewDataStore(testNamespace, lhClient, kubeClient, extensionsClient, informerFactories)
+
+	settingIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().Settings().Informer().GetIndexer()
+	for name, value := range settings {
+		setting, err := lhClient.LonghornV1beta2().Settings(testNamespace).Create(context.TODO(), &longhorn.Setting{
+			ObjectMeta: metav1.ObjectMeta{Name: string(name), Namespace: testNamespace},
+			Value:      value,
+		}, metav1.CreateOptions{})
+		require.NoError(t, err)
+		require.NoError(t, settingIndexer.Add(setting))
+	}
+
+	historyIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().SettingHistories().Informer().GetIndexer()
+	for name, revisions := range histories {
+		history := &longhorn.SettingHistory{
+			ObjectMeta: metav1.ObjectMeta{Name: string(name), Namespace: testNamespace},
+			Spec:       longhorn.SettingHistorySpec{SettingName: string(name)},
+			Status: longhorn.SettingHistoryStatus{
+				CurrentRevision: revisions[len(revisions)-1].Revision,
+				Revisions:       revisions,
+			},
+		}
+		require.NoError(t, historyIndexer.Add(history))
+	}
+
+	return NewVolumeManager("node-1", ds, util.NewAtomicCounter()), lhClient
+}
+
+func TestRollbackSettings(t *testing.T) {
+	backupLimit := types.SettingNameBackupConcurrentLimit
+	restoreLimit := types.SettingNameRestoreConcurrentLimit
+	settings := map[types.SettingName]string{
+		backupLimit:  "5",
+		restoreLimit: "5",
+	}
+	histories := map[types.SettingName][]longhorn.SettingRevision{
+		backupLimit: {
+			{Revision: 3, OldValue: "2", NewValue: "3"},
+			{Revision: 4, OldValue: "3", NewValue: "5"},
+		},
+		restoreLimit: {
+			{Revision: 1, OldValue: "2", NewValue: "4"},
+			{Revision: 2, OldValue: "4", NewValue: "5"},
+		},
+	}
+
+	testCases := map[string]struct {
+		revisions      map[types.SettingName]int64
+		expectError    bool
+		expectedValues map[types.SettingName]string
+	}{
+		"roll back settings": {
+			revisions: map[types.SettingName]int64{
+				backupLimit:  3,
+				restoreLimit: 1,
+			},
+			expectedValues: map[types.SettingName]string{
+				backupLimit:  "3",
+				restoreLimit: "4",
+			},
+		},
+		"revision trimmed from the history": {
+			revisions: map[types.SettingName]int64{
+				backupLimit:  2,
+				restoreLimit: 1,
+			},
+			expectError: true,
+		},
+		"setting without history": {
+			revisions: map[types.SettingName]int64{
+				types.SettingNameBackupExecutionTimeout: 1,
+			},
+			expectError: true,
+		},
+		"read-only setting": {
+			revisions: map[types.SettingName]int64{
+				types.SettingNameCurrentLonghornVersion: 1,
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m, lhClient := newTestSettingManager(t, settings, histories)
+
+			rolledBack, err := m.RollbackSettings(tc.revisions, "10.0.0.1:5000")
+			if tc.expectError {
+				require.Error(t, err)
+				// No setting is updated if any rollback is invalid
+				for sName, value := range settings {
+					setting, err := lhClient.LonghornV1beta2().Settings(testNamespace).Get(context.TODO(), string(sName), metav1.GetOptions{})
+					require.NoError(t, err)
+					require.Equal(t, value, setting.Value)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, rolledBack, len(tc.expectedValues))
+
+			for sName, value := range tc.expectedValues {
+				setting, err := lhClient.LonghornV1beta2().Settings(testNamespace).Get(context.TODO(), string(sName), metav1.GetOptions{})
+				require.NoError(t, err)
+				require.Equal(t, value, setting.Value)
+				require.Equal(t, "10.0.0.1:5000", setting.Annotations[types.GetLonghornLabelKey(types.UpdateSettingRequester)])
+			}
+		})
+	}
+}
@@ -22,21 +22,26 @@ type SupportBundle struct {
 	ProgressPercentage int
 	Size               int64
 
+	Scope           longhorn.SupportBundleScope
+	RedactionPolicy longhorn.SupportBundleRedactionPolicy
+
 	Error string
 }
 
 // CreateSupportBundle creates a SupportBundle custom resource that triggers
 // creation of support bundle manager deployment. The support bundle manager then
 // creates a bundle zip file that is available in https://<cluster-ip>:8080/bundle
-func (m *VolumeManager) CreateSupportBundle(issueURL string, description string) (*SupportBundle, error) {
+func (m *VolumeManager) CreateSupportBundle(issueURL string, description string, scope longhorn.SupportBundleScope, redactionPolicy longhorn.SupportBundleRedactionPolicy) (*SupportBundle, error) {
 	now := strings.ToLower(strings.ReplaceAll(util.Now(), ":", "-"))
 	newSupportBundle := &longhorn.SupportBundle{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: fmt.Sprintf(types.SupportBundleNameFmt, now),
 		},
 		Spec: longhorn.SupportBundleSpec{
-			Description: description,
-			IssueURL:    issueURL,
+			Description:     description,
+			IssueURL:        issueURL,
+			Scope:           scope,
+			RedactionPolicy: redactionPolicy,
 		},
 	}
 
@@ -46,8 +51,10 @@ func (m *VolumeManager) CreateSupportBundle(issueURL string, description string)
 	}
 
 	ret := &SupportBundle{
-		Name:  supportBundle.Name,
-		State: supportBundle.Status.State,
+		Name:            supportBundle.Name,
+		State:           supportBundle.Status.State,
+		Scope:           supportBundle.Spec.Scope,
+		RedactionPolicy: supportBundle.Spec.RedactionPolicy,
 	}
 	return ret, nil
 }
@@ -56,6 +63,10 @@ func (m *VolumeManager) DeleteSupportBundle(name string) error {
 	return m.ds.DeleteSupportBundle(name)
 }
 
+func (m *VolumeManager) GetSupportBundleRO(name string) (*longhorn.SupportBundle, error) {
+	return m.ds.GetSupportBundleRO(name)
+}
+
 func (m *VolumeManager) GetSupportBundle(name string) (*SupportBundle, string, error) {
 	supportBundle, err := m.ds.GetSupportBundleRO(name)
 	if err != nil {
@@ -73,6 +84,8 @@ func (m *VolumeManager) GetSupportBundle(name string) (*SupportBundle, string, e
 		Filename:           supportBundle.Status.Filename,
 		Size:               supportBundle.Status.Filesize,
 		ProgressPercentage: supportBundle.Status.Progress,
+		Scope:              supportBundle.Spec.Scope,
+		RedactionPolicy:    supportBundle.Spec.RedactionPolicy,
 		Error:              fmt.Sprintf("%v: %v", supportBundleError.Reason, supportBundleError.Message),
 	}
 
@@ -3,6 +3,7 @@ package manager
 import (
 	"fmt"
 	"io"
+	"reflect"
 	"strconv"
 	"time"
 
@@ -81,6 +82,29 @@ func (m *VolumeManager) ListSorted() ([]*longhorn.Volume, error) {
 	return volumes, nil
 }
 
+// ListIdleVolumesSorted returns the volumes that have not been active for longer than the idle volume threshold
+func (m *VolumeManager) ListIdleVolumesSorted() ([]*longhorn.Volume, error) {
+	thresholdDays, err := m.ds.GetSettingAsInt(types.SettingNameIdleVolumeThreshold)
+	if err != nil {
+		return nil, err
+	}
+	threshold := time.Duration(thresholdDays) * 24 * time.Hour
+
+	volumes, err := m.ListSorted()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	idleVolumes := []*longhorn.Volume{}
+	for _, v := range volumes {
+		if types.IsVolumeIdle(v, threshold, now) {
+			idleVolumes = append(idleVolumes, v)
+		}
+	}
+	return idleVolumes, nil
+}
+
 func (m *VolumeManager) Get(vName string) (*longhorn.Volume, error) {
 	return m.ds.GetVolume(vName)
 }
@@ -201,6 +225,7 @@ func (m *VolumeManager) Create(name string, spec *longhorn.VolumeSpec, recurring
 			BackupTargetName:                backupTargetName,
 			OfflineRebuilding:               spec.OfflineRebuilding,
 			ReplicaRebuildingBandwidthLimit: spec.ReplicaRebuildingBandwidthLimit,
+			RecoveryPointObjective:          spec.RecoveryPointObjective,
 		},
 	}
 
@@ -1171,6 +1196,87 @@ func (m *VolumeManager) UpdateSnapshotMaxCount(name string, snapshotMaxCount int
 	return v, nil
 }
 
+func (m *VolumeManager) UpdateRecoveryPointObjective(name string, recoveryPointObjective int) (v *longhorn.Volume, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "unable to update field RecoveryPointObjective for volume %s", name)
+	}()
+
+	v, err = m.ds.GetVolume(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.Spec.RecoveryPointObjective == recoveryPointObjective {
+		logrus.Debugf("Volume %s already set field RecoveryPointObjective to %d", v.Name, recoveryPointObjective)
+		return v, nil
+	}
+
+	oldRecoveryPointObjective := v.Spec.RecoveryPointObjective
+	v.Spec.RecoveryPointObjective = recoveryPointObjective
+	v, err = m.ds.UpdateVolume(v)
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Infof("Updated volume %s field RecoveryPointObjective from %d to %d", v.Name, oldRecoveryPointObjective, recoveryPointObjective)
+	return v, nil
+}
+
+func (m *VolumeManager) UpdateAutoExpansionPolicy(name string, policy *longhorn.VolumeAutoExpansionPolicy) (v *longhorn.Volume, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "unable to update field AutoExpansionPolicy for volume %s", name)
+	}()
+
+	v, err = m.ds.GetVolume(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if reflect.DeepEqual(v.Spec.AutoExpansionPolicy, policy) {
+		logrus.Debugf("Volume %s already set field AutoExpansionPolicy to %+v", v.Name, policy)
+		return v, nil
+	}
+
+	v.Spec.AutoExpansionPolicy = policy
+	v, err = m.ds.UpdateVolume(v)
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Infof("Updated volume %s field AutoExpansionPolicy to %+v", v.Name, policy)
+	return v, nil
+}
+
+// UpdateFilesystemUsage records the filesystem usage reported by the CSI plugin. The status is updated only if the
+// used percentage, the filesystem size or the volume size changes, so that the periodic reports do not churn it.
+func (m *VolumeManager) UpdateFilesystemUsage(name string, usedBytes, totalBytes int64) (v *longhorn.Volume, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "unable to update filesystem usage for volume %s", name)
+	}()
+
+	v, err = m.ds.GetVolume(name)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := v.Status.FilesystemUsage
+	if usage != nil && usage.VolumeSize == v.Spec.Size && usage.TotalBytes == totalBytes &&
+		types.GetFilesystemUsagePercentage(usage.UsedBytes, usage.TotalBytes) == types.GetFilesystemUsagePercentage(usedBytes, totalBytes) {
+		return v, nil
+	}
+
+	if usage == nil {
+		usage = &longhorn.VolumeFilesystemUsage{}
+	}
+	usage.UsedBytes = usedBytes
+	usage.TotalBytes = totalBytes
+	usage.VolumeSize = v.Spec.Size
+	usage.ReportedAt = util.Now()
+	v.Status.FilesystemUsage = usage
+
+	return m.ds.UpdateVolumeStatus(v)
+}
+
 func (m *VolumeManager) UpdateSnapshotMaxSize(name string, snapshotMaxSize int64) (v *longhorn.Volume, err error) {
 	defer func() {
 		err = errors.Wrapf(err, "unable to update field SnapshotMaxSize for volume %s", name)
@@ -1223,6 +1329,31 @@ func (m *VolumeManager) UpdateReplicaRebuildingBandwidthLimit(name string, repli
 	return v, nil
 }
 
+func (m *VolumeManager) UpdateTieringPolicy(name string, policy *longhorn.VolumeTieringPolicy) (v *longhorn.Volume, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "unable to update field TieringPolicy for volume %s", name)
+	}()
+
+	v, err = m.ds.GetVolume(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if reflect.DeepEqual(v.Spec.TieringPolicy, policy) {
+		logrus.Debugf("Volume %s already set field TieringPolicy to %+v", v.Name, policy)
+		return v, nil
+	}
+
+	v.Spec.TieringPolicy = policy
+	v, err = m.ds.UpdateVolume(v)
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Infof("Updated volume %s field TieringPolicy to %+v", v.Name, policy)
+	return v, nil
+}
+
 func (m *VolumeManager) restoreBackingImage(backupTargetName, biName, secret, secretNamespace, dataEngine string) error {
 	if secret != "" || secretNamespace != "" {
 		_, err := m.ds.GetSecretRO(secretNamespace, secret)
@@ -1,9 +1,29 @@
 package manager
 
 import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
+// AttachmentTicketRequest describes an external attachment ticket requested by tooling outside of Longhorn
+type AttachmentTicketRequest struct {
+	AttachmentID    string
+	NodeID          string
+	Priority        int
+	TTL             time.Duration
+	Reason          string
+	Reservation     bool
+	DisableFrontend bool
+}
+
 func (m *VolumeManager) GetVolumeAttachment(volumeName string) (*longhorn.VolumeAttachment, error) {
 	return m.ds.GetLHVolumeAttachmentByVolumeName(volumeName)
 }
@@ -11,3 +31,97 @@ func (m *VolumeManager) GetVolumeAttachment(volumeName string) (*longhorn.Volume
 func (m *VolumeManager) ListVolumeAttachment() ([]*longhorn.VolumeAttachment, error) {
 	return m.ds.ListLHVolumeAttachments()
 }
+
+// CreateAttachmentTicket adds an external attachment ticket to the volume attachment of the volume. A reservation
+// ticket does not attach the volume, it only keeps the volume from being attached to the other nodes.
+func (m *VolumeManager) CreateAttachmentTicket(volumeName string, request *AttachmentTicketRequest) (va *longhorn.VolumeAttachment, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "unable to create attachment ticket for volume %v", volumeName)
+	}()
+
+	if request.NodeID == "" {
+		return nil, fmt.Errorf("node ID is required")
+	}
+	// The priority 0 leaves the priority of the ticket unset, so the default external priority is used
+	if request.Priority != 0 && (request.Priority < longhorn.AttacherPriorityLevelExternalMin || request.Priority > longhorn.AttacherPriorityLevelExternalMax) {
+		return nil, fmt.Errorf("invalid priority %v, it should be between %v and %v, or 0 for the default priority %v",
+			request.Priority, longhorn.AttacherPriorityLevelExternalMin, longhorn.AttacherPriorityLevelExternalMax, longhorn.AttacherPriorityLevelExternal)
+	}
+	if request.TTL < 0 {
+		return nil, fmt.Errorf("invalid TTL %v", request.TTL)
+	}
+
+	if _, err := m.ds.GetVolumeRO(volumeName); err != nil {
+		return nil, err
+	}
+	node, err := m.ds.GetNodeRO(request.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	if !request.Reservation {
+		readyCondition := types.GetCondition(node.Status.Conditions, longhorn.NodeConditionTypeReady)
+		if readyCondition.Status != longhorn.ConditionStatusTrue {
+			return nil, fmt.Errorf("node %v is not ready", node.Name)
+		}
+	}
+
+	va, err = m.ds.GetLHVolumeAttachmentByVolumeName(volumeName)
+	if err != nil {
+		return nil, err
+	}
+
+	attachmentID := request.AttachmentID
+	if attachmentID == "" {
+		attachmentID = longhorn.GetAttachmentTicketID(longhorn.AttacherTypeExternal, util.RandomID())
+	}
+	if ticket, ok := va.Spec.AttachmentTickets[attachmentID]; ok && ticket.Type != longhorn.AttacherTypeExternal {
+		return nil, fmt.Errorf("attachment ticket %v of type %v already exists", attachmentID, ticket.Type)
+	}
+
+	parameters := map[string]string{
+		longhorn.AttachmentParameterDisableFrontend: strconv.FormatBool(request.DisableFrontend),
+		longhorn.AttachmentParameterReservation:     strconv.FormatBool(request.Reservation),
+	}
+	if request.Priority > 0 {
+		parameters[longhorn.AttachmentParameterPriority] = strconv.Itoa(request.Priority)
+	}
+	if request.TTL > 0 {
+		parameters[longhorn.AttachmentParameterExpiresAt] = util.TimestampAfterDuration(request.TTL)
+	}
+	if request.Reason != "" {
+		parameters[longhorn.AttachmentParameterReason] = request.Reason
+	}
+
+	va.Spec.AttachmentTickets[attachmentID] = &longhorn.AttachmentTicket{
+		ID:         attachmentID,
+		Type:       longhorn.AttacherTypeExternal,
+		NodeID:     node.Name,
+		Parameters: parameters,
+	}
+
+	return m.ds.UpdateLHVolumeAttachment(va)
+}
+
+// DeleteAttachmentTicket removes an external attachment ticket from the volume attachment of the volume. The tickets
+// of the other attacher types are owned by their attachers and cannot be deleted here.
+func (m *VolumeManager) DeleteAttachmentTicket(volumeName, attachmentID string) (va *longhorn.VolumeAttachment, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "unable to delete attachment ticket %v for volume %v", attachmentID, volumeName)
+	}()
+
+	va, err = m.ds.GetLHVolumeAttachmentByVolumeName(volumeName)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket, ok := va.Spec.AttachmentTickets[attachmentID]
+	if !ok {
+		return va, nil
+	}
+	if ticket.Type != longhorn.AttacherTypeExternal {
+		return nil, fmt.Errorf("cannot delete attachment ticket of type %v", ticket.Type)
+	}
+
+	delete(va.Spec.AttachmentTickets, attachmentID)
+	return m.ds.UpdateLHVolumeAttachment(va)
+}
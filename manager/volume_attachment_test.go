@@ -0,0 +1,88 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kubernetes/pkg/controller"
+
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+)
+
+func TestCreateAttachmentTicketPriority(t *testing.T) {
+	datastore.SkipListerCheck = true
+
+	kubeClient := fake.NewSimpleClientset()
+	lhClient := lhfake.NewSimpleClientset()
+	informerFactories := util.NewInformerFactories(testNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+	ds := datastore.NewDataStore(testNamespace, lhClient, kubeClient, apiextensionsfake.NewSimpleClientset(), informerFactories)
+	lhInformers := informerFactories.LhInformerFactory.Longhorn().V1beta2()
+
+	volume, err := lhClient.LonghornV1beta2().Volumes(testNamespace).Create(context.TODO(), &longhorn.Volume{
+		ObjectMeta: metav1.ObjectMeta{Name: "vol-1", Namespace: testNamespace},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, lhInformers.Volumes().Informer().GetIndexer().Add(volume))
+
+	node, err := lhClient.LonghornV1beta2().Nodes(testNamespace).Create(context.TODO(), &longhorn.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1", Namespace: testNamespace},
+		Status: longhorn.NodeStatus{
+			Conditions: []longhorn.Condition{{Type: longhorn.NodeConditionTypeReady, Status: longhorn.ConditionStatusTrue}},
+		},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, lhInformers.Nodes().Informer().GetIndexer().Add(node))
+
+	va, err := lhClient.LonghornV1beta2().VolumeAttachments(testNamespace).Create(context.TODO(), &longhorn.VolumeAttachment{
+		ObjectMeta: metav1.ObjectMeta{Name: types.GetLHVolumeAttachmentNameFromVolumeName(volume.Name), Namespace: testNamespace},
+		Spec: longhorn.VolumeAttachmentSpec{
+			Volume:            volume.Name,
+			AttachmentTickets: map[string]*longhorn.AttachmentTicket{},
+		},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, lhInformers.VolumeAttachments().Informer().GetIndexer().Add(va))
+
+	m := NewVolumeManager("node-1", ds, util.NewAtomicCounter())
+
+	testCases := map[string]struct {
+		priority      int
+		priorityLevel int
+		valid         bool
+	}{
+		"negative":      {priority: -1},
+		"default":       {priority: 0, priorityLevel: longhorn.AttacherPriorityLevelExternal, valid: true},
+		"minimum":       {priority: longhorn.AttacherPriorityLevelExternalMin, priorityLevel: longhorn.AttacherPriorityLevelExternalMin, valid: true},
+		"maximum":       {priority: longhorn.AttacherPriorityLevelExternalMax, priorityLevel: longhorn.AttacherPriorityLevelExternalMax, valid: true},
+		"above maximum": {priority: longhorn.AttacherPriorityLevelExternalMax + 1},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			attachmentID := longhorn.GetAttachmentTicketID(longhorn.AttacherTypeExternal, name)
+			va, err := m.CreateAttachmentTicket(volume.Name, &AttachmentTicketRequest{
+				AttachmentID: attachmentID,
+				NodeID:       node.Name,
+				Priority:     tc.priority,
+				Reservation:  true,
+			})
+			if !tc.valid {
+				require.ErrorContains(t, err, "it should be between 1 and 899, or 0 for the default priority 800")
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.priorityLevel, longhorn.GetAttachmentTicketPriorityLevel(va.Spec.AttachmentTickets[attachmentID]))
+		})
+	}
+}
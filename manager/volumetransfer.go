@@ -0,0 +1,117 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/util/volumetransfer"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func (m *VolumeManager) GetVolumeTransfer(name string) (*longhorn.VolumeTransfer, error) {
+	return m.ds.GetVolumeTransferRO(name)
+}
+
+// GetVolumeTransferToken returns the token the source authenticates to the destination transfer with.
+func (m *VolumeManager) GetVolumeTransferToken(name string) (string, error) {
+	transfer, err := m.ds.GetVolumeTransferRO(name)
+	if err != nil {
+		return "", err
+	}
+	secret, err := m.ds.GetSecretRO(transfer.Namespace, transfer.Spec.CredentialSecret)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get credential secret %v", transfer.Spec.CredentialSecret)
+	}
+	return string(secret.Data[volumetransfer.CredentialKeyToken]), nil
+}
+
+// getReceivingVolumeTransfer returns the destination transfer and its volume if the volume is attached to this node
+// to receive the data.
+func (m *VolumeManager) getReceivingVolumeTransfer(name string) (*longhorn.VolumeTransfer, *longhorn.Volume, error) {
+	transfer, err := m.ds.GetVolumeTransferRO(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if transfer.Spec.Role != longhorn.VolumeTransferRoleDestination {
+		return nil, nil, fmt.Errorf("volume transfer %v is not a destination", name)
+	}
+	if transfer.Status.State != longhorn.VolumeTransferStateSyncing {
+		return nil, nil, fmt.Errorf("volume transfer %v is not ready to receive data in state %v", name, transfer.Status.State)
+	}
+
+	v, err := m.ds.GetVolumeRO(transfer.Spec.VolumeName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if v.Status.State != longhorn.VolumeStateAttached || v.Status.CurrentNodeID != m.currentNodeID {
+		return nil, nil, fmt.Errorf("volume %v of volume transfer %v is not attached to node %v", v.Name, name, m.currentNodeID)
+	}
+	return transfer, v, nil
+}
+
+func (m *VolumeManager) WriteVolumeTransferChunk(name string, index int, data []byte, checksum string) error {
+	_, v, err := m.getReceivingVolumeTransfer(name)
+	if err != nil {
+		return err
+	}
+	return volumetransfer.WriteChunk(types.GetVolumeTransferDevicePath(v.Name), v.Spec.Size, index, data, checksum)
+}
+
+func (m *VolumeManager) WriteVolumeTransferZeroChunk(name string, index int) error {
+	_, v, err := m.getReceivingVolumeTransfer(name)
+	if err != nil {
+		return err
+	}
+	return volumetransfer.WriteZeroChunk(types.GetVolumeTransferDevicePath(v.Name), v.Spec.Size, index)
+}
+
+// UpdateVolumeTransferProgress shows the progress reported by the source in the destination transfer.
+func (m *VolumeManager) UpdateVolumeTransferProgress(name string, progress *volumetransfer.Progress) error {
+	transfer, _, err := m.getReceivingVolumeTransfer(name)
+	if err != nil {
+		return err
+	}
+
+	transfer = transfer.DeepCopy()
+	transfer.Status.Progress = progress.Progress
+	transfer.Status.TransferredBytes = progress.TransferredBytes
+	transfer.Status.Throughput = progress.Throughput
+	if progress.SyncCount != transfer.Status.SyncCount {
+		transfer.Status.SyncCount = progress.SyncCount
+		transfer.Status.LastSyncedAt = util.Now()
+	}
+	if _, err := m.ds.UpdateVolumeTransferStatus(transfer); err != nil && !datastore.ErrorIsConflict(errors.Cause(err)) {
+		return err
+	}
+	return nil
+}
+
+// FinalizeVolumeTransfer flushes the received data and completes the destination transfer, so the volume is
+// detached and ready for the workload.
+func (m *VolumeManager) FinalizeVolumeTransfer(name string) error {
+	transfer, v, err := m.getReceivingVolumeTransfer(name)
+	if err != nil {
+		return err
+	}
+	if err := volumetransfer.FlushDevice(types.GetVolumeTransferDevicePath(v.Name)); err != nil {
+		return err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"volumeTransfer": name,
+		"volume":         v.Name,
+	}).Info("Finalizing VolumeTransfer")
+
+	transfer = transfer.DeepCopy()
+	transfer.Status.State = longhorn.VolumeTransferStateCompleted
+	transfer.Status.Progress = 100
+	transfer.Status.CompletedAt = util.Now()
+	_, err = m.ds.UpdateVolumeTransferStatus(transfer)
+	return err
+}
@@ -1,6 +1,7 @@
 package metricscollector
 
 import (
+	"math"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -10,6 +11,7 @@ import (
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/engineapi"
 	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util/capacityforecast"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -22,6 +24,10 @@ type DiskCollector struct {
 	reservationMetric metricInfo
 	statusMetric      metricInfo
 
+	// Capacity forecast metrics
+	daysUntilFullMetric                  metricInfo
+	daysUntilOverProvisioningLimitMetric metricInfo
+
 	// Performance metrics
 	readThroughputMetric  metricInfo
 	writeThroughputMetric metricInfo
@@ -80,6 +86,27 @@ func NewDiskCollector(
 		Type: prometheus.GaugeValue,
 	}
 
+	// Capacity forecast metrics
+	dc.daysUntilFullMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemDisk, "days_until_full"),
+			"The estimated days until this disk is full. It is +Inf if the usage is not growing",
+			[]string{nodeLabel, diskLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	dc.daysUntilOverProvisioningLimitMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemDisk, "days_until_over_provisioning_limit"),
+			"The estimated days until the scheduled storage of this disk reaches the over-provisioning limit. It is +Inf if the scheduled storage is not growing",
+			[]string{nodeLabel, diskLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
 	// Performance metrics
 	dc.readThroughputMetric = metricInfo{
 		Desc: prometheus.NewDesc(
@@ -149,6 +176,8 @@ func (dc *DiskCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- dc.usageMetric.Desc
 	ch <- dc.reservationMetric.Desc
 	ch <- dc.statusMetric.Desc
+	ch <- dc.daysUntilFullMetric.Desc
+	ch <- dc.daysUntilOverProvisioningLimitMetric.Desc
 	ch <- dc.readThroughputMetric.Desc
 	ch <- dc.writeThroughputMetric.Desc
 	ch <- dc.readIOPSMetric.Desc
@@ -217,6 +246,11 @@ func (dc *DiskCollector) collectDiskStorage(ch chan<- prometheus.Metric) {
 		ch <- prometheus.MustNewConstMetric(dc.usageMetric.Desc, dc.usageMetric.Type, float64(storageUsage), dc.currentNodeID, diskName)
 		ch <- prometheus.MustNewConstMetric(dc.reservationMetric.Desc, dc.reservationMetric.Type, float64(storageReservation), dc.currentNodeID, diskName)
 
+		if forecast := disk.Status.CapacityForecast; forecast != nil {
+			ch <- prometheus.MustNewConstMetric(dc.daysUntilFullMetric.Desc, dc.daysUntilFullMetric.Type, getForecastDaysMetricValue(forecast.DaysUntilFull), dc.currentNodeID, diskName)
+			ch <- prometheus.MustNewConstMetric(dc.daysUntilOverProvisioningLimitMetric.Desc, dc.daysUntilOverProvisioningLimitMetric.Type, getForecastDaysMetricValue(forecast.DaysUntilOverProvisioningLimit), dc.currentNodeID, diskName)
+		}
+
 		if diskServiceClient != nil && disk.Spec.Type == longhorn.DiskTypeBlock {
 			diskMetrics, err := diskServiceClient.MetricsGet(string(disk.Spec.Type), diskName, diskPath, diskDriver)
 			if err == nil {
@@ -243,3 +277,11 @@ func (dc *DiskCollector) collectDiskStorage(ch chan<- prometheus.Metric) {
 		}
 	}
 }
+
+// getForecastDaysMetricValue converts the estimated days to the metric value, which is +Inf if the storage is not growing.
+func getForecastDaysMetricValue(days int64) float64 {
+	if days == capacityforecast.DaysNotGrowing {
+		return math.Inf(1)
+	}
+	return float64(days)
+}
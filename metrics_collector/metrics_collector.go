@@ -31,6 +31,7 @@ func InitMetricsCollectorSystem(logger logrus.FieldLogger, currentNodeID string,
 	backupBackingImageCollector := NewBackupBackingImageCollector(logger, currentNodeID, ds)
 	engineCollector := NewEngineCollector(logger, currentNodeID, ds)
 	ReplicaCollector := NewReplicaCollector(logger, currentNodeID, ds)
+	scrubReportCollector := NewScrubReportCollector(logger, currentNodeID, ds)
 
 	if err := registry.Register(volumeCollector); err != nil {
 		logger.WithField("collector", subsystemVolume).WithError(err).Warn("Failed to register collector")
@@ -64,6 +65,10 @@ func InitMetricsCollectorSystem(logger logrus.FieldLogger, currentNodeID string,
 		logger.WithField("collector", subsystemReplica).WithError(err).Warn("Failed to register collector")
 	}
 
+	if err := registry.Register(scrubReportCollector); err != nil {
+		logger.WithField("collector", subsystemScrubReport).WithError(err).Warn("Failed to register collector")
+	}
+
 	namespace := os.Getenv(types.EnvPodNamespace)
 	if namespace == "" {
 		logger.Warnf("Cannot detect pod namespace, environment variable %v is missing, "+
@@ -12,6 +12,7 @@ import (
 	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
 
 	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/util/capacityforecast"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -30,6 +31,11 @@ type NodeCollector struct {
 	storageCapacityMetric    metricInfo
 	storageUsageMetric       metricInfo
 	storageReservationMetric metricInfo
+
+	storageDaysUntilFullMetric                         metricInfo
+	storageDaysUntilOverProvisioningLimitMetric        metricInfo
+	clusterStorageDaysUntilFullMetric                  metricInfo
+	clusterStorageDaysUntilOverProvisioningLimitMetric metricInfo
 }
 
 func NewNodeCollector(
@@ -133,6 +139,46 @@ func NewNodeCollector(
 		Type: prometheus.GaugeValue,
 	}
 
+	nc.storageDaysUntilFullMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemNode, "storage_days_until_full"),
+			"The estimated days until the disks of this node are full in total. It is +Inf if the usage is not growing",
+			[]string{nodeLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	nc.storageDaysUntilOverProvisioningLimitMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemNode, "storage_days_until_over_provisioning_limit"),
+			"The estimated days until the disks of this node reach the over-provisioning limit in total. It is +Inf if the scheduled storage is not growing",
+			[]string{nodeLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	nc.clusterStorageDaysUntilFullMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemCluster, "storage_days_until_full"),
+			"The estimated days until the disks of the cluster are full in total. It is +Inf if the usage is not growing",
+			[]string{},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	nc.clusterStorageDaysUntilOverProvisioningLimitMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemCluster, "storage_days_until_over_provisioning_limit"),
+			"The estimated days until the disks of the cluster reach the over-provisioning limit in total. It is +Inf if the scheduled storage is not growing",
+			[]string{},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
 	return nc
 }
 
@@ -146,6 +192,10 @@ func (nc *NodeCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- nc.storageCapacityMetric.Desc
 	ch <- nc.storageUsageMetric.Desc
 	ch <- nc.storageReservationMetric.Desc
+	ch <- nc.storageDaysUntilFullMetric.Desc
+	ch <- nc.storageDaysUntilOverProvisioningLimitMetric.Desc
+	ch <- nc.clusterStorageDaysUntilFullMetric.Desc
+	ch <- nc.clusterStorageDaysUntilOverProvisioningLimitMetric.Desc
 }
 
 func (nc *NodeCollector) Collect(ch chan<- prometheus.Metric) {
@@ -181,6 +231,12 @@ func (nc *NodeCollector) Collect(ch chan<- prometheus.Metric) {
 		nc.collectNodeStorage(ch)
 	}()
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		nc.collectStorageCapacityForecast(ch)
+	}()
+
 	wg.Wait()
 }
 
@@ -309,3 +365,31 @@ func (nc *NodeCollector) collectNodeStorage(ch chan<- prometheus.Metric) {
 	ch <- prometheus.MustNewConstMetric(nc.storageUsageMetric.Desc, nc.storageUsageMetric.Type, float64(storageUsage), nc.currentNodeID)
 	ch <- prometheus.MustNewConstMetric(nc.storageReservationMetric.Desc, nc.storageReservationMetric.Type, float64(storageReservation), nc.currentNodeID)
 }
+
+func (nc *NodeCollector) collectStorageCapacityForecast(ch chan<- prometheus.Metric) {
+	defer func() {
+		if err := recover(); err != nil {
+			nc.logger.WithField("error", err).Warn("Panic during collecting metrics")
+		}
+	}()
+
+	nodeList, err := nc.ds.ListNodesRO()
+	if err != nil {
+		nc.logger.WithError(err).Warn("Error during scrape")
+		return
+	}
+
+	for _, node := range nodeList {
+		if node.Name != nc.currentNodeID || node.Status.CapacityForecast == nil {
+			continue
+		}
+		forecast := node.Status.CapacityForecast
+		ch <- prometheus.MustNewConstMetric(nc.storageDaysUntilFullMetric.Desc, nc.storageDaysUntilFullMetric.Type, getForecastDaysMetricValue(forecast.DaysUntilFull), nc.currentNodeID)
+		ch <- prometheus.MustNewConstMetric(nc.storageDaysUntilOverProvisioningLimitMetric.Desc, nc.storageDaysUntilOverProvisioningLimitMetric.Type, getForecastDaysMetricValue(forecast.DaysUntilOverProvisioningLimit), nc.currentNodeID)
+	}
+
+	if forecast := capacityforecast.SumDisks(nodeList); forecast != nil {
+		ch <- prometheus.MustNewConstMetric(nc.clusterStorageDaysUntilFullMetric.Desc, nc.clusterStorageDaysUntilFullMetric.Type, getForecastDaysMetricValue(forecast.DaysUntilFull))
+		ch <- prometheus.MustNewConstMetric(nc.clusterStorageDaysUntilOverProvisioningLimitMetric.Desc, nc.clusterStorageDaysUntilOverProvisioningLimitMetric.Type, getForecastDaysMetricValue(forecast.DaysUntilOverProvisioningLimit))
+	}
+}
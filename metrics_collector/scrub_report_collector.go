@@ -0,0 +1,123 @@
+package metricscollector
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+type ScrubReportCollector struct {
+	*baseCollector
+
+	corruptedSnapshotsMetric metricInfo
+	corruptedReplicasMetric  metricInfo
+	repairedReplicasMetric   metricInfo
+	lastCompletedAtMetric    metricInfo
+}
+
+func NewScrubReportCollector(
+	logger logrus.FieldLogger,
+	nodeID string,
+	ds *datastore.DataStore) *ScrubReportCollector {
+
+	sc := &ScrubReportCollector{
+		baseCollector: newBaseCollector(subsystemScrubReport, logger, nodeID, ds),
+	}
+
+	sc.corruptedSnapshotsMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemScrubReport, "corrupted_snapshots"),
+			"The number of corrupted snapshots found by the latest scrub of this volume",
+			[]string{volumeLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	sc.corruptedReplicasMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemScrubReport, "corrupted_replicas"),
+			"The number of corrupted replicas found by the latest scrub of this volume",
+			[]string{volumeLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	sc.repairedReplicasMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemScrubReport, "repaired_replicas"),
+			"The number of corrupted replicas marked for rebuilding by the latest scrub of this volume",
+			[]string{volumeLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	sc.lastCompletedAtMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemScrubReport, "last_completed_timestamp_seconds"),
+			"The completion time of the latest scrub of this volume in Unix seconds",
+			[]string{volumeLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	return sc
+}
+
+func (c *ScrubReportCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.corruptedSnapshotsMetric.Desc
+	ch <- c.corruptedReplicasMetric.Desc
+	ch <- c.repairedReplicasMetric.Desc
+	ch <- c.lastCompletedAtMetric.Desc
+}
+
+func (c *ScrubReportCollector) Collect(ch chan<- prometheus.Metric) {
+	defer func() {
+		if err := recover(); err != nil {
+			c.logger.WithField("error", err).Warn("Panic during collecting metrics")
+		}
+	}()
+
+	scrubReports, err := c.ds.ListScrubReportsRO()
+	if err != nil {
+		c.logger.WithError(err).Warn("Error during scrape")
+		return
+	}
+
+	// Only the latest completed scrub report of each volume is exported.
+	latestScrubReports := map[string]*longhorn.ScrubReport{}
+	for _, scrubReport := range scrubReports {
+		if scrubReport.Status.State != longhorn.ScrubReportStateCompleted {
+			continue
+		}
+		latest, ok := latestScrubReports[scrubReport.Spec.VolumeName]
+		if !ok || latest.Status.CompletedAt < scrubReport.Status.CompletedAt {
+			latestScrubReports[scrubReport.Spec.VolumeName] = scrubReport
+		}
+	}
+
+	for volumeName, scrubReport := range latestScrubReports {
+		volume, err := c.ds.GetVolumeRO(volumeName)
+		if err != nil {
+			c.logger.WithError(err).Warnf("Failed to get volume %v for scrub report %v", volumeName, scrubReport.Name)
+			continue
+		}
+		if volume.Status.OwnerID != c.currentNodeID {
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(c.corruptedSnapshotsMetric.Desc, c.corruptedSnapshotsMetric.Type, float64(scrubReport.Status.CorruptedSnapshotCount), volumeName)
+		ch <- prometheus.MustNewConstMetric(c.corruptedReplicasMetric.Desc, c.corruptedReplicasMetric.Type, float64(scrubReport.Status.CorruptedReplicaCount), volumeName)
+		ch <- prometheus.MustNewConstMetric(c.repairedReplicasMetric.Desc, c.repairedReplicasMetric.Type, float64(scrubReport.Status.RepairedReplicaCount), volumeName)
+		if completedAt, err := util.ParseTime(scrubReport.Status.CompletedAt); err == nil {
+			ch <- prometheus.MustNewConstMetric(c.lastCompletedAtMetric.Desc, c.lastCompletedAtMetric.Type, float64(completedAt.Unix()), volumeName)
+		}
+	}
+}
@@ -20,6 +20,8 @@ const (
 	subsystemSnapshot           = "snapshot"
 	subsystemBackingImage       = "backing_image"
 	subsystemBackupBackingImage = "backup_backing_image"
+	subsystemScrubReport        = "scrub_report"
+	subsystemCluster            = "cluster"
 
 	nodeLabel               = "node"
 	diskLabel               = "disk"
@@ -1,6 +1,8 @@
 package metricscollector
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
@@ -26,6 +28,12 @@ type VolumeCollector struct {
 	stateMetric              metricInfo
 	robustnessMetric         metricInfo
 	fileSystemReadOnlyMetric metricInfo
+	restoreLagMetric         metricInfo
+	backupLagMetric          metricInfo
+	readBytesTotalMetric     metricInfo
+	writeBytesTotalMetric    metricInfo
+	idleSecondsMetric        metricInfo
+	idleMetric               metricInfo
 
 	volumePerfMetrics
 }
@@ -101,6 +109,66 @@ func NewVolumeCollector(
 		Type: prometheus.GaugeValue,
 	}
 
+	vc.restoreLagMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "restore_lag_seconds"),
+			"Time between the latest backup and the last restored backup of this standby volume",
+			[]string{nodeLabel, volumeLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.backupLagMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "backup_lag_seconds"),
+			"Time between the last write and the last backup of this volume",
+			[]string{nodeLabel, volumeLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.readBytesTotalMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "read_bytes_total"),
+			"Total bytes read from this volume over all of its engines",
+			[]string{nodeLabel, volumeLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.CounterValue,
+	}
+
+	vc.writeBytesTotalMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "write_bytes_total"),
+			"Total bytes written to this volume over all of its engines",
+			[]string{nodeLabel, volumeLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.CounterValue,
+	}
+
+	vc.idleSecondsMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "idle_seconds"),
+			"Time since the last IO, the last attachment or the creation of this volume",
+			[]string{nodeLabel, volumeLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.idleMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "idle"),
+			"Whether this volume has been idle for longer than the idle volume threshold: 1 means idle, 0 means active",
+			[]string{nodeLabel, volumeLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
 	vc.throughputMetrics.read = metricInfo{
 		Desc: prometheus.NewDesc(
 			prometheus.BuildFQName(longhornName, subsystemVolume, "read_throughput"),
@@ -170,6 +238,12 @@ func (vc *VolumeCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- vc.stateMetric.Desc
 	ch <- vc.robustnessMetric.Desc
 	ch <- vc.fileSystemReadOnlyMetric.Desc
+	ch <- vc.restoreLagMetric.Desc
+	ch <- vc.backupLagMetric.Desc
+	ch <- vc.readBytesTotalMetric.Desc
+	ch <- vc.writeBytesTotalMetric.Desc
+	ch <- vc.idleSecondsMetric.Desc
+	ch <- vc.idleMetric.Desc
 }
 
 func (vc *VolumeCollector) Collect(ch chan<- prometheus.Metric) {
@@ -185,14 +259,20 @@ func (vc *VolumeCollector) Collect(ch chan<- prometheus.Metric) {
 		return
 	}
 
+	idleThresholdDays, err := vc.ds.GetSettingAsInt(types.SettingNameIdleVolumeThreshold)
+	if err != nil {
+		vc.logger.WithError(err).Warnf("Failed to get setting %v", types.SettingNameIdleVolumeThreshold)
+	}
+	idleThreshold := time.Duration(idleThresholdDays) * 24 * time.Hour
+
 	for _, v := range volumeLists {
 		if v.Status.OwnerID == vc.currentNodeID {
-			vc.collectMetrics(ch, v)
+			vc.collectMetrics(ch, v, idleThreshold)
 		}
 	}
 }
 
-func (vc *VolumeCollector) collectMetrics(ch chan<- prometheus.Metric, v *longhorn.Volume) {
+func (vc *VolumeCollector) collectMetrics(ch chan<- prometheus.Metric, v *longhorn.Volume, idleThreshold time.Duration) {
 	defer func() {
 		if err := recover(); err != nil {
 			vc.logger.WithField("error", err).Warnf("Panic during collecting metrics for volume %v", v.Name)
@@ -203,6 +283,26 @@ func (vc *VolumeCollector) collectMetrics(ch chan<- prometheus.Metric, v *longho
 	ch <- prometheus.MustNewConstMetric(vc.sizeMetric.Desc, vc.sizeMetric.Type, float64(v.Status.ActualSize), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
 	ch <- prometheus.MustNewConstMetric(vc.stateMetric.Desc, vc.stateMetric.Type, float64(getVolumeStateValue(v)), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
 	ch <- prometheus.MustNewConstMetric(vc.robustnessMetric.Desc, vc.robustnessMetric.Type, float64(getVolumeRobustnessValue(v)), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+	if recoveryPoint := v.Status.RecoveryPoint; recoveryPoint != nil {
+		if v.Status.IsStandby {
+			ch <- prometheus.MustNewConstMetric(vc.restoreLagMetric.Desc, vc.restoreLagMetric.Type, float64(recoveryPoint.RestoreLag), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+		} else {
+			ch <- prometheus.MustNewConstMetric(vc.backupLagMetric.Desc, vc.backupLagMetric.Type, float64(recoveryPoint.BackupLag), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+		}
+	}
+	if activity := v.Status.Activity; activity != nil {
+		ch <- prometheus.MustNewConstMetric(vc.readBytesTotalMetric.Desc, vc.readBytesTotalMetric.Type, float64(activity.IO.ReadBytes), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+		ch <- prometheus.MustNewConstMetric(vc.writeBytesTotalMetric.Desc, vc.writeBytesTotalMetric.Type, float64(activity.IO.WriteBytes), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+	}
+	now := time.Now()
+	ch <- prometheus.MustNewConstMetric(vc.idleSecondsMetric.Desc, vc.idleSecondsMetric.Type, types.GetVolumeIdleDuration(v, now).Seconds(), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+	if idleThreshold > 0 {
+		isIdle := 0
+		if types.IsVolumeIdle(v, idleThreshold, now) {
+			isIdle = 1
+		}
+		ch <- prometheus.MustNewConstMetric(vc.idleMetric.Desc, vc.idleMetric.Type, float64(isIdle), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+	}
 
 	e, err := vc.ds.GetVolumeCurrentEngine(v.Name)
 	if err != nil {
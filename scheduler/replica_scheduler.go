@@ -434,6 +434,20 @@ func (rcs *ReplicaScheduler) filterNodeDisksForReplica(node *longhorn.Node, disk
 		return preferredDisks, errs
 	}
 
+	diskHealthPolicy, err := rcs.ds.GetSettingValueExisted(types.SettingNameDiskHealthPolicy)
+	if err != nil {
+		errs.Append(longhorn.ErrorReplicaScheduleLonghornClientOperationFailed,
+			errors.Wrapf(err, "failed to get %v setting", types.SettingNameDiskHealthPolicy))
+		return preferredDisks, errs
+	}
+
+	if requireSchedulingCheck {
+		if reason, err := rcs.checkNodeReplicaCountLimits(node, replicas); err != nil {
+			errs.Append(reason, err)
+			return preferredDisks, errs
+		}
+	}
+
 	// find disk that fit for current replica
 	for diskUUID := range disks {
 		var diskName string
@@ -463,6 +477,13 @@ func (rcs *ReplicaScheduler) filterNodeDisksForReplica(node *longhorn.Node, disk
 			continue
 		}
 
+		if requireSchedulingCheck && types.IsDiskExcludedByHealthPolicy(types.DiskHealthPolicy(diskHealthPolicy)) &&
+			types.GetCondition(diskStatus.Conditions, longhorn.DiskConditionTypeHealthy).Status == longhorn.ConditionStatusFalse {
+			errs.Append(longhorn.ErrorReplicaScheduleDiskUnhealthy,
+				fmt.Errorf("disk %v on node %v is unhealthy", diskName, node.Name))
+			continue
+		}
+
 		if !datastore.IsSupportedVolumeSize(volume.Spec.DataEngine, diskStatus.FSType, volume.Spec.Size) {
 			logrus.Debugf("Volume %v size %v is not compatible with the file system %v of the disk %v", volume.Name, volume.Spec.Size, diskStatus.Type, diskName)
 			errs.Append(longhorn.ErrorReplicaScheduleIncompatibleVolumeSize,
@@ -472,7 +493,7 @@ func (rcs *ReplicaScheduler) filterNodeDisksForReplica(node *longhorn.Node, disk
 		}
 
 		if requireSchedulingCheck {
-			info, err := rcs.GetDiskSchedulingInfo(diskSpec, diskStatus)
+			info, err := rcs.GetDiskSchedulingInfo(node.Name, diskSpec, diskStatus)
 			if err != nil {
 				errs.Append(longhorn.ErrorReplicaScheduleLonghornClientOperationFailed,
 					errors.Wrapf(err, "failed to get disk scheduling info for disk %v", diskName))
@@ -497,6 +518,19 @@ func (rcs *ReplicaScheduler) filterNodeDisksForReplica(node *longhorn.Node, disk
 						diskName, node.Name, volume.Name, volume.Spec.Size))
 				continue
 			}
+
+			replicaCountLimit, err := rcs.GetDiskReplicaCountLimit(node.Name, diskSpec)
+			if err != nil {
+				errs.Append(longhorn.ErrorReplicaScheduleLonghornClientOperationFailed,
+					errors.Wrapf(err, "failed to get replica count limit for disk %v", diskName))
+				return preferredDisks, errs
+			}
+			if replicaCount := getDiskReplicaCount(diskUUID, diskStatus, replicas); replicaCountLimit > 0 && replicaCount >= replicaCountLimit {
+				errs.Append(longhorn.ErrorReplicaScheduleDiskReplicaCountLimitReached,
+					fmt.Errorf("disk %v on node %v already has %v replicas, reaching the replica count limit %v",
+						diskName, node.Name, replicaCount, replicaCountLimit))
+				continue
+			}
 		}
 
 		// Check if the Disk's Tags are valid.
@@ -529,6 +563,92 @@ func (rcs *ReplicaScheduler) filterNodeDisksForReplica(node *longhorn.Node, disk
 	return preferredDisks, errs
 }
 
+// checkNodeReplicaCountLimits returns the scheduling failure reason and the error if the node reaches the replica
+// count limit, or the rebuilding replica count limit when the replica is scheduled for a rebuild.
+func (rcs *ReplicaScheduler) checkNodeReplicaCountLimits(node *longhorn.Node, replicas map[string]*longhorn.Replica) (string, error) {
+	replicaCountLimit, err := rcs.ds.GetNodeSettingAsInt(types.SettingNameReplicaCountLimitPerNode, node.Name)
+	if err != nil {
+		return longhorn.ErrorReplicaScheduleLonghornClientOperationFailed,
+			errors.Wrapf(err, "failed to get %v setting", types.SettingNameReplicaCountLimitPerNode)
+	}
+	if replicaCountLimit > 0 {
+		replicaCount := 0
+		for diskName, diskStatus := range node.Status.DiskStatus {
+			if _, exists := node.Spec.Disks[diskName]; exists {
+				replicaCount += getDiskReplicaCount(diskStatus.DiskUUID, diskStatus, replicas)
+			}
+		}
+		if int64(replicaCount) >= replicaCountLimit {
+			return longhorn.ErrorReplicaScheduleNodeReplicaCountLimitReached,
+				fmt.Errorf("node %v already has %v replicas, reaching the replica count limit %v", node.Name, replicaCount, replicaCountLimit)
+		}
+	}
+
+	if !isRebuildingReplica(replicas) {
+		return "", nil
+	}
+	rebuildingReplicaCountLimit, err := rcs.ds.GetNodeSettingAsInt(types.SettingNameRebuildingReplicaCountLimitPerNode, node.Name)
+	if err != nil {
+		return longhorn.ErrorReplicaScheduleLonghornClientOperationFailed,
+			errors.Wrapf(err, "failed to get %v setting", types.SettingNameRebuildingReplicaCountLimitPerNode)
+	}
+	if rebuildingReplicaCountLimit > 0 {
+		nodeReplicas, err := rcs.ds.ListReplicasByNodeRO(node.Name)
+		if err != nil {
+			return longhorn.ErrorReplicaScheduleLonghornClientOperationFailed,
+				errors.Wrapf(err, "failed to list replicas on node %v", node.Name)
+		}
+		rebuildingReplicaCount := 0
+		for _, r := range nodeReplicas {
+			if r.DeletionTimestamp == nil && r.Spec.DesireState == longhorn.InstanceStateRunning && r.Spec.HealthyAt == "" && r.Spec.FailedAt == "" {
+				rebuildingReplicaCount++
+			}
+		}
+		if int64(rebuildingReplicaCount) >= rebuildingReplicaCountLimit {
+			return longhorn.ErrorReplicaScheduleNodeRebuildingReplicaLimitReached,
+				fmt.Errorf("node %v already has %v replicas being rebuilt, reaching the rebuilding replica count limit %v",
+					node.Name, rebuildingReplicaCount, rebuildingReplicaCountLimit)
+		}
+	}
+
+	return "", nil
+}
+
+// GetDiskReplicaCountLimit returns the maximum number of replicas on the disk. 0 means unlimited.
+func (rcs *ReplicaScheduler) GetDiskReplicaCountLimit(nodeName string, diskSpec longhorn.DiskSpec) (int, error) {
+	if diskSpec.MaxReplicaCount > 0 {
+		return diskSpec.MaxReplicaCount, nil
+	}
+	replicaCountLimit, err := rcs.ds.GetNodeSettingAsInt(types.SettingNameReplicaCountLimitPerDisk, nodeName)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get %v setting", types.SettingNameReplicaCountLimitPerDisk)
+	}
+	return int(replicaCountLimit), nil
+}
+
+// getDiskReplicaCount returns the number of the replicas scheduled to the disk, including the replicas of the volume
+// that are scheduled to the disk but not accounted in the disk status yet.
+func getDiskReplicaCount(diskUUID string, diskStatus *longhorn.DiskStatus, replicas map[string]*longhorn.Replica) int {
+	replicaCount := len(diskStatus.ScheduledReplica)
+	for rName, r := range replicas {
+		if _, ok := diskStatus.ScheduledReplica[rName]; !ok && r.Spec.DiskID != "" && r.Spec.DiskID == diskUUID {
+			replicaCount++
+		}
+	}
+	return replicaCount
+}
+
+// isRebuildingReplica returns true if the volume already has a healthy replica, so the new replica will be rebuilt
+// from the existing replicas.
+func isRebuildingReplica(replicas map[string]*longhorn.Replica) bool {
+	for _, r := range replicas {
+		if r.Spec.HealthyAt != "" && r.Spec.FailedAt == "" {
+			return true
+		}
+	}
+	return false
+}
+
 // filterDiskWithMatchingReplicas returns disk that have no matching replicas when diskSoftAntiAffinity is false.
 // Otherwise, it returns the input disks map.
 func filterDisksWithMatchingReplicas(disks map[string]*Disk, replicas map[string]*longhorn.Replica,
@@ -810,7 +930,7 @@ func (rcs *ReplicaScheduler) isFailedReplicaReusable(r *longhorn.Replica, v *lon
 			if types.GetCondition(diskStatus.Conditions, longhorn.DiskConditionTypeSchedulable).Reason != longhorn.DiskConditionReasonDiskPressure {
 				continue
 			}
-			schedulingInfo, err := rcs.GetDiskSchedulingInfo(diskSpec, diskStatus)
+			schedulingInfo, err := rcs.GetDiskSchedulingInfo(node.Name, diskSpec, diskStatus)
 			if err != nil {
 				logrus.Warnf("failed to GetDiskSchedulingInfo of disk %v on node %v when checking replica %v is reusable: %v", diskName, node.Name, r.Name, err)
 			}
@@ -971,7 +1091,7 @@ func (rcs *ReplicaScheduler) FilterNodesSchedulableForVolume(nodes map[string]*l
 				continue
 			}
 
-			diskInfo, err := rcs.GetDiskSchedulingInfo(diskSpec, diskStatus)
+			diskInfo, err := rcs.GetDiskSchedulingInfo(node.Name, diskSpec, diskStatus)
 			if err != nil {
 				logrus.WithError(err).Debugf("Failed to get disk scheduling info for disk %v on node %v", diskName, node.Name)
 				continue
@@ -1001,13 +1121,13 @@ func (rcs *ReplicaScheduler) isDiskNotFull(info *DiskSchedulingInfo) bool {
 		info.StorageAvailable > int64(float64(info.StorageMaximum)*float64(info.MinimalAvailablePercentage)/100)
 }
 
-func (rcs *ReplicaScheduler) GetDiskSchedulingInfo(disk longhorn.DiskSpec, diskStatus *longhorn.DiskStatus) (*DiskSchedulingInfo, error) {
-	// get StorageOverProvisioningPercentage and StorageMinimalAvailablePercentage settings
-	overProvisioningPercentage, err := rcs.ds.GetSettingAsInt(types.SettingNameStorageOverProvisioningPercentage)
+func (rcs *ReplicaScheduler) GetDiskSchedulingInfo(nodeName string, disk longhorn.DiskSpec, diskStatus *longhorn.DiskStatus) (*DiskSchedulingInfo, error) {
+	// get StorageOverProvisioningPercentage and StorageMinimalAvailablePercentage settings, which can be overridden per node
+	overProvisioningPercentage, err := rcs.ds.GetNodeSettingAsInt(types.SettingNameStorageOverProvisioningPercentage, nodeName)
 	if err != nil {
 		return nil, err
 	}
-	minimalAvailablePercentage, err := rcs.ds.GetSettingAsInt(types.SettingNameStorageMinimalAvailablePercentage)
+	minimalAvailablePercentage, err := rcs.ds.GetNodeSettingAsInt(types.SettingNameStorageMinimalAvailablePercentage, nodeName)
 	if err != nil {
 		return nil, err
 	}
@@ -1048,7 +1168,7 @@ func (rcs *ReplicaScheduler) CheckReplicasSizeExpansion(v *longhorn.Volume, oldS
 			errs.Append(longhorn.ErrorReplicaScheduleDiskNotFound, fmt.Errorf("failed to find disk %v in node %v", r.Spec.DiskID, node.Name))
 			return errs, fmt.Errorf("failed to find disk %v in node %v", r.Spec.DiskID, node.Name)
 		}
-		diskInfo, err := rcs.GetDiskSchedulingInfo(diskSpec, &diskStatus)
+		diskInfo, err := rcs.GetDiskSchedulingInfo(node.Name, diskSpec, &diskStatus)
 		if err != nil {
 			errs := multierr.NewMultiError()
 			errs.Append(longhorn.ErrorReplicaScheduleLonghornClientOperationFailed, fmt.Errorf("failed to get disk scheduling info for disk %v on node %v: %v", r.Spec.DiskID, node.Name, err))
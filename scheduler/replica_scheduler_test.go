@@ -1153,6 +1153,48 @@ func (s *TestSuite) TestReplicaScheduler(c *C) {
 	tc.firstNilReplica = -1
 	testCases["non-reusable replica after interval expires"] = tc
 
+	// Test replica count limit of disk, the second replica should fail to schedule
+	tc = generateSchedulerTestCase()
+	daemon1 = newDaemonPod(corev1.PodRunning, TestDaemon1, TestNamespace, TestNode1, TestIP1)
+	tc.daemons = []*corev1.Pod{
+		daemon1,
+	}
+	node1 = newNode(TestNode1, TestNamespace, TestZone1, true, longhorn.ConditionStatusTrue)
+	tc.engineImage.Status.NodeDeploymentMap[node1.Name] = true
+	disk = newDisk(TestDefaultDataPath, true, 0)
+	disk.MaxReplicaCount = 2
+	node1.Spec.Disks = map[string]longhorn.DiskSpec{
+		getDiskID(TestNode1, "1"): disk,
+	}
+	node1.Status.DiskStatus = map[string]*longhorn.DiskStatus{
+		getDiskID(TestNode1, "1"): {
+			StorageAvailable: TestDiskAvailableSize,
+			StorageScheduled: TestVolumeSize,
+			StorageMaximum:   TestDiskSize,
+			ScheduledReplica: map[string]int64{
+				"other-replica": TestVolumeSize,
+			},
+			Conditions: []longhorn.Condition{
+				newCondition(longhorn.DiskConditionTypeSchedulable, longhorn.ConditionStatusTrue),
+			},
+			DiskUUID: getDiskID(TestNode1, "1"),
+			Type:     longhorn.DiskTypeFilesystem,
+		},
+	}
+	nodes = map[string]*longhorn.Node{
+		TestNode1: node1,
+	}
+	tc.nodes = nodes
+	expectedNodes = map[string]*longhorn.Node{
+		TestNode1: node1,
+	}
+	tc.expectedNodes = expectedNodes
+	tc.err = false
+	tc.firstNilReplica = 1
+	tc.replicaNodeSoftAntiAffinity = "true"
+	tc.replicaDiskSoftAntiAffinity = "true"
+	testCases["replica count limit of disk reached"] = tc
+
 	for name, tc := range testCases {
 		fmt.Printf("testing %v\n", name)
 
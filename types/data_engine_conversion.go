@@ -0,0 +1,23 @@
+package types
+
+import (
+	"fmt"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// GetDataEngineConversionTargetVolumeName returns the default name of the volume a volume is converted into.
+func GetDataEngineConversionTargetVolumeName(volumeName string, dataEngine longhorn.DataEngineType) string {
+	return fmt.Sprintf("%s-%s", volumeName, dataEngine)
+}
+
+// GetDataEngineConversionDevicePath returns the block device of the attached volume seen by the manager.
+func GetDataEngineConversionDevicePath(volumeName string) string {
+	return fmt.Sprintf("%s/longhorn/%s", VolumeTransferHostDevDirectory, volumeName)
+}
+
+func GetDataEngineConversionLabels(conversionName string) map[string]string {
+	return map[string]string{
+		GetLonghornLabelKey(LonghornLabelDataEngineConversion): conversionName,
+	}
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// DiskProvisioningRule adds the block devices matching the device selector to the nodes
+// matching the node selector as Longhorn disks.
+type DiskProvisioningRule struct {
+	Name           string             `json:"name"`
+	NodeSelector   map[string]string  `json:"nodeSelector"`
+	DeviceSelector DiskDeviceSelector `json:"deviceSelector"`
+
+	DiskType                  longhorn.DiskType   `json:"diskType"`
+	DiskDriver                longhorn.DiskDriver `json:"diskDriver"`
+	Tags                      []string            `json:"tags"`
+	StorageReservedPercentage int64               `json:"storageReservedPercentage"`
+}
+
+// DiskDeviceSelector matches a block device only if all of the specified fields match.
+type DiskDeviceSelector struct {
+	// Models are the glob patterns of the device model, for example "Samsung SSD 9*".
+	Models []string `json:"models"`
+	// Paths are the glob patterns of the device path, for example "/dev/nvme*n1".
+	Paths []string `json:"paths"`
+	// MinSize and MaxSize are quantities, for example "100Gi".
+	MinSize    string `json:"minSize"`
+	MaxSize    string `json:"maxSize"`
+	Rotational *bool  `json:"rotational"`
+}
+
+func UnmarshalDiskProvisioningRules(value string) ([]DiskProvisioningRule, error) {
+	rules := []DiskProvisioningRule{}
+	if value == "" {
+		return rules, nil
+	}
+	if err := json.Unmarshal([]byte(value), &rules); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal disk provisioning rules %v", value)
+	}
+	return rules, nil
+}
+
+// ValidateDiskProvisioningPolicy validates the value of the setting disk-provisioning-policy.
+func ValidateDiskProvisioningPolicy(value string) error {
+	rules, err := UnmarshalDiskProvisioningRules(value)
+	if err != nil {
+		return err
+	}
+
+	names := map[string]struct{}{}
+	for _, rule := range rules {
+		if rule.Name == "" {
+			return fmt.Errorf("disk provisioning rule name is required")
+		}
+		if _, ok := names[rule.Name]; ok {
+			return fmt.Errorf("duplicate disk provisioning rule %v", rule.Name)
+		}
+		names[rule.Name] = struct{}{}
+
+		switch rule.DiskType {
+		case longhorn.DiskTypeFilesystem:
+			if rule.DiskDriver != "" && rule.DiskDriver != longhorn.DiskDriverNone {
+				return fmt.Errorf("disk provisioning rule %v: disk driver %v is not supported by filesystem disks", rule.Name, rule.DiskDriver)
+			}
+		case longhorn.DiskTypeBlock:
+			if rule.DiskDriver != "" && rule.DiskDriver != longhorn.DiskDriverAuto && rule.DiskDriver != longhorn.DiskDriverAio && rule.DiskDriver != longhorn.DiskDriverNvme {
+				return fmt.Errorf("disk provisioning rule %v: invalid disk driver %v", rule.Name, rule.DiskDriver)
+			}
+		default:
+			return fmt.Errorf("disk provisioning rule %v: invalid disk type %v", rule.Name, rule.DiskType)
+		}
+
+		if rule.StorageReservedPercentage < 0 || rule.StorageReservedPercentage > 100 {
+			return fmt.Errorf("disk provisioning rule %v: storage reserved percentage %v should be between 0 and 100", rule.Name, rule.StorageReservedPercentage)
+		}
+
+		if err := validateDiskDeviceSelector(rule.DeviceSelector); err != nil {
+			return errors.Wrapf(err, "disk provisioning rule %v", rule.Name)
+		}
+	}
+
+	return nil
+}
+
+func validateDiskDeviceSelector(selector DiskDeviceSelector) error {
+	for _, pattern := range append(append([]string{}, selector.Models...), selector.Paths...) {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return errors.Wrapf(err, "invalid glob pattern %v", pattern)
+		}
+	}
+
+	minSize, maxSize, err := GetDiskDeviceSelectorSizeRange(selector)
+	if err != nil {
+		return err
+	}
+	if maxSize > 0 && minSize > maxSize {
+		return fmt.Errorf("min size %v is larger than max size %v", selector.MinSize, selector.MaxSize)
+	}
+
+	if len(selector.Models) == 0 && len(selector.Paths) == 0 && minSize == 0 && maxSize == 0 && selector.Rotational == nil {
+		return fmt.Errorf("device selector cannot be empty")
+	}
+	return nil
+}
+
+// GetDiskDeviceSelectorSizeRange returns the size range in bytes of the device selector. 0 means unlimited.
+func GetDiskDeviceSelectorSizeRange(selector DiskDeviceSelector) (minSize, maxSize int64, err error) {
+	if selector.MinSize != "" {
+		quantity, err := resource.ParseQuantity(selector.MinSize)
+		if err != nil {
+			return 0, 0, errors.Wrapf(err, "invalid min size %v", selector.MinSize)
+		}
+		minSize = quantity.Value()
+	}
+	if selector.MaxSize != "" {
+		quantity, err := resource.ParseQuantity(selector.MaxSize)
+		if err != nil {
+			return 0, 0, errors.Wrapf(err, "invalid max size %v", selector.MaxSize)
+		}
+		maxSize = quantity.Value()
+	}
+	return minSize, maxSize, nil
+}
+
+// GetDiskProvisioningRulesForNode returns the disk provisioning rules applying to the node with the labels.
+func GetDiskProvisioningRulesForNode(rules []DiskProvisioningRule, nodeLabels map[string]string) []DiskProvisioningRule {
+	nodeRules := []DiskProvisioningRule{}
+	for _, rule := range rules {
+		if isNodeSelectorMatched(rule.NodeSelector, nodeLabels) {
+			nodeRules = append(nodeRules, rule)
+		}
+	}
+	return nodeRules
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	FileRestoreSessionVolumeNamePrefix = "frs-"
+	FileRestoreSessionPodNamePrefix    = "file-restore-"
+	FileRestoreSessionSecretNameSuffix = "-token"
+
+	FileRestoreSessionDefaultTTLMinutes = 60
+
+	FileRestoreServerPort         = 8080
+	FileRestoreServerListPath     = "/v1/files"
+	FileRestoreServerDownloadPath = "/v1/download"
+	FileRestoreServerQueryPath    = "path"
+
+	// FileRestoreServerTokenKey is the key of the token in the secret of the session. The file server only serves
+	// requests carrying the token as a bearer token, which only the manager proxying the requests can read.
+	FileRestoreServerTokenKey = "token"
+	FileRestoreServerTokenEnv = "FILE_RESTORE_SERVER_TOKEN"
+
+	FileRestoreServerHostDevDirectory = "/host/dev"
+
+	FileRestoreDownloadTimeout = 1 * time.Hour
+)
+
+func GetFileRestoreSessionVolumeName(sessionName string) string {
+	return FileRestoreSessionVolumeNamePrefix + sessionName
+}
+
+func GetFileRestoreSessionPodName(sessionName string) string {
+	return FileRestoreSessionPodNamePrefix + sessionName
+}
+
+func GetFileRestoreSessionSecretName(sessionName string) string {
+	return FileRestoreSessionPodNamePrefix + sessionName + FileRestoreSessionSecretNameSuffix
+}
+
+func GetFileRestoreSessionLabels(sessionName string) map[string]string {
+	labels := GetBaseLabelsForSystemManagedComponent()
+	labels[GetLonghornLabelComponentKey()] = LonghornLabelFileRestoreSession
+	labels[GetLonghornLabelKey(LonghornLabelFileRestoreSession)] = sessionName
+	return labels
+}
+
+// GetFileRestoreServerURL returns the URL of the file server listing or downloading the path.
+func GetFileRestoreServerURL(endpoint, apiPath, path string) string {
+	return fmt.Sprintf("http://%s%s?%s=%s", endpoint, apiPath, FileRestoreServerQueryPath, url.QueryEscape(path))
+}
+
+// FileRestoreEntry is a file or a directory served by the file server of a file restore session
+type FileRestoreEntry struct {
+	Name        string `json:"name"`
+	Path        string `json:"path"`
+	Size        int64  `json:"size"`
+	Mode        string `json:"mode"`
+	IsDirectory bool   `json:"isDirectory"`
+	ModifiedAt  string `json:"modifiedAt"`
+}
@@ -15,6 +15,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/longhorn/longhorn-manager/meta"
@@ -157,6 +158,35 @@ const (
 	SettingNameDefaultBackupBlockSize                                   = SettingName("default-backup-block-size")
 	SettingNameInstanceManagerPodLivenessProbeTimeout                   = SettingName("instance-manager-pod-liveness-probe-timeout")
 	SettingNameLogPath                                                  = SettingName("log-path")
+	SettingNameSettingHistoryLimit                                      = SettingName("setting-history-limit")
+	SettingNameNodeSettingOverrides                                     = SettingName("node-setting-overrides")
+	SettingNameSupportBundleRedactionEnforced                           = SettingName("support-bundle-redaction-enforced")
+	SettingNameCorruptedReplicaRepairPolicy                             = SettingName("corrupted-replica-repair-policy")
+	SettingNameCorruptedReplicaRepairMinimalHealthyReplicas             = SettingName("corrupted-replica-repair-minimal-healthy-replicas")
+	SettingNameCorruptedReplicaRepairInterval                           = SettingName("corrupted-replica-repair-interval")
+	SettingNameScrubReportHistoryLimit                                  = SettingName("scrub-report-history-limit")
+	SettingNameNodeDownPodDeletionOwnerKinds                            = SettingName("node-down-pod-deletion-owner-kinds")
+	SettingNameNodeDownPodDeletionGracePeriod                           = SettingName("node-down-pod-deletion-grace-period")
+	SettingNameNodeDownPodDeletionRequireOutOfServiceTaint              = SettingName("node-down-pod-deletion-require-out-of-service-taint")
+	SettingNameDiskHealthPolicy                                         = SettingName("disk-health-policy")
+	SettingNameDiskHealthIOErrorThreshold                               = SettingName("disk-health-io-error-threshold")
+	SettingNameDiskHealthIOLatencyThreshold                             = SettingName("disk-health-io-latency-threshold")
+	SettingNameDiskProvisioningPolicy                                   = SettingName("disk-provisioning-policy")
+	SettingNameAutomaticEngineUpgradeCanaryVolumeSelector               = SettingName("automatic-engine-upgrade-canary-volume-selector")
+	SettingNameAutomaticEngineUpgradeHealthGatePeriod                   = SettingName("automatic-engine-upgrade-health-gate-period")
+	SettingNameAutomaticEngineUpgradeWaveSize                           = SettingName("automatic-engine-upgrade-wave-size")
+	SettingNameAutomaticEngineUpgradeFailureAction                      = SettingName("automatic-engine-upgrade-failure-action")
+	SettingNameCapacityForecastHistoryWindow                            = SettingName("capacity-forecast-history-window")
+	SettingNameCapacityForecastWarningThreshold                         = SettingName("capacity-forecast-warning-threshold")
+	SettingNameInstanceManagerResourceRecommendationMode                = SettingName("instance-manager-resource-recommendation-mode")
+	SettingNameInstanceManagerResourceRecommendationHistoryWindow       = SettingName("instance-manager-resource-recommendation-history-window")
+	SettingNameReplicaCountLimitPerDisk                                 = SettingName("replica-count-limit-per-disk")
+	SettingNameReplicaCountLimitPerNode                                 = SettingName("replica-count-limit-per-node")
+	SettingNameRebuildingReplicaCountLimitPerNode                       = SettingName("rebuilding-replica-count-limit-per-node")
+	SettingNameVolumeCloneBandwidthLimit                                = SettingName("volume-clone-bandwidth-limit")
+	SettingNameConcurrentVolumeClonePerNodeLimit                        = SettingName("concurrent-volume-clone-per-node-limit")
+	SettingNameConcurrentVolumeClonePerSourceVolumeLimit                = SettingName("concurrent-volume-clone-per-source-volume-limit")
+	SettingNameIdleVolumeThreshold                                      = SettingName("idle-volume-threshold")
 
 	// These three backup target parameters are used in the "longhorn-default-resource" ConfigMap
 	// to update the default BackupTarget resource.
@@ -273,6 +303,35 @@ var (
 		SettingNameDefaultBackupBlockSize,
 		SettingNameInstanceManagerPodLivenessProbeTimeout,
 		SettingNameLogPath,
+		SettingNameSettingHistoryLimit,
+		SettingNameNodeSettingOverrides,
+		SettingNameSupportBundleRedactionEnforced,
+		SettingNameCorruptedReplicaRepairPolicy,
+		SettingNameCorruptedReplicaRepairMinimalHealthyReplicas,
+		SettingNameCorruptedReplicaRepairInterval,
+		SettingNameScrubReportHistoryLimit,
+		SettingNameNodeDownPodDeletionOwnerKinds,
+		SettingNameNodeDownPodDeletionGracePeriod,
+		SettingNameNodeDownPodDeletionRequireOutOfServiceTaint,
+		SettingNameDiskHealthPolicy,
+		SettingNameDiskHealthIOErrorThreshold,
+		SettingNameDiskHealthIOLatencyThreshold,
+		SettingNameDiskProvisioningPolicy,
+		SettingNameAutomaticEngineUpgradeCanaryVolumeSelector,
+		SettingNameAutomaticEngineUpgradeHealthGatePeriod,
+		SettingNameAutomaticEngineUpgradeWaveSize,
+		SettingNameAutomaticEngineUpgradeFailureAction,
+		SettingNameCapacityForecastHistoryWindow,
+		SettingNameCapacityForecastWarningThreshold,
+		SettingNameInstanceManagerResourceRecommendationMode,
+		SettingNameInstanceManagerResourceRecommendationHistoryWindow,
+		SettingNameReplicaCountLimitPerDisk,
+		SettingNameReplicaCountLimitPerNode,
+		SettingNameRebuildingReplicaCountLimitPerNode,
+		SettingNameVolumeCloneBandwidthLimit,
+		SettingNameConcurrentVolumeClonePerNodeLimit,
+		SettingNameConcurrentVolumeClonePerSourceVolumeLimit,
+		SettingNameIdleVolumeThreshold,
 	}
 )
 
@@ -287,6 +346,20 @@ var replacedSettingNames = map[SettingName]bool{
 	SettingNameV2DataEngineSnapshotDataIntegrity:        true, // SettingNameSnapshotDataIntegrity
 }
 
+// nodeOverridableSettingNames are the settings which can be overridden by the Longhorn node spec
+// or by the node setting overrides setting.
+var nodeOverridableSettingNames = map[SettingName]bool{
+	SettingNameConcurrentReplicaRebuildPerNodeLimit: true,
+	SettingNameStorageMinimalAvailablePercentage:    true,
+	SettingNameStorageOverProvisioningPercentage:    true,
+	SettingNameReplicaRebuildingBandwidthLimit:      true,
+	SettingNameReplicaCountLimitPerDisk:             true,
+	SettingNameReplicaCountLimitPerNode:             true,
+	SettingNameRebuildingReplicaCountLimitPerNode:   true,
+	SettingNameVolumeCloneBandwidthLimit:            true,
+	SettingNameConcurrentVolumeClonePerNodeLimit:    true,
+}
+
 type SettingCategory string
 
 const (
@@ -415,6 +488,35 @@ var (
 		SettingNameDefaultBackupBlockSize:                                   SettingDefinitionDefaultBackupBlockSize,
 		SettingNameInstanceManagerPodLivenessProbeTimeout:                   SettingDefinitionInstanceManagerPodLivenessProbeTimeout,
 		SettingNameLogPath:                                                  SettingDefinitionLogPath,
+		SettingNameSettingHistoryLimit:                                      SettingDefinitionSettingHistoryLimit,
+		SettingNameNodeSettingOverrides:                                     SettingDefinitionNodeSettingOverrides,
+		SettingNameSupportBundleRedactionEnforced:                           SettingDefinitionSupportBundleRedactionEnforced,
+		SettingNameCorruptedReplicaRepairPolicy:                             SettingDefinitionCorruptedReplicaRepairPolicy,
+		SettingNameCorruptedReplicaRepairMinimalHealthyReplicas:             SettingDefinitionCorruptedReplicaRepairMinimalHealthyReplicas,
+		SettingNameCorruptedReplicaRepairInterval:                           SettingDefinitionCorruptedReplicaRepairInterval,
+		SettingNameScrubReportHistoryLimit:                                  SettingDefinitionScrubReportHistoryLimit,
+		SettingNameNodeDownPodDeletionOwnerKinds:                            SettingDefinitionNodeDownPodDeletionOwnerKinds,
+		SettingNameNodeDownPodDeletionGracePeriod:                           SettingDefinitionNodeDownPodDeletionGracePeriod,
+		SettingNameNodeDownPodDeletionRequireOutOfServiceTaint:              SettingDefinitionNodeDownPodDeletionRequireOutOfServiceTaint,
+		SettingNameDiskHealthPolicy:                                         SettingDefinitionDiskHealthPolicy,
+		SettingNameDiskHealthIOErrorThreshold:                               SettingDefinitionDiskHealthIOErrorThreshold,
+		SettingNameDiskHealthIOLatencyThreshold:                             SettingDefinitionDiskHealthIOLatencyThreshold,
+		SettingNameDiskProvisioningPolicy:                                   SettingDefinitionDiskProvisioningPolicy,
+		SettingNameAutomaticEngineUpgradeCanaryVolumeSelector:               SettingDefinitionAutomaticEngineUpgradeCanaryVolumeSelector,
+		SettingNameAutomaticEngineUpgradeHealthGatePeriod:                   SettingDefinitionAutomaticEngineUpgradeHealthGatePeriod,
+		SettingNameAutomaticEngineUpgradeWaveSize:                           SettingDefinitionAutomaticEngineUpgradeWaveSize,
+		SettingNameAutomaticEngineUpgradeFailureAction:                      SettingDefinitionAutomaticEngineUpgradeFailureAction,
+		SettingNameCapacityForecastHistoryWindow:                            SettingDefinitionCapacityForecastHistoryWindow,
+		SettingNameCapacityForecastWarningThreshold:                         SettingDefinitionCapacityForecastWarningThreshold,
+		SettingNameInstanceManagerResourceRecommendationMode:                SettingDefinitionInstanceManagerResourceRecommendationMode,
+		SettingNameInstanceManagerResourceRecommendationHistoryWindow:       SettingDefinitionInstanceManagerResourceRecommendationHistoryWindow,
+		SettingNameReplicaCountLimitPerDisk:                                 SettingDefinitionReplicaCountLimitPerDisk,
+		SettingNameReplicaCountLimitPerNode:                                 SettingDefinitionReplicaCountLimitPerNode,
+		SettingNameRebuildingReplicaCountLimitPerNode:                       SettingDefinitionRebuildingReplicaCountLimitPerNode,
+		SettingNameVolumeCloneBandwidthLimit:                                SettingDefinitionVolumeCloneBandwidthLimit,
+		SettingNameConcurrentVolumeClonePerNodeLimit:                        SettingDefinitionConcurrentVolumeClonePerNodeLimit,
+		SettingNameConcurrentVolumeClonePerSourceVolumeLimit:                SettingDefinitionConcurrentVolumeClonePerSourceVolumeLimit,
+		SettingNameIdleVolumeThreshold:                                      SettingDefinitionIdleVolumeThreshold,
 	}
 
 	SettingDefinitionAllowRecurringJobWhileVolumeDetached = SettingDefinition{
@@ -1716,6 +1818,498 @@ var (
 		DataEngineSpecific: false,
 		Default:            DefaultLogDirectoryOnHost,
 	}
+
+	SettingDefinitionSettingHistoryLimit = SettingDefinition{
+		DisplayName: "Setting History Limit",
+		Description: "This setting specifies how many revisions of each setting are retained in the setting history. " +
+			"The setting history records the old value, the new value, the requester and the time of every setting change, " +
+			"and a setting can be rolled back to any retained revision.\n\n" +
+			"Set this value to **0** to stop recording the setting history.\n\n",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "20",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+			ValueIntRangeMaximum: 100,
+		},
+	}
+
+	SettingDefinitionNodeSettingOverrides = SettingDefinition{
+		DisplayName: "Node Setting Overrides",
+		Description: "This setting overrides some global settings for the nodes selected by Kubernetes node labels. " +
+			"The value is a JSON array of rules, and each rule contains a `nodeSelector` and the overridden `settings`. " +
+			"If multiple rules matching a node override the same setting, the first one takes effect. " +
+			"The overrides specified in the Longhorn node `spec.settingOverrides` take precedence over this setting.\n\n" +
+			"Only the following settings can be overridden: `concurrent-replica-rebuild-per-node-limit`, `storage-minimal-available-percentage`, " +
+			"`storage-over-provisioning-percentage` and `replica-rebuilding-bandwidth-limit`. For example: \n\n" +
+			"* `[{\"nodeSelector\":{\"node.longhorn.io/nic\":\"25g\"},\"settings\":{\"concurrent-replica-rebuild-per-node-limit\":\"10\"}}]` \n\n",
+		Category:           SettingCategoryScheduling,
+		Type:               SettingTypeString,
+		Required:           false,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "[]",
+	}
+
+	SettingDefinitionSupportBundleRedactionEnforced = SettingDefinition{
+		DisplayName: "Support Bundle Redaction Enforced",
+		Description: "If enabled, all redaction options are enforced for the newly created support bundles, " +
+			"so the secrets, the backup target credentials, the IP addresses and the node hostnames are scrubbed before the support bundles are downloaded.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeBool,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "false",
+	}
+
+	SettingDefinitionCorruptedReplicaRepairPolicy = SettingDefinition{
+		DisplayName: "Corrupted Replica Repair Policy",
+		Description: "This setting specifies how Longhorn repairs the replicas whose snapshot checksums disagree with the other replicas during the snapshot data integrity check.\n\n" +
+			"Available options are:\n\n" +
+			"- **immediate**: Longhorn immediately marks all corrupted replicas as failed, and then rebuilds them.\n" +
+			"- **rate-limited**: Longhorn repairs at most one corrupted replica of a volume at a time, no more often than the interval specified by the setting `corrupted-replica-repair-interval`, " +
+			"and only if the volume keeps at least the number of healthy replicas specified by the setting `corrupted-replica-repair-minimal-healthy-replicas`.\n" +
+			"- **disabled**: Longhorn only records the corrupted replicas in the scrub reports and does not repair them.\n",
+		Category:           SettingCategorySnapshot,
+		Type:               SettingTypeString,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            string(CorruptedReplicaRepairPolicyImmediate),
+		Choices: []any{
+			string(CorruptedReplicaRepairPolicyImmediate),
+			string(CorruptedReplicaRepairPolicyRateLimited),
+			string(CorruptedReplicaRepairPolicyDisabled),
+		},
+	}
+
+	SettingDefinitionCorruptedReplicaRepairMinimalHealthyReplicas = SettingDefinition{
+		DisplayName: "Corrupted Replica Repair Minimal Healthy Replicas",
+		Description: "The minimal number of healthy replicas a volume keeps after a corrupted replica is marked as failed. " +
+			"This setting only takes effect when the setting `corrupted-replica-repair-policy` is **rate-limited**.",
+		Category:           SettingCategorySnapshot,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "1",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 1,
+			ValueIntRangeMaximum: 20,
+		},
+	}
+
+	SettingDefinitionCorruptedReplicaRepairInterval = SettingDefinition{
+		DisplayName: "Corrupted Replica Repair Interval",
+		Description: "In minutes. The minimal interval between two repairs of the corrupted replicas of a volume. " +
+			"This setting only takes effect when the setting `corrupted-replica-repair-policy` is **rate-limited**.",
+		Category:           SettingCategorySnapshot,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "10",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+		},
+	}
+
+	SettingDefinitionScrubReportHistoryLimit = SettingDefinition{
+		DisplayName: "Scrub Report History Limit",
+		Description: "This setting specifies how many scrub reports of each volume are retained. " +
+			"A scrub report records the checksums of the snapshots on each replica and the verdicts of a snapshot data integrity check run.\n\n" +
+			"Set this value to **0** to stop creating scrub reports.",
+		Category:           SettingCategorySnapshot,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "5",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+			ValueIntRangeMaximum: 100,
+		},
+	}
+
+	SettingDefinitionNodeDownPodDeletionOwnerKinds = SettingDefinition{
+		DisplayName: "Pod Deletion Owner Kinds When Node is Down",
+		Description: "A semicolon-separated list of additional owner kinds, for example `Job;VirtualMachineInstance`. " +
+			"Longhorn force deletes the terminating pods owned by these kinds on nodes that are down, in addition to the pods selected by the setting Pod Deletion Policy When Node is Down.\n\n" +
+			"A workload or a namespace can also opt in or out by the label `longhorn.io/node-down-pod-deletion` with the value `enabled` or `disabled`. " +
+			"The label on the pod takes precedence over the label on the namespace, and both take precedence over the settings.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeString,
+		Required:           false,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "",
+	}
+
+	SettingDefinitionNodeDownPodDeletionGracePeriod = SettingDefinition{
+		DisplayName:        "Pod Deletion Grace Period When Node is Down",
+		Description:        "In seconds. The time Longhorn waits after the deletion grace period of a terminating pod on a node that is down has passed before force deleting the pod.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "0",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+		},
+	}
+
+	SettingDefinitionNodeDownPodDeletionRequireOutOfServiceTaint = SettingDefinition{
+		DisplayName: "Require Node Fencing for Pod Deletion When Node is Down",
+		Description: "When enabled, Longhorn force deletes the terminating pods on a node that is down only after the node is fenced, " +
+			"which is confirmed by the Kubernetes taint `node.kubernetes.io/out-of-service` on the node.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeBool,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "false",
+	}
+
+	SettingDefinitionDiskHealthPolicy = SettingDefinition{
+		DisplayName: "Disk Health Policy",
+		Description: "Defines the Longhorn action when a disk is detected as unhealthy by the SMART overall-health, the kernel IO error counter or the IO latency of the block device backing the disk.\n" +
+			"- **disabled** Longhorn does not collect the disk health data.\n" +
+			"- **monitor** Longhorn only reports the disk health by the Healthy condition of the disk.\n" +
+			"- **exclude-from-scheduling** Longhorn does not schedule new replicas to the unhealthy disk.\n" +
+			"- **evict-replicas** Longhorn disables scheduling and requests eviction on the unhealthy disk, which evicts the replicas on it. " +
+			"Re-enable scheduling and cancel the eviction of the disk after it is repaired or replaced.\n\n" +
+			"SMART data is collected only if `smartctl` is installed on the host.",
+		Category:           SettingCategoryScheduling,
+		Type:               SettingTypeString,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            string(DiskHealthPolicyDisabled),
+		Choices: []any{
+			string(DiskHealthPolicyDisabled),
+			string(DiskHealthPolicyMonitor),
+			string(DiskHealthPolicyExcludeFromScheduling),
+			string(DiskHealthPolicyEvictReplicas),
+		},
+	}
+
+	SettingDefinitionDiskHealthIOErrorThreshold = SettingDefinition{
+		DisplayName: "Disk Health IO Error Threshold",
+		Description: "A disk is considered unhealthy when the number of new IO errors reported by the kernel within an hour for the block device backing the disk reaches this value. " +
+			"The IO errors counted before Longhorn starts monitoring the disk are ignored. " +
+			"Set this value to **0** to disable the check.",
+		Category:           SettingCategoryScheduling,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "10",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+		},
+	}
+
+	SettingDefinitionDiskHealthIOLatencyThreshold = SettingDefinition{
+		DisplayName: "Disk Health IO Latency Threshold",
+		Description: "In milliseconds. A disk is considered unhealthy when the average IO latency of the block device backing the disk exceeds this value in consecutive disk monitor samples. " +
+			"Set this value to **0** to disable the check.",
+		Category:           SettingCategoryScheduling,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "1000",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+		},
+	}
+
+	SettingDefinitionDiskProvisioningPolicy = SettingDefinition{
+		DisplayName: "Disk Provisioning Policy",
+		Description: "This setting automatically adds the block devices discovered on the nodes as Longhorn disks. " +
+			"The value is a JSON array of rules. Each rule selects the nodes by `nodeSelector` and the block devices by `deviceSelector`, " +
+			"which matches the device `models` and `paths` by glob patterns, the `minSize` and `maxSize`, and whether the device is `rotational`. " +
+			"The matched devices are added with the `diskType`, `diskDriver`, `tags` and `storageReservedPercentage` of the first matching rule.\n\n" +
+			"A `block` disk is only provisioned from a blank device without partitions, holders, mounts or any filesystem, LVM, RAID or partition table signature. " +
+			"A `filesystem` disk is provisioned from a device mounted as a whole or with exactly one mounted partition, using the mount point as the disk path. " +
+			"The discovered devices and the reasons of the rejected ones are listed in the Longhorn node `status.discoveredDevices`. " +
+			"A provisioned disk removed from the node by the user is not added again. For example: \n\n" +
+			"* `[{\"name\":\"nvme\",\"deviceSelector\":{\"paths\":[\"/dev/nvme*n1\"],\"rotational\":false,\"minSize\":\"100Gi\"},\"diskType\":\"block\",\"tags\":[\"nvme\"]}]` \n\n",
+		Category:           SettingCategoryScheduling,
+		Type:               SettingTypeString,
+		Required:           false,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "[]",
+	}
+
+	SettingDefinitionAutomaticEngineUpgradeCanaryVolumeSelector = SettingDefinition{
+		DisplayName: "Automatic Engine Upgrade Canary Volume Selector",
+		Description: "A label selector of the canary volumes, for example `longhorn.io/engine-upgrade-canary=true`. " +
+			"If it is set, the automatic engine upgrade controlled by the setting **Concurrent Automatic Engine Upgrade Per Node Limit** is rolled out in stages: " +
+			"the canary volumes are upgraded first, then the rest of the volumes are upgraded in progressive waves. " +
+			"Before each wave, the upgraded volumes must stay healthy for the period specified by the setting **Automatic Engine Upgrade Health Gate Period**. " +
+			"The progress is recorded in the default engine image `status.autoUpgradeRollout`. " +
+			"If it is empty, all eligible volumes are upgraded at once.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeString,
+		Required:           false,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "",
+	}
+
+	SettingDefinitionAutomaticEngineUpgradeHealthGatePeriod = SettingDefinition{
+		DisplayName:        "Automatic Engine Upgrade Health Gate Period",
+		Description:        "In minutes. The period during which the volumes upgraded in a stage of the staged automatic engine upgrade must keep healthy robustness and have no failed replicas before the next wave starts.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "10",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+		},
+	}
+
+	SettingDefinitionAutomaticEngineUpgradeWaveSize = SettingDefinition{
+		DisplayName: "Automatic Engine Upgrade Wave Size",
+		Description: "The maximum number of volumes upgraded in each progressive wave of the staged automatic engine upgrade. " +
+			"The number of volumes upgraded at the same time on a node is still limited by the setting **Concurrent Automatic Engine Upgrade Per Node Limit**.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "10",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 1,
+		},
+	}
+
+	SettingDefinitionAutomaticEngineUpgradeFailureAction = SettingDefinition{
+		DisplayName: "Automatic Engine Upgrade Failure Action",
+		Description: "The action taken when an upgraded volume becomes unhealthy during the staged automatic engine upgrade.\n\n" +
+			"- **pause** Longhorn stops upgrading more volumes. Once the volumes upgraded in the current stage are healthy again, " +
+			"the rollout is resumed by adding the annotation `longhorn.io/resume-auto-upgrade-rollout` to the engine image, which restarts the health gate.\n" +
+			"- **rollback** Longhorn reverts the volumes upgraded in the current stage to their previous engine images and stops the rollout. " +
+			"The rollout is restarted when the setting **Automatic Engine Upgrade Canary Volume Selector** is changed.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeString,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            string(AutomaticEngineUpgradeFailureActionPause),
+		Choices: []any{
+			string(AutomaticEngineUpgradeFailureActionPause),
+			string(AutomaticEngineUpgradeFailureActionRollback),
+		},
+	}
+
+	SettingDefinitionCapacityForecastHistoryWindow = SettingDefinition{
+		DisplayName: "Capacity Forecast History Window",
+		Description: "In hours. The period of the disk usage history used to fit the growth trend of the disks. " +
+			"The usage is sampled every 10 minutes, and the samples of every hour are saved in the Longhorn node status, " +
+			"so the history is restored at the hourly granularity when the Longhorn manager restarts. " +
+			"The forecast of a disk is unavailable until the history has at least 3 samples.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "168",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 1,
+		},
+	}
+
+	SettingDefinitionCapacityForecastWarningThreshold = SettingDefinition{
+		DisplayName: "Capacity Forecast Warning Threshold",
+		Description: "In days. The node condition **CapacitySufficient** becomes false when a disk of the node is forecast to be full or to reach the over-provisioning limit within this period. " +
+			"Set the value to 0 to disable the condition.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "14",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+		},
+	}
+
+	SettingDefinitionInstanceManagerResourceRecommendationMode = SettingDefinition{
+		DisplayName: "Instance Manager Resource Recommendation Mode",
+		Description: "Longhorn keeps the CPU and memory usage history of each instance manager and recommends the resource requests based on the observed peaks and the number of the engines and replicas.\n\n" +
+			"- **disabled** Longhorn does not collect the usage history or recommend the resource requests.\n" +
+			"- **recommend** Longhorn shows the recommended resource requests in the instance manager status only.\n" +
+			"- **apply** Longhorn applies the recommended resource requests when the instance manager pod is restarted without running instances. " +
+			"The CPU request of a node set by **Instance Manager CPU Request** of the node takes precedence over the recommendation.\n\n" +
+			"The usage is read from the Kubernetes metrics server.",
+		Category:           SettingCategoryDangerZone,
+		Type:               SettingTypeString,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            string(InstanceManagerResourceRecommendationModeRecommend),
+		Choices: []any{
+			string(InstanceManagerResourceRecommendationModeDisabled),
+			string(InstanceManagerResourceRecommendationModeRecommend),
+			string(InstanceManagerResourceRecommendationModeApply),
+		},
+	}
+
+	SettingDefinitionInstanceManagerResourceRecommendationHistoryWindow = SettingDefinition{
+		DisplayName: "Instance Manager Resource Recommendation History Window",
+		Description: "In hours. The period of the instance manager usage history used to find the usage peaks. " +
+			"The history is kept in memory by the Longhorn manager on each node and starts over when the manager restarts.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "168",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 1,
+		},
+	}
+
+	SettingDefinitionReplicaCountLimitPerDisk = SettingDefinition{
+		DisplayName: "Replica Count Limit Per Disk",
+		Description: "The maximum number of replicas scheduled to a disk, regardless of the storage available on the disk. " +
+			"It prevents a large disk from holding too many small replicas and becoming an IO hotspot. " +
+			"The value can be overridden per disk by the disk field **maxReplicaCount**. Set the value to 0 for no limit.",
+		Category:           SettingCategoryScheduling,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "0",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+		},
+	}
+
+	SettingDefinitionReplicaCountLimitPerNode = SettingDefinition{
+		DisplayName:        "Replica Count Limit Per Node",
+		Description:        "The maximum number of replicas scheduled to the disks of a node. Set the value to 0 for no limit.",
+		Category:           SettingCategoryScheduling,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "0",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+		},
+	}
+
+	SettingDefinitionRebuildingReplicaCountLimitPerNode = SettingDefinition{
+		DisplayName: "Rebuilding Replica Count Limit Per Node",
+		Description: "The maximum number of replicas being rebuilt on a node. A replica rebuilt for a volume is not scheduled to the node once the limit is reached, " +
+			"so that the rebuilds are spread over the nodes. Different from **Concurrent Replica Rebuild Per Node Limit**, which delays the rebuilds on a node, " +
+			"this setting moves the rebuilds to other nodes. Set the value to 0 for no limit.",
+		Category:           SettingCategoryScheduling,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "0",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+		},
+	}
+
+	SettingDefinitionVolumeCloneBandwidthLimit = SettingDefinition{
+		DisplayName: "Volume Clone Bandwidth Limit",
+		Description: "Specifies the write bandwidth limit, in megabytes per second (MB/s), for cloning a snapshot into a new volume. " +
+			"For the V1 Data Engine, the limit is applied to the sync-agent of the replica receiving the snapshot files. " +
+			"The replica rebuilding bandwidth limit is restored once the clone finishes. " +
+			"If this value is set to 0, there will be no write bandwidth limitation.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeInt,
+		Required:           false,
+		ReadOnly:           false,
+		DataEngineSpecific: true,
+		Default:            fmt.Sprintf("{%q:\"0\",%q:\"0\"}", longhorn.DataEngineTypeV1, longhorn.DataEngineTypeV2),
+	}
+
+	SettingDefinitionConcurrentVolumeClonePerNodeLimit = SettingDefinition{
+		DisplayName: "Concurrent Volume Clone Per Node Limit",
+		Description: "The maximum number of volumes cloned at the same time from source volumes attached to a node. " +
+			"Longhorn queues the clones once the limit is reached and starts them in creation order, and shows the queue position and the estimated start of a queued clone in the volume clone status. " +
+			"Set the value to 0 for no limit.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "5",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+		},
+	}
+
+	SettingDefinitionConcurrentVolumeClonePerSourceVolumeLimit = SettingDefinition{
+		DisplayName: "Concurrent Volume Clone Per Source Volume Limit",
+		Description: "The maximum number of volumes cloned at the same time from a source volume. " +
+			"Longhorn queues the clones once the limit is reached, the same as for **Concurrent Volume Clone Per Node Limit**. " +
+			"Set the value to 0 for no limit.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "0",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+		},
+	}
+
+	SettingDefinitionIdleVolumeThreshold = SettingDefinition{
+		DisplayName: "Idle Volume Threshold",
+		Description: "In days. A volume is reported as idle when no IO to the volume is observed and the volume is not attached again for this period. " +
+			"Idle volumes are listed by the idle volume report and the metric longhorn_volume_idle. " +
+			"Set the value to 0 to disable the idle volume detection.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "30",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+		},
+	}
+)
+
+type InstanceManagerResourceRecommendationMode string
+
+const (
+	InstanceManagerResourceRecommendationModeDisabled  = InstanceManagerResourceRecommendationMode("disabled")
+	InstanceManagerResourceRecommendationModeRecommend = InstanceManagerResourceRecommendationMode("recommend")
+	InstanceManagerResourceRecommendationModeApply     = InstanceManagerResourceRecommendationMode("apply")
+)
+
+type AutomaticEngineUpgradeFailureAction string
+
+const (
+	AutomaticEngineUpgradeFailureActionPause    = AutomaticEngineUpgradeFailureAction("pause")
+	AutomaticEngineUpgradeFailureActionRollback = AutomaticEngineUpgradeFailureAction("rollback")
+)
+
+type CorruptedReplicaRepairPolicy string
+
+const (
+	CorruptedReplicaRepairPolicyImmediate   = CorruptedReplicaRepairPolicy("immediate")
+	CorruptedReplicaRepairPolicyRateLimited = CorruptedReplicaRepairPolicy("rate-limited")
+	CorruptedReplicaRepairPolicyDisabled    = CorruptedReplicaRepairPolicy("disabled")
 )
 
 type NodeDownPodDeletionPolicy string
@@ -1727,6 +2321,31 @@ const (
 	NodeDownPodDeletionPolicyDeleteBothStatefulsetAndDeploymentPod = NodeDownPodDeletionPolicy("delete-both-statefulset-and-deployment-pod")
 )
 
+// UnmarshalNodeDownPodDeletionOwnerKinds parses the semicolon-separated owner kinds of the setting node-down-pod-deletion-owner-kinds.
+func UnmarshalNodeDownPodDeletionOwnerKinds(ownerKindsSetting string) []string {
+	ownerKinds := []string{}
+	for _, item := range strings.Split(ownerKindsSetting, ";") {
+		if ownerKind := strings.TrimSpace(item); ownerKind != "" {
+			ownerKinds = append(ownerKinds, ownerKind)
+		}
+	}
+	return ownerKinds
+}
+
+type DiskHealthPolicy string
+
+const (
+	DiskHealthPolicyDisabled              = DiskHealthPolicy("disabled")
+	DiskHealthPolicyMonitor               = DiskHealthPolicy("monitor")
+	DiskHealthPolicyExcludeFromScheduling = DiskHealthPolicy("exclude-from-scheduling")
+	DiskHealthPolicyEvictReplicas         = DiskHealthPolicy("evict-replicas")
+)
+
+// IsDiskExcludedByHealthPolicy returns true if the unhealthy disks are excluded from the replica scheduling by the policy.
+func IsDiskExcludedByHealthPolicy(policy DiskHealthPolicy) bool {
+	return policy == DiskHealthPolicyExcludeFromScheduling || policy == DiskHealthPolicyEvictReplicas
+}
+
 type NodeDrainPolicy string
 
 const (
@@ -1948,6 +2567,82 @@ func UnmarshalOrphanResourceTypes(resourceTypesSetting string) (map[OrphanResour
 	return resourceTypes, nil
 }
 
+// NodeSettingOverrideRule overrides the settings for the nodes whose labels match the node selector.
+type NodeSettingOverrideRule struct {
+	NodeSelector map[string]string `json:"nodeSelector"`
+	Settings     map[string]string `json:"settings"`
+}
+
+// IsSettingNodeOverridable checks if the setting can be overridden per node.
+func IsSettingNodeOverridable(name SettingName) bool {
+	return nodeOverridableSettingNames[name]
+}
+
+// ValidateNodeSettingOverrides checks if the settings are allowed to be overridden per node
+// and if the overridden values are valid.
+func ValidateNodeSettingOverrides(overrides map[string]string) error {
+	for name, value := range overrides {
+		if !IsSettingNodeOverridable(SettingName(name)) {
+			return fmt.Errorf("setting %v cannot be overridden per node", name)
+		}
+		// Disabling the replica rebuilding is a cluster-wide decision made by the global setting.
+		if SettingName(name) == SettingNameConcurrentReplicaRebuildPerNodeLimit && strings.TrimSpace(value) == "0" {
+			return fmt.Errorf("setting %v cannot be overridden to 0 per node", name)
+		}
+		if err := ValidateSetting(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnmarshalNodeSettingOverrideRules parses and validates the value of the node setting overrides setting.
+func UnmarshalNodeSettingOverrideRules(value string) ([]NodeSettingOverrideRule, error) {
+	rules := []NodeSettingOverrideRule{}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return rules, nil
+	}
+	if err := json.Unmarshal([]byte(value), &rules); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse node setting override rules %v", value)
+	}
+
+	for i, rule := range rules {
+		if len(rule.NodeSelector) == 0 {
+			return nil, fmt.Errorf("node selector of rule %v cannot be empty", i)
+		}
+		if err := ValidateNodeSettingOverrides(rule.Settings); err != nil {
+			return nil, errors.Wrapf(err, "invalid settings of rule %v", i)
+		}
+	}
+	return rules, nil
+}
+
+// GetNodeSettingOverrideFromRules returns the overridden value of the setting from the first rule matching the node labels.
+func GetNodeSettingOverrideFromRules(rules []NodeSettingOverrideRule, nodeLabels map[string]string, name SettingName) (string, bool) {
+	for _, rule := range rules {
+		if !isNodeSelectorMatched(rule.NodeSelector, nodeLabels) {
+			continue
+		}
+		value, ok := rule.Settings[string(name)]
+		if !ok {
+			continue
+		}
+		return value, true
+	}
+	return "", false
+}
+
+func isNodeSelectorMatched(nodeSelector, nodeLabels map[string]string) bool {
+	for key, value := range nodeSelector {
+		if labelValue, ok := nodeLabels[key]; !ok || labelValue != value {
+			return false
+		}
+	}
+	return true
+}
+
 func IsSettingReplaced(name SettingName) bool {
 	return replacedSettingNames[name]
 }
@@ -2410,6 +3105,21 @@ func validateSettingString(name SettingName, definition SettingDefinition, value
 			if _, err := UnmarshalOrphanResourceTypes(strValue); err != nil {
 				return errors.Wrapf(err, "the value of %v is invalid", name)
 			}
+
+		case SettingNameNodeSettingOverrides:
+			if _, err := UnmarshalNodeSettingOverrideRules(strValue); err != nil {
+				return errors.Wrapf(err, "the value of %v is invalid", name)
+			}
+
+		case SettingNameDiskProvisioningPolicy:
+			if err := ValidateDiskProvisioningPolicy(strValue); err != nil {
+				return errors.Wrapf(err, "the value of %v is invalid", name)
+			}
+
+		case SettingNameAutomaticEngineUpgradeCanaryVolumeSelector:
+			if _, err := labels.Parse(strValue); err != nil {
+				return errors.Wrapf(err, "the value of %v is invalid", name)
+			}
 		}
 	}
 
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"os"
+	"path/filepath"
 	"time"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
@@ -19,6 +21,12 @@ const (
 	SupportBundleDownloadTimeout = 24 * time.Hour
 )
 
+// GetProcessedSupportBundlePath returns where the owner longhorn-manager keeps the support bundle limited to its scope
+// and redacted.
+func GetProcessedSupportBundlePath(name string) string {
+	return filepath.Join(os.TempDir(), "longhorn-support-bundles", name+".zip")
+}
+
 func IsSupportBundleControllerDeleting(supportBundle *longhorn.SupportBundle) bool {
 	switch supportBundle.Status.State {
 	case longhorn.SupportBundleStatePurging,
@@ -14,6 +14,7 @@ import (
 	"unsafe"
 
 	"github.com/pkg/errors"
+	"github.com/robfig/cron"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sys/unix"
 
@@ -42,12 +43,15 @@ const (
 	LonghornKindBackingImageManager = "BackingImageManager"
 	LonghornKindRecurringJob        = "RecurringJob"
 	LonghornKindSetting             = "Setting"
+	LonghornKindSettingHistory      = "SettingHistory"
 	LonghornKindSupportBundle       = "SupportBundle"
 	LonghornKindSystemBackup        = "SystemBackup"
 	LonghornKindSystemRestore       = "SystemRestore"
 	LonghornKindOrphan              = "Orphan"
 
 	LonghornKindBackingImageDataSource = "BackingImageDataSource"
+	LonghornKindFileRestoreSession     = "FileRestoreSession"
+	LonghornKindVolumeShrink           = "VolumeShrink"
 
 	LonghornKindEngineImageList  = "EngineImageList"
 	LonghornKindRecurringJobList = "RecurringJobList"
@@ -110,6 +114,8 @@ const (
 
 	DefaultLogDirectoryOnHost = "/var/lib/longhorn/logs/"
 
+	DefaultKubeletRootDir = "/var/lib/kubelet"
+
 	BackingImageManagerDirectory = "/backing-images/"
 	BackingImageFileName         = "backing"
 
@@ -140,6 +146,11 @@ const (
 	ConfigMapResourceVersionKey = "configmap-resource-version"
 	UpdateSettingFromLonghorn   = "update-setting-from-longhorn"
 
+	// annotations to note who changed a setting value, used for recording the setting history.
+	UpdateSettingRequester  = "update-setting-requester"
+	SettingPendingRevisions = "setting-pending-revisions"
+	SettingLatestRevision   = "setting-latest-revision"
+
 	DeleteCustomResourceOnly = "delete-custom-resource-only"
 
 	// annotations to note that deleting backup target is by Longhorn during uninstalling.
@@ -147,6 +158,9 @@ const (
 	DeleteEngineImageFromLonghorn  = "delete-engine-image-from-longhorn"
 	DeleteNodeFromLonghorn         = "delete-node-from-longhorn"
 
+	// annotation to resume the paused automatic engine upgrade rollout of the engine image.
+	ResumeAutoUpgradeRollout = "resume-auto-upgrade-rollout"
+
 	KubernetesStatusLabel = "KubernetesStatus"
 	KubernetesReplicaSet  = "ReplicaSet"
 	KubernetesStatefulSet = "StatefulSet"
@@ -196,11 +210,17 @@ const (
 	LonghornLabelVersion                    = "version"
 	LonghornLabelAdmissionWebhook           = "admission-webhook"
 	LonghornLabelConversionWebhook          = "conversion-webhook"
+	LonghornLabelNodeDownPodDeletion        = "node-down-pod-deletion"
+	LonghornLabelFileRestoreSession         = "file-restore-session"
+	LonghornLabelDataEngineConversion       = "data-engine-conversion"
+	LonghornLabelVolumeTransfer             = "volume-transfer"
+	LonghornLabelVolumeShrink               = "volume-shrink"
 
 	LonghornRecoveryBackendServiceName = "longhorn-recovery-backend"
 
-	LonghornLabelValueEnabled = "enabled"
-	LonghornLabelValueIgnored = "ignored"
+	LonghornLabelValueEnabled  = "enabled"
+	LonghornLabelValueIgnored  = "ignored"
+	LonghornLabelValueDisabled = "disabled"
 
 	LonghornLabelExportFromVolume                 = "export-from-volume"
 	LonghornLabelSnapshotForExportingBackingImage = "for-exporting-backing-image"
@@ -292,6 +312,16 @@ const (
 	OptionDiskSelector        = "diskSelector"
 	OptionNodeSelector        = "nodeSelector"
 
+	OptionTieringDiskSelector   = "tieringDiskSelector"
+	OptionTieringTrigger        = "tieringTrigger"
+	OptionTieringSchedule       = "tieringSchedule"
+	OptionTieringThresholdHours = "tieringThresholdHours"
+	OptionTieringBandwidthLimit = "tieringBandwidthLimit"
+
+	OptionAutoExpansionUsageThreshold = "autoExpansionUsageThreshold"
+	OptionAutoExpansionStepSize       = "autoExpansionStepSize"
+	OptionAutoExpansionMaxSize        = "autoExpansionMaxSize"
+
 	// DefaultStaleReplicaTimeout in minutes. 48h by default
 	DefaultStaleReplicaTimeout = "2880"
 
@@ -446,6 +476,11 @@ func GetLonghornLabelKey(name string) string {
 	return fmt.Sprintf("%s/%s", LonghornLabelKeyPrefix, name)
 }
 
+// GetServiceAccountUsername returns the username Kubernetes authenticates the requests of the service account as.
+func GetServiceAccountUsername(namespace, serviceAccount string) string {
+	return fmt.Sprintf("system:serviceaccount:%s:%s", namespace, serviceAccount)
+}
+
 func GetBaseLabelsForSystemManagedComponent() map[string]string {
 	return map[string]string{GetLonghornLabelKey(LonghornLabelManagedBy): ControlPlaneName}
 }
@@ -1058,6 +1093,114 @@ func ValidateReplicaRebuildingBandwidthLimit(dataEengine longhorn.DataEngineType
 	return fmt.Errorf("replicaRebuildingBandwidthLimit is not supported for data engine %v", dataEengine)
 }
 
+func ValidateVolumeTieringPolicy(dataEngine longhorn.DataEngineType, policy *longhorn.VolumeTieringPolicy) error {
+	if policy == nil {
+		return nil
+	}
+
+	if len(policy.DiskSelector) == 0 {
+		return fmt.Errorf("disk selector of the tiering policy is required")
+	}
+	switch policy.Trigger {
+	case longhorn.VolumeTieringTriggerOnDemand, longhorn.VolumeTieringTriggerAge, longhorn.VolumeTieringTriggerIdle:
+	case longhorn.VolumeTieringTriggerSchedule:
+		if _, err := cron.ParseStandard(policy.Schedule); err != nil {
+			return errors.Wrapf(err, "invalid schedule %v of the tiering policy", policy.Schedule)
+		}
+	default:
+		return fmt.Errorf("invalid trigger %v of the tiering policy", policy.Trigger)
+	}
+	if policy.ThresholdHours < 0 {
+		return fmt.Errorf("threshold hours %v of the tiering policy cannot be negative", policy.ThresholdHours)
+	}
+	if policy.BandwidthLimit < 0 {
+		return fmt.Errorf("bandwidth limit %v of the tiering policy cannot be negative", policy.BandwidthLimit)
+	}
+	return ValidateReplicaRebuildingBandwidthLimit(dataEngine, policy.BandwidthLimit)
+}
+
+// GetVolumeTieringPolicyFromStorageClassParameters returns the tiering policy in the parameters of a StorageClass.
+// It returns nil if the parameters do not have the tiering disk selector.
+func GetVolumeTieringPolicyFromStorageClassParameters(parameters map[string]string) (*longhorn.VolumeTieringPolicy, error) {
+	diskSelector := parameters[OptionTieringDiskSelector]
+	if diskSelector == "" {
+		return nil, nil
+	}
+
+	policy := &longhorn.VolumeTieringPolicy{
+		DiskSelector: strings.Split(diskSelector, ","),
+		Trigger:      longhorn.VolumeTieringTrigger(parameters[OptionTieringTrigger]),
+		Schedule:     parameters[OptionTieringSchedule],
+	}
+	if policy.Trigger == "" {
+		policy.Trigger = longhorn.VolumeTieringTriggerOnDemand
+	}
+	if value, ok := parameters[OptionTieringThresholdHours]; ok {
+		thresholdHours, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid parameter %v", OptionTieringThresholdHours)
+		}
+		policy.ThresholdHours = thresholdHours
+	}
+	if value, ok := parameters[OptionTieringBandwidthLimit]; ok {
+		bandwidthLimit, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid parameter %v", OptionTieringBandwidthLimit)
+		}
+		policy.BandwidthLimit = bandwidthLimit
+	}
+	return policy, nil
+}
+
+func ValidateVolumeAutoExpansionPolicy(policy *longhorn.VolumeAutoExpansionPolicy) error {
+	if policy == nil {
+		return nil
+	}
+
+	if policy.UsageThreshold < 1 || policy.UsageThreshold > 99 {
+		return fmt.Errorf("usage threshold %v of the auto expansion policy should be between 1 and 99", policy.UsageThreshold)
+	}
+	if policy.StepSize <= 0 {
+		return fmt.Errorf("step size %v of the auto expansion policy should be positive", policy.StepSize)
+	}
+	if policy.MaxSize <= 0 {
+		return fmt.Errorf("max size %v of the auto expansion policy should be positive", policy.MaxSize)
+	}
+	return nil
+}
+
+// GetFilesystemUsagePercentage returns the used percentage of the filesystem, rounded down.
+func GetFilesystemUsagePercentage(usedBytes, totalBytes int64) int64 {
+	if totalBytes <= 0 {
+		return 0
+	}
+	return usedBytes * 100 / totalBytes
+}
+
+// GetVolumeAutoExpansionPolicyFromStorageClassParameters returns the auto expansion policy in the parameters of a
+// StorageClass. It returns nil if the parameters do not have the usage threshold.
+func GetVolumeAutoExpansionPolicyFromStorageClassParameters(parameters map[string]string) (*longhorn.VolumeAutoExpansionPolicy, error) {
+	value, ok := parameters[OptionAutoExpansionUsageThreshold]
+	if !ok || value == "" {
+		return nil, nil
+	}
+
+	usageThreshold, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid parameter %v", OptionAutoExpansionUsageThreshold)
+	}
+	policy := &longhorn.VolumeAutoExpansionPolicy{
+		UsageThreshold: usageThreshold,
+	}
+	if policy.StepSize, err = util.ConvertSize(parameters[OptionAutoExpansionStepSize]); err != nil {
+		return nil, errors.Wrapf(err, "invalid parameter %v", OptionAutoExpansionStepSize)
+	}
+	if policy.MaxSize, err = util.ConvertSize(parameters[OptionAutoExpansionMaxSize]); err != nil {
+		return nil, errors.Wrapf(err, "invalid parameter %v", OptionAutoExpansionMaxSize)
+	}
+	return policy, nil
+}
+
 func GetDaemonSetNameFromEngineImageName(engineImageName string) string {
 	return "engine-image-" + engineImageName
 }
@@ -1203,6 +1346,20 @@ func UnmarshalToNodeTags(s string) ([]string, error) {
 	return res, nil
 }
 
+// GetPendingSettingRevisions returns the setting revisions noted in the annotations that are not recorded into the
+// setting history yet.
+func GetPendingSettingRevisions(annotations map[string]string) ([]longhorn.SettingRevision, error) {
+	s, ok := annotations[GetLonghornLabelKey(SettingPendingRevisions)]
+	if !ok || s == "" {
+		return nil, nil
+	}
+	var res []longhorn.SettingRevision
+	if err := json.Unmarshal([]byte(s), &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func IsBDF(addr string) bool {
 	bdfFormat := "[a-f0-9]{4}:[a-f0-9]{2}:[a-f0-9]{2}\\.[a-f0-9]{1}"
 	bdfPattern := regexp.MustCompile(bdfFormat)
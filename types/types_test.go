@@ -4,10 +4,14 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 
 	. "gopkg.in/check.v1"
 )
@@ -233,3 +237,95 @@ func (s *TestSuite) TestGenerateEngineNameForVolume(c *C) {
 		c.Assert(actual, Equals, testCase.expectedEngineName, Commentf(TestErrResultFmt, testName))
 	}
 }
+
+func (s *TestSuite) TestNodeSettingOverrideRules(c *C) {
+	type testCase struct {
+		value         string
+		nodeLabels    map[string]string
+		settingName   SettingName
+		expectError   bool
+		expectedValue string
+		expectedFound bool
+	}
+	testCases := map[string]testCase{
+		"empty rules": {
+			value:       "[]",
+			nodeLabels:  map[string]string{"nic": "25g"},
+			settingName: SettingNameStorageOverProvisioningPercentage,
+		},
+		"matched rule": {
+			value:         `[{"nodeSelector":{"nic":"25g"},"settings":{"storage-over-provisioning-percentage":"300"}}]`,
+			nodeLabels:    map[string]string{"nic": "25g", "disk": "nvme"},
+			settingName:   SettingNameStorageOverProvisioningPercentage,
+			expectedValue: "300",
+			expectedFound: true,
+		},
+		"unmatched rule": {
+			value:       `[{"nodeSelector":{"nic":"25g"},"settings":{"storage-over-provisioning-percentage":"300"}}]`,
+			nodeLabels:  map[string]string{"nic": "10g"},
+			settingName: SettingNameStorageOverProvisioningPercentage,
+		},
+		"first matched rule overriding the setting": {
+			value: `[{"nodeSelector":{"disk":"nvme"},"settings":{"storage-minimal-available-percentage":"10"}},` +
+				`{"nodeSelector":{"nic":"25g"},"settings":{"storage-over-provisioning-percentage":"300"}},` +
+				`{"nodeSelector":{"disk":"nvme"},"settings":{"storage-over-provisioning-percentage":"200"}}]`,
+			nodeLabels:    map[string]string{"nic": "25g", "disk": "nvme"},
+			settingName:   SettingNameStorageOverProvisioningPercentage,
+			expectedValue: "300",
+			expectedFound: true,
+		},
+		"not overridable setting": {
+			value:       `[{"nodeSelector":{"nic":"25g"},"settings":{"backup-concurrent-limit":"5"}}]`,
+			expectError: true,
+		},
+		"invalid setting value": {
+			value:       `[{"nodeSelector":{"nic":"25g"},"settings":{"storage-minimal-available-percentage":"101"}}]`,
+			expectError: true,
+		},
+		"disabling replica rebuilding": {
+			value:       `[{"nodeSelector":{"nic":"25g"},"settings":{"concurrent-replica-rebuild-per-node-limit":"0"}}]`,
+			expectError: true,
+		},
+		"empty node selector": {
+			value:       `[{"nodeSelector":{},"settings":{"storage-over-provisioning-percentage":"300"}}]`,
+			expectError: true,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		fmt.Printf("testing %v\n", testName)
+
+		rules, err := UnmarshalNodeSettingOverrideRules(testCase.value)
+		if testCase.expectError {
+			c.Assert(err, NotNil, Commentf(TestErrResultFmt, testName))
+			continue
+		}
+		c.Assert(err, IsNil, Commentf(TestErrErrorFmt, testName, err))
+
+		value, found := GetNodeSettingOverrideFromRules(rules, testCase.nodeLabels, testCase.settingName)
+		c.Assert(found, Equals, testCase.expectedFound, Commentf(TestErrResultFmt, testName))
+		c.Assert(value, Equals, testCase.expectedValue, Commentf(TestErrResultFmt, testName))
+	}
+}
+
+func (s *TestSuite) TestIsVolumeIdle(c *C) {
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	v := &longhorn.Volume{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))},
+	}
+	threshold := 30 * 24 * time.Hour
+
+	c.Assert(IsVolumeIdle(v, threshold, now), Equals, true)
+	c.Assert(IsVolumeIdle(v, 0, now), Equals, false)
+
+	v.Status.Activity = &longhorn.VolumeActivityStatus{LastAttachedAt: "2024-02-15T00:00:00Z"}
+	c.Assert(IsVolumeIdle(v, threshold, now), Equals, false)
+	c.Assert(GetVolumeIdleDuration(v, now), Equals, 15*24*time.Hour)
+
+	v.Status.Activity = &longhorn.VolumeActivityStatus{
+		IO:             longhorn.VolumeIOStats{LastIOAt: "2024-01-10T00:00:00Z"},
+		LastAttachedAt: "2024-01-05T00:00:00Z",
+	}
+	c.Assert(IsVolumeIdle(v, threshold, now), Equals, true)
+	c.Assert(GetVolumeLastActiveAt(v), Equals, time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC))
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"time"
+
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// GetVolumeLastActiveAt returns the latest of the last IO, the last attachment and the creation of the volume.
+func GetVolumeLastActiveAt(v *longhorn.Volume) time.Time {
+	lastActiveAt := v.CreationTimestamp.Time
+	if v.Status.Activity == nil {
+		return lastActiveAt
+	}
+	for _, timestamp := range []string{v.Status.Activity.IO.LastIOAt, v.Status.Activity.LastAttachedAt} {
+		if t, err := util.ParseTime(timestamp); err == nil && t.After(lastActiveAt) {
+			lastActiveAt = t
+		}
+	}
+	return lastActiveAt
+}
+
+// GetVolumeIdleDuration returns how long the volume has not been active.
+func GetVolumeIdleDuration(v *longhorn.Volume, now time.Time) time.Duration {
+	lastActiveAt := GetVolumeLastActiveAt(v)
+	if !now.After(lastActiveAt) {
+		return 0
+	}
+	return now.Sub(lastActiveAt)
+}
+
+// IsVolumeIdle returns true if the volume has not been active for longer than the threshold. A threshold of 0
+// disables the detection.
+func IsVolumeIdle(v *longhorn.Volume, threshold time.Duration, now time.Time) bool {
+	if threshold <= 0 {
+		return false
+	}
+	return GetVolumeIdleDuration(v, now) > threshold
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	VolumeShrinkTargetVolumeNameSuffix  = "-shrunk"
+	VolumeShrinkPodNamePrefix           = "volume-shrink-"
+	VolumeShrinkDefaultMarginPercentage = 10
+
+	VolumeShrinkMoverPort             = 8080
+	VolumeShrinkMoverProgressPath     = "/v1/progress"
+	VolumeShrinkMoverHostDevDirectory = "/host/dev"
+
+	// VolumeShrinkMoverModeCopy formats the target volume and copies the files while the workload uses the source
+	// volume.
+	VolumeShrinkMoverModeCopy = "copy"
+	// VolumeShrinkMoverModeFinal copies the changes since the first copy once the workload detaches the source volume,
+	// then verifies the files of both volumes are identical.
+	VolumeShrinkMoverModeFinal = "final"
+)
+
+// VolumeShrinkMoverProgress is the progress of the copy served by the mover pod of a volume shrink
+type VolumeShrinkMoverProgress struct {
+	Progress int `json:"progress"`
+}
+
+// GetVolumeShrinkTargetVolumeName returns the default name of the volume a volume is shrunk into.
+func GetVolumeShrinkTargetVolumeName(volumeName string) string {
+	return volumeName + VolumeShrinkTargetVolumeNameSuffix
+}
+
+func GetVolumeShrinkPodName(shrinkName, mode string) string {
+	return fmt.Sprintf("%s%s-%s", VolumeShrinkPodNamePrefix, shrinkName, mode)
+}
+
+func GetVolumeShrinkLabels(shrinkName string) map[string]string {
+	return map[string]string{
+		GetLonghornLabelKey(LonghornLabelVolumeShrink): shrinkName,
+	}
+}
+
+func GetVolumeShrinkPodLabels(shrinkName string) map[string]string {
+	labels := GetBaseLabelsForSystemManagedComponent()
+	labels[GetLonghornLabelComponentKey()] = LonghornLabelVolumeShrink
+	labels[GetLonghornLabelKey(LonghornLabelVolumeShrink)] = shrinkName
+	return labels
+}
+
+// GetVolumeShrinkDevicePath returns the block device of the attached volume seen by the mover pod.
+func GetVolumeShrinkDevicePath(volumeName string) string {
+	return fmt.Sprintf("%s/longhorn/%s", VolumeShrinkMoverHostDevDirectory, volumeName)
+}
+
+// GetVolumeShrinkRequiredSize returns the size the target volume needs to hold the used space of the filesystem plus
+// the margin.
+func GetVolumeShrinkRequiredSize(usedBytes int64, marginPercentage int) int64 {
+	return usedBytes + usedBytes*int64(marginPercentage)/100
+}
+
+// GetVolumeShrinkMoverProgressURL returns the URL the progress of the mover pod is served on.
+func GetVolumeShrinkMoverProgressURL(podIP string) string {
+	return fmt.Sprintf("http://%s:%d%s", podIP, VolumeShrinkMoverPort, VolumeShrinkMoverProgressPath)
+}
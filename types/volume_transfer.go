@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	VolumeTransferDefaultSyncIntervalSeconds = 300
+
+	// VolumeTransferHostDevDirectory is where the host /dev is mounted in the manager container.
+	VolumeTransferHostDevDirectory = "/host/dev"
+)
+
+func GetVolumeTransferLabels(transferName string) map[string]string {
+	return map[string]string{
+		GetLonghornLabelKey(LonghornLabelVolumeTransfer): transferName,
+	}
+}
+
+// GetVolumeTransferSnapshotName returns the snapshot of the source volume the sync sends. The final sync takes its own
+// snapshot once the workload detaches the volume.
+func GetVolumeTransferSnapshotName(transferName string, syncCount int, final bool) string {
+	if final {
+		return fmt.Sprintf("%s-final", transferName)
+	}
+	return fmt.Sprintf("%s-%d", transferName, syncCount)
+}
+
+// GetVolumeTransferDevicePath returns the block device of the attached volume seen by the manager.
+func GetVolumeTransferDevicePath(volumeName string) string {
+	return fmt.Sprintf("%s/longhorn/%s", VolumeTransferHostDevDirectory, volumeName)
+}
@@ -0,0 +1,148 @@
+package capacityforecast
+
+import (
+	"math"
+	"time"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	// MinimumSamples is the minimum number of samples required to fit a growth trend.
+	MinimumSamples = 3
+
+	// DaysNotGrowing is the estimated days when the value is not growing.
+	DaysNotGrowing = int64(-1)
+
+	day = 24 * time.Hour
+)
+
+// Sample is the storage usage of a disk at a point in time.
+type Sample struct {
+	Time time.Time
+	// Used is the storage used on the disk, including the data not managed by Longhorn.
+	Used int64
+	// ActualSize is the sum of the actual size of the volumes having replicas on the disk.
+	ActualSize int64
+	// Scheduled is the storage scheduled to the replicas and the backing images on the disk.
+	Scheduled int64
+}
+
+// History is the rolling usage history of a disk.
+type History struct {
+	samples []Sample
+}
+
+// NewHistory returns the history starting with the samples, for example the samples saved before the restart.
+func NewHistory(samples []Sample) *History {
+	return &History{samples: append([]Sample{}, samples...)}
+}
+
+// Add appends the sample if the last sample is older than the interval, then drops the samples older than the window.
+// It returns false if the sample is skipped.
+func (h *History) Add(sample Sample, interval, window time.Duration) bool {
+	if len(h.samples) > 0 && sample.Time.Sub(h.samples[len(h.samples)-1].Time) < interval {
+		return false
+	}
+	h.samples = append(h.samples, sample)
+
+	cutoff := sample.Time.Add(-window)
+	for len(h.samples) > 0 && h.samples[0].Time.Before(cutoff) {
+		h.samples = h.samples[1:]
+	}
+	return true
+}
+
+// Samples returns the samples in the history from the oldest to the newest.
+func (h *History) Samples() []Sample {
+	return h.samples
+}
+
+// Downsample keeps the first sample of every interval, so the history can be saved compactly.
+func Downsample(samples []Sample, interval time.Duration) []Sample {
+	downsampled := []Sample{}
+	for _, sample := range samples {
+		if len(downsampled) > 0 && sample.Time.Sub(downsampled[len(downsampled)-1].Time) < interval {
+			continue
+		}
+		downsampled = append(downsampled, sample)
+	}
+	return downsampled
+}
+
+// GrowthPerDay fits a linear trend to the values of the samples by least squares and returns the slope in bytes per day.
+func GrowthPerDay(samples []Sample, value func(Sample) int64) float64 {
+	if len(samples) < 2 {
+		return 0
+	}
+
+	origin := samples[0].Time
+	var sumX, sumY, sumXY, sumXX float64
+	for _, sample := range samples {
+		x := float64(sample.Time.Sub(origin)) / float64(day)
+		y := float64(value(sample))
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+
+	n := float64(len(samples))
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return 0
+	}
+	return (n*sumXY - sumX*sumY) / denominator
+}
+
+// DaysUntil estimates the days until the remaining storage is consumed at the growth per day.
+// DaysNotGrowing is returned if the growth is not positive.
+func DaysUntil(remaining int64, growthPerDay float64) int64 {
+	if growthPerDay <= 0 {
+		return DaysNotGrowing
+	}
+	if remaining <= 0 {
+		return 0
+	}
+	return int64(math.Floor(float64(remaining) / growthPerDay))
+}
+
+// Sum estimates when the storage of the forecasts runs out in total. It returns nil if there is no forecast.
+func Sum(forecasts []*longhorn.CapacityForecast) *longhorn.CapacityForecast {
+	if len(forecasts) == 0 {
+		return nil
+	}
+
+	sum := &longhorn.CapacityForecast{}
+	var fullGrowth int64
+	for i, forecast := range forecasts {
+		sum.UsageGrowthPerDay += forecast.UsageGrowthPerDay
+		sum.ActualSizeGrowthPerDay += forecast.ActualSizeGrowthPerDay
+		sum.ScheduledGrowthPerDay += forecast.ScheduledGrowthPerDay
+		sum.StorageRemaining += forecast.StorageRemaining
+		sum.SchedulableRemaining += forecast.SchedulableRemaining
+		fullGrowth += max(forecast.UsageGrowthPerDay, forecast.ActualSizeGrowthPerDay)
+		if i == 0 || forecast.SampleCount < sum.SampleCount {
+			sum.SampleCount = forecast.SampleCount
+		}
+		if forecast.LastUpdatedAt > sum.LastUpdatedAt {
+			sum.LastUpdatedAt = forecast.LastUpdatedAt
+		}
+	}
+	sum.DaysUntilFull = DaysUntil(sum.StorageRemaining, float64(fullGrowth))
+	sum.DaysUntilOverProvisioningLimit = DaysUntil(sum.SchedulableRemaining, float64(sum.ScheduledGrowthPerDay))
+	return sum
+}
+
+// SumDisks estimates when the storage of the disks on the nodes runs out in total. It returns nil if none of the disks has a forecast.
+func SumDisks(nodes []*longhorn.Node) *longhorn.CapacityForecast {
+	forecasts := []*longhorn.CapacityForecast{}
+	for _, node := range nodes {
+		for _, diskStatus := range node.Status.DiskStatus {
+			if diskStatus != nil && diskStatus.CapacityForecast != nil {
+				forecasts = append(forecasts, diskStatus.CapacityForecast)
+			}
+		}
+	}
+	return Sum(forecasts)
+}
@@ -0,0 +1,122 @@
+package capacityforecast
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func TestHistoryAdd(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	history := &History{}
+
+	require.True(t, history.Add(Sample{Time: start, Used: 1}, 10*time.Minute, 2*time.Hour))
+	require.False(t, history.Add(Sample{Time: start.Add(5 * time.Minute), Used: 2}, 10*time.Minute, 2*time.Hour))
+	require.True(t, history.Add(Sample{Time: start.Add(time.Hour), Used: 3}, 10*time.Minute, 2*time.Hour))
+	require.Len(t, history.Samples(), 2)
+
+	require.True(t, history.Add(Sample{Time: start.Add(150 * time.Minute), Used: 4}, 10*time.Minute, 2*time.Hour))
+	samples := history.Samples()
+	require.Len(t, samples, 2)
+	require.Equal(t, int64(3), samples[0].Used)
+	require.Equal(t, int64(4), samples[1].Used)
+}
+
+func TestNewHistoryAndDownsample(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	samples := []Sample{}
+	for i := 0; i < 13; i++ {
+		samples = append(samples, Sample{Time: start.Add(time.Duration(i) * 10 * time.Minute), Used: int64(i)})
+	}
+
+	downsampled := Downsample(samples, time.Hour)
+	require.Len(t, downsampled, 3)
+	require.Equal(t, int64(0), downsampled[0].Used)
+	require.Equal(t, int64(6), downsampled[1].Used)
+	require.Equal(t, int64(12), downsampled[2].Used)
+
+	history := NewHistory(downsampled)
+	require.False(t, history.Add(Sample{Time: start.Add(125 * time.Minute), Used: 13}, 10*time.Minute, 2*time.Hour))
+	require.True(t, history.Add(Sample{Time: start.Add(130 * time.Minute), Used: 13}, 10*time.Minute, 2*time.Hour))
+	require.Len(t, history.Samples(), 3)
+}
+
+func TestGrowthPerDay(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	samples := []Sample{
+		{Time: start, Used: 100, Scheduled: 500},
+		{Time: start.Add(12 * time.Hour), Used: 160, Scheduled: 500},
+		{Time: start.Add(24 * time.Hour), Used: 200, Scheduled: 500},
+		{Time: start.Add(36 * time.Hour), Used: 240, Scheduled: 500},
+	}
+
+	usageGrowth := GrowthPerDay(samples, func(sample Sample) int64 { return sample.Used })
+	require.InDelta(t, 92, usageGrowth, 0.001)
+
+	scheduledGrowth := GrowthPerDay(samples, func(sample Sample) int64 { return sample.Scheduled })
+	require.Zero(t, scheduledGrowth)
+
+	require.Zero(t, GrowthPerDay(samples[:1], func(sample Sample) int64 { return sample.Used }))
+}
+
+func TestDaysUntil(t *testing.T) {
+	require.Equal(t, int64(10), DaysUntil(1000, 100))
+	require.Equal(t, int64(3), DaysUntil(1000, 300))
+	require.Equal(t, int64(0), DaysUntil(-10, 100))
+	require.Equal(t, DaysNotGrowing, DaysUntil(1000, 0))
+	require.Equal(t, DaysNotGrowing, DaysUntil(1000, -50))
+}
+
+func TestSumDisks(t *testing.T) {
+	require.Nil(t, SumDisks([]*longhorn.Node{{}}))
+
+	nodes := []*longhorn.Node{
+		{
+			Status: longhorn.NodeStatus{
+				DiskStatus: map[string]*longhorn.DiskStatus{
+					"disk-1": {
+						CapacityForecast: &longhorn.CapacityForecast{
+							UsageGrowthPerDay:      100,
+							ActualSizeGrowthPerDay: 150,
+							ScheduledGrowthPerDay:  0,
+							StorageRemaining:       2000,
+							SchedulableRemaining:   5000,
+							SampleCount:            10,
+							LastUpdatedAt:          "2024-01-01T00:00:00Z",
+						},
+					},
+					"disk-2": {},
+				},
+			},
+		},
+		{
+			Status: longhorn.NodeStatus{
+				DiskStatus: map[string]*longhorn.DiskStatus{
+					"disk-3": {
+						CapacityForecast: &longhorn.CapacityForecast{
+							UsageGrowthPerDay:      50,
+							ActualSizeGrowthPerDay: 0,
+							ScheduledGrowthPerDay:  0,
+							StorageRemaining:       1000,
+							SchedulableRemaining:   1000,
+							SampleCount:            5,
+							LastUpdatedAt:          "2024-01-01T00:10:00Z",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	forecast := SumDisks(nodes)
+	require.NotNil(t, forecast)
+	require.Equal(t, int64(150), forecast.UsageGrowthPerDay)
+	require.Equal(t, int64(3000), forecast.StorageRemaining)
+	require.Equal(t, int64(15), forecast.DaysUntilFull)
+	require.Equal(t, DaysNotGrowing, forecast.DaysUntilOverProvisioningLimit)
+	require.Equal(t, 5, forecast.SampleCount)
+	require.Equal(t, "2024-01-01T00:10:00Z", forecast.LastUpdatedAt)
+}
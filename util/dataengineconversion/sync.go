@@ -0,0 +1,138 @@
+package dataengineconversion
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"golang.org/x/sys/unix"
+)
+
+// ChunkSize is the unit the volume data is compared and copied in.
+const ChunkSize = 2 * 1024 * 1024
+
+// SyncResult is the outcome of a copy or of the final sync.
+type SyncResult struct {
+	CopiedChunks int
+	CopiedBytes  int64
+	Duration     time.Duration
+}
+
+// ContentMismatchError means the target device still differs from the source device after the copy.
+type ContentMismatchError struct {
+	Offset int64
+}
+
+func (e *ContentMismatchError) Error() string {
+	return fmt.Sprintf("content differs from the source volume at offset %v", e.Offset)
+}
+
+func getChunkCount(size int64) int {
+	return int((size + ChunkSize - 1) / ChunkSize)
+}
+
+func getChunkLength(size int64, index int) int64 {
+	return min(ChunkSize, size-int64(index)*ChunkSize)
+}
+
+// Copy copies the chunks of the source device that differ from the target device. The workload may still write to
+// the source device, so the target device is only consistent after Sync runs once the workload stops. onProgress is
+// called with the progress in percentage.
+func Copy(ctx context.Context, sourceDevice, targetDevice string, size int64, onProgress func(progress int)) (*SyncResult, error) {
+	return copyDevice(ctx, sourceDevice, targetDevice, size, false, onProgress)
+}
+
+// Sync copies the chunks of the source device that differ from the target device, then reads both devices again
+// and fails with a ContentMismatchError if any chunk differs. The page cache of both devices is dropped before the
+// comparison, so the data is read back from the replicas rather than from the written buffers. onProgress is called
+// with the progress in percentage.
+func Sync(ctx context.Context, sourceDevice, targetDevice string, size int64, onProgress func(progress int)) (*SyncResult, error) {
+	return copyDevice(ctx, sourceDevice, targetDevice, size, true, onProgress)
+}
+
+func copyDevice(ctx context.Context, sourceDevice, targetDevice string, size int64, verify bool, onProgress func(progress int)) (*SyncResult, error) {
+	startedAt := time.Now()
+
+	source, err := os.Open(sourceDevice)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open device %v", sourceDevice)
+	}
+	defer source.Close()
+
+	target, err := os.OpenFile(targetDevice, os.O_RDWR, 0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open device %v", targetDevice)
+	}
+	defer target.Close()
+
+	count := getChunkCount(size)
+	result := &SyncResult{}
+	sourceBuf := make([]byte, ChunkSize)
+	targetBuf := make([]byte, ChunkSize)
+
+	// With the verification, the first half of the progress is the copy and the second half is the comparison
+	copyProgress := 100
+	if verify {
+		copyProgress = 50
+	}
+	for i := 0; i < count; i++ {
+		sourceData, targetData, err := readChunks(ctx, source, target, sourceBuf, targetBuf, size, i)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(sourceData, targetData) {
+			if _, err := target.WriteAt(sourceData, int64(i)*ChunkSize); err != nil {
+				return nil, errors.Wrapf(err, "failed to write chunk %v to device %v", i, targetDevice)
+			}
+			result.CopiedChunks++
+			result.CopiedBytes += int64(len(sourceData))
+		}
+		onProgress((i + 1) * copyProgress / count)
+	}
+	if err := target.Sync(); err != nil {
+		return nil, errors.Wrapf(err, "failed to flush device %v", targetDevice)
+	}
+	if !verify {
+		result.Duration = time.Since(startedAt)
+		return result, nil
+	}
+
+	for _, f := range []*os.File{source, target} {
+		if err := unix.Fadvise(int(f.Fd()), 0, 0, unix.FADV_DONTNEED); err != nil {
+			return nil, errors.Wrapf(err, "failed to drop the page cache of device %v", f.Name())
+		}
+	}
+	for i := 0; i < count; i++ {
+		sourceData, targetData, err := readChunks(ctx, source, target, sourceBuf, targetBuf, size, i)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(sourceData, targetData) {
+			return nil, &ContentMismatchError{Offset: int64(i) * ChunkSize}
+		}
+		onProgress(50 + (i+1)*50/count)
+	}
+
+	result.Duration = time.Since(startedAt)
+	return result, nil
+}
+
+func readChunks(ctx context.Context, source, target *os.File, sourceBuf, targetBuf []byte, size int64, index int) ([]byte, []byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	length := getChunkLength(size, index)
+	sourceData := sourceBuf[:length]
+	targetData := targetBuf[:length]
+	if _, err := source.ReadAt(sourceData, int64(index)*ChunkSize); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to read chunk %v of device %v", index, source.Name())
+	}
+	if _, err := target.ReadAt(targetData, int64(index)*ChunkSize); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to read chunk %v of device %v", index, target.Name())
+	}
+	return sourceData, targetData, nil
+}
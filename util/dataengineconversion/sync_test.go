@@ -0,0 +1,68 @@
+package dataengineconversion
+
+import (
+	"context"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDevice(t *testing.T, name string, data []byte) string {
+	device := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(device, data, 0600))
+	return device
+}
+
+func TestSync(t *testing.T) {
+	size := int64(3*ChunkSize + 1024)
+	sourceData := make([]byte, size)
+	_, err := rand.Read(sourceData)
+	require.NoError(t, err)
+
+	source := newTestDevice(t, "source", sourceData)
+	target := newTestDevice(t, "target", make([]byte, size))
+
+	lastProgress := 0
+	result, err := Copy(context.Background(), source, target, size, func(progress int) {
+		require.GreaterOrEqual(t, progress, lastProgress)
+		lastProgress = progress
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, result.CopiedChunks)
+	require.Equal(t, size, result.CopiedBytes)
+	require.Equal(t, 100, lastProgress)
+
+	// The workload wrote to two chunks of the source after the copy
+	sourceData[10] ^= 0xff
+	sourceData[3*ChunkSize+10] ^= 0xff
+	require.NoError(t, os.WriteFile(source, sourceData, 0600))
+
+	lastProgress = 0
+	result, err = Sync(context.Background(), source, target, size, func(progress int) {
+		require.GreaterOrEqual(t, progress, lastProgress)
+		lastProgress = progress
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.CopiedChunks)
+	require.Equal(t, int64(ChunkSize+1024), result.CopiedBytes)
+	require.Equal(t, 100, lastProgress)
+	targetData, err := os.ReadFile(target)
+	require.NoError(t, err)
+	require.Equal(t, sourceData, targetData)
+
+	// Nothing is copied if both volumes match
+	result, err = Sync(context.Background(), source, target, size, func(int) {})
+	require.NoError(t, err)
+	require.Equal(t, 0, result.CopiedChunks)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = Sync(ctx, source, target, size, func(int) {})
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = Sync(context.Background(), source, filepath.Join(t.TempDir(), "missing"), size, func(int) {})
+	require.Error(t, err)
+}
@@ -0,0 +1,297 @@
+package diskdiscovery
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	lhns "github.com/longhorn/go-common-libs/ns"
+	lhtypes "github.com/longhorn/go-common-libs/types"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	SysfsDirectory = "/sys"
+
+	sectorSize = 512
+
+	binaryWipefs  = "wipefs"
+	wipefsTimeout = 30 * time.Second
+)
+
+// ignoredDevicePrefixes are the virtual block devices that are never provisioned as Longhorn disks.
+var ignoredDevicePrefixes = []string{"loop", "ram", "zram", "dm-", "md", "sr", "fd", "nbd", "rbd"}
+
+// BlockDevice is a whole block device discovered in sysfs.
+type BlockDevice struct {
+	Name       string
+	Path       string
+	Model      string
+	Size       int64
+	Rotational bool
+	Removable  bool
+	ReadOnly   bool
+	// Partitioned indicates the device has partitions.
+	Partitioned bool
+	// Held indicates the device or one of its partitions is used by another block device, for example LVM or RAID.
+	Held bool
+	// MountPoint is the mount point of the device. It is empty if the device is not mounted directly.
+	MountPoint string
+	// PartitionMountPoints are the mount points of the mounted partitions of the device.
+	PartitionMountPoints []string
+	// Signatures are the filesystem, LVM, RAID or partition table signatures found on the device by DetectSignatures.
+	Signatures []string
+}
+
+// Discover lists the whole block devices in <sysfsDir>/block. The mount points map the device paths to the mount points.
+func Discover(sysfsDir string, mountPoints map[string]string) ([]*BlockDevice, error) {
+	blockDir := filepath.Join(sysfsDir, "block")
+	entries, err := os.ReadDir(blockDir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %v", blockDir)
+	}
+
+	devices := []*BlockDevice{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if isIgnoredDevice(name) {
+			continue
+		}
+
+		deviceDir := filepath.Join(blockDir, name)
+		device := &BlockDevice{
+			Name:       name,
+			Path:       filepath.Join("/dev", name),
+			Model:      readSysfsString(filepath.Join(deviceDir, "device", "model")),
+			Rotational: readSysfsString(filepath.Join(deviceDir, "queue", "rotational")) == "1",
+			Removable:  readSysfsString(filepath.Join(deviceDir, "removable")) == "1",
+			ReadOnly:   readSysfsString(filepath.Join(deviceDir, "ro")) == "1",
+			Held:       hasHolders(deviceDir),
+			MountPoint: mountPoints[filepath.Join("/dev", name)],
+		}
+
+		sectors, err := strconv.ParseInt(readSysfsString(filepath.Join(deviceDir, "size")), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the size of block device %v", name)
+		}
+		device.Size = sectors * sectorSize
+
+		partitions, err := getPartitions(deviceDir, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, partition := range partitions {
+			device.Partitioned = true
+			if hasHolders(filepath.Join(deviceDir, partition)) {
+				device.Held = true
+			}
+			if mountPoint := mountPoints[filepath.Join("/dev", partition)]; mountPoint != "" {
+				device.PartitionMountPoints = append(device.PartitionMountPoints, mountPoint)
+			}
+		}
+
+		devices = append(devices, device)
+	}
+
+	sort.Slice(devices, func(i, j int) bool {
+		return devices[i].Name < devices[j].Name
+	})
+	return devices, nil
+}
+
+// DetectSignatures lists the signatures on the devices with wipefs in the host mount namespace. Without --all or
+// --offset, wipefs only reports the signatures and does not erase anything.
+func DetectSignatures(devices []*BlockDevice) error {
+	namespaces := []lhtypes.Namespace{lhtypes.NamespaceMnt}
+	nsexec, err := lhns.NewNamespaceExecutor(lhtypes.ProcessNone, lhtypes.HostProcDirectory, namespaces)
+	if err != nil {
+		return err
+	}
+
+	for _, device := range devices {
+		output, err := nsexec.Execute(nil, binaryWipefs, []string{"--noheadings", "--output", "TYPE", device.Path}, wipefsTimeout)
+		if err != nil {
+			return errors.Wrapf(err, "failed to detect the signatures of block device %v", device.Name)
+		}
+		device.Signatures = parseSignatures(output)
+	}
+	return nil
+}
+
+// parseSignatures parses the output of wipefs --noheadings --output TYPE, one signature type per line.
+func parseSignatures(output string) []string {
+	signatures := []string{}
+	for _, line := range strings.Split(output, "\n") {
+		signature := strings.TrimSpace(line)
+		if signature != "" {
+			signatures = append(signatures, signature)
+		}
+	}
+	return signatures
+}
+
+// GetMountPoints parses the mounts file, for example /proc/1/mounts, and maps the device paths to the mount points.
+func GetMountPoints(procMountsPath string) (map[string]string, error) {
+	file, err := os.Open(procMountsPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close() // nolint: errcheck
+
+	mountPoints := map[string]string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || !strings.HasPrefix(fields[0], "/dev/") {
+			continue
+		}
+		if _, ok := mountPoints[fields[0]]; !ok {
+			mountPoints[fields[0]] = fields[1]
+		}
+	}
+	return mountPoints, scanner.Err()
+}
+
+// MatchRules returns the first rule matching the device and the disk path to be provisioned.
+// If no rule matches, the reasons of the rejection are returned.
+func MatchRules(rules []types.DiskProvisioningRule, device *BlockDevice) (*types.DiskProvisioningRule, string, string) {
+	reasons := []string{}
+	for i := range rules {
+		rule := &rules[i]
+		diskPath, reason := matchRule(rule, device)
+		if reason == "" {
+			return rule, diskPath, ""
+		}
+		reasons = append(reasons, fmt.Sprintf("rule %v: %v", rule.Name, reason))
+	}
+	if len(reasons) == 0 {
+		return nil, "", "no disk provisioning rule applies to the node"
+	}
+	return nil, "", strings.Join(reasons, "; ")
+}
+
+func matchRule(rule *types.DiskProvisioningRule, device *BlockDevice) (string, string) {
+	selector := rule.DeviceSelector
+
+	if len(selector.Models) > 0 && !matchAnyPattern(selector.Models, device.Model) {
+		return "", fmt.Sprintf("model %q does not match", device.Model)
+	}
+	if len(selector.Paths) > 0 && !matchAnyPattern(selector.Paths, device.Path) {
+		return "", fmt.Sprintf("path %v does not match", device.Path)
+	}
+
+	minSize, maxSize, err := types.GetDiskDeviceSelectorSizeRange(selector)
+	if err != nil {
+		return "", err.Error()
+	}
+	if minSize > 0 && device.Size < minSize {
+		return "", fmt.Sprintf("size %v is smaller than %v", device.Size, selector.MinSize)
+	}
+	if maxSize > 0 && device.Size > maxSize {
+		return "", fmt.Sprintf("size %v is larger than %v", device.Size, selector.MaxSize)
+	}
+	if selector.Rotational != nil && *selector.Rotational != device.Rotational {
+		return "", fmt.Sprintf("rotational is %v", device.Rotational)
+	}
+
+	if device.Removable {
+		return "", "device is removable"
+	}
+	if device.ReadOnly {
+		return "", "device is read-only"
+	}
+
+	switch rule.DiskType {
+	case longhorn.DiskTypeBlock:
+		switch {
+		case device.MountPoint != "" || len(device.PartitionMountPoints) > 0:
+			return "", "device is mounted"
+		case device.Partitioned:
+			return "", "device has partitions"
+		case device.Held:
+			return "", "device is held by another block device"
+		case len(device.Signatures) > 0:
+			return "", fmt.Sprintf("device is not blank, found signatures %v", strings.Join(device.Signatures, ", "))
+		}
+		return device.Path, ""
+	case longhorn.DiskTypeFilesystem:
+		// The filesystem is either on the whole device or on its only mounted partition.
+		mountPoint := device.MountPoint
+		if mountPoint == "" {
+			switch len(device.PartitionMountPoints) {
+			case 0:
+				return "", "device is not mounted"
+			case 1:
+				mountPoint = device.PartitionMountPoints[0]
+			default:
+				return "", fmt.Sprintf("device has multiple mounted partitions %v", strings.Join(device.PartitionMountPoints, ", "))
+			}
+		}
+		if mountPoint == "/" {
+			return "", "device is mounted as the root filesystem"
+		}
+		return mountPoint, ""
+	default:
+		return "", fmt.Sprintf("invalid disk type %v", rule.DiskType)
+	}
+}
+
+func matchAnyPattern(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if matched, err := filepath.Match(pattern, value); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+func isIgnoredDevice(name string) bool {
+	for _, prefix := range ignoredDevicePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// getPartitions returns the partitions, which are the subdirectories containing the file "partition".
+func getPartitions(deviceDir, deviceName string) ([]string, error) {
+	entries, err := os.ReadDir(deviceDir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %v", deviceDir)
+	}
+
+	partitions := []string{}
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), deviceName) {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(deviceDir, entry.Name(), "partition")); err == nil {
+			partitions = append(partitions, entry.Name())
+		}
+	}
+	return partitions, nil
+}
+
+func hasHolders(dir string) bool {
+	entries, err := os.ReadDir(filepath.Join(dir, "holders"))
+	return err == nil && len(entries) > 0
+}
+
+func readSysfsString(path string) string {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(content))
+}
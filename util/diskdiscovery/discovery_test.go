@@ -0,0 +1,157 @@
+package diskdiscovery
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+type fakeBlockDevice struct {
+	name       string
+	sectors    string
+	model      string
+	rotational string
+	removable  string
+	partitions []string
+	holders    []string
+}
+
+func writeFakeSysfsFile(t *testing.T, path, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content+"\n"), 0644))
+}
+
+func newFakeSysfs(t *testing.T, devices []fakeBlockDevice) string {
+	sysfsDir := t.TempDir()
+	for _, device := range devices {
+		deviceDir := filepath.Join(sysfsDir, "block", device.name)
+		writeFakeSysfsFile(t, filepath.Join(deviceDir, "size"), device.sectors)
+		writeFakeSysfsFile(t, filepath.Join(deviceDir, "device", "model"), device.model)
+		writeFakeSysfsFile(t, filepath.Join(deviceDir, "queue", "rotational"), device.rotational)
+		writeFakeSysfsFile(t, filepath.Join(deviceDir, "removable"), device.removable)
+		writeFakeSysfsFile(t, filepath.Join(deviceDir, "ro"), "0")
+		require.NoError(t, os.MkdirAll(filepath.Join(deviceDir, "holders"), 0755))
+		for _, holder := range device.holders {
+			writeFakeSysfsFile(t, filepath.Join(deviceDir, "holders", holder), "")
+		}
+		for _, partition := range device.partitions {
+			writeFakeSysfsFile(t, filepath.Join(deviceDir, partition, "partition"), "1")
+		}
+	}
+	return sysfsDir
+}
+
+func TestDiscover(t *testing.T) {
+	sysfsDir := newFakeSysfs(t, []fakeBlockDevice{
+		{name: "sda", sectors: "1953525168", model: "ST1000DM010", rotational: "1", removable: "0", partitions: []string{"sda1"}},
+		{name: "nvme0n1", sectors: "3907029168", model: "Samsung SSD 980 PRO 2TB", rotational: "0", removable: "0"},
+		{name: "sdb", sectors: "209715200", model: "Virtual Disk", rotational: "0", removable: "0", holders: []string{"dm-0"}},
+		{name: "loop0", sectors: "1024", model: "", rotational: "0", removable: "0"},
+	})
+
+	devices, err := Discover(sysfsDir, map[string]string{"/dev/sda1": "/"})
+	require.NoError(t, err)
+	require.Len(t, devices, 3)
+
+	require.Equal(t, "nvme0n1", devices[0].Name)
+	require.Equal(t, "/dev/nvme0n1", devices[0].Path)
+	require.Equal(t, "Samsung SSD 980 PRO 2TB", devices[0].Model)
+	require.Equal(t, int64(3907029168*512), devices[0].Size)
+	require.False(t, devices[0].Rotational)
+	require.False(t, devices[0].Partitioned)
+
+	require.Equal(t, "sda", devices[1].Name)
+	require.True(t, devices[1].Rotational)
+	require.True(t, devices[1].Partitioned)
+	require.Equal(t, []string{"/"}, devices[1].PartitionMountPoints)
+
+	require.Equal(t, "sdb", devices[2].Name)
+	require.True(t, devices[2].Held)
+}
+
+func TestGetMountPoints(t *testing.T) {
+	mountsPath := filepath.Join(t.TempDir(), "mounts")
+	writeFakeSysfsFile(t, mountsPath, "/dev/sda1 / ext4 rw 0 0\n"+
+		"proc /proc proc rw 0 0\n"+
+		"/dev/sdc /var/lib/longhorn-sdc xfs rw 0 0\n"+
+		"/dev/sdc /var/lib/kubelet/pods/x xfs rw 0 0")
+
+	mountPoints, err := GetMountPoints(mountsPath)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"/dev/sda1": "/",
+		"/dev/sdc":  "/var/lib/longhorn-sdc",
+	}, mountPoints)
+}
+
+func TestMatchRules(t *testing.T) {
+	notRotational := false
+	rules := []types.DiskProvisioningRule{
+		{
+			Name:           "nvme",
+			DeviceSelector: types.DiskDeviceSelector{Paths: []string{"/dev/nvme*n1"}, MinSize: "1Ti"},
+			DiskType:       longhorn.DiskTypeBlock,
+		},
+		{
+			Name:           "ssd-filesystem",
+			DeviceSelector: types.DiskDeviceSelector{Rotational: &notRotational},
+			DiskType:       longhorn.DiskTypeFilesystem,
+		},
+	}
+
+	rule, diskPath, reason := MatchRules(rules, &BlockDevice{Name: "nvme0n1", Path: "/dev/nvme0n1", Size: 2 << 40})
+	require.NotNil(t, rule)
+	require.Equal(t, "nvme", rule.Name)
+	require.Equal(t, "/dev/nvme0n1", diskPath)
+	require.Empty(t, reason)
+
+	rule, diskPath, reason = MatchRules(rules, &BlockDevice{Name: "sdc", Path: "/dev/sdc", Size: 100 << 30, MountPoint: "/var/lib/longhorn-sdc"})
+	require.NotNil(t, rule)
+	require.Equal(t, "ssd-filesystem", rule.Name)
+	require.Equal(t, "/var/lib/longhorn-sdc", diskPath)
+	require.Empty(t, reason)
+
+	rule, diskPath, reason = MatchRules(rules, &BlockDevice{Name: "sdd", Path: "/dev/sdd", Size: 100 << 30, Partitioned: true, PartitionMountPoints: []string{"/mnt/sdd1"}})
+	require.NotNil(t, rule)
+	require.Equal(t, "ssd-filesystem", rule.Name)
+	require.Equal(t, "/mnt/sdd1", diskPath)
+	require.Empty(t, reason)
+
+	rule, _, reason = MatchRules(rules, &BlockDevice{Name: "sde", Path: "/dev/sde", Size: 100 << 30, Partitioned: true, PartitionMountPoints: []string{"/mnt/sde1", "/mnt/sde2"}})
+	require.Nil(t, rule)
+	require.Contains(t, reason, "rule ssd-filesystem: device has multiple mounted partitions /mnt/sde1, /mnt/sde2")
+
+	rule, _, reason = MatchRules(rules, &BlockDevice{Name: "sdf", Path: "/dev/sdf", Size: 100 << 30, Partitioned: true, PartitionMountPoints: []string{"/"}})
+	require.Nil(t, rule)
+	require.Contains(t, reason, "rule ssd-filesystem: device is mounted as the root filesystem")
+
+	rule, _, reason = MatchRules(rules, &BlockDevice{Name: "nvme1n1", Path: "/dev/nvme1n1", Size: 2 << 40, Partitioned: true})
+	require.Nil(t, rule)
+	require.Contains(t, reason, "rule nvme: device has partitions")
+	require.Contains(t, reason, "rule ssd-filesystem: device is not mounted")
+
+	rule, _, reason = MatchRules(rules, &BlockDevice{Name: "nvme2n1", Path: "/dev/nvme2n1", Size: 2 << 40, Signatures: []string{"LVM2_member"}})
+	require.Nil(t, rule)
+	require.Contains(t, reason, "rule nvme: device is not blank, found signatures LVM2_member")
+
+	rule, _, reason = MatchRules(rules, &BlockDevice{Name: "sda", Path: "/dev/sda", Size: 2 << 40, Rotational: true, MountPoint: "/"})
+	require.Nil(t, rule)
+	require.Contains(t, reason, "rule nvme: path /dev/sda does not match")
+	require.Contains(t, reason, "rule ssd-filesystem: rotational is true")
+
+	rule, _, reason = MatchRules(nil, &BlockDevice{Name: "sda", Path: "/dev/sda"})
+	require.Nil(t, rule)
+	require.Equal(t, "no disk provisioning rule applies to the node", reason)
+}
+
+func TestParseSignatures(t *testing.T) {
+	require.Empty(t, parseSignatures(""))
+	require.Equal(t, []string{"ext4"}, parseSignatures("ext4\n"))
+	require.Equal(t, []string{"gpt", "gpt", "PMBR"}, parseSignatures("gpt\ngpt\n\nPMBR\n"))
+}
@@ -0,0 +1,318 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	SeverityWarning  = "warning"
+	SeverityCritical = "critical"
+
+	CredentialKeyToken    = "token"
+	CredentialKeyUsername = "username"
+	CredentialKeyPassword = "password"
+
+	alertmanagerAlertsPath = "/api/v2/alerts"
+
+	sendTimeout = 30 * time.Second
+)
+
+// Notification is an event of a Longhorn resource sent to the sinks.
+type Notification struct {
+	Class    longhorn.NotificationEventClass `json:"class"`
+	Severity string                          `json:"severity"`
+	Kind     string                          `json:"kind"`
+	Name     string                          `json:"name"`
+	// KubernetesNamespace is the namespace of the workload using the volume of the resource, if any.
+	KubernetesNamespace string    `json:"kubernetesNamespace,omitempty"`
+	Message             string    `json:"message"`
+	Time                time.Time `json:"time"`
+}
+
+// Key identifies the same event of the same resource for the deduplication.
+func (n *Notification) Key() string {
+	return fmt.Sprintf("%s/%s/%s", n.Class, n.Kind, n.Name)
+}
+
+// AllEventClasses is the event classes subscribed by a sink without explicit event classes.
+var AllEventClasses = []longhorn.NotificationEventClass{
+	longhorn.NotificationEventClassVolumeDegraded,
+	longhorn.NotificationEventClassVolumeFaulted,
+	longhorn.NotificationEventClassBackupFailed,
+	longhorn.NotificationEventClassDiskUnschedulable,
+	longhorn.NotificationEventClassReplicaCorruption,
+	longhorn.NotificationEventClassSystemBackupFailed,
+}
+
+// IsSubscribed checks if the sink subscribes the event class and the notification passes the filter of the sink.
+func IsSubscribed(sink *longhorn.NotificationSink, n *Notification) (bool, error) {
+	if len(sink.Spec.EventClasses) > 0 {
+		subscribed := false
+		for _, class := range sink.Spec.EventClasses {
+			if class == n.Class {
+				subscribed = true
+				break
+			}
+		}
+		if !subscribed {
+			return false, nil
+		}
+	}
+
+	filter := sink.Spec.Filter
+	if filter.NamePattern != "" {
+		re, err := regexp.Compile(filter.NamePattern)
+		if err != nil {
+			return false, errors.Wrapf(err, "invalid name pattern %v", filter.NamePattern)
+		}
+		if !re.MatchString(n.Name) {
+			return false, nil
+		}
+	}
+	if len(filter.KubernetesNamespaces) > 0 && isVolumeEventClass(n.Class) {
+		for _, namespace := range filter.KubernetesNamespaces {
+			if namespace == n.KubernetesNamespace {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+func isVolumeEventClass(class longhorn.NotificationEventClass) bool {
+	switch class {
+	case longhorn.NotificationEventClassVolumeDegraded,
+		longhorn.NotificationEventClassVolumeFaulted,
+		longhorn.NotificationEventClassBackupFailed,
+		longhorn.NotificationEventClassReplicaCorruption:
+		return true
+	}
+	return false
+}
+
+// Sender delivers the notifications to a sink.
+type Sender interface {
+	Send(notifications []*Notification) error
+}
+
+// NewSender returns the sender of the sink. The credentials are the data of the credential secret of the sink.
+func NewSender(sink *longhorn.NotificationSink, credentials map[string][]byte) (Sender, error) {
+	switch sink.Spec.Type {
+	case longhorn.NotificationSinkTypeWebhook:
+		if err := ValidateURL(sink.Spec.URL); err != nil {
+			return nil, err
+		}
+		return &webhookSender{url: sink.Spec.URL, credentials: credentials, client: &http.Client{Timeout: sendTimeout}}, nil
+	case longhorn.NotificationSinkTypeAlertmanager:
+		if err := ValidateURL(sink.Spec.URL); err != nil {
+			return nil, err
+		}
+		return &alertmanagerSender{url: strings.TrimSuffix(sink.Spec.URL, "/") + alertmanagerAlertsPath, credentials: credentials, client: &http.Client{Timeout: sendTimeout}}, nil
+	case longhorn.NotificationSinkTypeSMTP:
+		if sink.Spec.SMTP == nil || sink.Spec.SMTP.Server == "" || sink.Spec.SMTP.From == "" || len(sink.Spec.SMTP.To) == 0 {
+			return nil, fmt.Errorf("the server, the sender and the recipients of the SMTP sink are required")
+		}
+		return &smtpSender{smtp: *sink.Spec.SMTP, credentials: credentials}, nil
+	}
+	return nil, fmt.Errorf("invalid notification sink type %v", sink.Spec.Type)
+}
+
+// ValidateURL checks if the URL of a webhook or Alertmanager sink is an absolute HTTP URL.
+func ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrapf(err, "invalid URL %v", rawURL)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid URL %v, an http or https URL is required", rawURL)
+	}
+	return nil
+}
+
+func setAuthorization(req *http.Request, credentials map[string][]byte) {
+	if token := string(credentials[CredentialKeyToken]); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return
+	}
+	if username := string(credentials[CredentialKeyUsername]); username != "" {
+		req.SetBasicAuth(username, string(credentials[CredentialKeyPassword]))
+	}
+}
+
+func postJSON(client *http.Client, url string, credentials map[string][]byte, body interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	setAuthorization(req, credentials)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected response %v from %v: %s", resp.Status, url, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
+
+type webhookSender struct {
+	url         string
+	credentials map[string][]byte
+	client      *http.Client
+}
+
+type webhookPayload struct {
+	Notifications []*Notification `json:"notifications"`
+}
+
+func (s *webhookSender) Send(notifications []*Notification) error {
+	return postJSON(s.client, s.url, s.credentials, &webhookPayload{Notifications: notifications})
+}
+
+type alertmanagerSender struct {
+	url         string
+	credentials map[string][]byte
+	client      *http.Client
+}
+
+type alertmanagerAlert struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	StartsAt    string            `json:"startsAt"`
+}
+
+func (s *alertmanagerSender) Send(notifications []*Notification) error {
+	alerts := []*alertmanagerAlert{}
+	for _, n := range notifications {
+		labels := map[string]string{
+			"alertname": "Longhorn" + strings.ToUpper(string(n.Class[:1])) + string(n.Class[1:]),
+			"severity":  n.Severity,
+			"kind":      n.Kind,
+			"name":      n.Name,
+		}
+		if n.KubernetesNamespace != "" {
+			labels["namespace"] = n.KubernetesNamespace
+		}
+		alerts = append(alerts, &alertmanagerAlert{
+			Labels:      labels,
+			Annotations: map[string]string{"description": n.Message},
+			StartsAt:    n.Time.UTC().Format(time.RFC3339),
+		})
+	}
+	return postJSON(s.client, s.url, s.credentials, alerts)
+}
+
+type smtpSender struct {
+	smtp        longhorn.NotificationSinkSMTP
+	credentials map[string][]byte
+}
+
+func (s *smtpSender) Send(notifications []*Notification) error {
+	host, _, err := net.SplitHostPort(s.smtp.Server)
+	if err != nil {
+		return errors.Wrapf(err, "invalid SMTP server %v", s.smtp.Server)
+	}
+	var auth smtp.Auth
+	if username := string(s.credentials[CredentialKeyUsername]); username != "" {
+		auth = smtp.PlainAuth("", username, string(s.credentials[CredentialKeyPassword]), host)
+	}
+	return sendMail(s.smtp.Server, host, auth, s.smtp.From, s.smtp.To, formatMail(s.smtp.From, s.smtp.To, notifications), sendTimeout)
+}
+
+// sendMail works as smtp.SendMail, but the whole conversation with the server is bounded by the timeout, so an
+// unresponsive server cannot block the delivery of the other sinks.
+func sendMail(addr, host string, auth smtp.Auth, from string, to []string, msg []byte, timeout time.Duration) error {
+	conn, err := (&net.Dialer{Timeout: timeout}).Dial("tcp", addr)
+	if err != nil {
+		return err
+	}
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		_ = conn.Close()
+		return err
+	}
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("SMTP server does not support AUTH")
+		}
+		if err := c.Auth(auth); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+	for _, addr := range to {
+		if err := c.Rcpt(addr); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+func formatMail(from string, to []string, notifications []*Notification) []byte {
+	subject := fmt.Sprintf("[Longhorn] %d notifications", len(notifications))
+	if len(notifications) == 1 {
+		subject = fmt.Sprintf("[Longhorn] %s %s %s", notifications[0].Class, notifications[0].Kind, notifications[0].Name)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&buf, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	for _, n := range notifications {
+		fmt.Fprintf(&buf, "%s [%s] %s %s %s: %s\r\n", n.Time.UTC().Format(time.RFC3339), n.Severity, n.Class, n.Kind, n.Name, n.Message)
+	}
+	return buf.Bytes()
+}
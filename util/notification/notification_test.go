@@ -0,0 +1,174 @@
+package notification
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func newTestNotification(class longhorn.NotificationEventClass, name string) *Notification {
+	return &Notification{
+		Class:               class,
+		Severity:            SeverityWarning,
+		Kind:                "Volume",
+		Name:                name,
+		KubernetesNamespace: "default",
+		Message:             "Volume " + name + " is degraded",
+		Time:                time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestIsSubscribed(t *testing.T) {
+	sink := &longhorn.NotificationSink{}
+	degraded := newTestNotification(longhorn.NotificationEventClassVolumeDegraded, "pvc-1")
+	diskUnschedulable := newTestNotification(longhorn.NotificationEventClassDiskUnschedulable, "node-1/disk-1")
+	diskUnschedulable.KubernetesNamespace = ""
+
+	subscribed, err := IsSubscribed(sink, degraded)
+	require.NoError(t, err)
+	require.True(t, subscribed)
+
+	sink.Spec.EventClasses = []longhorn.NotificationEventClass{longhorn.NotificationEventClassVolumeFaulted}
+	subscribed, err = IsSubscribed(sink, degraded)
+	require.NoError(t, err)
+	require.False(t, subscribed)
+
+	sink.Spec.EventClasses = nil
+	sink.Spec.Filter.NamePattern = "^pvc-"
+	subscribed, err = IsSubscribed(sink, degraded)
+	require.NoError(t, err)
+	require.True(t, subscribed)
+	subscribed, err = IsSubscribed(sink, diskUnschedulable)
+	require.NoError(t, err)
+	require.False(t, subscribed)
+
+	sink.Spec.Filter.NamePattern = ""
+	sink.Spec.Filter.KubernetesNamespaces = []string{"prod"}
+	subscribed, err = IsSubscribed(sink, degraded)
+	require.NoError(t, err)
+	require.False(t, subscribed)
+	subscribed, err = IsSubscribed(sink, diskUnschedulable)
+	require.NoError(t, err)
+	require.True(t, subscribed)
+
+	sink.Spec.Filter.NamePattern = "["
+	_, err = IsSubscribed(sink, degraded)
+	require.Error(t, err)
+}
+
+func TestThrottle(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	throttle := NewThrottle()
+	n1 := newTestNotification(longhorn.NotificationEventClassVolumeDegraded, "pvc-1")
+	n2 := newTestNotification(longhorn.NotificationEventClassVolumeDegraded, "pvc-2")
+	n3 := newTestNotification(longhorn.NotificationEventClassVolumeDegraded, "pvc-3")
+
+	require.Equal(t, VerdictAllowed, throttle.Allow(n1, now, 10*time.Minute, 2))
+	require.Equal(t, VerdictDeduplicated, throttle.Allow(n1, now.Add(5*time.Minute), 10*time.Minute, 2))
+	require.Equal(t, VerdictAllowed, throttle.Allow(n2, now.Add(5*time.Minute), 10*time.Minute, 2))
+	require.Equal(t, VerdictRateLimited, throttle.Allow(n3, now.Add(6*time.Minute), 10*time.Minute, 2))
+	require.Equal(t, VerdictRateLimited, throttle.Allow(n1, now.Add(20*time.Minute), 10*time.Minute, 2))
+	require.Equal(t, VerdictAllowed, throttle.Allow(n3, now.Add(61*time.Minute), 10*time.Minute, 2))
+
+	unlimited := NewThrottle()
+	require.Equal(t, VerdictAllowed, unlimited.Allow(n1, now, 0, 0))
+	require.Equal(t, VerdictAllowed, unlimited.Allow(n1, now, 0, 0))
+}
+
+func TestWebhookSender(t *testing.T) {
+	var payload webhookPayload
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+	}))
+	defer server.Close()
+
+	sink := &longhorn.NotificationSink{Spec: longhorn.NotificationSinkSpec{Type: longhorn.NotificationSinkTypeWebhook, URL: server.URL}}
+	sender, err := NewSender(sink, map[string][]byte{CredentialKeyToken: []byte("secret")})
+	require.NoError(t, err)
+
+	require.NoError(t, sender.Send([]*Notification{newTestNotification(longhorn.NotificationEventClassVolumeDegraded, "pvc-1")}))
+	require.Equal(t, "Bearer secret", authorization)
+	require.Len(t, payload.Notifications, 1)
+	require.Equal(t, "pvc-1", payload.Notifications[0].Name)
+}
+
+func TestAlertmanagerSender(t *testing.T) {
+	var alerts []alertmanagerAlert
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&alerts))
+	}))
+	defer server.Close()
+
+	sink := &longhorn.NotificationSink{Spec: longhorn.NotificationSinkSpec{Type: longhorn.NotificationSinkTypeAlertmanager, URL: server.URL + "/"}}
+	sender, err := NewSender(sink, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, sender.Send([]*Notification{newTestNotification(longhorn.NotificationEventClassVolumeDegraded, "pvc-1")}))
+	require.Equal(t, alertmanagerAlertsPath, path)
+	require.Len(t, alerts, 1)
+	require.Equal(t, "LonghornVolumeDegraded", alerts[0].Labels["alertname"])
+	require.Equal(t, "default", alerts[0].Labels["namespace"])
+	require.Equal(t, "2024-01-01T00:00:00Z", alerts[0].StartsAt)
+}
+
+func TestSenderError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	sink := &longhorn.NotificationSink{Spec: longhorn.NotificationSinkSpec{Type: longhorn.NotificationSinkTypeWebhook, URL: server.URL}}
+	sender, err := NewSender(sink, nil)
+	require.NoError(t, err)
+	err = sender.Send([]*Notification{newTestNotification(longhorn.NotificationEventClassVolumeDegraded, "pvc-1")})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unavailable")
+
+	_, err = NewSender(&longhorn.NotificationSink{Spec: longhorn.NotificationSinkSpec{Type: longhorn.NotificationSinkTypeWebhook, URL: "ftp://example.com"}}, nil)
+	require.Error(t, err)
+	_, err = NewSender(&longhorn.NotificationSink{Spec: longhorn.NotificationSinkSpec{Type: longhorn.NotificationSinkTypeSMTP}}, nil)
+	require.Error(t, err)
+}
+
+func TestFormatMail(t *testing.T) {
+	mail := string(formatMail("longhorn@example.com", []string{"ops@example.com", "dev@example.com"},
+		[]*Notification{newTestNotification(longhorn.NotificationEventClassVolumeDegraded, "pvc-1")}))
+
+	require.True(t, strings.HasPrefix(mail, "From: longhorn@example.com\r\n"))
+	require.Contains(t, mail, "To: ops@example.com, dev@example.com\r\n")
+	require.Contains(t, mail, "Subject: [Longhorn] volumeDegraded Volume pvc-1\r\n")
+	require.Contains(t, mail, "[warning] volumeDegraded Volume pvc-1: Volume pvc-1 is degraded")
+}
+
+func TestSendMailTimeout(t *testing.T) {
+	// The server accepts the connection but never greets
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = io.Copy(io.Discard, conn)
+	}()
+
+	start := time.Now()
+	err = sendMail(listener.Addr().String(), "127.0.0.1", nil, "longhorn@example.com", []string{"ops@example.com"}, []byte("test"), 200*time.Millisecond)
+	require.Error(t, err)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
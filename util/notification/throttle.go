@@ -0,0 +1,52 @@
+package notification
+
+import (
+	"time"
+)
+
+type Verdict string
+
+const (
+	VerdictAllowed      = Verdict("allowed")
+	VerdictDeduplicated = Verdict("deduplicated")
+	VerdictRateLimited  = Verdict("rateLimited")
+
+	rateLimitPeriod = time.Hour
+)
+
+// Throttle drops the duplicated notifications and the notifications exceeding the rate limit of a sink.
+type Throttle struct {
+	lastSent map[string]time.Time
+	sentAt   []time.Time
+}
+
+func NewThrottle() *Throttle {
+	return &Throttle{lastSent: map[string]time.Time{}}
+}
+
+// Allow decides if the notification can be sent at the time. A notification with the same key sent within the
+// deduplication window is deduplicated, and the notifications exceeding the rate limit per hour are dropped.
+// Zero disables the deduplication or the rate limit.
+func (t *Throttle) Allow(n *Notification, now time.Time, deduplicationWindow time.Duration, rateLimit int) Verdict {
+	for key, sentAt := range t.lastSent {
+		if now.Sub(sentAt) >= deduplicationWindow {
+			delete(t.lastSent, key)
+		}
+	}
+	for len(t.sentAt) > 0 && now.Sub(t.sentAt[0]) >= rateLimitPeriod {
+		t.sentAt = t.sentAt[1:]
+	}
+
+	if _, ok := t.lastSent[n.Key()]; ok && deduplicationWindow > 0 {
+		return VerdictDeduplicated
+	}
+	if rateLimit > 0 && len(t.sentAt) >= rateLimit {
+		return VerdictRateLimited
+	}
+
+	if deduplicationWindow > 0 {
+		t.lastSent[n.Key()] = now
+	}
+	t.sentAt = append(t.sentAt, now)
+	return VerdictAllowed
+}
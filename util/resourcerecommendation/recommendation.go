@@ -0,0 +1,169 @@
+package resourcerecommendation
+
+import (
+	"time"
+
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	// MinimumSamples is the minimum number of samples required to recommend the resource requests.
+	MinimumSamples = 10
+
+	// The estimated resource usage of an instance manager without any instance, and of each engine and replica.
+	BaseCPUMilli          = int64(50)
+	CPUMilliPerEngine     = int64(20)
+	CPUMilliPerReplica    = int64(10)
+	BaseMemoryBytes       = int64(64 * mib)
+	MemoryBytesPerEngine  = int64(32 * mib)
+	MemoryBytesPerReplica = int64(16 * mib)
+
+	// HeadroomPercentage is added on top of the observed peaks.
+	HeadroomPercentage = 20
+
+	// MaxAllocatablePercentage caps the recommended requests to the percentage of the node allocatable resources, the
+	// same as the maximum of the guaranteed instance manager CPU setting.
+	MaxAllocatablePercentage = 40
+
+	// SignificantChangePercentage is the minimum change of a recommended request to replace the current recommendation,
+	// so that the recommendation does not churn with every sample.
+	SignificantChangePercentage = 10
+
+	cpuMilliStep    = 10
+	memoryBytesStep = 16 * mib
+
+	mib = 1 << 20
+)
+
+// Sample is the resource usage of an instance manager at a point in time.
+type Sample struct {
+	Time             time.Time
+	CPUUsageMilli    int64
+	MemoryUsageBytes int64
+	EngineCount      int
+	ReplicaCount     int
+}
+
+// Allocatable is the allocatable resources of the node of an instance manager. Zero means unknown.
+type Allocatable struct {
+	CPUMilli    int64
+	MemoryBytes int64
+}
+
+// History is the rolling usage history of an instance manager.
+type History struct {
+	samples []Sample
+}
+
+// Add appends the sample if the last sample is older than the interval, then drops the samples older than the window.
+// It returns false if the sample is skipped.
+func (h *History) Add(sample Sample, interval, window time.Duration) bool {
+	if len(h.samples) > 0 && sample.Time.Sub(h.samples[len(h.samples)-1].Time) < interval {
+		return false
+	}
+	h.samples = append(h.samples, sample)
+
+	cutoff := sample.Time.Add(-window)
+	for len(h.samples) > 0 && h.samples[0].Time.Before(cutoff) {
+		h.samples = h.samples[1:]
+	}
+	return true
+}
+
+// Samples returns the samples in the history from the oldest to the newest.
+func (h *History) Samples() []Sample {
+	return h.samples
+}
+
+// Recommend returns the resource requests for the current number of engines and replicas. It returns nil if there
+// are not enough samples.
+//
+// The usage of each sample is projected to the current instances if the instances grew since the sample was taken,
+// and the recommended request is the larger one of the projected peak with the headroom and the estimate from the
+// instance counts, capped to MaxAllocatablePercentage of the node allocatable resources.
+func Recommend(samples []Sample, engineCount, replicaCount int, allocatable Allocatable) *longhorn.InstanceManagerResourceRecommendation {
+	if len(samples) < MinimumSamples {
+		return nil
+	}
+
+	var peakCPU, peakMemory float64
+	for _, sample := range samples {
+		peakCPU = max(peakCPU, projectUsage(sample, sample.CPUUsageMilli, engineCount, replicaCount,
+			BaseCPUMilli, CPUMilliPerEngine, CPUMilliPerReplica))
+		peakMemory = max(peakMemory, projectUsage(sample, sample.MemoryUsageBytes, engineCount, replicaCount,
+			BaseMemoryBytes, MemoryBytesPerEngine, MemoryBytesPerReplica))
+	}
+
+	estimatedCPU := BaseCPUMilli + CPUMilliPerEngine*int64(engineCount) + CPUMilliPerReplica*int64(replicaCount)
+	estimatedMemory := BaseMemoryBytes + MemoryBytesPerEngine*int64(engineCount) + MemoryBytesPerReplica*int64(replicaCount)
+
+	cpuRequest, isCPUCapped := capToAllocatable(roundUp(max(withHeadroom(peakCPU), estimatedCPU), cpuMilliStep), allocatable.CPUMilli)
+	memoryRequest, isMemoryCapped := capToAllocatable(roundUp(max(withHeadroom(peakMemory), estimatedMemory), memoryBytesStep), allocatable.MemoryBytes)
+
+	return &longhorn.InstanceManagerResourceRecommendation{
+		CPURequest:      cpuRequest,
+		MemoryRequest:   memoryRequest,
+		Capped:          isCPUCapped || isMemoryCapped,
+		PeakCPUUsage:    int64(peakCPU),
+		PeakMemoryUsage: int64(peakMemory),
+		EngineCount:     engineCount,
+		ReplicaCount:    replicaCount,
+		SampleCount:     len(samples),
+		LastUpdatedAt:   util.Now(),
+	}
+}
+
+// projectUsage projects the usage of the sample to the current instances. Only the usage above the baseline of an
+// instance manager grows with the instances. The usage of a sample without any instance is the baseline only, so the
+// estimated usage of the current instances is added to it.
+func projectUsage(sample Sample, usage int64, engineCount, replicaCount int, base, perEngine, perReplica int64) float64 {
+	sampleInstanceCount := sample.EngineCount + sample.ReplicaCount
+	instanceCount := engineCount + replicaCount
+	if instanceCount <= sampleInstanceCount {
+		return float64(usage)
+	}
+	if sampleInstanceCount == 0 {
+		return float64(usage + perEngine*int64(engineCount) + perReplica*int64(replicaCount))
+	}
+
+	instanceUsage := max(usage-base, 0)
+	return float64(usage-instanceUsage) + float64(instanceUsage)*float64(instanceCount)/float64(sampleInstanceCount)
+}
+
+// capToAllocatable returns the request capped to MaxAllocatablePercentage of the allocatable resource, and whether it
+// is capped.
+func capToAllocatable(request, allocatable int64) (int64, bool) {
+	if allocatable <= 0 {
+		return request, false
+	}
+	if limit := allocatable * MaxAllocatablePercentage / 100; request > limit {
+		return limit, true
+	}
+	return request, false
+}
+
+// IsSignificantChange returns true if the recommended requests of b differ from a by at least SignificantChangePercentage.
+func IsSignificantChange(a, b *longhorn.InstanceManagerResourceRecommendation) bool {
+	if a == nil || b == nil {
+		return a != b
+	}
+	return isSignificantChange(a.CPURequest, b.CPURequest) || isSignificantChange(a.MemoryRequest, b.MemoryRequest)
+}
+
+func isSignificantChange(a, b int64) bool {
+	diff := b - a
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff*100 >= a*SignificantChangePercentage
+}
+
+func withHeadroom(value float64) int64 {
+	return int64(value * (100 + HeadroomPercentage) / 100)
+}
+
+func roundUp(value, step int64) int64 {
+	return (value + step - 1) / step * step
+}
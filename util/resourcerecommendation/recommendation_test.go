@@ -0,0 +1,106 @@
+package resourcerecommendation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func newTestSamples(count int, cpu, memory int64, engineCount, replicaCount int) []Sample {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	samples := []Sample{}
+	for i := 0; i < count; i++ {
+		samples = append(samples, Sample{
+			Time:             start.Add(time.Duration(i) * time.Minute),
+			CPUUsageMilli:    cpu,
+			MemoryUsageBytes: memory,
+			EngineCount:      engineCount,
+			ReplicaCount:     replicaCount,
+		})
+	}
+	return samples
+}
+
+func TestHistoryAdd(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	history := &History{}
+
+	require.True(t, history.Add(Sample{Time: start, CPUUsageMilli: 1}, time.Minute, time.Hour))
+	require.False(t, history.Add(Sample{Time: start.Add(30 * time.Second), CPUUsageMilli: 2}, time.Minute, time.Hour))
+	require.True(t, history.Add(Sample{Time: start.Add(30 * time.Minute), CPUUsageMilli: 3}, time.Minute, time.Hour))
+	require.True(t, history.Add(Sample{Time: start.Add(90 * time.Minute), CPUUsageMilli: 4}, time.Minute, time.Hour))
+
+	samples := history.Samples()
+	require.Len(t, samples, 2)
+	require.Equal(t, int64(3), samples[0].CPUUsageMilli)
+	require.Equal(t, int64(4), samples[1].CPUUsageMilli)
+}
+
+func TestRecommend(t *testing.T) {
+	require.Nil(t, Recommend(newTestSamples(MinimumSamples-1, 100, 100*mib, 2, 2), 2, 2, Allocatable{}))
+
+	// The observed CPU peak with the headroom and the memory estimate from the instance counts
+	samples := newTestSamples(MinimumSamples, 100, 100*mib, 2, 2)
+	recommendation := Recommend(samples, 2, 2, Allocatable{})
+	require.NotNil(t, recommendation)
+	require.Equal(t, int64(120), recommendation.CPURequest)
+	require.Equal(t, int64(160*mib), recommendation.MemoryRequest)
+	require.Equal(t, int64(100), recommendation.PeakCPUUsage)
+	require.Equal(t, MinimumSamples, recommendation.SampleCount)
+	require.False(t, recommendation.Capped)
+
+	// Only the usage above the baseline is scaled up by the growth of the instances
+	recommendation = Recommend(samples, 4, 4, Allocatable{})
+	require.Equal(t, int64(180), recommendation.CPURequest)
+	require.Equal(t, int64(256*mib), recommendation.MemoryRequest)
+	require.Equal(t, int64(150), recommendation.PeakCPUUsage)
+
+	// The peaks are not scaled down when the instances are removed
+	recommendation = Recommend(samples, 1, 0, Allocatable{})
+	require.Equal(t, int64(120), recommendation.CPURequest)
+	require.Equal(t, int64(128*mib), recommendation.MemoryRequest)
+
+	samples[3].CPUUsageMilli = 305
+	recommendation = Recommend(samples, 2, 2, Allocatable{})
+	require.Equal(t, int64(370), recommendation.CPURequest)
+}
+
+func TestRecommendWithoutInstancesInSamples(t *testing.T) {
+	// The baseline is not multiplied by the instance count
+	samples := newTestSamples(MinimumSamples, 300, 200*mib, 0, 0)
+	recommendation := Recommend(samples, 10, 10, Allocatable{})
+	require.NotNil(t, recommendation)
+	require.Equal(t, int64(600), recommendation.PeakCPUUsage)
+	require.Equal(t, int64(720), recommendation.CPURequest)
+	require.Equal(t, int64(680*mib), recommendation.PeakMemoryUsage)
+	require.Equal(t, int64(816*mib), recommendation.MemoryRequest)
+	require.False(t, recommendation.Capped)
+}
+
+func TestRecommendCappedToAllocatable(t *testing.T) {
+	samples := newTestSamples(MinimumSamples, 3000, 4096*mib, 2, 2)
+	allocatable := Allocatable{CPUMilli: 4000, MemoryBytes: 16384 * mib}
+
+	recommendation := Recommend(samples, 2, 2, allocatable)
+	require.Equal(t, int64(1600), recommendation.CPURequest)
+	require.Equal(t, int64(4928*mib), recommendation.MemoryRequest)
+	require.True(t, recommendation.Capped)
+
+	allocatable.CPUMilli = 16000
+	recommendation = Recommend(samples, 2, 2, allocatable)
+	require.Equal(t, int64(3600), recommendation.CPURequest)
+	require.False(t, recommendation.Capped)
+}
+
+func TestIsSignificantChange(t *testing.T) {
+	a := &longhorn.InstanceManagerResourceRecommendation{CPURequest: 100, MemoryRequest: 160 * mib}
+
+	require.False(t, IsSignificantChange(a, &longhorn.InstanceManagerResourceRecommendation{CPURequest: 105, MemoryRequest: 160 * mib}))
+	require.True(t, IsSignificantChange(a, &longhorn.InstanceManagerResourceRecommendation{CPURequest: 90, MemoryRequest: 160 * mib}))
+	require.True(t, IsSignificantChange(a, &longhorn.InstanceManagerResourceRecommendation{CPURequest: 100, MemoryRequest: 192 * mib}))
+	require.True(t, IsSignificantChange(nil, a))
+	require.False(t, IsSignificantChange(nil, nil))
+}
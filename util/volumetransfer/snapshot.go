@@ -0,0 +1,196 @@
+package volumetransfer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	snapshotFilePrefix = "volume-snap-"
+	diskFileSuffix     = ".img"
+	diskMetaFileSuffix = ".meta"
+
+	// maxSnapshotChainLength guards against a corrupted chain that loops. A replica holds at most 250 snapshots.
+	maxSnapshotChainLength = 256
+)
+
+// GetSnapshotFileName returns the disk file of the snapshot in a v1 replica directory.
+func GetSnapshotFileName(snapshotName string) string {
+	return snapshotFilePrefix + snapshotName + diskFileSuffix
+}
+
+// diskMeta is the metadata a v1 replica keeps next to each disk file.
+type diskMeta struct {
+	Parent string `json:"Parent"`
+}
+
+// extent is a range of a disk file that holds data.
+type extent struct {
+	offset int64
+	length int64
+}
+
+type snapshotDisk struct {
+	name    string
+	file    *os.File
+	extents []extent
+}
+
+// SnapshotChain is the disk files of a v1 replica a snapshot consists of. Each disk file only holds the data written
+// between its parent snapshot and itself, so the data of a block is in the newest disk file holding the block.
+type SnapshotChain struct {
+	size int64
+	// disks are ordered from the oldest one to the snapshot.
+	disks []*snapshotDisk
+}
+
+// OpenSnapshotChain opens the disk files of the snapshot and of its ancestors in the replica directory. The open files
+// keep the data of the snapshot even if the replica coalesces the files of a deleted snapshot while it is read, since
+// coalescing only copies data that a newer disk file of the chain also holds.
+func OpenSnapshotChain(replicaDirectory, snapshotName string, size int64) (_ *SnapshotChain, err error) {
+	chain := &SnapshotChain{size: size}
+	defer func() {
+		if err != nil {
+			chain.Close()
+		}
+	}()
+
+	for name := GetSnapshotFileName(snapshotName); name != ""; {
+		if len(chain.disks) >= maxSnapshotChainLength {
+			return nil, fmt.Errorf("snapshot chain of %v in %v is longer than %v", snapshotName, replicaDirectory, maxSnapshotChainLength)
+		}
+
+		path := filepath.Join(replicaDirectory, name)
+		content, err := os.ReadFile(path + diskMetaFileSuffix)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read the metadata of disk %v", path)
+		}
+		meta := &diskMeta{}
+		if err := json.Unmarshal(content, meta); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the metadata of disk %v", path)
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to open disk %v", path)
+		}
+		disk := &snapshotDisk{name: name, file: f}
+		chain.disks = append([]*snapshotDisk{disk}, chain.disks...)
+		if disk.extents, err = getDataExtents(f); err != nil {
+			return nil, err
+		}
+
+		name = meta.Parent
+	}
+	return chain, nil
+}
+
+// getDataExtents returns the ranges of the sparse file that hold data.
+func getDataExtents(f *os.File) ([]extent, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to stat disk %v", f.Name())
+	}
+
+	fd := int(f.Fd())
+	extents := []extent{}
+	for offset := int64(0); offset < info.Size(); {
+		start, err := unix.Seek(fd, offset, unix.SEEK_DATA)
+		if err != nil {
+			if errors.Is(err, unix.ENXIO) {
+				break
+			}
+			return nil, errors.Wrapf(err, "failed to seek data of disk %v", f.Name())
+		}
+		end, err := unix.Seek(fd, start, unix.SEEK_HOLE)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to seek hole of disk %v", f.Name())
+		}
+		extents = append(extents, extent{offset: start, length: end - start})
+		offset = end
+	}
+	return extents, nil
+}
+
+func (c *SnapshotChain) Close() {
+	for _, disk := range c.disks {
+		_ = disk.file.Close()
+	}
+}
+
+// GetChangedChunks returns the chunks written after the base snapshot, which the destination already holds. With an
+// empty base snapshot, the destination holds an empty volume. If the base snapshot is not in the chain anymore, for
+// example because it is deleted, all chunks are returned and full is true.
+func (c *SnapshotChain) GetChangedChunks(baseSnapshotName string) (chunks []int, full bool) {
+	count := GetChunkCount(c.size)
+
+	start := 0
+	if baseSnapshotName != "" {
+		start = -1
+		base := GetSnapshotFileName(baseSnapshotName)
+		for i, disk := range c.disks {
+			if disk.name == base {
+				start = i + 1
+				break
+			}
+		}
+	}
+	if start < 0 {
+		chunks = make([]int, count)
+		for i := range chunks {
+			chunks[i] = i
+		}
+		return chunks, true
+	}
+
+	changed := make([]bool, count)
+	for _, disk := range c.disks[start:] {
+		for _, e := range disk.extents {
+			first := int(e.offset / ChunkSize)
+			last := min(int((e.offset+e.length-1)/ChunkSize), count-1)
+			for i := first; i <= last; i++ {
+				changed[i] = true
+			}
+		}
+	}
+	for i, isChanged := range changed {
+		if isChanged {
+			chunks = append(chunks, i)
+		}
+	}
+	return chunks, false
+}
+
+// ReadChunk reads the chunk of the snapshot into the buffer and returns the chunk data, and whether any disk file holds
+// data of the chunk.
+func (c *SnapshotChain) ReadChunk(index int, buf []byte) ([]byte, bool, error) {
+	offset := int64(index) * ChunkSize
+	length := getChunkLength(c.size, index)
+	data := buf[:length]
+	clear(data)
+
+	hasData := false
+	// The newer disk files overwrite the data of the older ones
+	for _, disk := range c.disks {
+		i := sort.Search(len(disk.extents), func(i int) bool {
+			return disk.extents[i].offset+disk.extents[i].length > offset
+		})
+		for ; i < len(disk.extents) && disk.extents[i].offset < offset+length; i++ {
+			start := max(disk.extents[i].offset, offset)
+			end := min(disk.extents[i].offset+disk.extents[i].length, offset+length)
+			if _, err := disk.file.ReadAt(data[start-offset:end-offset], start); err != nil && err != io.EOF {
+				return nil, false, errors.Wrapf(err, "failed to read chunk %v from disk %v", index, disk.name)
+			}
+			hasData = true
+		}
+	}
+	return data, hasData, nil
+}
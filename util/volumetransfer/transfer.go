@@ -0,0 +1,292 @@
+package volumetransfer
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// ChunkSize is the unit the volume data is compared and streamed in.
+	ChunkSize = 2 * 1024 * 1024
+
+	CredentialKeyToken = "token"
+	// CredentialKeyCACert is the optional CA certificate the source verifies the destination endpoint with.
+	CredentialKeyCACert = "ca.crt"
+
+	HeaderChunkChecksum = "X-Longhorn-Chunk-Checksum"
+	// HeaderChunkZero marks a chunk without data, which is sent without a body.
+	HeaderChunkZero = "X-Longhorn-Chunk-Zero"
+
+	ChunkPathFormat    = "/v1/volumetransfers/%s/chunks/%d"
+	ProgressPathFormat = "/v1/volumetransfers/%s/progress"
+	FinalizePathFormat = "/v1/volumetransfers/%s/finalize"
+
+	// requestTimeout covers writing a chunk or flushing the destination volume.
+	requestTimeout = 5 * time.Minute
+
+	progressReportInterval = 5 * time.Second
+)
+
+// Progress is the state of the sync the source reports to the destination.
+type Progress struct {
+	Progress         int    `json:"progress"`
+	TransferredBytes int64  `json:"transferredBytes"`
+	Throughput       string `json:"throughput"`
+	SyncCount        int    `json:"syncCount"`
+}
+
+// SyncResult is the outcome of a sync.
+type SyncResult struct {
+	ChangedChunks    int
+	TransferredBytes int64
+	Duration         time.Duration
+	// Full means all chunks are sent since the base snapshot is not in the chain anymore.
+	Full bool
+}
+
+// GetChunkCount returns the number of chunks of a volume of the size.
+func GetChunkCount(size int64) int {
+	return int((size + ChunkSize - 1) / ChunkSize)
+}
+
+func getChunkLength(size int64, index int) int64 {
+	offset := int64(index) * ChunkSize
+	return min(ChunkSize, size-offset)
+}
+
+// ChecksumChunk returns the checksum of the chunk data.
+func ChecksumChunk(data []byte) string {
+	checksum := sha256.Sum256(data)
+	return hex.EncodeToString(checksum[:])
+}
+
+// WriteChunk verifies the chunk data against the checksum and writes it to the device.
+func WriteChunk(device string, size int64, index int, data []byte, checksum string) error {
+	if index < 0 || index >= GetChunkCount(size) {
+		return fmt.Errorf("chunk %v is out of the range of the volume size %v", index, size)
+	}
+	if int64(len(data)) != getChunkLength(size, index) {
+		return fmt.Errorf("chunk %v has length %v, expected %v", index, len(data), getChunkLength(size, index))
+	}
+	if ChecksumChunk(data) != checksum {
+		return fmt.Errorf("chunk %v does not match checksum %v", index, checksum)
+	}
+	return writeChunk(device, index, data)
+}
+
+// WriteZeroChunk zeroes the chunk of the device.
+func WriteZeroChunk(device string, size int64, index int) error {
+	if index < 0 || index >= GetChunkCount(size) {
+		return fmt.Errorf("chunk %v is out of the range of the volume size %v", index, size)
+	}
+	return writeChunk(device, index, make([]byte, getChunkLength(size, index)))
+}
+
+// writeChunk writes the chunk through to the replicas before it returns, since the source only sends the chunks
+// changed after the last sync next time.
+func writeChunk(device string, index int, data []byte) error {
+	f, err := os.OpenFile(device, os.O_WRONLY|unix.O_DSYNC, 0)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open device %v", device)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteAt(data, int64(index)*ChunkSize); err != nil {
+		return errors.Wrapf(err, "failed to write chunk %v to device %v", index, device)
+	}
+	return nil
+}
+
+// FlushDevice flushes the written chunks of the device to the replicas.
+func FlushDevice(device string) error {
+	f, err := os.OpenFile(device, os.O_WRONLY, 0)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open device %v", device)
+	}
+	defer f.Close()
+
+	return errors.Wrapf(f.Sync(), "failed to flush device %v", device)
+}
+
+// Authenticate checks the bearer token of the request against the token of the transfer.
+func Authenticate(req *http.Request, token string) error {
+	requestToken, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" || subtle.ConstantTimeCompare([]byte(requestToken), []byte(token)) != 1 {
+		return fmt.Errorf("invalid volume transfer credential")
+	}
+	return nil
+}
+
+// FormatThroughput returns the throughput of the bytes transferred in the duration.
+func FormatThroughput(bytes int64, duration time.Duration) string {
+	if duration <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%.1f MiB/s", float64(bytes)/(1024*1024)/duration.Seconds())
+}
+
+// Client sends the data of the source volume to the receiving endpoint of the destination cluster.
+type Client struct {
+	url          string
+	transferName string
+	token        string
+	httpClient   *http.Client
+}
+
+// NewClient returns the client of the destination endpoint. The endpoint is verified with the CA certificate if it
+// is given, otherwise with the system CA certificates.
+func NewClient(remoteURL, transferName, token string, caCert []byte) (*Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(caCert) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("invalid CA certificate of the destination endpoint")
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+	return &Client{
+		url:          strings.TrimSuffix(remoteURL, "/"),
+		transferName: transferName,
+		token:        token,
+		httpClient:   &http.Client{Timeout: requestTimeout, Transport: transport},
+	}, nil
+}
+
+func (c *Client) PutChunk(ctx context.Context, index int, data []byte) error {
+	header := map[string]string{
+		HeaderChunkChecksum: ChecksumChunk(data),
+		"Content-Type":      "application/octet-stream",
+	}
+	if err := c.do(ctx, http.MethodPut, fmt.Sprintf(ChunkPathFormat, c.transferName, index), bytes.NewReader(data), header, nil); err != nil {
+		return errors.Wrapf(err, "failed to send chunk %v", index)
+	}
+	return nil
+}
+
+func (c *Client) PutZeroChunk(ctx context.Context, index int) error {
+	header := map[string]string{HeaderChunkZero: "true"}
+	if err := c.do(ctx, http.MethodPut, fmt.Sprintf(ChunkPathFormat, c.transferName, index), nil, header, nil); err != nil {
+		return errors.Wrapf(err, "failed to send zero chunk %v", index)
+	}
+	return nil
+}
+
+func (c *Client) ReportProgress(ctx context.Context, progress *Progress) error {
+	body, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	header := map[string]string{"Content-Type": "application/json"}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf(ProgressPathFormat, c.transferName), bytes.NewReader(body), header, nil); err != nil {
+		return errors.Wrap(err, "failed to report the progress")
+	}
+	return nil
+}
+
+// Finalize tells the destination that the final sync is done.
+func (c *Client) Finalize(ctx context.Context) error {
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf(FinalizePathFormat, c.transferName), nil, nil, nil); err != nil {
+		return errors.Wrap(err, "failed to finalize the destination volume")
+	}
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body io.Reader, header map[string]string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	for key, value := range header {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("destination responded %v: %v", resp.Status, strings.TrimSpace(string(message)))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// Sync sends the chunks of the snapshot written after the base snapshot, which the destination already holds. If the
+// base snapshot is not in the chain anymore, all chunks are sent and the chunks without data are zeroed. onProgress is
+// called with the progress in percentage and the bytes sent so far.
+func Sync(ctx context.Context, client *Client, chain *SnapshotChain, baseSnapshotName string, onProgress func(progress int, transferredBytes int64)) (*SyncResult, error) {
+	startedAt := time.Now()
+
+	changed, full := chain.GetChangedChunks(baseSnapshotName)
+	result := &SyncResult{ChangedChunks: len(changed), Full: full}
+	buf := make([]byte, ChunkSize)
+	for i, index := range changed {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		data, hasData, err := chain.ReadChunk(index, buf)
+		if err != nil {
+			return nil, err
+		}
+		if hasData {
+			if err := client.PutChunk(ctx, index, data); err != nil {
+				return nil, err
+			}
+			result.TransferredBytes += int64(len(data))
+		} else if err := client.PutZeroChunk(ctx, index); err != nil {
+			return nil, err
+		}
+		onProgress((i+1)*100/len(changed), result.TransferredBytes)
+	}
+	if len(changed) == 0 {
+		onProgress(100, 0)
+	}
+
+	result.Duration = time.Since(startedAt)
+	return result, nil
+}
+
+// ProgressReporter reports the progress to the destination at most once per interval.
+type ProgressReporter struct {
+	client         *Client
+	lastReportedAt time.Time
+}
+
+func NewProgressReporter(client *Client) *ProgressReporter {
+	return &ProgressReporter{client: client}
+}
+
+// Report sends the progress if the interval passed or force is set. The errors are returned to the caller to log,
+// since a missed report does not break the transfer.
+func (r *ProgressReporter) Report(ctx context.Context, progress *Progress, force bool) error {
+	if !force && time.Since(r.lastReportedAt) < progressReportInterval {
+		return nil
+	}
+	r.lastReportedAt = time.Now()
+	return r.client.ReportProgress(ctx, progress)
+}
@@ -0,0 +1,216 @@
+package volumetransfer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testToken        = "secret-token"
+	testTransferName = "transfer-1"
+)
+
+// newTestReceiver serves the receiving endpoint of the destination over TLS with a regular file as the device, and
+// returns the client trusting the endpoint.
+func newTestReceiver(t *testing.T, device string, size int64, token string) (*Client, *int) {
+	received := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /v1/volumetransfers/"+testTransferName+"/chunks/{index}", func(w http.ResponseWriter, req *http.Request) {
+		if err := Authenticate(req, testToken); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		var index int
+		if _, err := fmt.Sscanf(req.PathValue("index"), "%d", &index); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if req.Header.Get(HeaderChunkZero) == "true" {
+			err := WriteZeroChunk(device, size, index)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+			}
+			received++
+			return
+		}
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := WriteChunk(device, size, index, data, req.Header.Get(HeaderChunkChecksum)); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		received++
+	})
+	server := httptest.NewTLSServer(mux)
+	t.Cleanup(server.Close)
+
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	client, err := NewClient(server.URL+"/", testTransferName, token, caCert)
+	require.NoError(t, err)
+	return client, &received
+}
+
+func newTestDevice(t *testing.T, name string, data []byte) string {
+	device := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(device, data, 0600))
+	return device
+}
+
+// writeTestSnapshot creates the sparse disk file of the snapshot in the replica directory with the data written at the
+// offsets, and applies the writes to the expected volume data.
+func writeTestSnapshot(t *testing.T, replicaDirectory, name, parent string, size int64, writes map[int64][]byte, expected []byte) {
+	path := filepath.Join(replicaDirectory, GetSnapshotFileName(name))
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, f.Truncate(size))
+	for offset, data := range writes {
+		_, err := f.WriteAt(data, offset)
+		require.NoError(t, err)
+		copy(expected[offset:], data)
+	}
+
+	parentFile := ""
+	if parent != "" {
+		parentFile = GetSnapshotFileName(parent)
+	}
+	meta, err := json.Marshal(map[string]interface{}{"Name": GetSnapshotFileName(name), "Parent": parentFile})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path+diskMetaFileSuffix, meta, 0600))
+}
+
+func newTestData(t *testing.T, length int) []byte {
+	data := make([]byte, length)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+	return data
+}
+
+func syncTestSnapshot(t *testing.T, client *Client, replicaDirectory, snapshotName, baseSnapshotName string, size int64) (*SyncResult, error) {
+	chain, err := OpenSnapshotChain(replicaDirectory, snapshotName, size)
+	require.NoError(t, err)
+	defer chain.Close()
+
+	lastProgress := 0
+	result, err := Sync(context.Background(), client, chain, baseSnapshotName, func(progress int, transferredBytes int64) {
+		require.GreaterOrEqual(t, progress, lastProgress)
+		lastProgress = progress
+	})
+	if err == nil {
+		require.Equal(t, 100, lastProgress)
+	}
+	return result, err
+}
+
+func TestSync(t *testing.T) {
+	size := int64(3*ChunkSize + 1024)
+	replicaDirectory := t.TempDir()
+	expected := make([]byte, size)
+
+	destination := newTestDevice(t, "destination", make([]byte, size))
+	client, received := newTestReceiver(t, destination, size, testToken)
+
+	// The first sync sends the chunks holding data to the empty destination volume
+	writeTestSnapshot(t, replicaDirectory, "s1", "", size, map[int64][]byte{
+		10:              newTestData(t, 4096),
+		2*ChunkSize + 5: newTestData(t, 8192),
+	}, expected)
+	result, err := syncTestSnapshot(t, client, replicaDirectory, "s1", "", size)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.ChangedChunks)
+	require.False(t, result.Full)
+	require.Equal(t, int64(2*ChunkSize), result.TransferredBytes)
+	destinationData, err := os.ReadFile(destination)
+	require.NoError(t, err)
+	require.Equal(t, expected, destinationData)
+
+	// The incremental sync only sends the chunks written after the base snapshot
+	writeTestSnapshot(t, replicaDirectory, "s2", "s1", size, map[int64][]byte{
+		2*ChunkSize + 4096: newTestData(t, 4096),
+		3 * ChunkSize:      newTestData(t, 1024),
+	}, expected)
+	result, err = syncTestSnapshot(t, client, replicaDirectory, "s2", "s1", size)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.ChangedChunks)
+	require.Equal(t, int64(ChunkSize+1024), result.TransferredBytes)
+	require.Equal(t, 4, *received)
+	destinationData, err = os.ReadFile(destination)
+	require.NoError(t, err)
+	require.Equal(t, expected, destinationData)
+
+	// Nothing is sent if nothing is written after the base snapshot
+	writeTestSnapshot(t, replicaDirectory, "s3", "s2", size, nil, expected)
+	result, err = syncTestSnapshot(t, client, replicaDirectory, "s3", "s2", size)
+	require.NoError(t, err)
+	require.Equal(t, 0, result.ChangedChunks)
+
+	// All chunks are sent if the base snapshot is gone, and the chunks without data are zeroed
+	garbage := newTestData(t, 100)
+	f, err := os.OpenFile(destination, os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteAt(garbage, ChunkSize+10)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	result, err = syncTestSnapshot(t, client, replicaDirectory, "s3", "deleted", size)
+	require.NoError(t, err)
+	require.True(t, result.Full)
+	require.Equal(t, 4, result.ChangedChunks)
+	require.Equal(t, int64(2*ChunkSize+1024), result.TransferredBytes)
+	destinationData, err = os.ReadFile(destination)
+	require.NoError(t, err)
+	require.Equal(t, expected, destinationData)
+
+	wrongClient, _ := newTestReceiver(t, destination, size, "wrong")
+	_, err = syncTestSnapshot(t, wrongClient, replicaDirectory, "s3", "", size)
+	require.ErrorContains(t, err, "401")
+
+	_, err = OpenSnapshotChain(replicaDirectory, "missing", size)
+	require.Error(t, err)
+}
+
+func TestNewClient(t *testing.T) {
+	_, err := NewClient("https://longhorn.example.com", testTransferName, testToken, []byte("invalid"))
+	require.Error(t, err)
+	_, err = NewClient("https://longhorn.example.com", testTransferName, testToken, nil)
+	require.NoError(t, err)
+}
+
+func TestWriteChunk(t *testing.T) {
+	size := int64(ChunkSize + 10)
+	device := newTestDevice(t, "device", make([]byte, size))
+	data := []byte("0123456789")
+
+	require.NoError(t, WriteChunk(device, size, 1, data, ChecksumChunk(data)))
+	require.Error(t, WriteChunk(device, size, 1, data, ChecksumChunk([]byte("other"))))
+	require.Error(t, WriteChunk(device, size, 0, data, ChecksumChunk(data)))
+	require.Error(t, WriteChunk(device, size, 2, data, ChecksumChunk(data)))
+
+	require.NoError(t, WriteZeroChunk(device, size, 1))
+	require.Error(t, WriteZeroChunk(device, size, 2))
+}
+
+func TestAuthenticate(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	require.Error(t, Authenticate(req, testToken))
+
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	require.NoError(t, Authenticate(req, testToken))
+	require.Error(t, Authenticate(req, ""))
+
+	req.Header.Set("Authorization", "Bearer other")
+	require.Error(t, Authenticate(req, testToken))
+}
@@ -0,0 +1,76 @@
+package dataengineconversion
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+	"github.com/longhorn/longhorn-manager/webhook/common"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	werror "github.com/longhorn/longhorn-manager/webhook/error"
+)
+
+type dataEngineConversionMutator struct {
+	admission.DefaultMutator
+	ds *datastore.DataStore
+}
+
+func NewMutator(ds *datastore.DataStore) admission.Mutator {
+	return &dataEngineConversionMutator{ds: ds}
+}
+
+func (m *dataEngineConversionMutator) Resource() admission.Resource {
+	return admission.Resource{
+		Name:       "dataengineconversions",
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   longhorn.SchemeGroupVersion.Group,
+		APIVersion: longhorn.SchemeGroupVersion.Version,
+		ObjectType: &longhorn.DataEngineConversion{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Create,
+			admissionregv1.Update,
+		},
+	}
+}
+
+func (m *dataEngineConversionMutator) Create(request *admission.Request, newObj runtime.Object) (admission.PatchOps, error) {
+	return mutate(newObj)
+}
+
+func (m *dataEngineConversionMutator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) (admission.PatchOps, error) {
+	return mutate(newObj)
+}
+
+// mutate contains functionality shared by Create and Update.
+func mutate(newObj runtime.Object) (admission.PatchOps, error) {
+	conversion, ok := newObj.(*longhorn.DataEngineConversion)
+	if !ok {
+		return nil, werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.DataEngineConversion", newObj), "")
+	}
+
+	var patchOps admission.PatchOps
+
+	if conversion.Spec.TargetVolumeName == "" && conversion.Spec.VolumeName != "" && conversion.Spec.TargetDataEngine != "" {
+		targetVolumeName := types.GetDataEngineConversionTargetVolumeName(conversion.Spec.VolumeName, conversion.Spec.TargetDataEngine)
+		patchOps = append(patchOps, fmt.Sprintf(`{"op": "replace", "path": "/spec/targetVolumeName", "value": "%s"}`, targetVolumeName))
+	}
+
+	patchOp, err := common.GetLonghornFinalizerPatchOpIfNeeded(conversion)
+	if err != nil {
+		err := errors.Wrapf(err, "failed to get finalizer patch for DataEngineConversion %v", conversion.Name)
+		return nil, werror.NewInvalidError(err.Error(), "")
+	}
+	if patchOp != "" {
+		patchOps = append(patchOps, patchOp)
+	}
+
+	return patchOps, nil
+}
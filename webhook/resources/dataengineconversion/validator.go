@@ -0,0 +1,112 @@
+package dataengineconversion
+
+import (
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	wcommon "github.com/longhorn/longhorn-manager/webhook/common"
+	werror "github.com/longhorn/longhorn-manager/webhook/error"
+)
+
+type dataEngineConversionValidator struct {
+	admission.DefaultValidator
+	ds *datastore.DataStore
+}
+
+func NewValidator(ds *datastore.DataStore) admission.Validator {
+	return &dataEngineConversionValidator{ds: ds}
+}
+
+func (v *dataEngineConversionValidator) Resource() admission.Resource {
+	return admission.Resource{
+		Name:       "dataengineconversions",
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   longhorn.SchemeGroupVersion.Group,
+		APIVersion: longhorn.SchemeGroupVersion.Version,
+		ObjectType: &longhorn.DataEngineConversion{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Create,
+			admissionregv1.Update,
+		},
+	}
+}
+
+func (v *dataEngineConversionValidator) Create(request *admission.Request, newObj runtime.Object) error {
+	conversion, ok := newObj.(*longhorn.DataEngineConversion)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.DataEngineConversion", newObj), "")
+	}
+
+	if conversion.Spec.VolumeName == "" {
+		return werror.NewInvalidError("volume name is required", "spec.volumeName")
+	}
+	if !types.IsDataEngineV1(conversion.Spec.TargetDataEngine) && !types.IsDataEngineV2(conversion.Spec.TargetDataEngine) {
+		return werror.NewInvalidError(fmt.Sprintf("invalid target data engine %v", conversion.Spec.TargetDataEngine), "spec.targetDataEngine")
+	}
+	if conversion.Spec.TargetVolumeName == conversion.Spec.VolumeName {
+		return werror.NewInvalidError("target volume cannot be the source volume", "spec.targetVolumeName")
+	}
+
+	volume, err := v.ds.GetVolumeRO(conversion.Spec.VolumeName)
+	if err != nil {
+		return werror.NewInvalidError(fmt.Sprintf("failed to get volume %v: %v", conversion.Spec.VolumeName, err), "spec.volumeName")
+	}
+	if volume.Spec.DataEngine == conversion.Spec.TargetDataEngine {
+		return werror.NewInvalidError(fmt.Sprintf("volume %v already uses data engine %v", volume.Name, volume.Spec.DataEngine), "spec.targetDataEngine")
+	}
+	if volume.Spec.Encrypted {
+		return werror.NewInvalidError(fmt.Sprintf("converting encrypted volume %v is not supported", volume.Name), "spec.volumeName")
+	}
+	if volume.Spec.BackingImage != "" {
+		return werror.NewInvalidError(fmt.Sprintf("converting volume %v with backing image %v is not supported", volume.Name, volume.Spec.BackingImage), "spec.volumeName")
+	}
+
+	if _, err := v.ds.GetVolumeRO(conversion.Spec.TargetVolumeName); err == nil {
+		return werror.NewInvalidError(fmt.Sprintf("target volume %v already exists", conversion.Spec.TargetVolumeName), "spec.targetVolumeName")
+	} else if !datastore.ErrorIsNotFound(err) {
+		return werror.NewInternalError(err.Error())
+	}
+
+	conversions, err := v.ds.ListDataEngineConversionsRO()
+	if err != nil {
+		return werror.NewInternalError(err.Error())
+	}
+	for _, c := range conversions {
+		if c.Name == conversion.Name || c.Spec.VolumeName != conversion.Spec.VolumeName {
+			continue
+		}
+		if c.Status.State != longhorn.DataEngineConversionStateCompleted &&
+			c.Status.State != longhorn.DataEngineConversionStateRolledBack &&
+			c.Status.State != longhorn.DataEngineConversionStateError {
+			return werror.NewInvalidError(fmt.Sprintf("volume %v is being converted by %v", volume.Name, c.Name), "spec.volumeName")
+		}
+	}
+
+	return wcommon.ValidateRequiredDataEngineEnabled(v.ds, conversion.Spec.TargetDataEngine)
+}
+
+func (v *dataEngineConversionValidator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) error {
+	oldConversion, ok := oldObj.(*longhorn.DataEngineConversion)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.DataEngineConversion", oldObj), "")
+	}
+	newConversion, ok := newObj.(*longhorn.DataEngineConversion)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.DataEngineConversion", newObj), "")
+	}
+
+	if !reflect.DeepEqual(oldConversion.Spec, newConversion.Spec) {
+		return werror.NewInvalidError(fmt.Sprintf("spec of data engine conversion %v is immutable", newConversion.Name), "spec")
+	}
+
+	return nil
+}
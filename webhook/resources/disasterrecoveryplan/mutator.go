@@ -0,0 +1,74 @@
+package disasterrecoveryplan
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+	"github.com/longhorn/longhorn-manager/webhook/common"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	werror "github.com/longhorn/longhorn-manager/webhook/error"
+)
+
+type disasterRecoveryPlanMutator struct {
+	admission.DefaultMutator
+	ds *datastore.DataStore
+}
+
+func NewMutator(ds *datastore.DataStore) admission.Mutator {
+	return &disasterRecoveryPlanMutator{ds: ds}
+}
+
+func (m *disasterRecoveryPlanMutator) Resource() admission.Resource {
+	return admission.Resource{
+		Name:       "disasterrecoveryplans",
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   longhorn.SchemeGroupVersion.Group,
+		APIVersion: longhorn.SchemeGroupVersion.Version,
+		ObjectType: &longhorn.DisasterRecoveryPlan{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Create,
+			admissionregv1.Update,
+		},
+	}
+}
+
+func (m *disasterRecoveryPlanMutator) Create(request *admission.Request, newObj runtime.Object) (admission.PatchOps, error) {
+	return mutate(newObj)
+}
+
+func (m *disasterRecoveryPlanMutator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) (admission.PatchOps, error) {
+	return mutate(newObj)
+}
+
+// mutate contains functionality shared by Create and Update.
+func mutate(newObj runtime.Object) (admission.PatchOps, error) {
+	plan, ok := newObj.(*longhorn.DisasterRecoveryPlan)
+	if !ok {
+		return nil, werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.DisasterRecoveryPlan", newObj), "")
+	}
+
+	var patchOps admission.PatchOps
+
+	if plan.Spec.Frontend == "" {
+		patchOps = append(patchOps, fmt.Sprintf(`{"op": "replace", "path": "/spec/frontend", "value": "%s"}`, longhorn.VolumeFrontendBlockDev))
+	}
+
+	patchOp, err := common.GetLonghornFinalizerPatchOpIfNeeded(plan)
+	if err != nil {
+		err := errors.Wrapf(err, "failed to get finalizer patch for DisasterRecoveryPlan %v", plan.Name)
+		return nil, werror.NewInvalidError(err.Error(), "")
+	}
+	if patchOp != "" {
+		patchOps = append(patchOps, patchOp)
+	}
+
+	return patchOps, nil
+}
@@ -0,0 +1,91 @@
+package disasterrecoveryplan
+
+import (
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	werror "github.com/longhorn/longhorn-manager/webhook/error"
+)
+
+type disasterRecoveryPlanValidator struct {
+	admission.DefaultValidator
+	ds *datastore.DataStore
+}
+
+func NewValidator(ds *datastore.DataStore) admission.Validator {
+	return &disasterRecoveryPlanValidator{ds: ds}
+}
+
+func (v *disasterRecoveryPlanValidator) Resource() admission.Resource {
+	return admission.Resource{
+		Name:       "disasterrecoveryplans",
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   longhorn.SchemeGroupVersion.Group,
+		APIVersion: longhorn.SchemeGroupVersion.Version,
+		ObjectType: &longhorn.DisasterRecoveryPlan{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Create,
+			admissionregv1.Update,
+		},
+	}
+}
+
+func (v *disasterRecoveryPlanValidator) Create(request *admission.Request, newObj runtime.Object) error {
+	plan, ok := newObj.(*longhorn.DisasterRecoveryPlan)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.DisasterRecoveryPlan", newObj), "")
+	}
+
+	return validate(plan)
+}
+
+func (v *disasterRecoveryPlanValidator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) error {
+	oldPlan, ok := oldObj.(*longhorn.DisasterRecoveryPlan)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.DisasterRecoveryPlan", oldObj), "")
+	}
+	newPlan, ok := newObj.(*longhorn.DisasterRecoveryPlan)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.DisasterRecoveryPlan", newObj), "")
+	}
+
+	switch oldPlan.Status.State {
+	case longhorn.DisasterRecoveryPlanStateActivating,
+		longhorn.DisasterRecoveryPlanStateCreatingPVC,
+		longhorn.DisasterRecoveryPlanStateFailedOver,
+		longhorn.DisasterRecoveryPlanStateError:
+		if !reflect.DeepEqual(oldPlan.Spec, newPlan.Spec) {
+			return werror.NewInvalidError(fmt.Sprintf("spec of disaster recovery plan %v is immutable after the failover started", newPlan.Name), "spec")
+		}
+	}
+
+	return validate(newPlan)
+}
+
+func validate(plan *longhorn.DisasterRecoveryPlan) error {
+	if len(plan.Spec.Volumes) == 0 && len(plan.Spec.VolumeSelector) == 0 {
+		return werror.NewInvalidError("either volumes or volume selector is required", "spec.volumes")
+	}
+
+	if plan.Spec.Frontend != "" && plan.Spec.Frontend != longhorn.VolumeFrontendBlockDev && plan.Spec.Frontend != longhorn.VolumeFrontendISCSI {
+		return werror.NewInvalidError(fmt.Sprintf("invalid frontend %v", plan.Spec.Frontend), "spec.frontend")
+	}
+
+	if plan.Spec.FSType != "" && plan.Spec.FSType != "ext4" && plan.Spec.FSType != "xfs" {
+		return werror.NewInvalidError(fmt.Sprintf("unsupported filesystem type %v", plan.Spec.FSType), "spec.fsType")
+	}
+
+	if plan.Spec.MaxRecoveryPointSkew < 0 {
+		return werror.NewInvalidError("max recovery point skew cannot be negative", "spec.maxRecoveryPointSkew")
+	}
+
+	return nil
+}
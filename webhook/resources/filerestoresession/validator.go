@@ -0,0 +1,90 @@
+package filerestoresession
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	werror "github.com/longhorn/longhorn-manager/webhook/error"
+)
+
+type fileRestoreSessionValidator struct {
+	admission.DefaultValidator
+	ds *datastore.DataStore
+}
+
+func NewValidator(ds *datastore.DataStore) admission.Validator {
+	return &fileRestoreSessionValidator{ds: ds}
+}
+
+func (v *fileRestoreSessionValidator) Resource() admission.Resource {
+	return admission.Resource{
+		Name:       "filerestoresessions",
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   longhorn.SchemeGroupVersion.Group,
+		APIVersion: longhorn.SchemeGroupVersion.Version,
+		ObjectType: &longhorn.FileRestoreSession{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Create,
+			admissionregv1.Update,
+		},
+	}
+}
+
+func (v *fileRestoreSessionValidator) Create(request *admission.Request, newObj runtime.Object) error {
+	session, ok := newObj.(*longhorn.FileRestoreSession)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.FileRestoreSession", newObj), "")
+	}
+
+	if session.Spec.NodeID != "" {
+		if _, err := v.ds.GetNodeRO(session.Spec.NodeID); err != nil {
+			return werror.NewInvalidError(fmt.Sprintf("failed to get node %v: %v", session.Spec.NodeID, err), "spec.nodeID")
+		}
+	}
+
+	return validate(session)
+}
+
+func (v *fileRestoreSessionValidator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) error {
+	oldSession, ok := oldObj.(*longhorn.FileRestoreSession)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.FileRestoreSession", oldObj), "")
+	}
+	newSession, ok := newObj.(*longhorn.FileRestoreSession)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.FileRestoreSession", newObj), "")
+	}
+
+	if oldSession.Spec.BackupName != newSession.Spec.BackupName ||
+		oldSession.Spec.SourceVolume != newSession.Spec.SourceVolume ||
+		oldSession.Spec.SnapshotName != newSession.Spec.SnapshotName ||
+		oldSession.Spec.NodeID != newSession.Spec.NodeID {
+		return werror.NewInvalidError(fmt.Sprintf("source and node of file restore session %v are immutable", newSession.Name), "spec")
+	}
+
+	return validate(newSession)
+}
+
+func validate(session *longhorn.FileRestoreSession) error {
+	isBackup := session.Spec.BackupName != ""
+	isSnapshot := session.Spec.SourceVolume != "" || session.Spec.SnapshotName != ""
+	if isBackup == isSnapshot {
+		return werror.NewInvalidError("either backup name or source volume and snapshot name is required", "spec")
+	}
+	if isSnapshot && (session.Spec.SourceVolume == "" || session.Spec.SnapshotName == "") {
+		return werror.NewInvalidError("both source volume and snapshot name are required to browse a snapshot", "spec")
+	}
+
+	if session.Spec.TTLMinutes < 1 {
+		return werror.NewInvalidError(fmt.Sprintf("invalid TTL %v minutes", session.Spec.TTLMinutes), "spec.ttlMinutes")
+	}
+
+	return nil
+}
@@ -55,6 +55,10 @@ func (n *nodeValidator) Create(request *admission.Request, newObj runtime.Object
 		return werror.NewInvalidError("instanceManagerCPURequest should be greater than or equal to 0", "")
 	}
 
+	if err := types.ValidateNodeSettingOverrides(node.Spec.SettingOverrides); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.settingOverrides")
+	}
+
 	v2DataEngineEnabled, err := n.ds.GetSettingAsBool(types.SettingNameV2DataEngine)
 	if err != nil {
 		err = errors.Wrapf(err, "failed to get spdk setting")
@@ -113,6 +117,10 @@ func (n *nodeValidator) Update(request *admission.Request, oldObj runtime.Object
 		return werror.NewForbiddenError(fmt.Sprintf("spec and status of disks on node %v are being syncing and please retry later.", oldNode.Name))
 	}
 
+	if err := types.ValidateNodeSettingOverrides(newNode.Spec.SettingOverrides); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.settingOverrides")
+	}
+
 	// We need to make sure the tags passed in are valid before updating the node.
 	_, err = util.ValidateTags(newNode.Spec.Tags)
 	if err != nil {
@@ -163,12 +171,16 @@ func (n *nodeValidator) Update(request *admission.Request, oldObj runtime.Object
 		return werror.NewInvalidError(err.Error(), "")
 	}
 
-	// Validate Disks StorageReserved, Tags and Type
+	// Validate Disks StorageReserved, MaxReplicaCount, Tags and Type
 	for name, disk := range newNode.Spec.Disks {
 		if disk.StorageReserved < 0 {
 			return werror.NewInvalidError(fmt.Sprintf("update disk on node %v error: The storageReserved setting of disk %v(%v) is not valid, should be positive and no more than storageMaximum and storageAvailable",
 				newNode.Name, name, disk.Path), "")
 		}
+		if disk.MaxReplicaCount < 0 {
+			return werror.NewInvalidError(fmt.Sprintf("update disk on node %v error: The maxReplicaCount %v of disk %v(%v) should not be negative",
+				newNode.Name, disk.MaxReplicaCount, name, disk.Path), "")
+		}
 		_, err := util.ValidateTags(disk.Tags)
 		if err != nil {
 			return werror.NewInvalidError(err.Error(), "")
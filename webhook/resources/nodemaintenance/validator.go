@@ -0,0 +1,110 @@
+package nodemaintenance
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	werror "github.com/longhorn/longhorn-manager/webhook/error"
+)
+
+type nodeMaintenanceValidator struct {
+	admission.DefaultValidator
+	ds *datastore.DataStore
+}
+
+func NewValidator(ds *datastore.DataStore) admission.Validator {
+	return &nodeMaintenanceValidator{ds: ds}
+}
+
+func (v *nodeMaintenanceValidator) Resource() admission.Resource {
+	return admission.Resource{
+		Name:       "nodemaintenances",
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   longhorn.SchemeGroupVersion.Group,
+		APIVersion: longhorn.SchemeGroupVersion.Version,
+		ObjectType: &longhorn.NodeMaintenance{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Create,
+			admissionregv1.Update,
+		},
+	}
+}
+
+func (v *nodeMaintenanceValidator) Create(request *admission.Request, newObj runtime.Object) error {
+	nodeMaintenance, ok := newObj.(*longhorn.NodeMaintenance)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.NodeMaintenance", newObj), "")
+	}
+
+	if nodeMaintenance.Spec.NodeID == "" {
+		return werror.NewInvalidError("spec.nodeID is required", "spec.nodeID")
+	}
+	if _, err := v.ds.GetNodeRO(nodeMaintenance.Spec.NodeID); err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return werror.NewInvalidError(fmt.Sprintf("node %v is not found", nodeMaintenance.Spec.NodeID), "spec.nodeID")
+		}
+		return werror.NewInternalError(err.Error())
+	}
+
+	switch nodeMaintenance.Spec.ReplicaPolicy {
+	case longhorn.NodeMaintenanceReplicaPolicyEvict, longhorn.NodeMaintenanceReplicaPolicyRebuild:
+	default:
+		return werror.NewInvalidError(fmt.Sprintf("invalid replica policy %v", nodeMaintenance.Spec.ReplicaPolicy), "spec.replicaPolicy")
+	}
+
+	if nodeMaintenance.Spec.Timeout < 0 {
+		return werror.NewInvalidError(fmt.Sprintf("timeout %v cannot be negative", nodeMaintenance.Spec.Timeout), "spec.timeout")
+	}
+
+	nodeMaintenances, err := v.ds.ListNodeMaintenancesByNodeRO(nodeMaintenance.Spec.NodeID)
+	if err != nil {
+		return werror.NewInternalError(err.Error())
+	}
+	for _, existing := range nodeMaintenances {
+		if existing.Name == nodeMaintenance.Name || existing.DeletionTimestamp != nil {
+			continue
+		}
+		if existing.Status.State == longhorn.NodeMaintenanceStateAborted || existing.Status.State == longhorn.NodeMaintenanceStateTimedOut {
+			continue
+		}
+		return werror.NewInvalidError(fmt.Sprintf("node %v is already under maintenance %v", nodeMaintenance.Spec.NodeID, existing.Name), "spec.nodeID")
+	}
+
+	return nil
+}
+
+func (v *nodeMaintenanceValidator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) error {
+	oldNodeMaintenance, ok := oldObj.(*longhorn.NodeMaintenance)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.NodeMaintenance", oldObj), "")
+	}
+	newNodeMaintenance, ok := newObj.(*longhorn.NodeMaintenance)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.NodeMaintenance", newObj), "")
+	}
+
+	if oldNodeMaintenance.Spec.NodeID != newNodeMaintenance.Spec.NodeID {
+		return werror.NewInvalidError("spec.nodeID is immutable", "spec.nodeID")
+	}
+	if oldNodeMaintenance.Spec.ReplicaPolicy != newNodeMaintenance.Spec.ReplicaPolicy {
+		return werror.NewInvalidError("spec.replicaPolicy is immutable", "spec.replicaPolicy")
+	}
+	if oldNodeMaintenance.Spec.DetachManuallyAttachedVolumes != newNodeMaintenance.Spec.DetachManuallyAttachedVolumes {
+		return werror.NewInvalidError("spec.detachManuallyAttachedVolumes is immutable", "spec.detachManuallyAttachedVolumes")
+	}
+	if oldNodeMaintenance.Spec.Abort && !newNodeMaintenance.Spec.Abort {
+		return werror.NewInvalidError("an aborted maintenance cannot be resumed", "spec.abort")
+	}
+	if newNodeMaintenance.Spec.Timeout < 0 {
+		return werror.NewInvalidError(fmt.Sprintf("timeout %v cannot be negative", newNodeMaintenance.Spec.Timeout), "spec.timeout")
+	}
+
+	return nil
+}
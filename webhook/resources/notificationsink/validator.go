@@ -0,0 +1,106 @@
+package notificationsink
+
+import (
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/util/notification"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	werror "github.com/longhorn/longhorn-manager/webhook/error"
+)
+
+type notificationSinkValidator struct {
+	admission.DefaultValidator
+	ds *datastore.DataStore
+}
+
+func NewValidator(ds *datastore.DataStore) admission.Validator {
+	return &notificationSinkValidator{ds: ds}
+}
+
+func (v *notificationSinkValidator) Resource() admission.Resource {
+	return admission.Resource{
+		Name:       "notificationsinks",
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   longhorn.SchemeGroupVersion.Group,
+		APIVersion: longhorn.SchemeGroupVersion.Version,
+		ObjectType: &longhorn.NotificationSink{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Create,
+			admissionregv1.Update,
+		},
+	}
+}
+
+func (v *notificationSinkValidator) Create(request *admission.Request, newObj runtime.Object) error {
+	sink, ok := newObj.(*longhorn.NotificationSink)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.NotificationSink", newObj), "")
+	}
+	return validate(sink)
+}
+
+func (v *notificationSinkValidator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) error {
+	sink, ok := newObj.(*longhorn.NotificationSink)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.NotificationSink", newObj), "")
+	}
+	return validate(sink)
+}
+
+func validate(sink *longhorn.NotificationSink) error {
+	switch sink.Spec.Type {
+	case longhorn.NotificationSinkTypeWebhook, longhorn.NotificationSinkTypeAlertmanager:
+		if err := notification.ValidateURL(sink.Spec.URL); err != nil {
+			return werror.NewInvalidError(err.Error(), "spec.url")
+		}
+	case longhorn.NotificationSinkTypeSMTP:
+		smtp := sink.Spec.SMTP
+		if smtp == nil || smtp.Server == "" {
+			return werror.NewInvalidError("spec.smtp.server is required for the smtp sink", "spec.smtp.server")
+		}
+		if smtp.From == "" {
+			return werror.NewInvalidError("spec.smtp.from is required for the smtp sink", "spec.smtp.from")
+		}
+		if len(smtp.To) == 0 {
+			return werror.NewInvalidError("spec.smtp.to is required for the smtp sink", "spec.smtp.to")
+		}
+	default:
+		return werror.NewInvalidError(fmt.Sprintf("invalid notification sink type %v", sink.Spec.Type), "spec.type")
+	}
+
+	for _, class := range sink.Spec.EventClasses {
+		valid := false
+		for _, supported := range notification.AllEventClasses {
+			if class == supported {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return werror.NewInvalidError(fmt.Sprintf("invalid event class %v", class), "spec.eventClasses")
+		}
+	}
+
+	if sink.Spec.Filter.NamePattern != "" {
+		if _, err := regexp.Compile(sink.Spec.Filter.NamePattern); err != nil {
+			return werror.NewInvalidError(fmt.Sprintf("invalid name pattern %v: %v", sink.Spec.Filter.NamePattern, err), "spec.filter.namePattern")
+		}
+	}
+
+	if sink.Spec.DeduplicationWindow < 0 {
+		return werror.NewInvalidError(fmt.Sprintf("deduplication window %v cannot be negative", sink.Spec.DeduplicationWindow), "spec.deduplicationWindow")
+	}
+	if sink.Spec.RateLimit < 0 {
+		return werror.NewInvalidError(fmt.Sprintf("rate limit %v cannot be negative", sink.Spec.RateLimit), "spec.rateLimit")
+	}
+
+	return nil
+}
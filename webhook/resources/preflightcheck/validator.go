@@ -0,0 +1,85 @@
+package preflightcheck
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	werror "github.com/longhorn/longhorn-manager/webhook/error"
+)
+
+type preflightCheckValidator struct {
+	admission.DefaultValidator
+	ds *datastore.DataStore
+}
+
+func NewValidator(ds *datastore.DataStore) admission.Validator {
+	return &preflightCheckValidator{ds: ds}
+}
+
+func (v *preflightCheckValidator) Resource() admission.Resource {
+	return admission.Resource{
+		Name:       "preflightchecks",
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   longhorn.SchemeGroupVersion.Group,
+		APIVersion: longhorn.SchemeGroupVersion.Version,
+		ObjectType: &longhorn.PreflightCheck{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Create,
+			admissionregv1.Update,
+		},
+	}
+}
+
+func (v *preflightCheckValidator) Create(request *admission.Request, newObj runtime.Object) error {
+	preflightCheck, ok := newObj.(*longhorn.PreflightCheck)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.PreflightCheck", newObj), "")
+	}
+
+	for _, nodeName := range preflightCheck.Spec.Nodes {
+		if _, err := v.ds.GetNodeRO(nodeName); err != nil {
+			if datastore.ErrorIsNotFound(err) {
+				return werror.NewInvalidError(fmt.Sprintf("node %v is not found", nodeName), "spec.nodes")
+			}
+			return werror.NewInternalError(err.Error())
+		}
+	}
+
+	for _, path := range preflightCheck.Spec.DiskPaths {
+		if !filepath.IsAbs(path) {
+			return werror.NewInvalidError(fmt.Sprintf("disk path %v is not an absolute path", path), "spec.diskPaths")
+		}
+	}
+
+	if preflightCheck.Spec.KubeletRootDir != "" && !filepath.IsAbs(preflightCheck.Spec.KubeletRootDir) {
+		return werror.NewInvalidError(fmt.Sprintf("kubelet root directory %v is not an absolute path", preflightCheck.Spec.KubeletRootDir), "spec.kubeletRootDir")
+	}
+
+	return nil
+}
+
+func (v *preflightCheckValidator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) error {
+	oldPreflightCheck, ok := oldObj.(*longhorn.PreflightCheck)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.PreflightCheck", oldObj), "")
+	}
+	newPreflightCheck, ok := newObj.(*longhorn.PreflightCheck)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.PreflightCheck", newObj), "")
+	}
+
+	if !reflect.DeepEqual(oldPreflightCheck.Spec, newPreflightCheck.Spec) {
+		return werror.NewInvalidError("spec of a preflight check is immutable", "spec")
+	}
+
+	return nil
+}
@@ -1,7 +1,12 @@
 package setting
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/pkg/errors"
 
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -9,19 +14,27 @@ import (
 
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
 	"github.com/longhorn/longhorn-manager/webhook/admission"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 	werror "github.com/longhorn/longhorn-manager/webhook/error"
 )
 
+// maxPendingSettingRevisions bounds the revisions waiting in the setting annotation for the setting controller, in case
+// the controller cannot record them for a while.
+const maxPendingSettingRevisions = 100
+
 type settingMutator struct {
 	admission.DefaultMutator
 	ds *datastore.DataStore
+	// managerUsername is the user longhorn-manager is authenticated as. Only longhorn-manager can note the requester
+	// of an update and maintain the pending revisions of a setting.
+	managerUsername string
 }
 
-func NewMutator(ds *datastore.DataStore) admission.Mutator {
-	return &settingMutator{ds: ds}
+func NewMutator(ds *datastore.DataStore, managerUsername string) admission.Mutator {
+	return &settingMutator{ds: ds, managerUsername: managerUsername}
 }
 
 func (s *settingMutator) Resource() admission.Resource {
@@ -43,7 +56,148 @@ func (s *settingMutator) Create(request *admission.Request, newObj runtime.Objec
 }
 
 func (s *settingMutator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) (admission.PatchOps, error) {
-	return s.mutate(newObj)
+	patchOps, err := s.mutate(newObj)
+	if err != nil {
+		return nil, err
+	}
+
+	patchOp, err := s.getSettingUpdateRecordPatchOp(request, oldObj, newObj)
+	if err != nil {
+		return nil, werror.NewInvalidError(err.Error(), "")
+	}
+	if patchOp != "" {
+		patchOps = append(patchOps, patchOp)
+	}
+
+	return patchOps, nil
+}
+
+// getSettingUpdateRecordPatchOp returns the patch op that appends the value change to the pending revisions of the
+// setting, with who changed the value, when and from which value. The setting controller moves the pending revisions
+// into the SettingHistory.
+func (s *settingMutator) getSettingUpdateRecordPatchOp(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) (string, error) {
+	oldSetting, ok := oldObj.(*longhorn.Setting)
+	if !ok {
+		return "", fmt.Errorf("oldObj %v is not a *longhorn.Setting", oldObj)
+	}
+	setting, ok := newObj.(*longhorn.Setting)
+	if !ok {
+		return "", fmt.Errorf("newObj %v is not a *longhorn.Setting", newObj)
+	}
+
+	definition, isExist := types.GetSettingDefinition(types.SettingName(setting.Name))
+	if !isExist {
+		return "", fmt.Errorf("setting %s does not exist", setting.Name)
+	}
+	value, err := datastore.GetSettingValidValue(definition, setting.Value)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get valid value for setting %s", setting.Name)
+	}
+
+	annotations := map[string]string{}
+	for k, v := range setting.Annotations {
+		annotations[k] = v
+	}
+
+	isFromManager := request.Username() == s.managerUsername
+
+	requesterKey := types.GetLonghornLabelKey(types.UpdateSettingRequester)
+	apiRequester, hasRequester := annotations[requesterKey]
+	// The requester annotation is only a hint from the Longhorn API for the current request.
+	delete(annotations, requesterKey)
+
+	// Other users cannot forge the revisions, so their changes to the bookkeeping annotations are reverted
+	if !isFromManager {
+		for _, key := range []string{
+			types.GetLonghornLabelKey(types.SettingPendingRevisions),
+			types.GetLonghornLabelKey(types.SettingLatestRevision),
+		} {
+			if v, ok := oldSetting.Annotations[key]; ok {
+				annotations[key] = v
+			} else {
+				delete(annotations, key)
+			}
+		}
+	}
+
+	if value != oldSetting.Value {
+		revision := longhorn.SettingRevision{
+			OldValue:  oldSetting.Value,
+			NewValue:  value,
+			Requester: request.Username(),
+			Source:    longhorn.SettingUpdateSourceKubernetes,
+			UpdatedAt: util.Now(),
+		}
+		if isFromManager {
+			if _, isFromLH := annotations[types.GetLonghornLabelKey(types.UpdateSettingFromLonghorn)]; isFromLH {
+				revision.Source = longhorn.SettingUpdateSourceLonghorn
+			}
+			if hasRequester {
+				revision.Source = longhorn.SettingUpdateSourceAPI
+				if apiRequester != "" {
+					revision.Requester = apiRequester
+				}
+			}
+		}
+		if err := s.appendPendingSettingRevision(setting.Name, annotations, revision); err != nil {
+			return "", err
+		}
+	}
+
+	if reflect.DeepEqual(annotations, setting.Annotations) || (len(annotations) == 0 && len(setting.Annotations) == 0) {
+		return "", nil
+	}
+
+	bytes, err := json.Marshal(annotations)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get JSON encoding annotations of setting %v", setting.Name)
+	}
+
+	return fmt.Sprintf(`{"op": "add", "path": "/metadata/annotations", "value": %v}`, string(bytes)), nil
+}
+
+// appendPendingSettingRevision numbers the revision after the latest revision of the setting and appends it to the
+// pending revisions in the annotations.
+func (s *settingMutator) appendPendingSettingRevision(settingName string, annotations map[string]string, revision longhorn.SettingRevision) error {
+	latestKey := types.GetLonghornLabelKey(types.SettingLatestRevision)
+	pendingKey := types.GetLonghornLabelKey(types.SettingPendingRevisions)
+
+	var latest int64
+	if v, ok := annotations[latestKey]; ok {
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid latest revision %v of setting %v", v, settingName)
+		}
+		latest = parsed
+	} else {
+		// The revisions recorded before the setting is annotated continue the numbering
+		history, err := s.ds.GetSettingHistoryRO(settingName)
+		if err != nil && !datastore.ErrorIsNotFound(err) {
+			return errors.Wrapf(err, "failed to get history of setting %v", settingName)
+		}
+		if history != nil {
+			latest = history.Status.CurrentRevision
+		}
+	}
+
+	pending, err := types.GetPendingSettingRevisions(annotations)
+	if err != nil {
+		return errors.Wrapf(err, "invalid pending revisions of setting %v", settingName)
+	}
+
+	revision.Revision = latest + 1
+	pending = append(pending, revision)
+	if len(pending) > maxPendingSettingRevisions {
+		pending = pending[len(pending)-maxPendingSettingRevisions:]
+	}
+
+	bytes, err := json.Marshal(pending)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get JSON encoding pending revisions of setting %v", settingName)
+	}
+	annotations[pendingKey] = string(bytes)
+	annotations[latestKey] = strconv.FormatInt(revision.Revision, 10)
+	return nil
 }
 
 // mutate contains functionality shared by Create and Update.
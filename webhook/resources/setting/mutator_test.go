@@ -0,0 +1,225 @@
+package setting
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rancher/wrangler/v3/pkg/webhook"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kubernetes/pkg/controller"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+)
+
+const (
+	testNamespace       = "longhorn-system"
+	testManagerUsername = "system:serviceaccount:longhorn-system:longhorn-service-account"
+	testUsername        = "kubernetes-admin"
+)
+
+func newTestSettingMutator(t *testing.T, histories ...*longhorn.SettingHistory) *settingMutator {
+	kubeClient := fake.NewSimpleClientset()
+	lhClient := lhfake.NewSimpleClientset()
+	extensionsClient := apiextensionsfake.NewSimpleClientset()
+	informerFactories := util.NewInformerFactories(testNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+	ds := datastore.NewDataStore(testNamespace, lhClient, kubeClient, extensionsClient, informerFactories)
+
+	indexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().SettingHistories().Informer().GetIndexer()
+	for _, history := range histories {
+		require.NoError(t, indexer.Add(history))
+	}
+
+	return NewMutator(ds, testManagerUsername).(*settingMutator)
+}
+
+func newTestSettingRequest(username string) *admission.Request {
+	return admission.NewRequest(&webhook.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Update,
+			UserInfo:  authenticationv1.UserInfo{Username: username},
+		},
+	})
+}
+
+func newTestSetting(value string, annotations map[string]string) *longhorn.Setting {
+	return &longhorn.Setting{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        string(types.SettingNameBackupConcurrentLimit),
+			Namespace:   testNamespace,
+			Annotations: annotations,
+		},
+		Value: value,
+	}
+}
+
+// getPatchedAnnotations returns the annotations the patch op sets, or nil if there is no patch op.
+func getPatchedAnnotations(t *testing.T, patchOp string) map[string]string {
+	if patchOp == "" {
+		return nil
+	}
+	var op struct {
+		Op    string            `json:"op"`
+		Path  string            `json:"path"`
+		Value map[string]string `json:"value"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(patchOp), &op))
+	require.Equal(t, "add", op.Op)
+	require.Equal(t, "/metadata/annotations", op.Path)
+	return op.Value
+}
+
+func getPatchedRevisions(t *testing.T, annotations map[string]string) []longhorn.SettingRevision {
+	revisions, err := types.GetPendingSettingRevisions(annotations)
+	require.NoError(t, err)
+	return revisions
+}
+
+func TestGetSettingUpdateRecordPatchOp(t *testing.T) {
+	requesterKey := types.GetLonghornLabelKey(types.UpdateSettingRequester)
+	fromLonghornKey := types.GetLonghornLabelKey(types.UpdateSettingFromLonghorn)
+	pendingKey := types.GetLonghornLabelKey(types.SettingPendingRevisions)
+	latestKey := types.GetLonghornLabelKey(types.SettingLatestRevision)
+
+	t.Run("update through the Longhorn API", func(t *testing.T) {
+		m := newTestSettingMutator(t)
+		patchOp, err := m.getSettingUpdateRecordPatchOp(newTestSettingRequest(testManagerUsername),
+			newTestSetting("1", nil),
+			newTestSetting("2", map[string]string{requesterKey: "10.0.0.1:5000", fromLonghornKey: ""}))
+		require.NoError(t, err)
+
+		annotations := getPatchedAnnotations(t, patchOp)
+		require.NotContains(t, annotations, requesterKey)
+		require.Equal(t, "1", annotations[latestKey])
+		revisions := getPatchedRevisions(t, annotations)
+		require.Len(t, revisions, 1)
+		require.Equal(t, int64(1), revisions[0].Revision)
+		require.Equal(t, "1", revisions[0].OldValue)
+		require.Equal(t, "2", revisions[0].NewValue)
+		require.Equal(t, "10.0.0.1:5000", revisions[0].Requester)
+		require.Equal(t, longhorn.SettingUpdateSourceAPI, revisions[0].Source)
+	})
+
+	t.Run("update by Longhorn", func(t *testing.T) {
+		m := newTestSettingMutator(t)
+		patchOp, err := m.getSettingUpdateRecordPatchOp(newTestSettingRequest(testManagerUsername),
+			newTestSetting("1", nil),
+			newTestSetting("2", map[string]string{fromLonghornKey: ""}))
+		require.NoError(t, err)
+
+		revisions := getPatchedRevisions(t, getPatchedAnnotations(t, patchOp))
+		require.Len(t, revisions, 1)
+		require.Equal(t, testManagerUsername, revisions[0].Requester)
+		require.Equal(t, longhorn.SettingUpdateSourceLonghorn, revisions[0].Source)
+	})
+
+	t.Run("forged requester is replaced by the real user", func(t *testing.T) {
+		m := newTestSettingMutator(t)
+		patchOp, err := m.getSettingUpdateRecordPatchOp(newTestSettingRequest(testUsername),
+			newTestSetting("1", nil),
+			newTestSetting("2", map[string]string{requesterKey: "somebody-else", fromLonghornKey: ""}))
+		require.NoError(t, err)
+
+		annotations := getPatchedAnnotations(t, patchOp)
+		require.NotContains(t, annotations, requesterKey)
+		revisions := getPatchedRevisions(t, annotations)
+		require.Len(t, revisions, 1)
+		require.Equal(t, testUsername, revisions[0].Requester)
+		require.Equal(t, longhorn.SettingUpdateSourceKubernetes, revisions[0].Source)
+	})
+
+	t.Run("updates between syncs are all kept", func(t *testing.T) {
+		m := newTestSettingMutator(t)
+		request := newTestSettingRequest(testUsername)
+
+		oldSetting := newTestSetting("1", nil)
+		newSetting := newTestSetting("2", nil)
+		patchOp, err := m.getSettingUpdateRecordPatchOp(request, oldSetting, newSetting)
+		require.NoError(t, err)
+
+		oldSetting = newTestSetting("2", getPatchedAnnotations(t, patchOp))
+		newSetting = newTestSetting("3", getPatchedAnnotations(t, patchOp))
+		patchOp, err = m.getSettingUpdateRecordPatchOp(request, oldSetting, newSetting)
+		require.NoError(t, err)
+
+		annotations := getPatchedAnnotations(t, patchOp)
+		require.Equal(t, "2", annotations[latestKey])
+		revisions := getPatchedRevisions(t, annotations)
+		require.Len(t, revisions, 2)
+		require.Equal(t, int64(1), revisions[0].Revision)
+		require.Equal(t, "2", revisions[0].NewValue)
+		require.Equal(t, int64(2), revisions[1].Revision)
+		require.Equal(t, "2", revisions[1].OldValue)
+		require.Equal(t, "3", revisions[1].NewValue)
+	})
+
+	t.Run("numbering continues from the history", func(t *testing.T) {
+		m := newTestSettingMutator(t, &longhorn.SettingHistory{
+			ObjectMeta: metav1.ObjectMeta{Name: string(types.SettingNameBackupConcurrentLimit), Namespace: testNamespace},
+			Status:     longhorn.SettingHistoryStatus{CurrentRevision: 7},
+		})
+		patchOp, err := m.getSettingUpdateRecordPatchOp(newTestSettingRequest(testUsername),
+			newTestSetting("1", nil),
+			newTestSetting("2", nil))
+		require.NoError(t, err)
+
+		revisions := getPatchedRevisions(t, getPatchedAnnotations(t, patchOp))
+		require.Len(t, revisions, 1)
+		require.Equal(t, int64(8), revisions[0].Revision)
+	})
+
+	t.Run("users cannot change the pending revisions", func(t *testing.T) {
+		m := newTestSettingMutator(t)
+		recorded := map[string]string{
+			pendingKey: `[{"revision":3,"oldValue":"1","newValue":"2"}]`,
+			latestKey:  "3",
+		}
+		patchOp, err := m.getSettingUpdateRecordPatchOp(newTestSettingRequest(testUsername),
+			newTestSetting("2", recorded),
+			newTestSetting("2", map[string]string{pendingKey: "[]", latestKey: "100"}))
+		require.NoError(t, err)
+		require.Equal(t, recorded, getPatchedAnnotations(t, patchOp))
+	})
+
+	t.Run("Longhorn clears the recorded revisions", func(t *testing.T) {
+		m := newTestSettingMutator(t)
+		patchOp, err := m.getSettingUpdateRecordPatchOp(newTestSettingRequest(testManagerUsername),
+			newTestSetting("2", map[string]string{pendingKey: `[{"revision":3}]`, latestKey: "3"}),
+			newTestSetting("2", map[string]string{latestKey: "3"}))
+		require.NoError(t, err)
+		require.Empty(t, patchOp)
+	})
+
+	t.Run("pending revisions are bounded", func(t *testing.T) {
+		m := newTestSettingMutator(t)
+		pending := make([]string, maxPendingSettingRevisions)
+		for i := range pending {
+			pending[i] = `{"revision":1}`
+		}
+		annotations := map[string]string{
+			pendingKey: "[" + strings.Join(pending, ",") + "]",
+			latestKey:  "1",
+		}
+		patchOp, err := m.getSettingUpdateRecordPatchOp(newTestSettingRequest(testUsername),
+			newTestSetting("1", annotations),
+			newTestSetting("2", annotations))
+		require.NoError(t, err)
+
+		revisions := getPatchedRevisions(t, getPatchedAnnotations(t, patchOp))
+		require.Len(t, revisions, maxPendingSettingRevisions)
+		require.Equal(t, int64(2), revisions[len(revisions)-1].Revision)
+	})
+}
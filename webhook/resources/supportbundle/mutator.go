@@ -1,6 +1,7 @@
 package supportbundle
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/pkg/errors"
@@ -10,6 +11,7 @@ import (
 	admissionregv1 "k8s.io/api/admissionregistration/v1"
 
 	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/webhook/admission"
 	"github.com/longhorn/longhorn-manager/webhook/common"
 
@@ -41,7 +43,36 @@ func (m *supportBundleMutator) Resource() admission.Resource {
 }
 
 func (m *supportBundleMutator) Create(request *admission.Request, newObj runtime.Object) (admission.PatchOps, error) {
-	return mutate(newObj)
+	patchOps, err := mutate(newObj)
+	if err != nil {
+		return nil, err
+	}
+
+	supportBundle := newObj.(*longhorn.SupportBundle)
+
+	redactionEnforced, err := m.ds.GetSettingAsBool(types.SettingNameSupportBundleRedactionEnforced)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to get %v setting", types.SettingNameSupportBundleRedactionEnforced)
+		return nil, werror.NewInvalidError(err.Error(), "")
+	}
+	if redactionEnforced {
+		redactionPolicy := longhorn.SupportBundleRedactionPolicy{
+			Secrets:                 true,
+			BackupTargetCredentials: true,
+			IPAddresses:             true,
+			Hostnames:               true,
+		}
+		if supportBundle.Spec.RedactionPolicy != redactionPolicy {
+			bytes, err := json.Marshal(redactionPolicy)
+			if err != nil {
+				err = errors.Wrapf(err, "failed to get JSON encoding for supportBundle %v redactionPolicy", supportBundle.Name)
+				return nil, werror.NewInvalidError(err.Error(), "")
+			}
+			patchOps = append(patchOps, fmt.Sprintf(`{"op": "add", "path": "/spec/redactionPolicy", "value": %s}`, string(bytes)))
+		}
+	}
+
+	return patchOps, nil
 }
 
 func (m *supportBundleMutator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) (admission.PatchOps, error) {
@@ -2,6 +2,7 @@ package supportbundle
 
 import (
 	"fmt"
+	"reflect"
 
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util/supportbundle"
 	"github.com/longhorn/longhorn-manager/webhook/admission"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
@@ -33,11 +35,21 @@ func (v *supportBundleValidator) Resource() admission.Resource {
 		ObjectType: &longhorn.SupportBundle{},
 		OperationTypes: []admissionregv1.OperationType{
 			admissionregv1.Create,
+			admissionregv1.Update,
 		},
 	}
 }
 
 func (v *supportBundleValidator) Create(request *admission.Request, newObj runtime.Object) error {
+	newSupportBundle, ok := newObj.(*longhorn.SupportBundle)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.SupportBundle", newObj), "")
+	}
+
+	if _, _, err := supportbundle.ParseScopeTimeWindow(newSupportBundle.Spec.Scope); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.scope")
+	}
+
 	supportBundles, err := v.ds.ListSupportBundlesRO()
 	if err != nil {
 		return err
@@ -74,3 +86,23 @@ func (v *supportBundleValidator) Create(request *admission.Request, newObj runti
 
 	return nil
 }
+
+func (v *supportBundleValidator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) error {
+	oldSupportBundle, ok := oldObj.(*longhorn.SupportBundle)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.SupportBundle", oldObj), "")
+	}
+	newSupportBundle, ok := newObj.(*longhorn.SupportBundle)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.SupportBundle", newObj), "")
+	}
+
+	if !reflect.DeepEqual(oldSupportBundle.Spec.Scope, newSupportBundle.Spec.Scope) {
+		return werror.NewInvalidError("spec.scope field is immutable", "spec.scope")
+	}
+	if oldSupportBundle.Spec.RedactionPolicy != newSupportBundle.Spec.RedactionPolicy {
+		return werror.NewInvalidError("spec.redactionPolicy field is immutable", "spec.redactionPolicy")
+	}
+
+	return nil
+}
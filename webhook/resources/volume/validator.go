@@ -110,6 +110,18 @@ func (v *volumeValidator) Create(request *admission.Request, newObj runtime.Obje
 		return werror.NewInvalidError(err.Error(), "spec.replicaRebuildingBandwidthLimit")
 	}
 
+	if err := types.ValidateVolumeTieringPolicy(volume.Spec.DataEngine, volume.Spec.TieringPolicy); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.tieringPolicy")
+	}
+
+	if err := types.ValidateVolumeAutoExpansionPolicy(volume.Spec.AutoExpansionPolicy); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.autoExpansionPolicy")
+	}
+
+	if volume.Spec.RecoveryPointObjective < 0 {
+		return werror.NewInvalidError("recovery point objective cannot be negative", "spec.recoveryPointObjective")
+	}
+
 	if volume.Spec.BackingImage != "" {
 		backingImage, err := v.ds.GetBackingImage(volume.Spec.BackingImage)
 		if err != nil {
@@ -286,6 +298,18 @@ func (v *volumeValidator) Update(request *admission.Request, oldObj runtime.Obje
 		return werror.NewInvalidError(err.Error(), "spec.replicaRebuildingBandwidthLimit")
 	}
 
+	if err := types.ValidateVolumeTieringPolicy(newVolume.Spec.DataEngine, newVolume.Spec.TieringPolicy); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.tieringPolicy")
+	}
+
+	if err := types.ValidateVolumeAutoExpansionPolicy(newVolume.Spec.AutoExpansionPolicy); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.autoExpansionPolicy")
+	}
+
+	if newVolume.Spec.RecoveryPointObjective < 0 {
+		return werror.NewInvalidError("recovery point objective cannot be negative", "spec.recoveryPointObjective")
+	}
+
 	if oldVolume.Spec.DataEngine != "" {
 		if oldVolume.Spec.DataEngine != newVolume.Spec.DataEngine {
 			err := fmt.Errorf("changing data engine for volume %v is not supported", oldVolume.Name)
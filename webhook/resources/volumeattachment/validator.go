@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strconv"
 
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -49,6 +50,10 @@ func (v *volumeAttachmentValidator) Create(request *admission.Request, newObj ru
 		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.VolumeAttachment", newObj), "")
 	}
 
+	if err := verifyExternalAttachmentTickets(va.Spec.AttachmentTickets); err != nil {
+		return err
+	}
+
 	return verifyAttachmentTicketIDConsistency(va.Spec.AttachmentTickets)
 }
 
@@ -87,6 +92,10 @@ func (v *volumeAttachmentValidator) Update(request *admission.Request, oldObj ru
 		return err
 	}
 
+	if err := verifyExternalAttachmentTickets(newVA.Spec.AttachmentTickets); err != nil {
+		return err
+	}
+
 	return verifyAttachmentTicketIDConsistency(newVA.Spec.AttachmentTickets)
 }
 
@@ -99,6 +108,47 @@ func verifyAttachmentTicketIDConsistency(attachmentTickets map[string]*longhorn.
 	return nil
 }
 
+func verifyExternalAttachmentTickets(attachmentTickets map[string]*longhorn.AttachmentTicket) error {
+	reservedNodeID := ""
+	for _, ticket := range attachmentTickets {
+		if ticket.Type != longhorn.AttacherTypeExternal {
+			continue
+		}
+		if ticket.NodeID == "" {
+			return werror.NewInvalidError(fmt.Sprintf("external attachment ticket %v does not request a node", ticket.ID), "spec.attachmentTickets")
+		}
+		if priority, ok := ticket.Parameters[longhorn.AttachmentParameterPriority]; ok {
+			value, err := strconv.Atoi(priority)
+			if err != nil || value < longhorn.AttacherPriorityLevelExternalMin || value > longhorn.AttacherPriorityLevelExternalMax {
+				msg := fmt.Sprintf("invalid priority %v of external attachment ticket %v, it should be between %v and %v",
+					priority, ticket.ID, longhorn.AttacherPriorityLevelExternalMin, longhorn.AttacherPriorityLevelExternalMax)
+				return werror.NewInvalidError(msg, "spec.attachmentTickets")
+			}
+		}
+		if expiresAt, ok := ticket.Parameters[longhorn.AttachmentParameterExpiresAt]; ok {
+			if _, err := util.ParseTime(expiresAt); err != nil {
+				msg := fmt.Sprintf("invalid expiration time %v of external attachment ticket %v", expiresAt, ticket.ID)
+				return werror.NewInvalidError(msg, "spec.attachmentTickets")
+			}
+		}
+		if reservation, ok := ticket.Parameters[longhorn.AttachmentParameterReservation]; ok {
+			if reservation != longhorn.TrueValue && reservation != longhorn.FalseValue {
+				msg := fmt.Sprintf("invalid reservation %v of external attachment ticket %v", reservation, ticket.ID)
+				return werror.NewInvalidError(msg, "spec.attachmentTickets")
+			}
+		}
+		if !longhorn.IsReservationAttachmentTicket(ticket) {
+			continue
+		}
+		if reservedNodeID != "" && reservedNodeID != ticket.NodeID {
+			msg := fmt.Sprintf("cannot reserve the volume for both node %v and node %v", reservedNodeID, ticket.NodeID)
+			return werror.NewInvalidError(msg, "spec.attachmentTickets")
+		}
+		reservedNodeID = ticket.NodeID
+	}
+	return nil
+}
+
 func (v *volumeAttachmentValidator) verifyTicketCountForMigratableVolume(va *longhorn.VolumeAttachment) error {
 	vol, err := v.ds.GetVolumeRO(va.Spec.Volume)
 	if err != nil {
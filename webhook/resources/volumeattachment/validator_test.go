@@ -0,0 +1,42 @@
+package volumeattachment
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func TestVerifyExternalAttachmentTicketPriority(t *testing.T) {
+	testCases := map[string]struct {
+		priority string
+		valid    bool
+	}{
+		"zero":          {priority: "0"},
+		"minimum":       {priority: strconv.Itoa(longhorn.AttacherPriorityLevelExternalMin), valid: true},
+		"maximum":       {priority: strconv.Itoa(longhorn.AttacherPriorityLevelExternalMax), valid: true},
+		"above maximum": {priority: strconv.Itoa(longhorn.AttacherPriorityLevelExternalMax + 1)},
+		"not a number":  {priority: "high"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tickets := map[string]*longhorn.AttachmentTicket{
+				"external-1": {
+					ID:         "external-1",
+					Type:       longhorn.AttacherTypeExternal,
+					NodeID:     "node-1",
+					Parameters: map[string]string{longhorn.AttachmentParameterPriority: tc.priority},
+				},
+			}
+			err := verifyExternalAttachmentTickets(tickets)
+			if tc.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, "it should be between 1 and 899")
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package volumeshrink
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+	"github.com/longhorn/longhorn-manager/webhook/common"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	werror "github.com/longhorn/longhorn-manager/webhook/error"
+)
+
+type volumeShrinkMutator struct {
+	admission.DefaultMutator
+	ds *datastore.DataStore
+}
+
+func NewMutator(ds *datastore.DataStore) admission.Mutator {
+	return &volumeShrinkMutator{ds: ds}
+}
+
+func (m *volumeShrinkMutator) Resource() admission.Resource {
+	return admission.Resource{
+		Name:       "volumeshrinks",
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   longhorn.SchemeGroupVersion.Group,
+		APIVersion: longhorn.SchemeGroupVersion.Version,
+		ObjectType: &longhorn.VolumeShrink{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Create,
+			admissionregv1.Update,
+		},
+	}
+}
+
+func (m *volumeShrinkMutator) Create(request *admission.Request, newObj runtime.Object) (admission.PatchOps, error) {
+	shrink, ok := newObj.(*longhorn.VolumeShrink)
+	if !ok {
+		return nil, werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.VolumeShrink", newObj), "")
+	}
+
+	var patchOps admission.PatchOps
+
+	if shrink.Spec.TargetVolumeName == "" && shrink.Spec.VolumeName != "" {
+		targetVolumeName := types.GetVolumeShrinkTargetVolumeName(shrink.Spec.VolumeName)
+		patchOps = append(patchOps, fmt.Sprintf(`{"op": "replace", "path": "/spec/targetVolumeName", "value": "%s"}`, targetVolumeName))
+	}
+	if shrink.Spec.MarginPercentage == 0 {
+		patchOps = append(patchOps, fmt.Sprintf(`{"op": "replace", "path": "/spec/marginPercentage", "value": %v}`, types.VolumeShrinkDefaultMarginPercentage))
+	}
+	// The volume mutator rounds the size of the target volume up the same way
+	if size := util.RoundUpSize(shrink.Spec.Size); shrink.Spec.Size > 0 && size != shrink.Spec.Size {
+		patchOps = append(patchOps, fmt.Sprintf(`{"op": "replace", "path": "/spec/size", "value": "%v"}`, size))
+	}
+
+	patchOps, err := appendFinalizerPatchOp(shrink, patchOps)
+	if err != nil {
+		return nil, err
+	}
+	return patchOps, nil
+}
+
+func (m *volumeShrinkMutator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) (admission.PatchOps, error) {
+	shrink, ok := newObj.(*longhorn.VolumeShrink)
+	if !ok {
+		return nil, werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.VolumeShrink", newObj), "")
+	}
+	return appendFinalizerPatchOp(shrink, nil)
+}
+
+func appendFinalizerPatchOp(shrink *longhorn.VolumeShrink, patchOps admission.PatchOps) (admission.PatchOps, error) {
+	patchOp, err := common.GetLonghornFinalizerPatchOpIfNeeded(shrink)
+	if err != nil {
+		err := errors.Wrapf(err, "failed to get finalizer patch for VolumeShrink %v", shrink.Name)
+		return nil, werror.NewInvalidError(err.Error(), "")
+	}
+	if patchOp != "" {
+		patchOps = append(patchOps, patchOp)
+	}
+	return patchOps, nil
+}
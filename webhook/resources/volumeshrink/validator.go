@@ -0,0 +1,124 @@
+package volumeshrink
+
+import (
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	werror "github.com/longhorn/longhorn-manager/webhook/error"
+)
+
+type volumeShrinkValidator struct {
+	admission.DefaultValidator
+	ds *datastore.DataStore
+}
+
+func NewValidator(ds *datastore.DataStore) admission.Validator {
+	return &volumeShrinkValidator{ds: ds}
+}
+
+func (v *volumeShrinkValidator) Resource() admission.Resource {
+	return admission.Resource{
+		Name:       "volumeshrinks",
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   longhorn.SchemeGroupVersion.Group,
+		APIVersion: longhorn.SchemeGroupVersion.Version,
+		ObjectType: &longhorn.VolumeShrink{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Create,
+			admissionregv1.Update,
+		},
+	}
+}
+
+func (v *volumeShrinkValidator) Create(request *admission.Request, newObj runtime.Object) error {
+	shrink, ok := newObj.(*longhorn.VolumeShrink)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.VolumeShrink", newObj), "")
+	}
+
+	if shrink.Spec.VolumeName == "" {
+		return werror.NewInvalidError("volume name is required", "spec.volumeName")
+	}
+	if shrink.Spec.Size <= 0 {
+		return werror.NewInvalidError(fmt.Sprintf("invalid size %v", shrink.Spec.Size), "spec.size")
+	}
+	if shrink.Spec.MarginPercentage < 0 {
+		return werror.NewInvalidError(fmt.Sprintf("invalid margin percentage %v", shrink.Spec.MarginPercentage), "spec.marginPercentage")
+	}
+	if shrink.Spec.TargetVolumeName == shrink.Spec.VolumeName {
+		return werror.NewInvalidError("target volume cannot be the source volume", "spec.targetVolumeName")
+	}
+	if !util.ValidateName(shrink.Spec.TargetVolumeName) {
+		return werror.NewInvalidError(fmt.Sprintf("invalid target volume name %v", shrink.Spec.TargetVolumeName), "spec.targetVolumeName")
+	}
+
+	volume, err := v.ds.GetVolumeRO(shrink.Spec.VolumeName)
+	if err != nil {
+		return werror.NewInvalidError(fmt.Sprintf("failed to get volume %v: %v", shrink.Spec.VolumeName, err), "spec.volumeName")
+	}
+	if shrink.Spec.Size >= volume.Spec.Size {
+		return werror.NewInvalidError(fmt.Sprintf("size %v is not smaller than size %v of volume %v", shrink.Spec.Size, volume.Spec.Size, volume.Name), "spec.size")
+	}
+	if volume.Spec.Encrypted {
+		return werror.NewInvalidError(fmt.Sprintf("shrinking encrypted volume %v is not supported", volume.Name), "spec.volumeName")
+	}
+	if volume.Status.KubernetesStatus.PVName == "" {
+		return werror.NewInvalidError(fmt.Sprintf("volume %v is not bound to a PV", volume.Name), "spec.volumeName")
+	}
+	usage := volume.Status.FilesystemUsage
+	if usage == nil {
+		return werror.NewInvalidError(fmt.Sprintf("filesystem usage of volume %v is not reported, the volume needs to be used by a workload first", volume.Name), "spec.volumeName")
+	}
+	if requiredSize := types.GetVolumeShrinkRequiredSize(usage.UsedBytes, shrink.Spec.MarginPercentage); requiredSize > shrink.Spec.Size {
+		return werror.NewInvalidError(fmt.Sprintf("size %v is smaller than %v, the used space %v of volume %v plus %v%% margin",
+			shrink.Spec.Size, requiredSize, usage.UsedBytes, volume.Name, shrink.Spec.MarginPercentage), "spec.size")
+	}
+
+	if _, err := v.ds.GetVolumeRO(shrink.Spec.TargetVolumeName); err == nil {
+		return werror.NewInvalidError(fmt.Sprintf("target volume %v already exists", shrink.Spec.TargetVolumeName), "spec.targetVolumeName")
+	} else if !datastore.ErrorIsNotFound(err) {
+		return werror.NewInternalError(err.Error())
+	}
+
+	shrinks, err := v.ds.ListVolumeShrinksRO()
+	if err != nil {
+		return werror.NewInternalError(err.Error())
+	}
+	for _, s := range shrinks {
+		if s.Name == shrink.Name || s.Spec.VolumeName != shrink.Spec.VolumeName {
+			continue
+		}
+		if s.Status.State != longhorn.VolumeShrinkStateCompleted && s.Status.State != longhorn.VolumeShrinkStateError {
+			return werror.NewInvalidError(fmt.Sprintf("volume %v is being shrunk by %v", volume.Name, s.Name), "spec.volumeName")
+		}
+	}
+
+	return nil
+}
+
+func (v *volumeShrinkValidator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) error {
+	oldShrink, ok := oldObj.(*longhorn.VolumeShrink)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.VolumeShrink", oldObj), "")
+	}
+	newShrink, ok := newObj.(*longhorn.VolumeShrink)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.VolumeShrink", newObj), "")
+	}
+
+	if !reflect.DeepEqual(oldShrink.Spec, newShrink.Spec) {
+		return werror.NewInvalidError(fmt.Sprintf("spec of volume shrink %v is immutable", newShrink.Name), "spec")
+	}
+
+	return nil
+}
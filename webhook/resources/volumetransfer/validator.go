@@ -0,0 +1,157 @@
+package volumetransfer
+
+import (
+	"fmt"
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	werror "github.com/longhorn/longhorn-manager/webhook/error"
+)
+
+type volumeTransferValidator struct {
+	admission.DefaultValidator
+	ds *datastore.DataStore
+}
+
+func NewValidator(ds *datastore.DataStore) admission.Validator {
+	return &volumeTransferValidator{ds: ds}
+}
+
+func (v *volumeTransferValidator) Resource() admission.Resource {
+	return admission.Resource{
+		Name:       "volumetransfers",
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   longhorn.SchemeGroupVersion.Group,
+		APIVersion: longhorn.SchemeGroupVersion.Version,
+		ObjectType: &longhorn.VolumeTransfer{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Create,
+			admissionregv1.Update,
+		},
+	}
+}
+
+func (v *volumeTransferValidator) Create(request *admission.Request, newObj runtime.Object) error {
+	transfer, ok := newObj.(*longhorn.VolumeTransfer)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.VolumeTransfer", newObj), "")
+	}
+
+	if transfer.Spec.VolumeName == "" {
+		return werror.NewInvalidError("volume name is required", "spec.volumeName")
+	}
+	if transfer.Spec.CredentialSecret == "" {
+		return werror.NewInvalidError("credential secret is required", "spec.credentialSecret")
+	}
+
+	switch transfer.Spec.Role {
+	case longhorn.VolumeTransferRoleSource:
+		if err := v.validateSource(transfer); err != nil {
+			return err
+		}
+	case longhorn.VolumeTransferRoleDestination:
+		if err := v.validateDestination(transfer); err != nil {
+			return err
+		}
+	default:
+		return werror.NewInvalidError(fmt.Sprintf("invalid role %v", transfer.Spec.Role), "spec.role")
+	}
+
+	transfers, err := v.ds.ListVolumeTransfersRO()
+	if err != nil {
+		return werror.NewInternalError(err.Error())
+	}
+	for _, t := range transfers {
+		if t.Name == transfer.Name || t.Spec.VolumeName != transfer.Spec.VolumeName {
+			continue
+		}
+		if t.Status.State != longhorn.VolumeTransferStateCompleted && t.Status.State != longhorn.VolumeTransferStateError {
+			return werror.NewInvalidError(fmt.Sprintf("volume %v is being transferred by %v", transfer.Spec.VolumeName, t.Name), "spec.volumeName")
+		}
+	}
+
+	return nil
+}
+
+func (v *volumeTransferValidator) validateSource(transfer *longhorn.VolumeTransfer) error {
+	volume, err := v.ds.GetVolumeRO(transfer.Spec.VolumeName)
+	if err != nil {
+		return werror.NewInvalidError(fmt.Sprintf("failed to get volume %v: %v", transfer.Spec.VolumeName, err), "spec.volumeName")
+	}
+	// The source reads the snapshots from the v1 replica files, which do not include the data of a backing image
+	if volume.Spec.DataEngine != longhorn.DataEngineTypeV1 {
+		return werror.NewInvalidError(fmt.Sprintf("volume %v does not use the v1 data engine", volume.Name), "spec.volumeName")
+	}
+	if volume.Spec.BackingImage != "" {
+		return werror.NewInvalidError(fmt.Sprintf("volume %v with backing image %v cannot be transferred", volume.Name, volume.Spec.BackingImage), "spec.volumeName")
+	}
+	// The token and the volume data are sent to the destination, so the connection must be encrypted
+	remoteURL, err := url.Parse(transfer.Spec.RemoteURL)
+	if err != nil || remoteURL.Scheme != "https" || remoteURL.Host == "" {
+		return werror.NewInvalidError(fmt.Sprintf("invalid remote URL %v, an HTTPS URL is required", transfer.Spec.RemoteURL), "spec.remoteURL")
+	}
+	if transfer.Spec.SyncIntervalSeconds < 0 {
+		return werror.NewInvalidError("sync interval cannot be negative", "spec.syncIntervalSeconds")
+	}
+	return nil
+}
+
+func (v *volumeTransferValidator) validateDestination(transfer *longhorn.VolumeTransfer) error {
+	if !util.ValidateName(transfer.Spec.VolumeName) {
+		return werror.NewInvalidError(fmt.Sprintf("invalid volume name %v", transfer.Spec.VolumeName), "spec.volumeName")
+	}
+	if _, err := v.ds.GetVolumeRO(transfer.Spec.VolumeName); err == nil {
+		return werror.NewInvalidError(fmt.Sprintf("volume %v already exists", transfer.Spec.VolumeName), "spec.volumeName")
+	} else if !datastore.ErrorIsNotFound(err) {
+		return werror.NewInternalError(err.Error())
+	}
+	if transfer.Spec.Size <= 0 {
+		return werror.NewInvalidError("size of the destination volume is required", "spec.size")
+	}
+	if transfer.Spec.NumberOfReplicas < 0 {
+		return werror.NewInvalidError("number of replicas cannot be negative", "spec.numberOfReplicas")
+	}
+	if transfer.Spec.Cutover {
+		return werror.NewInvalidError("cutover is requested on the source", "spec.cutover")
+	}
+	return nil
+}
+
+func (v *volumeTransferValidator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) error {
+	oldTransfer, ok := oldObj.(*longhorn.VolumeTransfer)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.VolumeTransfer", oldObj), "")
+	}
+	newTransfer, ok := newObj.(*longhorn.VolumeTransfer)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.VolumeTransfer", newObj), "")
+	}
+
+	if oldTransfer.Spec.Cutover && !newTransfer.Spec.Cutover {
+		return werror.NewInvalidError("cutover cannot be canceled", "spec.cutover")
+	}
+	if newTransfer.Spec.Role == longhorn.VolumeTransferRoleDestination && newTransfer.Spec.Cutover {
+		return werror.NewInvalidError("cutover is requested on the source", "spec.cutover")
+	}
+	if newTransfer.Spec.SyncIntervalSeconds < 0 {
+		return werror.NewInvalidError("sync interval cannot be negative", "spec.syncIntervalSeconds")
+	}
+
+	// Only the sync interval and the cutover can be changed during the transfer
+	oldSpec := oldTransfer.Spec
+	oldSpec.SyncIntervalSeconds = newTransfer.Spec.SyncIntervalSeconds
+	oldSpec.Cutover = newTransfer.Spec.Cutover
+	if oldSpec != newTransfer.Spec {
+		return werror.NewInvalidError(fmt.Sprintf("spec of volume transfer %v is immutable except the sync interval and the cutover", newTransfer.Name), "spec")
+	}
+
+	return nil
+}
@@ -14,11 +14,17 @@ import (
 	"github.com/longhorn/longhorn-manager/webhook/resources/backupbackingimage"
 	"github.com/longhorn/longhorn-manager/webhook/resources/backuptarget"
 	"github.com/longhorn/longhorn-manager/webhook/resources/backupvolume"
+	"github.com/longhorn/longhorn-manager/webhook/resources/dataengineconversion"
+	"github.com/longhorn/longhorn-manager/webhook/resources/disasterrecoveryplan"
 	"github.com/longhorn/longhorn-manager/webhook/resources/engine"
 	"github.com/longhorn/longhorn-manager/webhook/resources/engineimage"
+	"github.com/longhorn/longhorn-manager/webhook/resources/filerestoresession"
 	"github.com/longhorn/longhorn-manager/webhook/resources/instancemanager"
 	"github.com/longhorn/longhorn-manager/webhook/resources/node"
+	"github.com/longhorn/longhorn-manager/webhook/resources/nodemaintenance"
+	"github.com/longhorn/longhorn-manager/webhook/resources/notificationsink"
 	"github.com/longhorn/longhorn-manager/webhook/resources/orphan"
+	"github.com/longhorn/longhorn-manager/webhook/resources/preflightcheck"
 	"github.com/longhorn/longhorn-manager/webhook/resources/recurringjob"
 	"github.com/longhorn/longhorn-manager/webhook/resources/replica"
 	"github.com/longhorn/longhorn-manager/webhook/resources/setting"
@@ -28,9 +34,11 @@ import (
 	"github.com/longhorn/longhorn-manager/webhook/resources/systembackup"
 	"github.com/longhorn/longhorn-manager/webhook/resources/volume"
 	"github.com/longhorn/longhorn-manager/webhook/resources/volumeattachment"
+	"github.com/longhorn/longhorn-manager/webhook/resources/volumeshrink"
+	"github.com/longhorn/longhorn-manager/webhook/resources/volumetransfer"
 )
 
-func Mutation(ds *datastore.DataStore) (http.Handler, []admission.Resource, error) {
+func Mutation(ds *datastore.DataStore, managerUsername string) (http.Handler, []admission.Resource, error) {
 	resources := []admission.Resource{}
 	mutators := []admission.Mutator{
 		backup.NewMutator(ds),
@@ -43,6 +51,14 @@ func Mutation(ds *datastore.DataStore) (http.Handler, []admission.Resource, erro
 		recurringjob.NewMutator(ds),
 		engineimage.NewMutator(ds),
 		orphan.NewMutator(ds),
+		nodemaintenance.NewMutator(ds),
+		notificationsink.NewMutator(ds),
+		preflightcheck.NewMutator(ds),
+		disasterrecoveryplan.NewMutator(ds),
+		filerestoresession.NewMutator(ds),
+		dataengineconversion.NewMutator(ds),
+		volumetransfer.NewMutator(ds),
+		volumeshrink.NewMutator(ds),
 		sharemanager.NewMutator(ds),
 		backuptarget.NewMutator(ds),
 		backupvolume.NewMutator(ds),
@@ -53,7 +69,7 @@ func Mutation(ds *datastore.DataStore) (http.Handler, []admission.Resource, erro
 		volumeattachment.NewMutator(ds),
 		instancemanager.NewMutator(ds),
 		backupbackingimage.NewMutator(ds),
-		setting.NewMutator(ds),
+		setting.NewMutator(ds, managerUsername),
 	}
 
 	router := webhook.NewRouter()
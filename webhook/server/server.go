@@ -38,14 +38,17 @@ type WebhookServer struct {
 	namespace   string
 	webhookType string
 	clients     *client.Clients
+
+	serviceAccount string
 }
 
-func New(ctx context.Context, namespace, webhookType string, clients *client.Clients) *WebhookServer {
+func New(ctx context.Context, namespace, webhookType string, clients *client.Clients, serviceAccount string) *WebhookServer {
 	return &WebhookServer{
-		context:     ctx,
-		namespace:   namespace,
-		webhookType: webhookType,
-		clients:     clients,
+		context:        ctx,
+		namespace:      namespace,
+		webhookType:    webhookType,
+		clients:        clients,
+		serviceAccount: serviceAccount,
 	}
 }
 
@@ -54,7 +57,7 @@ func (s *WebhookServer) admissionWebhookListenAndServe() error {
 	if err != nil {
 		return err
 	}
-	mutationHandler, mutationResources, err := Mutation(s.clients.Datastore)
+	mutationHandler, mutationResources, err := Mutation(s.clients.Datastore, types.GetServiceAccountUsername(s.namespace, s.serviceAccount))
 	if err != nil {
 		return err
 	}
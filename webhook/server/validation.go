@@ -14,12 +14,18 @@ import (
 	"github.com/longhorn/longhorn-manager/webhook/resources/backupbackingimage"
 	"github.com/longhorn/longhorn-manager/webhook/resources/backuptarget"
 	"github.com/longhorn/longhorn-manager/webhook/resources/backupvolume"
+	"github.com/longhorn/longhorn-manager/webhook/resources/dataengineconversion"
+	"github.com/longhorn/longhorn-manager/webhook/resources/disasterrecoveryplan"
 	"github.com/longhorn/longhorn-manager/webhook/resources/engine"
 	"github.com/longhorn/longhorn-manager/webhook/resources/engineimage"
+	"github.com/longhorn/longhorn-manager/webhook/resources/filerestoresession"
 	"github.com/longhorn/longhorn-manager/webhook/resources/instancemanager"
 	"github.com/longhorn/longhorn-manager/webhook/resources/node"
+	"github.com/longhorn/longhorn-manager/webhook/resources/nodemaintenance"
+	"github.com/longhorn/longhorn-manager/webhook/resources/notificationsink"
 	"github.com/longhorn/longhorn-manager/webhook/resources/orphan"
 	"github.com/longhorn/longhorn-manager/webhook/resources/persistentvolumeclaim"
+	"github.com/longhorn/longhorn-manager/webhook/resources/preflightcheck"
 	"github.com/longhorn/longhorn-manager/webhook/resources/recurringjob"
 	"github.com/longhorn/longhorn-manager/webhook/resources/replica"
 	"github.com/longhorn/longhorn-manager/webhook/resources/setting"
@@ -29,6 +35,8 @@ import (
 	"github.com/longhorn/longhorn-manager/webhook/resources/systemrestore"
 	"github.com/longhorn/longhorn-manager/webhook/resources/volume"
 	"github.com/longhorn/longhorn-manager/webhook/resources/volumeattachment"
+	"github.com/longhorn/longhorn-manager/webhook/resources/volumeshrink"
+	"github.com/longhorn/longhorn-manager/webhook/resources/volumetransfer"
 )
 
 func Validation(ds *datastore.DataStore) (http.Handler, []admission.Resource, error) {
@@ -49,6 +57,14 @@ func Validation(ds *datastore.DataStore) (http.Handler, []admission.Resource, er
 		backuptarget.NewValidator(ds),
 		volume.NewValidator(ds, currentNodeID),
 		orphan.NewValidator(ds),
+		nodemaintenance.NewValidator(ds),
+		notificationsink.NewValidator(ds),
+		preflightcheck.NewValidator(ds),
+		disasterrecoveryplan.NewValidator(ds),
+		filerestoresession.NewValidator(ds),
+		dataengineconversion.NewValidator(ds),
+		volumetransfer.NewValidator(ds),
+		volumeshrink.NewValidator(ds),
 		snapshot.NewValidator(ds),
 		supportbundle.NewValidator(ds),
 		systembackup.NewValidator(ds),